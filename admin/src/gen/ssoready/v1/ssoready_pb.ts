@@ -4,7 +4,231 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Empty, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
+import { Empty, FieldMask, Message, proto3, Struct, Timestamp } from "@bufbuild/protobuf";
+
+/**
+ * @generated from enum ssoready.v1.SAMLAttributeMappingPreset
+ */
+export enum SAMLAttributeMappingPreset {
+  /**
+   * @generated from enum value: SAML_ATTRIBUTE_MAPPING_PRESET_UNSPECIFIED = 0;
+   */
+  SAML_ATTRIBUTE_MAPPING_PRESET_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SAML_ATTRIBUTE_MAPPING_PRESET_OKTA = 1;
+   */
+  SAML_ATTRIBUTE_MAPPING_PRESET_OKTA = 1,
+
+  /**
+   * @generated from enum value: SAML_ATTRIBUTE_MAPPING_PRESET_ENTRA = 2;
+   */
+  SAML_ATTRIBUTE_MAPPING_PRESET_ENTRA = 2,
+
+  /**
+   * @generated from enum value: SAML_ATTRIBUTE_MAPPING_PRESET_GOOGLE = 3;
+   */
+  SAML_ATTRIBUTE_MAPPING_PRESET_GOOGLE = 3,
+
+  /**
+   * @generated from enum value: SAML_ATTRIBUTE_MAPPING_PRESET_ADFS = 4;
+   */
+  SAML_ATTRIBUTE_MAPPING_PRESET_ADFS = 4,
+
+  /**
+   * Attribute names from the SAML V2.0 X.500/LDAP Attribute Profile, e.g. `urn:oid:2.5.4.42` for first names.
+   *
+   * @generated from enum value: SAML_ATTRIBUTE_MAPPING_PRESET_OID = 5;
+   */
+  SAML_ATTRIBUTE_MAPPING_PRESET_OID = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SAMLAttributeMappingPreset)
+proto3.util.setEnumType(SAMLAttributeMappingPreset, "ssoready.v1.SAMLAttributeMappingPreset", [
+  { no: 0, name: "SAML_ATTRIBUTE_MAPPING_PRESET_UNSPECIFIED" },
+  { no: 1, name: "SAML_ATTRIBUTE_MAPPING_PRESET_OKTA" },
+  { no: 2, name: "SAML_ATTRIBUTE_MAPPING_PRESET_ENTRA" },
+  { no: 3, name: "SAML_ATTRIBUTE_MAPPING_PRESET_GOOGLE" },
+  { no: 4, name: "SAML_ATTRIBUTE_MAPPING_PRESET_ADFS" },
+  { no: 5, name: "SAML_ATTRIBUTE_MAPPING_PRESET_OID" },
+]);
+
+/**
+ * An Identity Provider product SSOReady recognizes.
+ *
+ * @generated from enum ssoready.v1.SAMLIDPVendor
+ */
+export enum SAMLIDPVendor {
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_UNSPECIFIED = 0;
+   */
+  SAML_IDP_VENDOR_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_OKTA = 1;
+   */
+  SAML_IDP_VENDOR_OKTA = 1,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_ENTRA = 2;
+   */
+  SAML_IDP_VENDOR_ENTRA = 2,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_GOOGLE = 3;
+   */
+  SAML_IDP_VENDOR_GOOGLE = 3,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_ADFS = 4;
+   */
+  SAML_IDP_VENDOR_ADFS = 4,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_JUMPCLOUD = 5;
+   */
+  SAML_IDP_VENDOR_JUMPCLOUD = 5,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_PING = 6;
+   */
+  SAML_IDP_VENDOR_PING = 6,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_KEYCLOAK = 7;
+   */
+  SAML_IDP_VENDOR_KEYCLOAK = 7,
+
+  /**
+   * @generated from enum value: SAML_IDP_VENDOR_ONELOGIN = 8;
+   */
+  SAML_IDP_VENDOR_ONELOGIN = 8,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SAMLIDPVendor)
+proto3.util.setEnumType(SAMLIDPVendor, "ssoready.v1.SAMLIDPVendor", [
+  { no: 0, name: "SAML_IDP_VENDOR_UNSPECIFIED" },
+  { no: 1, name: "SAML_IDP_VENDOR_OKTA" },
+  { no: 2, name: "SAML_IDP_VENDOR_ENTRA" },
+  { no: 3, name: "SAML_IDP_VENDOR_GOOGLE" },
+  { no: 4, name: "SAML_IDP_VENDOR_ADFS" },
+  { no: 5, name: "SAML_IDP_VENDOR_JUMPCLOUD" },
+  { no: 6, name: "SAML_IDP_VENDOR_PING" },
+  { no: 7, name: "SAML_IDP_VENDOR_KEYCLOAK" },
+  { no: 8, name: "SAML_IDP_VENDOR_ONELOGIN" },
+]);
+
+/**
+ * @generated from enum ssoready.v1.SAMLBinding
+ */
+export enum SAMLBinding {
+  /**
+   * @generated from enum value: SAML_BINDING_UNSPECIFIED = 0;
+   */
+  SAML_BINDING_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SAML_BINDING_HTTP_POST = 1;
+   */
+  SAML_BINDING_HTTP_POST = 1,
+
+  /**
+   * @generated from enum value: SAML_BINDING_HTTP_REDIRECT = 2;
+   */
+  SAML_BINDING_HTTP_REDIRECT = 2,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SAMLBinding)
+proto3.util.setEnumType(SAMLBinding, "ssoready.v1.SAMLBinding", [
+  { no: 0, name: "SAML_BINDING_UNSPECIFIED" },
+  { no: 1, name: "SAML_BINDING_HTTP_POST" },
+  { no: 2, name: "SAML_BINDING_HTTP_REDIRECT" },
+]);
+
+/**
+ * @generated from enum ssoready.v1.SAMLSignatureAlgorithm
+ */
+export enum SAMLSignatureAlgorithm {
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_UNSPECIFIED = 0;
+   */
+  SAML_SIGNATURE_ALGORITHM_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_RSA_SHA1 = 1;
+   */
+  SAML_SIGNATURE_ALGORITHM_RSA_SHA1 = 1,
+
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_RSA_SHA256 = 2;
+   */
+  SAML_SIGNATURE_ALGORITHM_RSA_SHA256 = 2,
+
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_RSA_SHA384 = 3;
+   */
+  SAML_SIGNATURE_ALGORITHM_RSA_SHA384 = 3,
+
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_RSA_SHA512 = 4;
+   */
+  SAML_SIGNATURE_ALGORITHM_RSA_SHA512 = 4,
+
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256 = 5;
+   */
+  SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256 = 5,
+
+  /**
+   * @generated from enum value: SAML_SIGNATURE_ALGORITHM_ECDSA_SHA384 = 6;
+   */
+  SAML_SIGNATURE_ALGORITHM_ECDSA_SHA384 = 6,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SAMLSignatureAlgorithm)
+proto3.util.setEnumType(SAMLSignatureAlgorithm, "ssoready.v1.SAMLSignatureAlgorithm", [
+  { no: 0, name: "SAML_SIGNATURE_ALGORITHM_UNSPECIFIED" },
+  { no: 1, name: "SAML_SIGNATURE_ALGORITHM_RSA_SHA1" },
+  { no: 2, name: "SAML_SIGNATURE_ALGORITHM_RSA_SHA256" },
+  { no: 3, name: "SAML_SIGNATURE_ALGORITHM_RSA_SHA384" },
+  { no: 4, name: "SAML_SIGNATURE_ALGORITHM_RSA_SHA512" },
+  { no: 5, name: "SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256" },
+  { no: 6, name: "SAML_SIGNATURE_ALGORITHM_ECDSA_SHA384" },
+]);
+
+/**
+ * @generated from enum ssoready.v1.SAMLDigestAlgorithm
+ */
+export enum SAMLDigestAlgorithm {
+  /**
+   * @generated from enum value: SAML_DIGEST_ALGORITHM_UNSPECIFIED = 0;
+   */
+  SAML_DIGEST_ALGORITHM_UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: SAML_DIGEST_ALGORITHM_SHA1 = 1;
+   */
+  SAML_DIGEST_ALGORITHM_SHA1 = 1,
+
+  /**
+   * @generated from enum value: SAML_DIGEST_ALGORITHM_SHA256 = 2;
+   */
+  SAML_DIGEST_ALGORITHM_SHA256 = 2,
+
+  /**
+   * @generated from enum value: SAML_DIGEST_ALGORITHM_SHA384 = 3;
+   */
+  SAML_DIGEST_ALGORITHM_SHA384 = 3,
+
+  /**
+   * @generated from enum value: SAML_DIGEST_ALGORITHM_SHA512 = 4;
+   */
+  SAML_DIGEST_ALGORITHM_SHA512 = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(SAMLDigestAlgorithm)
+proto3.util.setEnumType(SAMLDigestAlgorithm, "ssoready.v1.SAMLDigestAlgorithm", [
+  { no: 0, name: "SAML_DIGEST_ALGORITHM_UNSPECIFIED" },
+  { no: 1, name: "SAML_DIGEST_ALGORITHM_SHA1" },
+  { no: 2, name: "SAML_DIGEST_ALGORITHM_SHA256" },
+  { no: 3, name: "SAML_DIGEST_ALGORITHM_SHA384" },
+  { no: 4, name: "SAML_DIGEST_ALGORITHM_SHA512" },
+]);
 
 /**
  * @generated from enum ssoready.v1.SAMLFlowStatus
@@ -495,6 +719,217 @@ export class SAMLConnection extends Message<SAMLConnection> {
    */
   spAcsUrl = "";
 
+  /**
+   * Signature algorithms SSOReady will accept from the Identity Provider.
+   *
+   * If empty, SSOReady accepts RSA and ECDSA signatures using SHA-256, SHA-384, or SHA-512. SHA-1 signatures are only
+   * accepted if explicitly listed here.
+   *
+   * @generated from field: repeated ssoready.v1.SAMLSignatureAlgorithm allowed_signature_algorithms = 9;
+   */
+  allowedSignatureAlgorithms: SAMLSignatureAlgorithm[] = [];
+
+  /**
+   * Digest algorithms SSOReady will accept from the Identity Provider.
+   *
+   * If empty, SSOReady accepts SHA-256, SHA-384, or SHA-512 digests. SHA-1 digests are only accepted if explicitly
+   * listed here.
+   *
+   * @generated from field: repeated ssoready.v1.SAMLDigestAlgorithm allowed_digest_algorithms = 10;
+   */
+  allowedDigestAlgorithms: SAMLDigestAlgorithm[] = [];
+
+  /**
+   * Certificate the Identity Provider uses to encrypt SAML assertions. This is a PEM-encoded X.509 certificate.
+   *
+   * SP certificates are assigned by SSOReady. Inputting them into your customer's Identity Provider is optional, and
+   * only required if your customer wants SAML assertions to be encrypted.
+   *
+   * @generated from field: string sp_certificate = 11;
+   */
+  spCertificate = "";
+
+  /**
+   * Whether SSOReady signs the SAML requests it sends to the Identity Provider.
+   *
+   * Some Identity Providers can be configured to reject unsigned SAML requests. If enabled, your customer needs to
+   * input the SAML connection's SP signing certificate into their Identity Provider.
+   *
+   * @generated from field: bool sign_authn_requests = 12;
+   */
+  signAuthnRequests = false;
+
+  /**
+   * The binding SSOReady uses to send SAML requests to idp_redirect_url.
+   *
+   * If unspecified, SSOReady uses HTTP-POST.
+   *
+   * @generated from field: ssoready.v1.SAMLBinding idp_binding = 13;
+   */
+  idpBinding = SAMLBinding.SAML_BINDING_UNSPECIFIED;
+
+  /**
+   * URL serving SAML metadata describing sp_entity_id, sp_acs_url, and the SAML connection's SP certificates.
+   *
+   * Many Identity Providers can be configured from this URL, instead of inputting each setting individually.
+   *
+   * @generated from field: string sp_metadata_url = 14;
+   */
+  spMetadataUrl = "";
+
+  /**
+   * Whether SSOReady accepts SAML logins the Identity Provider initiates, such as when a user clicks on an app tile in
+   * their Identity Provider.
+   *
+   * IDP-initiated logins are redirected to your environment's redirect URL with a SAML access code, just like any
+   * other SAML login. They are off by default, because they are more susceptible to replay and CSRF attacks.
+   *
+   * @generated from field: bool allow_idp_initiated = 15;
+   */
+  allowIdpInitiated = false;
+
+  /**
+   * URL of the Identity Provider's single logout service.
+   *
+   * SSOReady sends SAML logout messages to this URL using the HTTP-Redirect binding. If empty, SSOReady cannot log users
+   * out of their Identity Provider.
+   *
+   * @generated from field: string idp_slo_url = 16;
+   */
+  idpSloUrl = "";
+
+  /**
+   * URL the Identity Provider sends SAML logout messages to. Stands for "Service Provider Single Logout" URL.
+   *
+   * SP SLO URLs are assigned by SSOReady. Inputting them into your customer's Identity Provider is optional, and only
+   * required if your customer wants single logout.
+   *
+   * @generated from field: string sp_slo_url = 17;
+   */
+  spSloUrl = "";
+
+  /**
+   * URL of the Identity Provider's SAML metadata. Must be an https URL.
+   *
+   * If set, SSOReady periodically fetches this URL and updates idp_redirect_url and idp_certificate to match it, so that
+   * changes in your customer's Identity Provider don't break logins. A change to idp_entity_id is not applied until
+   * someone accepts it in the SSOReady app.
+   *
+   * @generated from field: string idp_metadata_url = 18;
+   */
+  idpMetadataUrl = "";
+
+  /**
+   * When SSOReady last fetched idp_metadata_url.
+   *
+   * @generated from field: google.protobuf.Timestamp idp_metadata_refresh_time = 19;
+   */
+  idpMetadataRefreshTime?: Timestamp;
+
+  /**
+   * Why SSOReady could not use idp_metadata_url the last time it fetched it. Empty if the last fetch succeeded.
+   *
+   * @generated from field: string idp_metadata_refresh_error = 20;
+   */
+  idpMetadataRefreshError = "";
+
+  /**
+   * The subject of idp_certificate.
+   *
+   * @generated from field: string idp_certificate_subject = 21;
+   */
+  idpCertificateSubject = "";
+
+  /**
+   * The issuer of idp_certificate.
+   *
+   * @generated from field: string idp_certificate_issuer = 22;
+   */
+  idpCertificateIssuer = "";
+
+  /**
+   * The start of idp_certificate's validity period.
+   *
+   * @generated from field: google.protobuf.Timestamp idp_certificate_not_before = 23;
+   */
+  idpCertificateNotBefore?: Timestamp;
+
+  /**
+   * The end of idp_certificate's validity period. SAML logins on this connection fail after this time, until the
+   * Identity Provider's new certificate is inputted into SSOReady.
+   *
+   * @generated from field: google.protobuf.Timestamp idp_certificate_not_after = 24;
+   */
+  idpCertificateNotAfter?: Timestamp;
+
+  /**
+   * The SAML attributes SSOReady takes users' email addresses from, in order of preference. SSOReady uses the first
+   * non-empty value among them.
+   *
+   * If empty, SSOReady requires the Identity Provider's subject ID (its "NameID") to be an email address. Set this if
+   * your customer's Identity Provider sends an opaque or persistent subject ID, with the user's email in an attribute
+   * such as `email` or `mail`.
+   *
+   * @generated from field: repeated string email_attribute_names = 25;
+   */
+  emailAttributeNames: string[] = [];
+
+  /**
+   * The conventions the Identity Provider follows for naming SAML attributes. SSOReady uses them to populate the
+   * `profile` of users logging in with this SAML connection.
+   *
+   * @generated from field: ssoready.v1.SAMLAttributeMappingPreset attribute_mapping_preset = 26;
+   */
+  attributeMappingPreset = SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_UNSPECIFIED;
+
+  /**
+   * The SAML attributes to populate each field of users' `profile` from. Fields set here take precedence over
+   * attribute_mapping_preset.
+   *
+   * @generated from field: ssoready.v1.SAMLAttributeMapping attribute_mapping = 27;
+   */
+  attributeMapping?: SAMLAttributeMapping;
+
+  /**
+   * Options for the SAML AuthnRequests SSOReady sends to the Identity Provider on this SAML connection.
+   *
+   * The `authnRequestOptions` of a Get SAML Redirect URL request add to these for that login.
+   *
+   * @generated from field: ssoready.v1.SAMLAuthnRequestOptions authn_request_options = 28;
+   */
+  authnRequestOptions?: SAMLAuthnRequestOptions;
+
+  /**
+   * How many seconds the Identity Provider's clock may be ahead of or behind SSOReady's when checking whether an
+   * assertion is within its validity window. Defaults to zero; at most 600.
+   *
+   * @generated from field: int32 allowed_clock_skew_seconds = 29;
+   */
+  allowedClockSkewSeconds = 0;
+
+  /**
+   * URL of the Identity Provider's artifact resolution service.
+   *
+   * If set, the Identity Provider may send SAML assertions using the HTTP-Artifact binding, and SSOReady retrieves them
+   * from this URL using the SOAP binding. If empty, SSOReady only accepts SAML assertions sent using HTTP-POST.
+   *
+   * @generated from field: string idp_artifact_resolution_url = 30;
+   */
+  idpArtifactResolutionUrl = "";
+
+  /**
+   * The Identity Provider product this SAML connection appears to be for, detected from idp_entity_id,
+   * idp_redirect_url, and idp_certificate. Unspecified if SSOReady does not recognize it.
+   *
+   * When SSOReady first detects a vendor, it defaults whichever of these settings aren't already set to suit that
+   * vendor: attribute_mapping_preset, the NameID format in authn_request_options (Microsoft Entra ID and Keycloak),
+   * sign_authn_requests (Keycloak), and allowed_clock_skew_seconds (ADFS). This field is ignored when creating or
+   * updating SAML connections.
+   *
+   * @generated from field: ssoready.v1.SAMLIDPVendor idp_vendor = 31;
+   */
+  idpVendor = SAMLIDPVendor.SAML_IDP_VENDOR_UNSPECIFIED;
+
   constructor(data?: PartialMessage<SAMLConnection>) {
     super();
     proto3.util.initPartial(data, this);
@@ -511,6 +946,29 @@ export class SAMLConnection extends Message<SAMLConnection> {
     { no: 5, name: "idp_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "sp_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "sp_acs_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "allowed_signature_algorithms", kind: "enum", T: proto3.getEnumType(SAMLSignatureAlgorithm), repeated: true },
+    { no: 10, name: "allowed_digest_algorithms", kind: "enum", T: proto3.getEnumType(SAMLDigestAlgorithm), repeated: true },
+    { no: 11, name: "sp_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "sign_authn_requests", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 13, name: "idp_binding", kind: "enum", T: proto3.getEnumType(SAMLBinding) },
+    { no: 14, name: "sp_metadata_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 15, name: "allow_idp_initiated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 16, name: "idp_slo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "sp_slo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 18, name: "idp_metadata_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 19, name: "idp_metadata_refresh_time", kind: "message", T: Timestamp },
+    { no: 20, name: "idp_metadata_refresh_error", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 21, name: "idp_certificate_subject", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 22, name: "idp_certificate_issuer", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 23, name: "idp_certificate_not_before", kind: "message", T: Timestamp },
+    { no: 24, name: "idp_certificate_not_after", kind: "message", T: Timestamp },
+    { no: 25, name: "email_attribute_names", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 26, name: "attribute_mapping_preset", kind: "enum", T: proto3.getEnumType(SAMLAttributeMappingPreset) },
+    { no: 27, name: "attribute_mapping", kind: "message", T: SAMLAttributeMapping },
+    { no: 28, name: "authn_request_options", kind: "message", T: SAMLAuthnRequestOptions },
+    { no: 29, name: "allowed_clock_skew_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 30, name: "idp_artifact_resolution_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 31, name: "idp_vendor", kind: "enum", T: proto3.getEnumType(SAMLIDPVendor) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLConnection {
@@ -531,3461 +989,5285 @@ export class SAMLConnection extends Message<SAMLConnection> {
 }
 
 /**
- * @generated from message ssoready.v1.SAMLFlow
+ * Options for the SAML AuthnRequest SSOReady sends to an Identity Provider to start a login.
+ *
+ * @generated from message ssoready.v1.SAMLAuthnRequestOptions
  */
-export class SAMLFlow extends Message<SAMLFlow> {
+export class SAMLAuthnRequestOptions extends Message<SAMLAuthnRequestOptions> {
   /**
-   * @generated from field: string id = 1;
+   * Whether to ask the Identity Provider to re-authenticate the user, even if they already have a session with it.
+   *
+   * Use this before sensitive actions in your product.
+   *
+   * @generated from field: bool force_authn = 1;
    */
-  id = "";
+  forceAuthn = false;
 
   /**
-   * @generated from field: string saml_connection_id = 2;
+   * Whether to ask the Identity Provider not to interact with the user. If the user does not already have a session
+   * with the Identity Provider, the login fails.
+   *
+   * @generated from field: bool is_passive = 2;
    */
-  samlConnectionId = "";
+  isPassive = false;
 
   /**
-   * @generated from field: ssoready.v1.SAMLFlowStatus status = 17;
+   * The NameID format to ask the Identity Provider for, e.g.
+   * `urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress`. If empty, the Identity Provider chooses.
+   *
+   * @generated from field: string name_id_policy_format = 3;
    */
-  status = SAMLFlowStatus.SAML_FLOW_STATUS_UNSPECIFIED;
+  nameIdPolicyFormat = "";
 
   /**
-   * @generated from oneof ssoready.v1.SAMLFlow.error
+   * The authentication context classes to ask the Identity Provider for, e.g.
+   * `urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract` to require multi-factor authentication. If empty,
+   * the Identity Provider chooses.
+   *
+   * @generated from field: repeated string requested_authn_context_class_refs = 4;
    */
-  error: {
-    /**
-     * @generated from field: google.protobuf.Empty saml_connection_not_configured = 26;
-     */
-    value: Empty;
-    case: "samlConnectionNotConfigured";
-  } | {
-    /**
-     * @generated from field: google.protobuf.Empty environment_oauth_redirect_uri_not_configured = 27;
-     */
-    value: Empty;
-    case: "environmentOauthRedirectUriNotConfigured";
-  } | {
-    /**
-     * @generated from field: google.protobuf.Empty unsigned_assertion = 22;
-     */
-    value: Empty;
-    case: "unsignedAssertion";
-  } | {
-    /**
-     * @generated from field: string bad_issuer = 18;
-     */
-    value: string;
-    case: "badIssuer";
-  } | {
-    /**
-     * @generated from field: string bad_audience = 19;
-     */
-    value: string;
-    case: "badAudience";
-  } | {
-    /**
-     * @generated from field: string bad_signature_algorithm = 23;
-     */
-    value: string;
-    case: "badSignatureAlgorithm";
-  } | {
-    /**
-     * @generated from field: string bad_digest_algorithm = 24;
-     */
-    value: string;
-    case: "badDigestAlgorithm";
-  } | {
-    /**
-     * @generated from field: string bad_certificate = 25;
-     */
-    value: string;
-    case: "badCertificate";
-  } | {
-    /**
-     * @generated from field: string bad_subject_id = 20;
-     */
-    value: string;
-    case: "badSubjectId";
-  } | {
-    /**
-     * @generated from field: string email_outside_organization_domains = 21;
-     */
-    value: string;
-    case: "emailOutsideOrganizationDomains";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  requestedAuthnContextClassRefs: string[] = [];
 
   /**
-   * @generated from field: string state = 3;
+   * How the Identity Provider compares requested_authn_context_class_refs against the user's authentication. One of
+   * `exact`, `minimum`, `maximum`, or `better`. If empty, the Identity Provider uses `exact`.
+   *
+   * @generated from field: string requested_authn_context_comparison = 5;
    */
-  state = "";
+  requestedAuthnContextComparison = "";
 
-  /**
-   * @generated from field: string email = 4;
-   */
-  email = "";
+  constructor(data?: PartialMessage<SAMLAuthnRequestOptions>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * @generated from field: map<string, string> attributes = 5;
-   */
-  attributes: { [key: string]: string } = {};
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SAMLAuthnRequestOptions";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "force_authn", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "is_passive", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "name_id_policy_format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "requested_authn_context_class_refs", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "requested_authn_context_comparison", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
 
-  /**
-   * @generated from field: google.protobuf.Timestamp create_time = 6;
-   */
-  createTime?: Timestamp;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLAuthnRequestOptions {
+    return new SAMLAuthnRequestOptions().fromBinary(bytes, options);
+  }
 
-  /**
-   * @generated from field: google.protobuf.Timestamp update_time = 7;
-   */
-  updateTime?: Timestamp;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLAuthnRequestOptions {
+    return new SAMLAuthnRequestOptions().fromJson(jsonValue, options);
+  }
 
-  /**
-   * @generated from field: string auth_redirect_url = 8;
-   */
-  authRedirectUrl = "";
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLAuthnRequestOptions {
+    return new SAMLAuthnRequestOptions().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SAMLAuthnRequestOptions | PlainMessage<SAMLAuthnRequestOptions> | undefined, b: SAMLAuthnRequestOptions | PlainMessage<SAMLAuthnRequestOptions> | undefined): boolean {
+    return proto3.util.equals(SAMLAuthnRequestOptions, a, b);
+  }
+}
 
+/**
+ * For each field of a SAMLProfile, the SAML attributes to populate it from, in order of preference. SSOReady uses the
+ * first of them the Identity Provider sent a non-empty value for.
+ *
+ * @generated from message ssoready.v1.SAMLAttributeMapping
+ */
+export class SAMLAttributeMapping extends Message<SAMLAttributeMapping> {
   /**
-   * @generated from field: google.protobuf.Timestamp get_redirect_time = 9;
+   * @generated from field: repeated string first_name = 1;
    */
-  getRedirectTime?: Timestamp;
+  firstName: string[] = [];
 
   /**
-   * @generated from field: string initiate_request = 10;
+   * @generated from field: repeated string last_name = 2;
    */
-  initiateRequest = "";
+  lastName: string[] = [];
 
   /**
-   * @generated from field: google.protobuf.Timestamp initiate_time = 11;
+   * @generated from field: repeated string display_name = 3;
    */
-  initiateTime?: Timestamp;
+  displayName: string[] = [];
 
   /**
-   * @generated from field: string assertion = 12;
+   * @generated from field: repeated string groups = 4;
    */
-  assertion = "";
+  groups: string[] = [];
 
   /**
-   * @generated from field: string app_redirect_url = 13;
+   * @generated from field: repeated string department = 5;
    */
-  appRedirectUrl = "";
+  department: string[] = [];
 
   /**
-   * @generated from field: google.protobuf.Timestamp receive_assertion_time = 14;
+   * @generated from field: repeated string job_title = 6;
    */
-  receiveAssertionTime?: Timestamp;
+  jobTitle: string[] = [];
 
   /**
-   * @generated from field: google.protobuf.Timestamp redeem_time = 15;
+   * @generated from field: repeated string employee_id = 7;
    */
-  redeemTime?: Timestamp;
+  employeeId: string[] = [];
 
   /**
-   * @generated from field: string redeem_response = 16;
+   * @generated from field: repeated string phone_number = 8;
    */
-  redeemResponse = "";
+  phoneNumber: string[] = [];
 
-  constructor(data?: PartialMessage<SAMLFlow>) {
+  constructor(data?: PartialMessage<SAMLAttributeMapping>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SAMLFlow";
+  static readonly typeName = "ssoready.v1.SAMLAttributeMapping";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 17, name: "status", kind: "enum", T: proto3.getEnumType(SAMLFlowStatus) },
-    { no: 26, name: "saml_connection_not_configured", kind: "message", T: Empty, oneof: "error" },
-    { no: 27, name: "environment_oauth_redirect_uri_not_configured", kind: "message", T: Empty, oneof: "error" },
-    { no: 22, name: "unsigned_assertion", kind: "message", T: Empty, oneof: "error" },
-    { no: 18, name: "bad_issuer", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 19, name: "bad_audience", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 23, name: "bad_signature_algorithm", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 24, name: "bad_digest_algorithm", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 25, name: "bad_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 20, name: "bad_subject_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 21, name: "email_outside_organization_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 3, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 6, name: "create_time", kind: "message", T: Timestamp },
-    { no: 7, name: "update_time", kind: "message", T: Timestamp },
-    { no: 8, name: "auth_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 9, name: "get_redirect_time", kind: "message", T: Timestamp },
-    { no: 10, name: "initiate_request", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 11, name: "initiate_time", kind: "message", T: Timestamp },
-    { no: 12, name: "assertion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 13, name: "app_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 14, name: "receive_assertion_time", kind: "message", T: Timestamp },
-    { no: 15, name: "redeem_time", kind: "message", T: Timestamp },
-    { no: 16, name: "redeem_response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "first_name", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "last_name", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "groups", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "department", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "job_title", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "employee_id", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 8, name: "phone_number", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLFlow {
-    return new SAMLFlow().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLAttributeMapping {
+    return new SAMLAttributeMapping().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLFlow {
-    return new SAMLFlow().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLAttributeMapping {
+    return new SAMLAttributeMapping().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLFlow {
-    return new SAMLFlow().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLAttributeMapping {
+    return new SAMLAttributeMapping().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SAMLFlow | PlainMessage<SAMLFlow> | undefined, b: SAMLFlow | PlainMessage<SAMLFlow> | undefined): boolean {
-    return proto3.util.equals(SAMLFlow, a, b);
+  static equals(a: SAMLAttributeMapping | PlainMessage<SAMLAttributeMapping> | undefined, b: SAMLAttributeMapping | PlainMessage<SAMLAttributeMapping> | undefined): boolean {
+    return proto3.util.equals(SAMLAttributeMapping, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SCIMDirectory
+ * Information about a user, normalized from the SAML attributes their Identity Provider sent according to their SAML
+ * connection's attribute mapping. Fields the Identity Provider did not send are empty.
+ *
+ * @generated from message ssoready.v1.SAMLProfile
  */
-export class SCIMDirectory extends Message<SCIMDirectory> {
+export class SAMLProfile extends Message<SAMLProfile> {
   /**
-   * Unique identifier for this SCIM directory.
-   *
-   * @generated from field: string id = 1;
+   * @generated from field: string first_name = 1;
    */
-  id = "";
+  firstName = "";
 
   /**
-   * The organization this SCIM directory belongs to.
-   *
-   * @generated from field: string organization_id = 2;
+   * @generated from field: string last_name = 2;
    */
-  organizationId = "";
+  lastName = "";
 
   /**
-   * Whether this is the primary SCIM directory for the organization.
-   *
-   * @generated from field: bool primary = 3;
+   * @generated from field: string display_name = 3;
    */
-  primary = false;
+  displayName = "";
 
   /**
-   * Base URL the Identity Provider uses to perform SCIM HTTP requests.
-   *
-   * SCIM base URLs are assigned by SSOReady, and need to be inputted into your customer's Identity Provider.
-   *
-   * @generated from field: string scim_base_url = 4;
+   * @generated from field: repeated string groups = 4;
    */
-  scimBaseUrl = "";
+  groups: string[] = [];
 
   /**
-   * Whether this SCIM directory has a bearer token assigned.
-   *
-   * SSOReady only stores a hash of the bearer token. To get a bearer token value, you must rotate this SCIM directory's
-   * bearer token.
-   *
-   * @generated from field: bool has_client_bearer_token = 6;
+   * @generated from field: string department = 5;
    */
-  hasClientBearerToken = false;
+  department = "";
 
-  constructor(data?: PartialMessage<SCIMDirectory>) {
+  /**
+   * @generated from field: string job_title = 6;
+   */
+  jobTitle = "";
+
+  /**
+   * @generated from field: string employee_id = 7;
+   */
+  employeeId = "";
+
+  /**
+   * @generated from field: string phone_number = 8;
+   */
+  phoneNumber = "";
+
+  constructor(data?: PartialMessage<SAMLProfile>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SCIMDirectory";
+  static readonly typeName = "ssoready.v1.SAMLProfile";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "primary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "scim_base_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "has_client_bearer_token", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "first_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "last_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "groups", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "department", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "job_title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "employee_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "phone_number", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMDirectory {
-    return new SCIMDirectory().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLProfile {
+    return new SAMLProfile().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMDirectory {
-    return new SCIMDirectory().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLProfile {
+    return new SAMLProfile().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMDirectory {
-    return new SCIMDirectory().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLProfile {
+    return new SAMLProfile().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SCIMDirectory | PlainMessage<SCIMDirectory> | undefined, b: SCIMDirectory | PlainMessage<SCIMDirectory> | undefined): boolean {
-    return proto3.util.equals(SCIMDirectory, a, b);
+  static equals(a: SAMLProfile | PlainMessage<SAMLProfile> | undefined, b: SAMLProfile | PlainMessage<SAMLProfile> | undefined): boolean {
+    return proto3.util.equals(SAMLProfile, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SCIMUser
+ * A change SSOReady applied to a SAML connection after fetching its idp_metadata_url. Fields that did not change are
+ * empty.
+ *
+ * @generated from message ssoready.v1.SAMLConnectionIDPMetadataUpdate
  */
-export class SCIMUser extends Message<SCIMUser> {
+export class SAMLConnectionIDPMetadataUpdate extends Message<SAMLConnectionIDPMetadataUpdate> {
   /**
-   * Unique identifier for this SCIM user.
-   *
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * SCIM directory this SCIM user belongs to.
-   *
-   * @generated from field: string scim_directory_id = 2;
+   * @generated from field: string saml_connection_id = 2;
    */
-  scimDirectoryId = "";
+  samlConnectionId = "";
 
   /**
-   * The SCIM user's email address.
+   * @generated from field: google.protobuf.Timestamp create_time = 3;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: string previous_idp_entity_id = 4;
+   */
+  previousIdpEntityId = "";
+
+  /**
+   * @generated from field: string idp_entity_id = 5;
+   */
+  idpEntityId = "";
+
+  /**
+   * @generated from field: string previous_idp_redirect_url = 6;
+   */
+  previousIdpRedirectUrl = "";
+
+  /**
+   * @generated from field: string idp_redirect_url = 7;
+   */
+  idpRedirectUrl = "";
+
+  /**
+   * A PEM-encoded X.509 certificate.
    *
-   * @generated from field: string email = 3;
+   * @generated from field: string previous_idp_certificate = 8;
    */
-  email = "";
+  previousIdpCertificate = "";
 
   /**
-   * Whether the SCIM user has been deleted or deprovisioned from its SCIM directory.
+   * A PEM-encoded X.509 certificate.
    *
-   * @generated from field: bool deleted = 4;
+   * @generated from field: string idp_certificate = 9;
    */
-  deleted = false;
+  idpCertificate = "";
 
   /**
-   * Arbitrary, potentially nested, attributes the Identity Provider included about the user.
+   * Whether the update is waiting for someone to accept it. SSOReady does not apply a change to idp_entity_id on its
+   * own, because it may mean idp_metadata_url now describes a different Identity Provider.
    *
-   * Typically, these `attributes` are used to pass along the user's first/last name, or whether they should be
-   * considered an admin within their company.
+   * @generated from field: bool pending = 10;
+   */
+  pending = false;
+
+  /**
+   * When the update was accepted. Empty if the update was never pending.
    *
-   * @generated from field: google.protobuf.Struct attributes = 5;
+   * @generated from field: google.protobuf.Timestamp accept_time = 11;
    */
-  attributes?: Struct;
+  acceptTime?: Timestamp;
 
-  constructor(data?: PartialMessage<SCIMUser>) {
+  constructor(data?: PartialMessage<SAMLConnectionIDPMetadataUpdate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SCIMUser";
+  static readonly typeName = "ssoready.v1.SAMLConnectionIDPMetadataUpdate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 5, name: "attributes", kind: "message", T: Struct },
+    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "create_time", kind: "message", T: Timestamp },
+    { no: 4, name: "previous_idp_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "idp_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "previous_idp_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 7, name: "idp_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "previous_idp_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "idp_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 10, name: "pending", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 11, name: "accept_time", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMUser {
-    return new SCIMUser().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLConnectionIDPMetadataUpdate {
+    return new SAMLConnectionIDPMetadataUpdate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMUser {
-    return new SCIMUser().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLConnectionIDPMetadataUpdate {
+    return new SAMLConnectionIDPMetadataUpdate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMUser {
-    return new SCIMUser().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLConnectionIDPMetadataUpdate {
+    return new SAMLConnectionIDPMetadataUpdate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SCIMUser | PlainMessage<SCIMUser> | undefined, b: SCIMUser | PlainMessage<SCIMUser> | undefined): boolean {
-    return proto3.util.equals(SCIMUser, a, b);
+  static equals(a: SAMLConnectionIDPMetadataUpdate | PlainMessage<SAMLConnectionIDPMetadataUpdate> | undefined, b: SAMLConnectionIDPMetadataUpdate | PlainMessage<SAMLConnectionIDPMetadataUpdate> | undefined): boolean {
+    return proto3.util.equals(SAMLConnectionIDPMetadataUpdate, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SCIMGroup
+ * An additional certificate SSOReady trusts to authenticate SAML assertions on a SAML connection, alongside its
+ * idp_certificate. Used to roll over to an Identity Provider's new certificate without downtime.
+ *
+ * @generated from message ssoready.v1.SAMLConnectionIDPCertificate
  */
-export class SCIMGroup extends Message<SCIMGroup> {
+export class SAMLConnectionIDPCertificate extends Message<SAMLConnectionIDPCertificate> {
   /**
-   * Unique identifier for this SCIM group.
-   *
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * SCIM directory this SCIM group belongs to.
+   * @generated from field: string saml_connection_id = 2;
+   */
+  samlConnectionId = "";
+
+  /**
+   * A PEM-encoded X.509 certificate.
    *
-   * @generated from field: string scim_directory_id = 2;
+   * @generated from field: string certificate = 3;
    */
-  scimDirectoryId = "";
+  certificate = "";
 
   /**
-   * A human-friendly name for the SCIM group.
+   * If set, the certificate is not trusted before this time.
    *
-   * @generated from field: string display_name = 3;
+   * @generated from field: google.protobuf.Timestamp not_before = 4;
    */
-  displayName = "";
+  notBefore?: Timestamp;
 
   /**
-   * Whether the SCIM group has been deleted or deprovisioned from its SCIM directory.
+   * If set, the certificate is not trusted from this time on.
    *
-   * Identity Providers are inconsistent about reliably deleting SCIM groups. Many Identity Providers will deprovision
-   * the users inside a group, but not the group itself. For this reason, it's typical to ignore this field until a
-   * specific need arises.
-   *
-   * @generated from field: bool deleted = 6;
+   * @generated from field: google.protobuf.Timestamp not_after = 5;
    */
-  deleted = false;
+  notAfter?: Timestamp;
 
   /**
-   * Arbitrary, potentially nested, attributes the Identity Provider included about the group.
-   *
-   * Identity Providers are inconsistent about supporting sending custom attributes on groups. For this reason, it's
-   * typical to not rely on them until a specific need arises.
+   * @generated from field: google.protobuf.Timestamp create_time = 6;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * Whether SSOReady added the certificate because the SAML connection's idp_metadata_url lists it. SSOReady removes
+   * these certificates once the Identity Provider stops listing them.
    *
-   * @generated from field: google.protobuf.Struct attributes = 4;
+   * @generated from field: bool from_idp_metadata = 7;
    */
-  attributes?: Struct;
+  fromIdpMetadata = false;
 
-  constructor(data?: PartialMessage<SCIMGroup>) {
+  constructor(data?: PartialMessage<SAMLConnectionIDPCertificate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SCIMGroup";
+  static readonly typeName = "ssoready.v1.SAMLConnectionIDPCertificate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "attributes", kind: "message", T: Struct },
+    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "not_before", kind: "message", T: Timestamp },
+    { no: 5, name: "not_after", kind: "message", T: Timestamp },
+    { no: 6, name: "create_time", kind: "message", T: Timestamp },
+    { no: 7, name: "from_idp_metadata", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMGroup {
-    return new SCIMGroup().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLConnectionIDPCertificate {
+    return new SAMLConnectionIDPCertificate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMGroup {
-    return new SCIMGroup().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLConnectionIDPCertificate {
+    return new SAMLConnectionIDPCertificate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMGroup {
-    return new SCIMGroup().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLConnectionIDPCertificate {
+    return new SAMLConnectionIDPCertificate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SCIMGroup | PlainMessage<SCIMGroup> | undefined, b: SCIMGroup | PlainMessage<SCIMGroup> | undefined): boolean {
-    return proto3.util.equals(SCIMGroup, a, b);
+  static equals(a: SAMLConnectionIDPCertificate | PlainMessage<SAMLConnectionIDPCertificate> | undefined, b: SAMLConnectionIDPCertificate | PlainMessage<SAMLConnectionIDPCertificate> | undefined): boolean {
+    return proto3.util.equals(SAMLConnectionIDPCertificate, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SCIMRequest
+ * @generated from message ssoready.v1.SAMLFlow
  */
-export class SCIMRequest extends Message<SCIMRequest> {
+export class SAMLFlow extends Message<SAMLFlow> {
   /**
    * @generated from field: string id = 1;
    */
   id = "";
 
   /**
-   * @generated from field: string scim_directory_id = 2;
+   * @generated from field: string saml_connection_id = 2;
    */
-  scimDirectoryId = "";
+  samlConnectionId = "";
 
   /**
-   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   * @generated from field: ssoready.v1.SAMLFlowStatus status = 17;
    */
-  timestamp?: Timestamp;
+  status = SAMLFlowStatus.SAML_FLOW_STATUS_UNSPECIFIED;
 
   /**
-   * @generated from field: string http_request_url = 4;
+   * @generated from oneof ssoready.v1.SAMLFlow.error
    */
-  httpRequestUrl = "";
+  error: {
+    /**
+     * @generated from field: google.protobuf.Empty saml_connection_not_configured = 26;
+     */
+    value: Empty;
+    case: "samlConnectionNotConfigured";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Empty environment_oauth_redirect_uri_not_configured = 27;
+     */
+    value: Empty;
+    case: "environmentOauthRedirectUriNotConfigured";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Empty unsigned_assertion = 22;
+     */
+    value: Empty;
+    case: "unsignedAssertion";
+  } | {
+    /**
+     * @generated from field: string bad_issuer = 18;
+     */
+    value: string;
+    case: "badIssuer";
+  } | {
+    /**
+     * @generated from field: string bad_audience = 19;
+     */
+    value: string;
+    case: "badAudience";
+  } | {
+    /**
+     * @generated from field: string bad_signature_algorithm = 23;
+     */
+    value: string;
+    case: "badSignatureAlgorithm";
+  } | {
+    /**
+     * @generated from field: string bad_digest_algorithm = 24;
+     */
+    value: string;
+    case: "badDigestAlgorithm";
+  } | {
+    /**
+     * @generated from field: string bad_certificate = 25;
+     */
+    value: string;
+    case: "badCertificate";
+  } | {
+    /**
+     * @generated from field: string bad_subject_id = 20;
+     */
+    value: string;
+    case: "badSubjectId";
+  } | {
+    /**
+     * @generated from field: string email_outside_organization_domains = 21;
+     */
+    value: string;
+    case: "emailOutsideOrganizationDomains";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Empty idp_initiated_not_allowed = 32;
+     */
+    value: Empty;
+    case: "idpInitiatedNotAllowed";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Empty expired_assertion = 38;
+     */
+    value: Empty;
+    case: "expiredAssertion";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Empty expired_subject_confirmation = 39;
+     */
+    value: Empty;
+    case: "expiredSubjectConfirmation";
+  } | {
+    /**
+     * @generated from field: string bad_destination = 40;
+     */
+    value: string;
+    case: "badDestination";
+  } | {
+    /**
+     * @generated from field: string bad_recipient = 41;
+     */
+    value: string;
+    case: "badRecipient";
+  } | {
+    /**
+     * @generated from field: string bad_subject_confirmation_method = 42;
+     */
+    value: string;
+    case: "badSubjectConfirmationMethod";
+  } | {
+    /**
+     * @generated from field: ssoready.v1.SAMLFlowIDPStatus idp_status = 43;
+     */
+    value: SAMLFlowIDPStatus;
+    case: "idpStatus";
+  } | {
+    /**
+     * @generated from field: string artifact_resolution_failed = 47;
+     */
+    value: string;
+    case: "artifactResolutionFailed";
+  } | {
+    /**
+     * @generated from field: google.protobuf.Empty expired_idp_initiated_assertion = 50;
+     */
+    value: Empty;
+    case: "expiredIdpInitiatedAssertion";
+  } | { case: undefined; value?: undefined } = { case: undefined };
 
   /**
-   * @generated from field: ssoready.v1.SCIMRequestHTTPMethod http_request_method = 5;
+   * @generated from field: string state = 3;
    */
-  httpRequestMethod = SCIMRequestHTTPMethod.SCIM_REQUEST_HTTP_METHOD_UNSPECIFIED;
+  state = "";
 
   /**
-   * @generated from field: google.protobuf.Struct http_request_body = 7;
+   * @generated from field: string email = 4;
    */
-  httpRequestBody?: Struct;
+  email = "";
 
   /**
-   * @generated from field: ssoready.v1.SCIMRequestHTTPStatus http_response_status = 8;
+   * The Identity Provider's identifier for the user (its "NameID").
+   *
+   * @generated from field: string subject_id = 35;
    */
-  httpResponseStatus = SCIMRequestHTTPStatus.SCIM_REQUEST_HTTP_STATUS_UNSPECIFIED;
+  subjectId = "";
 
   /**
-   * @generated from field: google.protobuf.Struct http_response_body = 9;
+   * The format of subject_id, e.g. `urn:oasis:names:tc:SAML:2.0:nameid-format:persistent`, if the Identity Provider
+   * specified one.
+   *
+   * @generated from field: string subject_id_format = 36;
    */
-  httpResponseBody?: Struct;
+  subjectIdFormat = "";
 
   /**
-   * @generated from oneof ssoready.v1.SCIMRequest.error
+   * @generated from field: map<string, string> attributes = 5;
    */
-  error: {
-    /**
-     * @generated from field: google.protobuf.Empty bad_bearer_token = 10;
-     */
-    value: Empty;
-    case: "badBearerToken";
-  } | {
-    /**
-     * @generated from field: string bad_username = 11;
-     */
-    value: string;
-    case: "badUsername";
-  } | {
-    /**
-     * @generated from field: string email_outside_organization_domains = 12;
-     */
-    value: string;
-    case: "emailOutsideOrganizationDomains";
-  } | { case: undefined; value?: undefined } = { case: undefined };
+  attributes: { [key: string]: string } = {};
+
+  /**
+   * @generated from field: map<string, ssoready.v1.SAMLAttributeValues> attribute_values = 34;
+   */
+  attributeValues: { [key: string]: SAMLAttributeValues } = {};
+
+  /**
+   * @generated from field: ssoready.v1.SAMLProfile profile = 37;
+   */
+  profile?: SAMLProfile;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 6;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp update_time = 7;
+   */
+  updateTime?: Timestamp;
+
+  /**
+   * @generated from field: string auth_redirect_url = 8;
+   */
+  authRedirectUrl = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp get_redirect_time = 9;
+   */
+  getRedirectTime?: Timestamp;
+
+  /**
+   * @generated from field: string initiate_request = 10;
+   */
+  initiateRequest = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp initiate_time = 11;
+   */
+  initiateTime?: Timestamp;
+
+  /**
+   * @generated from field: string assertion = 12;
+   */
+  assertion = "";
+
+  /**
+   * @generated from field: bool response_signed = 28;
+   */
+  responseSigned = false;
+
+  /**
+   * @generated from field: bool assertion_signed = 29;
+   */
+  assertionSigned = false;
+
+  /**
+   * @generated from field: string idp_certificate = 30;
+   */
+  idpCertificate = "";
+
+  /**
+   * @generated from field: string app_redirect_url = 13;
+   */
+  appRedirectUrl = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp receive_assertion_time = 14;
+   */
+  receiveAssertionTime?: Timestamp;
+
+  /**
+   * Whether the Identity Provider initiated this SAML flow, rather than SSOReady.
+   *
+   * @generated from field: bool idp_initiated = 31;
+   */
+  idpInitiated = false;
+
+  /**
+   * The Identity Provider's identifier for the session this SAML flow established, if any.
+   *
+   * @generated from field: string session_index = 33;
+   */
+  sessionIndex = "";
+
+  /**
+   * When the user authenticated to the Identity Provider, if it said.
+   *
+   * @generated from field: google.protobuf.Timestamp authn_instant = 44;
+   */
+  authnInstant?: Timestamp;
+
+  /**
+   * When the Identity Provider says the session this SAML flow established should end, if it said.
+   *
+   * @generated from field: google.protobuf.Timestamp session_not_on_or_after = 45;
+   */
+  sessionNotOnOrAfter?: Timestamp;
+
+  /**
+   * How the user authenticated to the Identity Provider, e.g.
+   * `urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport`, if it said.
+   *
+   * @generated from field: string authn_context_class_ref = 46;
+   */
+  authnContextClassRef = "";
+
+  /**
+   * The Identity Provider product this SAML flow's assertion appears to be from, if SSOReady recognizes it.
+   *
+   * @generated from field: ssoready.v1.SAMLIDPVendor idp_vendor = 48;
+   */
+  idpVendor = SAMLIDPVendor.SAML_IDP_VENDOR_UNSPECIFIED;
+
+  /**
+   * A failure status from a SAML response that claimed to respond to this SAML flow, but was unsigned or whose
+   * signature did not verify. Because anyone could have sent it, it does not affect the outcome of the SAML flow; it is
+   * only reported to help debug Identity Providers that do not sign failed responses.
+   *
+   * @generated from field: ssoready.v1.SAMLFlowIDPStatus unverified_idp_status = 49;
+   */
+  unverifiedIdpStatus?: SAMLFlowIDPStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp redeem_time = 15;
+   */
+  redeemTime?: Timestamp;
+
+  /**
+   * @generated from field: string redeem_response = 16;
+   */
+  redeemResponse = "";
+
+  constructor(data?: PartialMessage<SAMLFlow>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SAMLFlow";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 17, name: "status", kind: "enum", T: proto3.getEnumType(SAMLFlowStatus) },
+    { no: 26, name: "saml_connection_not_configured", kind: "message", T: Empty, oneof: "error" },
+    { no: 27, name: "environment_oauth_redirect_uri_not_configured", kind: "message", T: Empty, oneof: "error" },
+    { no: 22, name: "unsigned_assertion", kind: "message", T: Empty, oneof: "error" },
+    { no: 18, name: "bad_issuer", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 19, name: "bad_audience", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 23, name: "bad_signature_algorithm", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 24, name: "bad_digest_algorithm", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 25, name: "bad_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 20, name: "bad_subject_id", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 21, name: "email_outside_organization_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 32, name: "idp_initiated_not_allowed", kind: "message", T: Empty, oneof: "error" },
+    { no: 38, name: "expired_assertion", kind: "message", T: Empty, oneof: "error" },
+    { no: 39, name: "expired_subject_confirmation", kind: "message", T: Empty, oneof: "error" },
+    { no: 40, name: "bad_destination", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 41, name: "bad_recipient", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 42, name: "bad_subject_confirmation_method", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 43, name: "idp_status", kind: "message", T: SAMLFlowIDPStatus, oneof: "error" },
+    { no: 47, name: "artifact_resolution_failed", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 50, name: "expired_idp_initiated_assertion", kind: "message", T: Empty, oneof: "error" },
+    { no: 3, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 35, name: "subject_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 36, name: "subject_id_format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 34, name: "attribute_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: SAMLAttributeValues} },
+    { no: 37, name: "profile", kind: "message", T: SAMLProfile },
+    { no: 6, name: "create_time", kind: "message", T: Timestamp },
+    { no: 7, name: "update_time", kind: "message", T: Timestamp },
+    { no: 8, name: "auth_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "get_redirect_time", kind: "message", T: Timestamp },
+    { no: 10, name: "initiate_request", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "initiate_time", kind: "message", T: Timestamp },
+    { no: 12, name: "assertion", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 28, name: "response_signed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 29, name: "assertion_signed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 30, name: "idp_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "app_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 14, name: "receive_assertion_time", kind: "message", T: Timestamp },
+    { no: 31, name: "idp_initiated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 33, name: "session_index", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 44, name: "authn_instant", kind: "message", T: Timestamp },
+    { no: 45, name: "session_not_on_or_after", kind: "message", T: Timestamp },
+    { no: 46, name: "authn_context_class_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 48, name: "idp_vendor", kind: "enum", T: proto3.getEnumType(SAMLIDPVendor) },
+    { no: 49, name: "unverified_idp_status", kind: "message", T: SAMLFlowIDPStatus },
+    { no: 15, name: "redeem_time", kind: "message", T: Timestamp },
+    { no: 16, name: "redeem_response", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLFlow {
+    return new SAMLFlow().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLFlow {
+    return new SAMLFlow().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLFlow {
+    return new SAMLFlow().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SAMLFlow | PlainMessage<SAMLFlow> | undefined, b: SAMLFlow | PlainMessage<SAMLFlow> | undefined): boolean {
+    return proto3.util.equals(SAMLFlow, a, b);
+  }
+}
+
+/**
+ * The status an Identity Provider reported in a SAML response that did not succeed, such as when the user failed to
+ * authenticate.
+ *
+ * @generated from message ssoready.v1.SAMLFlowIDPStatus
+ */
+export class SAMLFlowIDPStatus extends Message<SAMLFlowIDPStatus> {
+  /**
+   * The top-level StatusCode, e.g. `urn:oasis:names:tc:SAML:2.0:status:Responder`.
+   *
+   * @generated from field: string status_code = 1;
+   */
+  statusCode = "";
+
+  /**
+   * The second-level StatusCode, e.g. `urn:oasis:names:tc:SAML:2.0:status:AuthnFailed`, if the Identity Provider
+   * specified one.
+   *
+   * @generated from field: string sub_status_code = 2;
+   */
+  subStatusCode = "";
+
+  /**
+   * The StatusMessage, if the Identity Provider specified one.
+   *
+   * @generated from field: string status_message = 3;
+   */
+  statusMessage = "";
+
+  constructor(data?: PartialMessage<SAMLFlowIDPStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SAMLFlowIDPStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "status_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "sub_status_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "status_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLFlowIDPStatus {
+    return new SAMLFlowIDPStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLFlowIDPStatus {
+    return new SAMLFlowIDPStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLFlowIDPStatus {
+    return new SAMLFlowIDPStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SAMLFlowIDPStatus | PlainMessage<SAMLFlowIDPStatus> | undefined, b: SAMLFlowIDPStatus | PlainMessage<SAMLFlowIDPStatus> | undefined): boolean {
+    return proto3.util.equals(SAMLFlowIDPStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.SCIMDirectory
+ */
+export class SCIMDirectory extends Message<SCIMDirectory> {
+  /**
+   * Unique identifier for this SCIM directory.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The organization this SCIM directory belongs to.
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * Whether this is the primary SCIM directory for the organization.
+   *
+   * @generated from field: bool primary = 3;
+   */
+  primary = false;
+
+  /**
+   * Base URL the Identity Provider uses to perform SCIM HTTP requests.
+   *
+   * SCIM base URLs are assigned by SSOReady, and need to be inputted into your customer's Identity Provider.
+   *
+   * @generated from field: string scim_base_url = 4;
+   */
+  scimBaseUrl = "";
+
+  /**
+   * Whether this SCIM directory has a bearer token assigned.
+   *
+   * SSOReady only stores a hash of the bearer token. To get a bearer token value, you must rotate this SCIM directory's
+   * bearer token.
+   *
+   * @generated from field: bool has_client_bearer_token = 6;
+   */
+  hasClientBearerToken = false;
+
+  constructor(data?: PartialMessage<SCIMDirectory>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SCIMDirectory";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "primary", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "scim_base_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "has_client_bearer_token", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMDirectory {
+    return new SCIMDirectory().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMDirectory {
+    return new SCIMDirectory().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMDirectory {
+    return new SCIMDirectory().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SCIMDirectory | PlainMessage<SCIMDirectory> | undefined, b: SCIMDirectory | PlainMessage<SCIMDirectory> | undefined): boolean {
+    return proto3.util.equals(SCIMDirectory, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.SCIMUser
+ */
+export class SCIMUser extends Message<SCIMUser> {
+  /**
+   * Unique identifier for this SCIM user.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * SCIM directory this SCIM user belongs to.
+   *
+   * @generated from field: string scim_directory_id = 2;
+   */
+  scimDirectoryId = "";
+
+  /**
+   * The SCIM user's email address.
+   *
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  /**
+   * Whether the SCIM user has been deleted or deprovisioned from its SCIM directory.
+   *
+   * @generated from field: bool deleted = 4;
+   */
+  deleted = false;
+
+  /**
+   * Arbitrary, potentially nested, attributes the Identity Provider included about the user.
+   *
+   * Typically, these `attributes` are used to pass along the user's first/last name, or whether they should be
+   * considered an admin within their company.
+   *
+   * @generated from field: google.protobuf.Struct attributes = 5;
+   */
+  attributes?: Struct;
+
+  constructor(data?: PartialMessage<SCIMUser>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SCIMUser";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "attributes", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMUser {
+    return new SCIMUser().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMUser {
+    return new SCIMUser().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMUser {
+    return new SCIMUser().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SCIMUser | PlainMessage<SCIMUser> | undefined, b: SCIMUser | PlainMessage<SCIMUser> | undefined): boolean {
+    return proto3.util.equals(SCIMUser, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.SCIMGroup
+ */
+export class SCIMGroup extends Message<SCIMGroup> {
+  /**
+   * Unique identifier for this SCIM group.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * SCIM directory this SCIM group belongs to.
+   *
+   * @generated from field: string scim_directory_id = 2;
+   */
+  scimDirectoryId = "";
+
+  /**
+   * A human-friendly name for the SCIM group.
+   *
+   * @generated from field: string display_name = 3;
+   */
+  displayName = "";
+
+  /**
+   * Whether the SCIM group has been deleted or deprovisioned from its SCIM directory.
+   *
+   * Identity Providers are inconsistent about reliably deleting SCIM groups. Many Identity Providers will deprovision
+   * the users inside a group, but not the group itself. For this reason, it's typical to ignore this field until a
+   * specific need arises.
+   *
+   * @generated from field: bool deleted = 6;
+   */
+  deleted = false;
+
+  /**
+   * Arbitrary, potentially nested, attributes the Identity Provider included about the group.
+   *
+   * Identity Providers are inconsistent about supporting sending custom attributes on groups. For this reason, it's
+   * typical to not rely on them until a specific need arises.
+   *
+   * @generated from field: google.protobuf.Struct attributes = 4;
+   */
+  attributes?: Struct;
+
+  constructor(data?: PartialMessage<SCIMGroup>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SCIMGroup";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "deleted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "attributes", kind: "message", T: Struct },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMGroup {
+    return new SCIMGroup().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMGroup {
+    return new SCIMGroup().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMGroup {
+    return new SCIMGroup().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SCIMGroup | PlainMessage<SCIMGroup> | undefined, b: SCIMGroup | PlainMessage<SCIMGroup> | undefined): boolean {
+    return proto3.util.equals(SCIMGroup, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.SCIMRequest
+ */
+export class SCIMRequest extends Message<SCIMRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string scim_directory_id = 2;
+   */
+  scimDirectoryId = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp timestamp = 3;
+   */
+  timestamp?: Timestamp;
+
+  /**
+   * @generated from field: string http_request_url = 4;
+   */
+  httpRequestUrl = "";
+
+  /**
+   * @generated from field: ssoready.v1.SCIMRequestHTTPMethod http_request_method = 5;
+   */
+  httpRequestMethod = SCIMRequestHTTPMethod.SCIM_REQUEST_HTTP_METHOD_UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Struct http_request_body = 7;
+   */
+  httpRequestBody?: Struct;
+
+  /**
+   * @generated from field: ssoready.v1.SCIMRequestHTTPStatus http_response_status = 8;
+   */
+  httpResponseStatus = SCIMRequestHTTPStatus.SCIM_REQUEST_HTTP_STATUS_UNSPECIFIED;
+
+  /**
+   * @generated from field: google.protobuf.Struct http_response_body = 9;
+   */
+  httpResponseBody?: Struct;
+
+  /**
+   * @generated from oneof ssoready.v1.SCIMRequest.error
+   */
+  error: {
+    /**
+     * @generated from field: google.protobuf.Empty bad_bearer_token = 10;
+     */
+    value: Empty;
+    case: "badBearerToken";
+  } | {
+    /**
+     * @generated from field: string bad_username = 11;
+     */
+    value: string;
+    case: "badUsername";
+  } | {
+    /**
+     * @generated from field: string email_outside_organization_domains = 12;
+     */
+    value: string;
+    case: "emailOutsideOrganizationDomains";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<SCIMRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SCIMRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "timestamp", kind: "message", T: Timestamp },
+    { no: 4, name: "http_request_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "http_request_method", kind: "enum", T: proto3.getEnumType(SCIMRequestHTTPMethod) },
+    { no: 7, name: "http_request_body", kind: "message", T: Struct },
+    { no: 8, name: "http_response_status", kind: "enum", T: proto3.getEnumType(SCIMRequestHTTPStatus) },
+    { no: 9, name: "http_response_body", kind: "message", T: Struct },
+    { no: 10, name: "bad_bearer_token", kind: "message", T: Empty, oneof: "error" },
+    { no: 11, name: "bad_username", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 12, name: "email_outside_organization_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMRequest {
+    return new SCIMRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMRequest {
+    return new SCIMRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMRequest {
+    return new SCIMRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SCIMRequest | PlainMessage<SCIMRequest> | undefined, b: SCIMRequest | PlainMessage<SCIMRequest> | undefined): boolean {
+    return proto3.util.equals(SCIMRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSAMLRedirectURLRequest
+ */
+export class GetSAMLRedirectURLRequest extends Message<GetSAMLRedirectURLRequest> {
+  /**
+   * The SAML connection to start a SAML login for.
+   *
+   * One of `samlConnectionId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string saml_connection_id = 1;
+   */
+  samlConnectionId = "";
+
+  /**
+   * The ID of the organization to start a SAML login for.
+   *
+   * The primary SAML connection in this organization will be used for logins.
+   *
+   * One of `samlConnectionId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * The `externalId` of the organization to start a SAML login for.
+   *
+   * The primary SAML connection in this organization will be used for logins.
+   *
+   * One of `samlConnectionId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string organization_external_id = 3;
+   */
+  organizationExternalId = "";
+
+  /**
+   * This string will be returned back to you when you redeem this login's SAML access code.
+   *
+   * You can do anything you like with this `state`, but the most common use-case is to keep track of where to redirect
+   * your user back to after logging in with SAML.
+   *
+   * @generated from field: string state = 4;
+   */
+  state = "";
+
+  /**
+   * Options for this login's SAML AuthnRequest.
+   *
+   * `forceAuthn` and `isPassive` are applied in addition to the SAML connection's `authnRequestOptions`. Other fields,
+   * if set, take precedence over the SAML connection's.
+   *
+   * @generated from field: ssoready.v1.SAMLAuthnRequestOptions authn_request_options = 5;
+   */
+  authnRequestOptions?: SAMLAuthnRequestOptions;
+
+  constructor(data?: PartialMessage<GetSAMLRedirectURLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSAMLRedirectURLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "authn_request_options", kind: "message", T: SAMLAuthnRequestOptions },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLRedirectURLRequest {
+    return new GetSAMLRedirectURLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLRequest {
+    return new GetSAMLRedirectURLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLRequest {
+    return new GetSAMLRedirectURLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSAMLRedirectURLRequest | PlainMessage<GetSAMLRedirectURLRequest> | undefined, b: GetSAMLRedirectURLRequest | PlainMessage<GetSAMLRedirectURLRequest> | undefined): boolean {
+    return proto3.util.equals(GetSAMLRedirectURLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSAMLRedirectURLResponse
+ */
+export class GetSAMLRedirectURLResponse extends Message<GetSAMLRedirectURLResponse> {
+  /**
+   * Redirect your user to this URL to start a SAML login.
+   *
+   * @generated from field: string redirect_url = 1;
+   */
+  redirectUrl = "";
+
+  constructor(data?: PartialMessage<GetSAMLRedirectURLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSAMLRedirectURLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLRedirectURLResponse {
+    return new GetSAMLRedirectURLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLResponse {
+    return new GetSAMLRedirectURLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLResponse {
+    return new GetSAMLRedirectURLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSAMLRedirectURLResponse | PlainMessage<GetSAMLRedirectURLResponse> | undefined, b: GetSAMLRedirectURLResponse | PlainMessage<GetSAMLRedirectURLResponse> | undefined): boolean {
+    return proto3.util.equals(GetSAMLRedirectURLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.RedeemSAMLAccessCodeRequest
+ */
+export class RedeemSAMLAccessCodeRequest extends Message<RedeemSAMLAccessCodeRequest> {
+  /**
+   * The SAML access code to redeem.
+   *
+   * @generated from field: string saml_access_code = 1;
+   */
+  samlAccessCode = "";
+
+  constructor(data?: PartialMessage<RedeemSAMLAccessCodeRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.RedeemSAMLAccessCodeRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_access_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedeemSAMLAccessCodeRequest {
+    return new RedeemSAMLAccessCodeRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeRequest {
+    return new RedeemSAMLAccessCodeRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeRequest {
+    return new RedeemSAMLAccessCodeRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RedeemSAMLAccessCodeRequest | PlainMessage<RedeemSAMLAccessCodeRequest> | undefined, b: RedeemSAMLAccessCodeRequest | PlainMessage<RedeemSAMLAccessCodeRequest> | undefined): boolean {
+    return proto3.util.equals(RedeemSAMLAccessCodeRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.RedeemSAMLAccessCodeResponse
+ */
+export class RedeemSAMLAccessCodeResponse extends Message<RedeemSAMLAccessCodeResponse> {
+  /**
+   * The user's email address.
+   *
+   * This is the Identity Provider's subject ID, unless the SAML connection takes emails from a SAML attribute instead.
+   * See `emailAttributeNames` on SAML connections.
+   *
+   * @generated from field: string email = 1;
+   */
+  email = "";
+
+  /**
+   * The Identity Provider's identifier for the user (its "NameID").
+   *
+   * Unlike `email`, some Identity Providers can be configured to never change a user's subject ID. If so, prefer it
+   * over `email` to identify users.
+   *
+   * @generated from field: string subject_id = 8;
+   */
+  subjectId = "";
+
+  /**
+   * The format of `subjectId`, e.g. `urn:oasis:names:tc:SAML:2.0:nameid-format:persistent`, if the Identity Provider
+   * specified one.
+   *
+   * @generated from field: string subject_id_format = 9;
+   */
+  subjectIdFormat = "";
+
+  /**
+   * The `state` you provided when getting a SAML initiation URL, if any.
+   *
+   * If your user logged in to your product using Identity Provider-initiated SAML (e.g. they clicked on your app inside
+   * their corporate Okta dashboard), then `state` will be empty.
+   *
+   * SSOReady validates the authenticity of non-empty `state` values. You do not need to implement your own CSRF on top
+   * of it, but doing so anyway will have no bad consequences.
+   *
+   * @generated from field: string state = 2;
+   */
+  state = "";
+
+  /**
+   * Arbitrary key-value pairs the Identity Provider included about the user.
+   *
+   * Typically, these `attributes` are used to pass along the user's first/last name, or whether they should be
+   * considered an admin within their company.
+   *
+   * If the Identity Provider sent more than one value for an attribute, only the first is included here. Use
+   * `attributeValues` to get every value.
+   *
+   * @generated from field: map<string, string> attributes = 3;
+   */
+  attributes: { [key: string]: string } = {};
+
+  /**
+   * Every value of each attribute the Identity Provider included about the user.
+   *
+   * Identity Providers often send a list of values for attributes such as the user's groups. Those are preserved in
+   * full here, in the order the Identity Provider sent them.
+   *
+   * @generated from field: map<string, ssoready.v1.SAMLAttributeValues> attribute_values = 7;
+   */
+  attributeValues: { [key: string]: SAMLAttributeValues } = {};
+
+  /**
+   * The user's profile, normalized from `attributeValues` according to the SAML connection's attribute mapping.
+   *
+   * Use this instead of `attributes` to get users' names, groups, and so on without handling each Identity
+   * Provider's attribute names yourself.
+   *
+   * @generated from field: ssoready.v1.SAMLProfile profile = 10;
+   */
+  profile?: SAMLProfile;
+
+  /**
+   * The ID of the organization this user belongs to.
+   *
+   * @generated from field: string organization_id = 4;
+   */
+  organizationId = "";
+
+  /**
+   * The `externalId`, if any, of the organization this user belongs to.
+   *
+   * @generated from field: string organization_external_id = 5;
+   */
+  organizationExternalId = "";
+
+  /**
+   * A unique identifier of this particular SAML login. It is not a secret. You can safely log it.
+   *
+   * SSOReady maintains an audit log of every SAML login. Use this SAML flow ID to find this login in the audit logs.
+   *
+   * To log this user out of their Identity Provider later, pass this SAML flow ID to GetSAMLLogoutRedirectURL.
+   *
+   * @generated from field: string saml_flow_id = 6;
+   */
+  samlFlowId = "";
+
+  /**
+   * When the user authenticated to their Identity Provider, if it said.
+   *
+   * If the user already had a session with their Identity Provider, this may be well before they logged in to your
+   * product. Use it to require that users have recently entered their credentials.
+   *
+   * @generated from field: google.protobuf.Timestamp authn_instant = 11;
+   */
+  authnInstant?: Timestamp;
+
+  /**
+   * The Identity Provider's identifier for the user's session, if it provided one.
+   *
+   * @generated from field: string session_index = 12;
+   */
+  sessionIndex = "";
+
+  /**
+   * When the Identity Provider says the user's session should end, if it said.
+   *
+   * @generated from field: google.protobuf.Timestamp session_not_on_or_after = 13;
+   */
+  sessionNotOnOrAfter?: Timestamp;
+
+  /**
+   * How the user authenticated to their Identity Provider, e.g.
+   * `urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport`, if it said.
+   *
+   * @generated from field: string authn_context_class_ref = 14;
+   */
+  authnContextClassRef = "";
+
+  constructor(data?: PartialMessage<RedeemSAMLAccessCodeResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.RedeemSAMLAccessCodeResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "subject_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 9, name: "subject_id_format", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 7, name: "attribute_values", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "message", T: SAMLAttributeValues} },
+    { no: 10, name: "profile", kind: "message", T: SAMLProfile },
+    { no: 4, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "saml_flow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 11, name: "authn_instant", kind: "message", T: Timestamp },
+    { no: 12, name: "session_index", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 13, name: "session_not_on_or_after", kind: "message", T: Timestamp },
+    { no: 14, name: "authn_context_class_ref", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedeemSAMLAccessCodeResponse {
+    return new RedeemSAMLAccessCodeResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeResponse {
+    return new RedeemSAMLAccessCodeResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeResponse {
+    return new RedeemSAMLAccessCodeResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RedeemSAMLAccessCodeResponse | PlainMessage<RedeemSAMLAccessCodeResponse> | undefined, b: RedeemSAMLAccessCodeResponse | PlainMessage<RedeemSAMLAccessCodeResponse> | undefined): boolean {
+    return proto3.util.equals(RedeemSAMLAccessCodeResponse, a, b);
+  }
+}
+
+/**
+ * The values of a SAML attribute.
+ *
+ * @generated from message ssoready.v1.SAMLAttributeValues
+ */
+export class SAMLAttributeValues extends Message<SAMLAttributeValues> {
+  /**
+   * The attribute's values, in the order the Identity Provider sent them.
+   *
+   * @generated from field: repeated string values = 1;
+   */
+  values: string[] = [];
+
+  constructor(data?: PartialMessage<SAMLAttributeValues>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SAMLAttributeValues";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "values", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLAttributeValues {
+    return new SAMLAttributeValues().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLAttributeValues {
+    return new SAMLAttributeValues().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLAttributeValues {
+    return new SAMLAttributeValues().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SAMLAttributeValues | PlainMessage<SAMLAttributeValues> | undefined, b: SAMLAttributeValues | PlainMessage<SAMLAttributeValues> | undefined): boolean {
+    return proto3.util.equals(SAMLAttributeValues, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSAMLLogoutRedirectURLRequest
+ */
+export class GetSAMLLogoutRedirectURLRequest extends Message<GetSAMLLogoutRedirectURLRequest> {
+  /**
+   * The SAML flow whose Identity Provider session to terminate. This is the `samlFlowId` returned when you redeemed
+   * the user's SAML access code.
+   *
+   * @generated from field: string saml_flow_id = 1;
+   */
+  samlFlowId = "";
+
+  /**
+   * Where SSOReady redirects your user back to after their Identity Provider has logged them out.
+   *
+   * Must have the same origin as your environment's redirect URL.
+   *
+   * @generated from field: string return_url = 2;
+   */
+  returnUrl = "";
+
+  constructor(data?: PartialMessage<GetSAMLLogoutRedirectURLRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSAMLLogoutRedirectURLRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_flow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "return_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLLogoutRedirectURLRequest {
+    return new GetSAMLLogoutRedirectURLRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLLogoutRedirectURLRequest {
+    return new GetSAMLLogoutRedirectURLRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLLogoutRedirectURLRequest {
+    return new GetSAMLLogoutRedirectURLRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSAMLLogoutRedirectURLRequest | PlainMessage<GetSAMLLogoutRedirectURLRequest> | undefined, b: GetSAMLLogoutRedirectURLRequest | PlainMessage<GetSAMLLogoutRedirectURLRequest> | undefined): boolean {
+    return proto3.util.equals(GetSAMLLogoutRedirectURLRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSAMLLogoutRedirectURLResponse
+ */
+export class GetSAMLLogoutRedirectURLResponse extends Message<GetSAMLLogoutRedirectURLResponse> {
+  /**
+   * Redirect your user to this URL to log them out of their Identity Provider.
+   *
+   * @generated from field: string redirect_url = 1;
+   */
+  redirectUrl = "";
+
+  /**
+   * The SAML logout this redirect URL starts.
+   *
+   * @generated from field: string saml_logout_id = 2;
+   */
+  samlLogoutId = "";
+
+  constructor(data?: PartialMessage<GetSAMLLogoutRedirectURLResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSAMLLogoutRedirectURLResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_logout_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLLogoutRedirectURLResponse {
+    return new GetSAMLLogoutRedirectURLResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLLogoutRedirectURLResponse {
+    return new GetSAMLLogoutRedirectURLResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLLogoutRedirectURLResponse {
+    return new GetSAMLLogoutRedirectURLResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSAMLLogoutRedirectURLResponse | PlainMessage<GetSAMLLogoutRedirectURLResponse> | undefined, b: GetSAMLLogoutRedirectURLResponse | PlainMessage<GetSAMLLogoutRedirectURLResponse> | undefined): boolean {
+    return proto3.util.equals(GetSAMLLogoutRedirectURLResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListSAMLLogoutsRequest
+ */
+export class ListSAMLLogoutsRequest extends Message<ListSAMLLogoutsRequest> {
+  /**
+   * The SAML connection to list SAML logouts from.
+   *
+   * @generated from field: string saml_connection_id = 1;
+   */
+  samlConnectionId = "";
+
+  /**
+   * Pagination token. Leave empty to get the first page of results.
+   *
+   * @generated from field: string page_token = 2;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSAMLLogoutsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListSAMLLogoutsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLLogoutsRequest {
+    return new ListSAMLLogoutsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLLogoutsRequest {
+    return new ListSAMLLogoutsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLLogoutsRequest {
+    return new ListSAMLLogoutsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSAMLLogoutsRequest | PlainMessage<ListSAMLLogoutsRequest> | undefined, b: ListSAMLLogoutsRequest | PlainMessage<ListSAMLLogoutsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSAMLLogoutsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListSAMLLogoutsResponse
+ */
+export class ListSAMLLogoutsResponse extends Message<ListSAMLLogoutsResponse> {
+  /**
+   * The list of SAML logouts, most recent first.
+   *
+   * @generated from field: repeated ssoready.v1.SAMLLogout saml_logouts = 1;
+   */
+  samlLogouts: SAMLLogout[] = [];
+
+  /**
+   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSAMLLogoutsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListSAMLLogoutsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_logouts", kind: "message", T: SAMLLogout, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLLogoutsResponse {
+    return new ListSAMLLogoutsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLLogoutsResponse {
+    return new ListSAMLLogoutsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLLogoutsResponse {
+    return new ListSAMLLogoutsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSAMLLogoutsResponse | PlainMessage<ListSAMLLogoutsResponse> | undefined, b: ListSAMLLogoutsResponse | PlainMessage<ListSAMLLogoutsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSAMLLogoutsResponse, a, b);
+  }
+}
+
+/**
+ * A request to terminate a user's sessions, made by either your application or the Identity Provider.
+ *
+ * @generated from message ssoready.v1.SAMLLogout
+ */
+export class SAMLLogout extends Message<SAMLLogout> {
+  /**
+   * Unique identifier for this SAML logout.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The SAML connection this SAML logout belongs to.
+   *
+   * @generated from field: string saml_connection_id = 2;
+   */
+  samlConnectionId = "";
+
+  /**
+   * Whether the Identity Provider initiated this SAML logout, rather than your application.
+   *
+   * If true, terminate the sessions listed in `samlFlowIds` within your application.
+   *
+   * @generated from field: bool idp_initiated = 3;
+   */
+  idpInitiated = false;
+
+  /**
+   * The user's email address.
+   *
+   * @generated from field: string email = 4;
+   */
+  email = "";
+
+  /**
+   * The Identity Provider's identifiers for the sessions to terminate. If empty, all of the user's sessions are to be
+   * terminated.
+   *
+   * @generated from field: repeated string session_indexes = 5;
+   */
+  sessionIndexes: string[] = [];
+
+  /**
+   * The SAML flows whose sessions are to be terminated. These are the `samlFlowId` values returned when you redeemed
+   * the user's SAML access codes.
+   *
+   * @generated from field: repeated string saml_flow_ids = 6;
+   */
+  samlFlowIds: string[] = [];
+
+  /**
+   * @generated from field: google.protobuf.Timestamp create_time = 7;
+   */
+  createTime?: Timestamp;
+
+  /**
+   * When the Identity Provider confirmed the SAML logout, for logouts your application initiated. For logouts the
+   * Identity Provider initiated, when SSOReady received it.
+   *
+   * @generated from field: google.protobuf.Timestamp complete_time = 8;
+   */
+  completeTime?: Timestamp;
+
+  /**
+   * Whether the Identity Provider reported that the SAML logout succeeded.
+   *
+   * @generated from field: bool success = 9;
+   */
+  success = false;
+
+  constructor(data?: PartialMessage<SAMLLogout>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SAMLLogout";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "idp_initiated", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "session_indexes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 6, name: "saml_flow_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "create_time", kind: "message", T: Timestamp },
+    { no: 8, name: "complete_time", kind: "message", T: Timestamp },
+    { no: 9, name: "success", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLLogout {
+    return new SAMLLogout().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLLogout {
+    return new SAMLLogout().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLLogout {
+    return new SAMLLogout().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SAMLLogout | PlainMessage<SAMLLogout> | undefined, b: SAMLLogout | PlainMessage<SAMLLogout> | undefined): boolean {
+    return proto3.util.equals(SAMLLogout, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListSCIMUsersRequest
+ */
+export class ListSCIMUsersRequest extends Message<ListSCIMUsersRequest> {
+  /**
+   * The SCIM directory to list from.
+   *
+   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string scim_directory_id = 1;
+   */
+  scimDirectoryId = "";
+
+  /**
+   * The ID of the organization to list from. The primary SCIM directory of this organization is used.
+   *
+   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * The `externalId` of the organization to list from. The primary SCIM directory of this organization is used.
+   *
+   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string organization_external_id = 3;
+   */
+  organizationExternalId = "";
+
+  /**
+   * If specified, only users that are members of this SCIM group are returned.
+   *
+   * @generated from field: string scim_group_id = 4;
+   */
+  scimGroupId = "";
+
+  /**
+   * Pagination token. Leave empty to get the first page of results.
+   *
+   * @generated from field: string page_token = 5;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSCIMUsersRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListSCIMUsersRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "scim_group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMUsersRequest {
+    return new ListSCIMUsersRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMUsersRequest {
+    return new ListSCIMUsersRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMUsersRequest {
+    return new ListSCIMUsersRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSCIMUsersRequest | PlainMessage<ListSCIMUsersRequest> | undefined, b: ListSCIMUsersRequest | PlainMessage<ListSCIMUsersRequest> | undefined): boolean {
+    return proto3.util.equals(ListSCIMUsersRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListSCIMUsersResponse
+ */
+export class ListSCIMUsersResponse extends Message<ListSCIMUsersResponse> {
+  /**
+   * List of SCIM users.
+   *
+   * @generated from field: repeated ssoready.v1.SCIMUser scim_users = 1;
+   */
+  scimUsers: SCIMUser[] = [];
+
+  /**
+   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSCIMUsersResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListSCIMUsersResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_users", kind: "message", T: SCIMUser, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMUsersResponse {
+    return new ListSCIMUsersResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMUsersResponse {
+    return new ListSCIMUsersResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMUsersResponse {
+    return new ListSCIMUsersResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSCIMUsersResponse | PlainMessage<ListSCIMUsersResponse> | undefined, b: ListSCIMUsersResponse | PlainMessage<ListSCIMUsersResponse> | undefined): boolean {
+    return proto3.util.equals(ListSCIMUsersResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSCIMUserRequest
+ */
+export class GetSCIMUserRequest extends Message<GetSCIMUserRequest> {
+  /**
+   * ID of the SCIM user to get.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetSCIMUserRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSCIMUserRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMUserRequest {
+    return new GetSCIMUserRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMUserRequest {
+    return new GetSCIMUserRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMUserRequest {
+    return new GetSCIMUserRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSCIMUserRequest | PlainMessage<GetSCIMUserRequest> | undefined, b: GetSCIMUserRequest | PlainMessage<GetSCIMUserRequest> | undefined): boolean {
+    return proto3.util.equals(GetSCIMUserRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSCIMUserResponse
+ */
+export class GetSCIMUserResponse extends Message<GetSCIMUserResponse> {
+  /**
+   * The requested SCIM user.
+   *
+   * @generated from field: ssoready.v1.SCIMUser scim_user = 1;
+   */
+  scimUser?: SCIMUser;
+
+  constructor(data?: PartialMessage<GetSCIMUserResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSCIMUserResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_user", kind: "message", T: SCIMUser },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMUserResponse {
+    return new GetSCIMUserResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMUserResponse {
+    return new GetSCIMUserResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMUserResponse {
+    return new GetSCIMUserResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSCIMUserResponse | PlainMessage<GetSCIMUserResponse> | undefined, b: GetSCIMUserResponse | PlainMessage<GetSCIMUserResponse> | undefined): boolean {
+    return proto3.util.equals(GetSCIMUserResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListSCIMGroupsRequest
+ */
+export class ListSCIMGroupsRequest extends Message<ListSCIMGroupsRequest> {
+  /**
+   * The SCIM directory to list from.
+   *
+   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string scim_directory_id = 1;
+   */
+  scimDirectoryId = "";
+
+  /**
+   * The ID of the organization to list from. The primary SCIM directory of this organization is used.
+   *
+   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string organization_id = 2;
+   */
+  organizationId = "";
+
+  /**
+   * The `externalId` of the organization to list from. The primary SCIM directory of this organization is used.
+   *
+   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   *
+   * @generated from field: string organization_external_id = 3;
+   */
+  organizationExternalId = "";
+
+  /**
+   * Pagination token. Leave empty to get the first page of results.
+   *
+   * @generated from field: string page_token = 4;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListSCIMGroupsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListSCIMGroupsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMGroupsRequest {
+    return new ListSCIMGroupsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMGroupsRequest {
+    return new ListSCIMGroupsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMGroupsRequest {
+    return new ListSCIMGroupsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSCIMGroupsRequest | PlainMessage<ListSCIMGroupsRequest> | undefined, b: ListSCIMGroupsRequest | PlainMessage<ListSCIMGroupsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSCIMGroupsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListSCIMGroupsResponse
+ */
+export class ListSCIMGroupsResponse extends Message<ListSCIMGroupsResponse> {
+  /**
+   * List of SCIM groups.
+   *
+   * @generated from field: repeated ssoready.v1.SCIMGroup scim_groups = 1;
+   */
+  scimGroups: SCIMGroup[] = [];
+
+  /**
+   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSCIMGroupsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListSCIMGroupsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_groups", kind: "message", T: SCIMGroup, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMGroupsResponse {
+    return new ListSCIMGroupsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMGroupsResponse {
+    return new ListSCIMGroupsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMGroupsResponse {
+    return new ListSCIMGroupsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListSCIMGroupsResponse | PlainMessage<ListSCIMGroupsResponse> | undefined, b: ListSCIMGroupsResponse | PlainMessage<ListSCIMGroupsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSCIMGroupsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSCIMGroupRequest
+ */
+export class GetSCIMGroupRequest extends Message<GetSCIMGroupRequest> {
+  /**
+   * ID of the SCIM group to get.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetSCIMGroupRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSCIMGroupRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMGroupRequest {
+    return new GetSCIMGroupRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMGroupRequest {
+    return new GetSCIMGroupRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMGroupRequest {
+    return new GetSCIMGroupRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSCIMGroupRequest | PlainMessage<GetSCIMGroupRequest> | undefined, b: GetSCIMGroupRequest | PlainMessage<GetSCIMGroupRequest> | undefined): boolean {
+    return proto3.util.equals(GetSCIMGroupRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetSCIMGroupResponse
+ */
+export class GetSCIMGroupResponse extends Message<GetSCIMGroupResponse> {
+  /**
+   * The requested SCIM group.
+   *
+   * @generated from field: ssoready.v1.SCIMGroup scim_group = 1;
+   */
+  scimGroup?: SCIMGroup;
+
+  constructor(data?: PartialMessage<GetSCIMGroupResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSCIMGroupResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_group", kind: "message", T: SCIMGroup },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMGroupResponse {
+    return new GetSCIMGroupResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMGroupResponse {
+    return new GetSCIMGroupResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMGroupResponse {
+    return new GetSCIMGroupResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSCIMGroupResponse | PlainMessage<GetSCIMGroupResponse> | undefined, b: GetSCIMGroupResponse | PlainMessage<GetSCIMGroupResponse> | undefined): boolean {
+    return proto3.util.equals(GetSCIMGroupResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListOrganizationsRequest
+ */
+export class ListOrganizationsRequest extends Message<ListOrganizationsRequest> {
+  /**
+   * Pagination token. Leave empty to get the first page of results.
+   *
+   * @generated from field: string page_token = 1;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListOrganizationsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationsRequest {
+    return new ListOrganizationsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationsRequest {
+    return new ListOrganizationsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationsRequest {
+    return new ListOrganizationsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationsRequest | PlainMessage<ListOrganizationsRequest> | undefined, b: ListOrganizationsRequest | PlainMessage<ListOrganizationsRequest> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.ListOrganizationsResponse
+ */
+export class ListOrganizationsResponse extends Message<ListOrganizationsResponse> {
+  /**
+   * List of organizations.
+   *
+   * @generated from field: repeated ssoready.v1.Organization organizations = 1;
+   */
+  organizations: Organization[] = [];
+
+  /**
+   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListOrganizationsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.ListOrganizationsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organizations", kind: "message", T: Organization, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationsResponse {
+    return new ListOrganizationsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationsResponse {
+    return new ListOrganizationsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationsResponse {
+    return new ListOrganizationsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ListOrganizationsResponse | PlainMessage<ListOrganizationsResponse> | undefined, b: ListOrganizationsResponse | PlainMessage<ListOrganizationsResponse> | undefined): boolean {
+    return proto3.util.equals(ListOrganizationsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetOrganizationRequest
+ */
+export class GetOrganizationRequest extends Message<GetOrganizationRequest> {
+  /**
+   * ID of the organization to get.
+   *
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetOrganizationRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetOrganizationRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationRequest {
+    return new GetOrganizationRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationRequest {
+    return new GetOrganizationRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationRequest {
+    return new GetOrganizationRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetOrganizationRequest | PlainMessage<GetOrganizationRequest> | undefined, b: GetOrganizationRequest | PlainMessage<GetOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.GetOrganizationResponse
+ */
+export class GetOrganizationResponse extends Message<GetOrganizationResponse> {
+  /**
+   * The requested organization.
+   *
+   * @generated from field: ssoready.v1.Organization organization = 1;
+   */
+  organization?: Organization;
 
-  constructor(data?: PartialMessage<SCIMRequest>) {
+  constructor(data?: PartialMessage<GetOrganizationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SCIMRequest";
+  static readonly typeName = "ssoready.v1.GetOrganizationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "timestamp", kind: "message", T: Timestamp },
-    { no: 4, name: "http_request_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "http_request_method", kind: "enum", T: proto3.getEnumType(SCIMRequestHTTPMethod) },
-    { no: 7, name: "http_request_body", kind: "message", T: Struct },
-    { no: 8, name: "http_response_status", kind: "enum", T: proto3.getEnumType(SCIMRequestHTTPStatus) },
-    { no: 9, name: "http_response_body", kind: "message", T: Struct },
-    { no: 10, name: "bad_bearer_token", kind: "message", T: Empty, oneof: "error" },
-    { no: 11, name: "bad_username", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
-    { no: 12, name: "email_outside_organization_domains", kind: "scalar", T: 9 /* ScalarType.STRING */, oneof: "error" },
+    { no: 1, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SCIMRequest {
-    return new SCIMRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationResponse {
+    return new GetOrganizationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SCIMRequest {
-    return new SCIMRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationResponse {
+    return new GetOrganizationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SCIMRequest {
-    return new SCIMRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationResponse {
+    return new GetOrganizationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SCIMRequest | PlainMessage<SCIMRequest> | undefined, b: SCIMRequest | PlainMessage<SCIMRequest> | undefined): boolean {
-    return proto3.util.equals(SCIMRequest, a, b);
+  static equals(a: GetOrganizationResponse | PlainMessage<GetOrganizationResponse> | undefined, b: GetOrganizationResponse | PlainMessage<GetOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(GetOrganizationResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSAMLRedirectURLRequest
+ * @generated from message ssoready.v1.CreateOrganizationRequest
  */
-export class GetSAMLRedirectURLRequest extends Message<GetSAMLRedirectURLRequest> {
-  /**
-   * The SAML connection to start a SAML login for.
-   *
-   * One of `samlConnectionId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string saml_connection_id = 1;
-   */
-  samlConnectionId = "";
-
-  /**
-   * The ID of the organization to start a SAML login for.
-   *
-   * The primary SAML connection in this organization will be used for logins.
-   *
-   * One of `samlConnectionId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string organization_id = 2;
-   */
-  organizationId = "";
-
-  /**
-   * The `externalId` of the organization to start a SAML login for.
-   *
-   * The primary SAML connection in this organization will be used for logins.
-   *
-   * One of `samlConnectionId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string organization_external_id = 3;
-   */
-  organizationExternalId = "";
-
+export class CreateOrganizationRequest extends Message<CreateOrganizationRequest> {
   /**
-   * This string will be returned back to you when you redeem this login's SAML access code.
-   *
-   * You can do anything you like with this `state`, but the most common use-case is to keep track of where to redirect
-   * your user back to after logging in with SAML.
-   *
-   * @generated from field: string state = 4;
+   * @generated from field: ssoready.v1.Organization organization = 1;
    */
-  state = "";
+  organization?: Organization;
 
-  constructor(data?: PartialMessage<GetSAMLRedirectURLRequest>) {
+  constructor(data?: PartialMessage<CreateOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSAMLRedirectURLRequest";
+  static readonly typeName = "ssoready.v1.CreateOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLRedirectURLRequest {
-    return new GetSAMLRedirectURLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationRequest {
+    return new CreateOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLRequest {
-    return new GetSAMLRedirectURLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationRequest {
+    return new CreateOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLRequest {
-    return new GetSAMLRedirectURLRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationRequest {
+    return new CreateOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSAMLRedirectURLRequest | PlainMessage<GetSAMLRedirectURLRequest> | undefined, b: GetSAMLRedirectURLRequest | PlainMessage<GetSAMLRedirectURLRequest> | undefined): boolean {
-    return proto3.util.equals(GetSAMLRedirectURLRequest, a, b);
+  static equals(a: CreateOrganizationRequest | PlainMessage<CreateOrganizationRequest> | undefined, b: CreateOrganizationRequest | PlainMessage<CreateOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSAMLRedirectURLResponse
+ * @generated from message ssoready.v1.CreateOrganizationResponse
  */
-export class GetSAMLRedirectURLResponse extends Message<GetSAMLRedirectURLResponse> {
+export class CreateOrganizationResponse extends Message<CreateOrganizationResponse> {
   /**
-   * Redirect your user to this URL to start a SAML login.
+   * The created organization.
    *
-   * @generated from field: string redirect_url = 1;
+   * @generated from field: ssoready.v1.Organization organization = 1;
    */
-  redirectUrl = "";
+  organization?: Organization;
 
-  constructor(data?: PartialMessage<GetSAMLRedirectURLResponse>) {
+  constructor(data?: PartialMessage<CreateOrganizationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSAMLRedirectURLResponse";
+  static readonly typeName = "ssoready.v1.CreateOrganizationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLRedirectURLResponse {
-    return new GetSAMLRedirectURLResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationResponse {
+    return new CreateOrganizationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLResponse {
-    return new GetSAMLRedirectURLResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationResponse {
+    return new CreateOrganizationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLRedirectURLResponse {
-    return new GetSAMLRedirectURLResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationResponse {
+    return new CreateOrganizationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSAMLRedirectURLResponse | PlainMessage<GetSAMLRedirectURLResponse> | undefined, b: GetSAMLRedirectURLResponse | PlainMessage<GetSAMLRedirectURLResponse> | undefined): boolean {
-    return proto3.util.equals(GetSAMLRedirectURLResponse, a, b);
+  static equals(a: CreateOrganizationResponse | PlainMessage<CreateOrganizationResponse> | undefined, b: CreateOrganizationResponse | PlainMessage<CreateOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(CreateOrganizationResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.RedeemSAMLAccessCodeRequest
+ * @generated from message ssoready.v1.UpdateOrganizationRequest
  */
-export class RedeemSAMLAccessCodeRequest extends Message<RedeemSAMLAccessCodeRequest> {
+export class UpdateOrganizationRequest extends Message<UpdateOrganizationRequest> {
   /**
-   * The SAML access code to redeem.
+   * ID of the organization to update.
    *
-   * @generated from field: string saml_access_code = 1;
+   * @generated from field: string id = 1;
    */
-  samlAccessCode = "";
+  id = "";
 
-  constructor(data?: PartialMessage<RedeemSAMLAccessCodeRequest>) {
+  /**
+   * The updated organization.
+   *
+   * @generated from field: ssoready.v1.Organization organization = 2;
+   */
+  organization?: Organization;
+
+  constructor(data?: PartialMessage<UpdateOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.RedeemSAMLAccessCodeRequest";
+  static readonly typeName = "ssoready.v1.UpdateOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_access_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedeemSAMLAccessCodeRequest {
-    return new RedeemSAMLAccessCodeRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationRequest {
+    return new UpdateOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeRequest {
-    return new RedeemSAMLAccessCodeRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationRequest {
+    return new UpdateOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeRequest {
-    return new RedeemSAMLAccessCodeRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationRequest {
+    return new UpdateOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RedeemSAMLAccessCodeRequest | PlainMessage<RedeemSAMLAccessCodeRequest> | undefined, b: RedeemSAMLAccessCodeRequest | PlainMessage<RedeemSAMLAccessCodeRequest> | undefined): boolean {
-    return proto3.util.equals(RedeemSAMLAccessCodeRequest, a, b);
+  static equals(a: UpdateOrganizationRequest | PlainMessage<UpdateOrganizationRequest> | undefined, b: UpdateOrganizationRequest | PlainMessage<UpdateOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.RedeemSAMLAccessCodeResponse
+ * @generated from message ssoready.v1.UpdateOrganizationResponse
  */
-export class RedeemSAMLAccessCodeResponse extends Message<RedeemSAMLAccessCodeResponse> {
+export class UpdateOrganizationResponse extends Message<UpdateOrganizationResponse> {
   /**
-   * The user's email address.
+   * The updated organization.
    *
-   * @generated from field: string email = 1;
+   * @generated from field: ssoready.v1.Organization organization = 1;
    */
-  email = "";
+  organization?: Organization;
 
-  /**
-   * The `state` you provided when getting a SAML initiation URL, if any.
-   *
-   * If your user logged in to your product using Identity Provider-initiated SAML (e.g. they clicked on your app inside
-   * their corporate Okta dashboard), then `state` will be empty.
-   *
-   * SSOReady validates the authenticity of non-empty `state` values. You do not need to implement your own CSRF on top
-   * of it, but doing so anyway will have no bad consequences.
-   *
-   * @generated from field: string state = 2;
-   */
-  state = "";
+  constructor(data?: PartialMessage<UpdateOrganizationResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
 
-  /**
-   * Arbitrary key-value pairs the Identity Provider included about the user.
-   *
-   * Typically, these `attributes` are used to pass along the user's first/last name, or whether they should be
-   * considered an admin within their company.
-   *
-   * @generated from field: map<string, string> attributes = 3;
-   */
-  attributes: { [key: string]: string } = {};
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.UpdateOrganizationResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "organization", kind: "message", T: Organization },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationResponse {
+    return new UpdateOrganizationResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationResponse {
+    return new UpdateOrganizationResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationResponse {
+    return new UpdateOrganizationResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateOrganizationResponse | PlainMessage<UpdateOrganizationResponse> | undefined, b: UpdateOrganizationResponse | PlainMessage<UpdateOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateOrganizationResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message ssoready.v1.CreateSetupURLRequest
+ */
+export class CreateSetupURLRequest extends Message<CreateSetupURLRequest> {
   /**
-   * The ID of the organization this user belongs to.
+   * The organization that the setup URL is for.
    *
-   * @generated from field: string organization_id = 4;
+   * @generated from field: string organization_id = 1;
    */
   organizationId = "";
 
   /**
-   * The `externalId`, if any, of the organization this user belongs to.
+   * Whether the setup URL lets the user manage SAML connections.
    *
-   * @generated from field: string organization_external_id = 5;
+   * @generated from field: bool can_manage_saml = 2;
    */
-  organizationExternalId = "";
+  canManageSaml = false;
 
   /**
-   * A unique identifier of this particular SAML login. It is not a secret. You can safely log it.
-   *
-   * SSOReady maintains an audit log of every SAML login. Use this SAML flow ID to find this login in the audit logs.
+   * Whether the setup URL lets the user manage SCIM directories.
    *
-   * @generated from field: string saml_flow_id = 6;
+   * @generated from field: bool can_manage_scim = 3;
    */
-  samlFlowId = "";
+  canManageScim = false;
 
-  constructor(data?: PartialMessage<RedeemSAMLAccessCodeResponse>) {
+  constructor(data?: PartialMessage<CreateSetupURLRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.RedeemSAMLAccessCodeResponse";
+  static readonly typeName = "ssoready.v1.CreateSetupURLRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "state", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "attributes", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
-    { no: 4, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 6, name: "saml_flow_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "can_manage_saml", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "can_manage_scim", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RedeemSAMLAccessCodeResponse {
-    return new RedeemSAMLAccessCodeResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSetupURLRequest {
+    return new CreateSetupURLRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeResponse {
-    return new RedeemSAMLAccessCodeResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSetupURLRequest {
+    return new CreateSetupURLRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RedeemSAMLAccessCodeResponse {
-    return new RedeemSAMLAccessCodeResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSetupURLRequest {
+    return new CreateSetupURLRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RedeemSAMLAccessCodeResponse | PlainMessage<RedeemSAMLAccessCodeResponse> | undefined, b: RedeemSAMLAccessCodeResponse | PlainMessage<RedeemSAMLAccessCodeResponse> | undefined): boolean {
-    return proto3.util.equals(RedeemSAMLAccessCodeResponse, a, b);
+  static equals(a: CreateSetupURLRequest | PlainMessage<CreateSetupURLRequest> | undefined, b: CreateSetupURLRequest | PlainMessage<CreateSetupURLRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSetupURLRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSCIMUsersRequest
+ * @generated from message ssoready.v1.CreateSetupURLResponse
  */
-export class ListSCIMUsersRequest extends Message<ListSCIMUsersRequest> {
-  /**
-   * The SCIM directory to list from.
-   *
-   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string scim_directory_id = 1;
-   */
-  scimDirectoryId = "";
-
-  /**
-   * The ID of the organization to list from. The primary SCIM directory of this organization is used.
-   *
-   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string organization_id = 2;
-   */
-  organizationId = "";
-
-  /**
-   * The `externalId` of the organization to list from. The primary SCIM directory of this organization is used.
-   *
-   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string organization_external_id = 3;
-   */
-  organizationExternalId = "";
-
+export class CreateSetupURLResponse extends Message<CreateSetupURLResponse> {
   /**
-   * If specified, only users that are members of this SCIM group are returned.
+   * The one-time, short-lived self-serve setup URL.
    *
-   * @generated from field: string scim_group_id = 4;
-   */
-  scimGroupId = "";
-
-  /**
-   * Pagination token. Leave empty to get the first page of results.
+   * Do not log or store this URL. Because this URL is one-time, loading it yourself means your customer will not be
+   * able to load it after you.
    *
-   * @generated from field: string page_token = 5;
+   * @generated from field: string url = 1;
    */
-  pageToken = "";
+  url = "";
 
-  constructor(data?: PartialMessage<ListSCIMUsersRequest>) {
+  constructor(data?: PartialMessage<CreateSetupURLResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSCIMUsersRequest";
+  static readonly typeName = "ssoready.v1.CreateSetupURLResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "scim_group_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMUsersRequest {
-    return new ListSCIMUsersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSetupURLResponse {
+    return new CreateSetupURLResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMUsersRequest {
-    return new ListSCIMUsersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSetupURLResponse {
+    return new CreateSetupURLResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMUsersRequest {
-    return new ListSCIMUsersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSetupURLResponse {
+    return new CreateSetupURLResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSCIMUsersRequest | PlainMessage<ListSCIMUsersRequest> | undefined, b: ListSCIMUsersRequest | PlainMessage<ListSCIMUsersRequest> | undefined): boolean {
-    return proto3.util.equals(ListSCIMUsersRequest, a, b);
+  static equals(a: CreateSetupURLResponse | PlainMessage<CreateSetupURLResponse> | undefined, b: CreateSetupURLResponse | PlainMessage<CreateSetupURLResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSetupURLResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSCIMUsersResponse
+ * @generated from message ssoready.v1.ListSAMLConnectionsRequest
  */
-export class ListSCIMUsersResponse extends Message<ListSCIMUsersResponse> {
+export class ListSAMLConnectionsRequest extends Message<ListSAMLConnectionsRequest> {
   /**
-   * List of SCIM users.
+   * The organization the SAML connections belong to.
    *
-   * @generated from field: repeated ssoready.v1.SCIMUser scim_users = 1;
+   * @generated from field: string organization_id = 1;
    */
-  scimUsers: SCIMUser[] = [];
+  organizationId = "";
 
   /**
-   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   * Pagination token. Leave empty to get the first page of results.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string page_token = 2;
    */
-  nextPageToken = "";
+  pageToken = "";
 
-  constructor(data?: PartialMessage<ListSCIMUsersResponse>) {
+  constructor(data?: PartialMessage<ListSAMLConnectionsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSCIMUsersResponse";
+  static readonly typeName = "ssoready.v1.ListSAMLConnectionsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_users", kind: "message", T: SCIMUser, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMUsersResponse {
-    return new ListSCIMUsersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLConnectionsRequest {
+    return new ListSAMLConnectionsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMUsersResponse {
-    return new ListSCIMUsersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLConnectionsRequest {
+    return new ListSAMLConnectionsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMUsersResponse {
-    return new ListSCIMUsersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLConnectionsRequest {
+    return new ListSAMLConnectionsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSCIMUsersResponse | PlainMessage<ListSCIMUsersResponse> | undefined, b: ListSCIMUsersResponse | PlainMessage<ListSCIMUsersResponse> | undefined): boolean {
-    return proto3.util.equals(ListSCIMUsersResponse, a, b);
+  static equals(a: ListSAMLConnectionsRequest | PlainMessage<ListSAMLConnectionsRequest> | undefined, b: ListSAMLConnectionsRequest | PlainMessage<ListSAMLConnectionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSAMLConnectionsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSCIMUserRequest
+ * @generated from message ssoready.v1.ListSAMLConnectionsResponse
  */
-export class GetSCIMUserRequest extends Message<GetSCIMUserRequest> {
+export class ListSAMLConnectionsResponse extends Message<ListSAMLConnectionsResponse> {
   /**
-   * ID of the SCIM user to get.
+   * The list of SAML connections.
    *
-   * @generated from field: string id = 1;
+   * @generated from field: repeated ssoready.v1.SAMLConnection saml_connections = 1;
    */
-  id = "";
+  samlConnections: SAMLConnection[] = [];
 
-  constructor(data?: PartialMessage<GetSCIMUserRequest>) {
+  /**
+   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListSAMLConnectionsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSCIMUserRequest";
+  static readonly typeName = "ssoready.v1.ListSAMLConnectionsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connections", kind: "message", T: SAMLConnection, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMUserRequest {
-    return new GetSCIMUserRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLConnectionsResponse {
+    return new ListSAMLConnectionsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMUserRequest {
-    return new GetSCIMUserRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLConnectionsResponse {
+    return new ListSAMLConnectionsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMUserRequest {
-    return new GetSCIMUserRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLConnectionsResponse {
+    return new ListSAMLConnectionsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSCIMUserRequest | PlainMessage<GetSCIMUserRequest> | undefined, b: GetSCIMUserRequest | PlainMessage<GetSCIMUserRequest> | undefined): boolean {
-    return proto3.util.equals(GetSCIMUserRequest, a, b);
+  static equals(a: ListSAMLConnectionsResponse | PlainMessage<ListSAMLConnectionsResponse> | undefined, b: ListSAMLConnectionsResponse | PlainMessage<ListSAMLConnectionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSAMLConnectionsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSCIMUserResponse
+ * @generated from message ssoready.v1.ListExpiringSAMLConnectionsRequest
  */
-export class GetSCIMUserResponse extends Message<GetSCIMUserResponse> {
+export class ListExpiringSAMLConnectionsRequest extends Message<ListExpiringSAMLConnectionsRequest> {
   /**
-   * The requested SCIM user.
+   * List SAML connections with an IDP certificate that expires within this many days. SAML connections with an IDP
+   * certificate that has already expired are always listed. Defaults to 30.
    *
-   * @generated from field: ssoready.v1.SCIMUser scim_user = 1;
+   * A SAML connection's primary IDP certificate is not listed if one of its additional IDP certificates will be
+   * trusted in its place.
+   *
+   * @generated from field: int32 expires_within_days = 1;
    */
-  scimUser?: SCIMUser;
+  expiresWithinDays = 0;
 
-  constructor(data?: PartialMessage<GetSCIMUserResponse>) {
+  constructor(data?: PartialMessage<ListExpiringSAMLConnectionsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSCIMUserResponse";
+  static readonly typeName = "ssoready.v1.ListExpiringSAMLConnectionsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_user", kind: "message", T: SCIMUser },
+    { no: 1, name: "expires_within_days", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMUserResponse {
-    return new GetSCIMUserResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListExpiringSAMLConnectionsRequest {
+    return new ListExpiringSAMLConnectionsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMUserResponse {
-    return new GetSCIMUserResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListExpiringSAMLConnectionsRequest {
+    return new ListExpiringSAMLConnectionsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMUserResponse {
-    return new GetSCIMUserResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListExpiringSAMLConnectionsRequest {
+    return new ListExpiringSAMLConnectionsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSCIMUserResponse | PlainMessage<GetSCIMUserResponse> | undefined, b: GetSCIMUserResponse | PlainMessage<GetSCIMUserResponse> | undefined): boolean {
-    return proto3.util.equals(GetSCIMUserResponse, a, b);
+  static equals(a: ListExpiringSAMLConnectionsRequest | PlainMessage<ListExpiringSAMLConnectionsRequest> | undefined, b: ListExpiringSAMLConnectionsRequest | PlainMessage<ListExpiringSAMLConnectionsRequest> | undefined): boolean {
+    return proto3.util.equals(ListExpiringSAMLConnectionsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSCIMGroupsRequest
+ * An IDP certificate that is expiring soon, or has already expired.
+ *
+ * @generated from message ssoready.v1.ExpiringIDPCertificate
  */
-export class ListSCIMGroupsRequest extends Message<ListSCIMGroupsRequest> {
+export class ExpiringIDPCertificate extends Message<ExpiringIDPCertificate> {
   /**
-   * The SCIM directory to list from.
-   *
-   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   * ID of the SAML connection the certificate belongs to.
    *
-   * @generated from field: string scim_directory_id = 1;
+   * @generated from field: string saml_connection_id = 1;
    */
-  scimDirectoryId = "";
+  samlConnectionId = "";
 
   /**
-   * The ID of the organization to list from. The primary SCIM directory of this organization is used.
+   * ID of the SAML connection's additional IDP certificate. Empty if the certificate is the SAML connection's primary
+   * IDP certificate.
    *
-   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
-   *
-   * @generated from field: string organization_id = 2;
+   * @generated from field: string saml_connection_idp_certificate_id = 2;
    */
-  organizationId = "";
+  samlConnectionIdpCertificateId = "";
 
   /**
-   * The `externalId` of the organization to list from. The primary SCIM directory of this organization is used.
-   *
-   * One of `scimDirectoryId`, `organizationId`, or `organizationExternalId` must be specified.
+   * A PEM-encoded X.509 certificate.
    *
-   * @generated from field: string organization_external_id = 3;
+   * @generated from field: string certificate = 3;
    */
-  organizationExternalId = "";
+  certificate = "";
 
   /**
-   * Pagination token. Leave empty to get the first page of results.
+   * When the certificate stops being trusted. This is the end of the certificate's validity period, or the additional
+   * IDP certificate's not_after if that is sooner.
    *
-   * @generated from field: string page_token = 4;
+   * @generated from field: google.protobuf.Timestamp expire_time = 4;
    */
-  pageToken = "";
+  expireTime?: Timestamp;
 
-  constructor(data?: PartialMessage<ListSCIMGroupsRequest>) {
+  constructor(data?: PartialMessage<ExpiringIDPCertificate>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSCIMGroupsRequest";
+  static readonly typeName = "ssoready.v1.ExpiringIDPCertificate";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directory_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "organization_external_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_connection_idp_certificate_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "expire_time", kind: "message", T: Timestamp },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMGroupsRequest {
-    return new ListSCIMGroupsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ExpiringIDPCertificate {
+    return new ExpiringIDPCertificate().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMGroupsRequest {
-    return new ListSCIMGroupsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ExpiringIDPCertificate {
+    return new ExpiringIDPCertificate().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMGroupsRequest {
-    return new ListSCIMGroupsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ExpiringIDPCertificate {
+    return new ExpiringIDPCertificate().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSCIMGroupsRequest | PlainMessage<ListSCIMGroupsRequest> | undefined, b: ListSCIMGroupsRequest | PlainMessage<ListSCIMGroupsRequest> | undefined): boolean {
-    return proto3.util.equals(ListSCIMGroupsRequest, a, b);
+  static equals(a: ExpiringIDPCertificate | PlainMessage<ExpiringIDPCertificate> | undefined, b: ExpiringIDPCertificate | PlainMessage<ExpiringIDPCertificate> | undefined): boolean {
+    return proto3.util.equals(ExpiringIDPCertificate, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSCIMGroupsResponse
+ * @generated from message ssoready.v1.ListExpiringSAMLConnectionsResponse
  */
-export class ListSCIMGroupsResponse extends Message<ListSCIMGroupsResponse> {
+export class ListExpiringSAMLConnectionsResponse extends Message<ListExpiringSAMLConnectionsResponse> {
   /**
-   * List of SCIM groups.
+   * The list of SAML connections with an expiring IDP certificate, ordered by their soonest-expiring certificate.
    *
-   * @generated from field: repeated ssoready.v1.SCIMGroup scim_groups = 1;
+   * @generated from field: repeated ssoready.v1.SAMLConnection saml_connections = 1;
    */
-  scimGroups: SCIMGroup[] = [];
+  samlConnections: SAMLConnection[] = [];
 
   /**
-   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   * The list of expiring IDP certificates, ordered by expire_time, soonest first.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: repeated ssoready.v1.ExpiringIDPCertificate expiring_idp_certificates = 2;
    */
-  nextPageToken = "";
+  expiringIdpCertificates: ExpiringIDPCertificate[] = [];
 
-  constructor(data?: PartialMessage<ListSCIMGroupsResponse>) {
+  constructor(data?: PartialMessage<ListExpiringSAMLConnectionsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSCIMGroupsResponse";
+  static readonly typeName = "ssoready.v1.ListExpiringSAMLConnectionsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_groups", kind: "message", T: SCIMGroup, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connections", kind: "message", T: SAMLConnection, repeated: true },
+    { no: 2, name: "expiring_idp_certificates", kind: "message", T: ExpiringIDPCertificate, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMGroupsResponse {
-    return new ListSCIMGroupsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListExpiringSAMLConnectionsResponse {
+    return new ListExpiringSAMLConnectionsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMGroupsResponse {
-    return new ListSCIMGroupsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListExpiringSAMLConnectionsResponse {
+    return new ListExpiringSAMLConnectionsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMGroupsResponse {
-    return new ListSCIMGroupsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListExpiringSAMLConnectionsResponse {
+    return new ListExpiringSAMLConnectionsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSCIMGroupsResponse | PlainMessage<ListSCIMGroupsResponse> | undefined, b: ListSCIMGroupsResponse | PlainMessage<ListSCIMGroupsResponse> | undefined): boolean {
-    return proto3.util.equals(ListSCIMGroupsResponse, a, b);
+  static equals(a: ListExpiringSAMLConnectionsResponse | PlainMessage<ListExpiringSAMLConnectionsResponse> | undefined, b: ListExpiringSAMLConnectionsResponse | PlainMessage<ListExpiringSAMLConnectionsResponse> | undefined): boolean {
+    return proto3.util.equals(ListExpiringSAMLConnectionsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSCIMGroupRequest
+ * @generated from message ssoready.v1.GetSAMLConnectionRequest
  */
-export class GetSCIMGroupRequest extends Message<GetSCIMGroupRequest> {
+export class GetSAMLConnectionRequest extends Message<GetSAMLConnectionRequest> {
   /**
-   * ID of the SCIM group to get.
+   * ID of the SAML connection to get.
    *
    * @generated from field: string id = 1;
    */
   id = "";
 
-  constructor(data?: PartialMessage<GetSCIMGroupRequest>) {
+  constructor(data?: PartialMessage<GetSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSCIMGroupRequest";
+  static readonly typeName = "ssoready.v1.GetSAMLConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMGroupRequest {
-    return new GetSCIMGroupRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLConnectionRequest {
+    return new GetSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMGroupRequest {
-    return new GetSCIMGroupRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLConnectionRequest {
+    return new GetSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMGroupRequest {
-    return new GetSCIMGroupRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLConnectionRequest {
+    return new GetSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSCIMGroupRequest | PlainMessage<GetSCIMGroupRequest> | undefined, b: GetSCIMGroupRequest | PlainMessage<GetSCIMGroupRequest> | undefined): boolean {
-    return proto3.util.equals(GetSCIMGroupRequest, a, b);
+  static equals(a: GetSAMLConnectionRequest | PlainMessage<GetSAMLConnectionRequest> | undefined, b: GetSAMLConnectionRequest | PlainMessage<GetSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(GetSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSCIMGroupResponse
+ * @generated from message ssoready.v1.GetSAMLConnectionResponse
  */
-export class GetSCIMGroupResponse extends Message<GetSCIMGroupResponse> {
+export class GetSAMLConnectionResponse extends Message<GetSAMLConnectionResponse> {
   /**
-   * The requested SCIM group.
+   * The requested SAML connection.
    *
-   * @generated from field: ssoready.v1.SCIMGroup scim_group = 1;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  scimGroup?: SCIMGroup;
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<GetSCIMGroupResponse>) {
+  constructor(data?: PartialMessage<GetSAMLConnectionResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSCIMGroupResponse";
+  static readonly typeName = "ssoready.v1.GetSAMLConnectionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_group", kind: "message", T: SCIMGroup },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMGroupResponse {
-    return new GetSCIMGroupResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLConnectionResponse {
+    return new GetSAMLConnectionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMGroupResponse {
-    return new GetSCIMGroupResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLConnectionResponse {
+    return new GetSAMLConnectionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMGroupResponse {
-    return new GetSCIMGroupResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLConnectionResponse {
+    return new GetSAMLConnectionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSCIMGroupResponse | PlainMessage<GetSCIMGroupResponse> | undefined, b: GetSCIMGroupResponse | PlainMessage<GetSCIMGroupResponse> | undefined): boolean {
-    return proto3.util.equals(GetSCIMGroupResponse, a, b);
+  static equals(a: GetSAMLConnectionResponse | PlainMessage<GetSAMLConnectionResponse> | undefined, b: GetSAMLConnectionResponse | PlainMessage<GetSAMLConnectionResponse> | undefined): boolean {
+    return proto3.util.equals(GetSAMLConnectionResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListOrganizationsRequest
+ * @generated from message ssoready.v1.CreateSAMLConnectionRequest
  */
-export class ListOrganizationsRequest extends Message<ListOrganizationsRequest> {
+export class CreateSAMLConnectionRequest extends Message<CreateSAMLConnectionRequest> {
   /**
-   * Pagination token. Leave empty to get the first page of results.
+   * The SAML connection to create.
    *
-   * @generated from field: string page_token = 1;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  pageToken = "";
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<ListOrganizationsRequest>) {
+  constructor(data?: PartialMessage<CreateSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListOrganizationsRequest";
+  static readonly typeName = "ssoready.v1.CreateSAMLConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationsRequest {
-    return new ListOrganizationsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSAMLConnectionRequest {
+    return new CreateSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationsRequest {
-    return new ListOrganizationsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSAMLConnectionRequest {
+    return new CreateSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationsRequest {
-    return new ListOrganizationsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSAMLConnectionRequest {
+    return new CreateSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationsRequest | PlainMessage<ListOrganizationsRequest> | undefined, b: ListOrganizationsRequest | PlainMessage<ListOrganizationsRequest> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationsRequest, a, b);
+  static equals(a: CreateSAMLConnectionRequest | PlainMessage<CreateSAMLConnectionRequest> | undefined, b: CreateSAMLConnectionRequest | PlainMessage<CreateSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListOrganizationsResponse
+ * @generated from message ssoready.v1.CreateSAMLConnectionResponse
  */
-export class ListOrganizationsResponse extends Message<ListOrganizationsResponse> {
-  /**
-   * List of organizations.
-   *
-   * @generated from field: repeated ssoready.v1.Organization organizations = 1;
-   */
-  organizations: Organization[] = [];
-
+export class CreateSAMLConnectionResponse extends Message<CreateSAMLConnectionResponse> {
   /**
-   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   * The created SAML connection.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  nextPageToken = "";
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<ListOrganizationsResponse>) {
+  constructor(data?: PartialMessage<CreateSAMLConnectionResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListOrganizationsResponse";
+  static readonly typeName = "ssoready.v1.CreateSAMLConnectionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organizations", kind: "message", T: Organization, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListOrganizationsResponse {
-    return new ListOrganizationsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSAMLConnectionResponse {
+    return new CreateSAMLConnectionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListOrganizationsResponse {
-    return new ListOrganizationsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSAMLConnectionResponse {
+    return new CreateSAMLConnectionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListOrganizationsResponse {
-    return new ListOrganizationsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSAMLConnectionResponse {
+    return new CreateSAMLConnectionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListOrganizationsResponse | PlainMessage<ListOrganizationsResponse> | undefined, b: ListOrganizationsResponse | PlainMessage<ListOrganizationsResponse> | undefined): boolean {
-    return proto3.util.equals(ListOrganizationsResponse, a, b);
+  static equals(a: CreateSAMLConnectionResponse | PlainMessage<CreateSAMLConnectionResponse> | undefined, b: CreateSAMLConnectionResponse | PlainMessage<CreateSAMLConnectionResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSAMLConnectionResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetOrganizationRequest
+ * @generated from message ssoready.v1.UpdateSAMLConnectionRequest
  */
-export class GetOrganizationRequest extends Message<GetOrganizationRequest> {
+export class UpdateSAMLConnectionRequest extends Message<UpdateSAMLConnectionRequest> {
   /**
-   * ID of the organization to get.
+   * The ID of the SAML connection to update.
    *
    * @generated from field: string id = 1;
    */
   id = "";
 
-  constructor(data?: PartialMessage<GetOrganizationRequest>) {
+  /**
+   * The updated SAML connection.
+   *
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 2;
+   */
+  samlConnection?: SAMLConnection;
+
+  /**
+   * The fields of saml_connection to update, such as "sign_authn_requests" or
+   * "idp_slo_url". Each field listed is set to its value in saml_connection,
+   * even if that value is empty; other fields keep their current value.
+   *
+   * If update_mask is not set, primary, idp_redirect_url, idp_certificate, and
+   * idp_entity_id are always updated, and every other field is only updated if
+   * saml_connection sets it to a non-empty value.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 3;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetOrganizationRequest";
+  static readonly typeName = "ssoready.v1.UpdateSAMLConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 3, name: "update_mask", kind: "message", T: FieldMask },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationRequest {
-    return new GetOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSAMLConnectionRequest {
+    return new UpdateSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationRequest {
-    return new GetOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionRequest {
+    return new UpdateSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationRequest {
-    return new GetOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionRequest {
+    return new UpdateSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetOrganizationRequest | PlainMessage<GetOrganizationRequest> | undefined, b: GetOrganizationRequest | PlainMessage<GetOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(GetOrganizationRequest, a, b);
+  static equals(a: UpdateSAMLConnectionRequest | PlainMessage<UpdateSAMLConnectionRequest> | undefined, b: UpdateSAMLConnectionRequest | PlainMessage<UpdateSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetOrganizationResponse
+ * @generated from message ssoready.v1.UpdateSAMLConnectionResponse
  */
-export class GetOrganizationResponse extends Message<GetOrganizationResponse> {
+export class UpdateSAMLConnectionResponse extends Message<UpdateSAMLConnectionResponse> {
   /**
-   * The requested organization.
+   * The updated SAML connection.
    *
-   * @generated from field: ssoready.v1.Organization organization = 1;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  organization?: Organization;
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<GetOrganizationResponse>) {
+  constructor(data?: PartialMessage<UpdateSAMLConnectionResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetOrganizationResponse";
+  static readonly typeName = "ssoready.v1.UpdateSAMLConnectionResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOrganizationResponse {
-    return new GetOrganizationResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSAMLConnectionResponse {
+    return new UpdateSAMLConnectionResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOrganizationResponse {
-    return new GetOrganizationResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionResponse {
+    return new UpdateSAMLConnectionResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOrganizationResponse {
-    return new GetOrganizationResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionResponse {
+    return new UpdateSAMLConnectionResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetOrganizationResponse | PlainMessage<GetOrganizationResponse> | undefined, b: GetOrganizationResponse | PlainMessage<GetOrganizationResponse> | undefined): boolean {
-    return proto3.util.equals(GetOrganizationResponse, a, b);
+  static equals(a: UpdateSAMLConnectionResponse | PlainMessage<UpdateSAMLConnectionResponse> | undefined, b: UpdateSAMLConnectionResponse | PlainMessage<UpdateSAMLConnectionResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSAMLConnectionResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateOrganizationRequest
+ * @generated from message ssoready.v1.RotateSAMLConnectionSPCertificateRequest
  */
-export class CreateOrganizationRequest extends Message<CreateOrganizationRequest> {
+export class RotateSAMLConnectionSPCertificateRequest extends Message<RotateSAMLConnectionSPCertificateRequest> {
   /**
-   * @generated from field: ssoready.v1.Organization organization = 1;
+   * The ID of the SAML connection whose SP certificate to rotate.
+   *
+   * @generated from field: string id = 1;
    */
-  organization?: Organization;
+  id = "";
 
-  constructor(data?: PartialMessage<CreateOrganizationRequest>) {
+  constructor(data?: PartialMessage<RotateSAMLConnectionSPCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateOrganizationRequest";
+  static readonly typeName = "ssoready.v1.RotateSAMLConnectionSPCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationRequest {
-    return new CreateOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateSAMLConnectionSPCertificateRequest {
+    return new RotateSAMLConnectionSPCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationRequest {
-    return new CreateOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateSAMLConnectionSPCertificateRequest {
+    return new RotateSAMLConnectionSPCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationRequest {
-    return new CreateOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateSAMLConnectionSPCertificateRequest {
+    return new RotateSAMLConnectionSPCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateOrganizationRequest | PlainMessage<CreateOrganizationRequest> | undefined, b: CreateOrganizationRequest | PlainMessage<CreateOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(CreateOrganizationRequest, a, b);
+  static equals(a: RotateSAMLConnectionSPCertificateRequest | PlainMessage<RotateSAMLConnectionSPCertificateRequest> | undefined, b: RotateSAMLConnectionSPCertificateRequest | PlainMessage<RotateSAMLConnectionSPCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(RotateSAMLConnectionSPCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateOrganizationResponse
+ * @generated from message ssoready.v1.RotateSAMLConnectionSPCertificateResponse
  */
-export class CreateOrganizationResponse extends Message<CreateOrganizationResponse> {
+export class RotateSAMLConnectionSPCertificateResponse extends Message<RotateSAMLConnectionSPCertificateResponse> {
   /**
-   * The created organization.
+   * The updated SAML connection.
    *
-   * @generated from field: ssoready.v1.Organization organization = 1;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  organization?: Organization;
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<CreateOrganizationResponse>) {
+  constructor(data?: PartialMessage<RotateSAMLConnectionSPCertificateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateOrganizationResponse";
+  static readonly typeName = "ssoready.v1.RotateSAMLConnectionSPCertificateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateOrganizationResponse {
-    return new CreateOrganizationResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateSAMLConnectionSPCertificateResponse {
+    return new RotateSAMLConnectionSPCertificateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateOrganizationResponse {
-    return new CreateOrganizationResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateSAMLConnectionSPCertificateResponse {
+    return new RotateSAMLConnectionSPCertificateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateOrganizationResponse {
-    return new CreateOrganizationResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateSAMLConnectionSPCertificateResponse {
+    return new RotateSAMLConnectionSPCertificateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateOrganizationResponse | PlainMessage<CreateOrganizationResponse> | undefined, b: CreateOrganizationResponse | PlainMessage<CreateOrganizationResponse> | undefined): boolean {
-    return proto3.util.equals(CreateOrganizationResponse, a, b);
+  static equals(a: RotateSAMLConnectionSPCertificateResponse | PlainMessage<RotateSAMLConnectionSPCertificateResponse> | undefined, b: RotateSAMLConnectionSPCertificateResponse | PlainMessage<RotateSAMLConnectionSPCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(RotateSAMLConnectionSPCertificateResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateOrganizationRequest
+ * @generated from message ssoready.v1.GetSAMLConnectionSPSigningCertificateRequest
  */
-export class UpdateOrganizationRequest extends Message<UpdateOrganizationRequest> {
+export class GetSAMLConnectionSPSigningCertificateRequest extends Message<GetSAMLConnectionSPSigningCertificateRequest> {
   /**
-   * ID of the organization to update.
-   *
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  /**
-   * The updated organization.
+   * The ID of the SAML connection whose SP signing certificate to get.
    *
-   * @generated from field: ssoready.v1.Organization organization = 2;
+   * @generated from field: string id = 1;
    */
-  organization?: Organization;
+  id = "";
 
-  constructor(data?: PartialMessage<UpdateOrganizationRequest>) {
+  constructor(data?: PartialMessage<GetSAMLConnectionSPSigningCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateOrganizationRequest";
+  static readonly typeName = "ssoready.v1.GetSAMLConnectionSPSigningCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationRequest {
-    return new UpdateOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLConnectionSPSigningCertificateRequest {
+    return new GetSAMLConnectionSPSigningCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationRequest {
-    return new UpdateOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLConnectionSPSigningCertificateRequest {
+    return new GetSAMLConnectionSPSigningCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationRequest {
-    return new UpdateOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLConnectionSPSigningCertificateRequest {
+    return new GetSAMLConnectionSPSigningCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateOrganizationRequest | PlainMessage<UpdateOrganizationRequest> | undefined, b: UpdateOrganizationRequest | PlainMessage<UpdateOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateOrganizationRequest, a, b);
+  static equals(a: GetSAMLConnectionSPSigningCertificateRequest | PlainMessage<GetSAMLConnectionSPSigningCertificateRequest> | undefined, b: GetSAMLConnectionSPSigningCertificateRequest | PlainMessage<GetSAMLConnectionSPSigningCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(GetSAMLConnectionSPSigningCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateOrganizationResponse
+ * @generated from message ssoready.v1.GetSAMLConnectionSPSigningCertificateResponse
  */
-export class UpdateOrganizationResponse extends Message<UpdateOrganizationResponse> {
+export class GetSAMLConnectionSPSigningCertificateResponse extends Message<GetSAMLConnectionSPSigningCertificateResponse> {
   /**
-   * The updated organization.
+   * The SAML connection's SP signing certificate. This is a PEM-encoded X.509 certificate.
    *
-   * @generated from field: ssoready.v1.Organization organization = 1;
+   * @generated from field: string certificate = 1;
    */
-  organization?: Organization;
+  certificate = "";
 
-  constructor(data?: PartialMessage<UpdateOrganizationResponse>) {
+  constructor(data?: PartialMessage<GetSAMLConnectionSPSigningCertificateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateOrganizationResponse";
+  static readonly typeName = "ssoready.v1.GetSAMLConnectionSPSigningCertificateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
+    { no: 1, name: "certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOrganizationResponse {
-    return new UpdateOrganizationResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLConnectionSPSigningCertificateResponse {
+    return new GetSAMLConnectionSPSigningCertificateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOrganizationResponse {
-    return new UpdateOrganizationResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLConnectionSPSigningCertificateResponse {
+    return new GetSAMLConnectionSPSigningCertificateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOrganizationResponse {
-    return new UpdateOrganizationResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLConnectionSPSigningCertificateResponse {
+    return new GetSAMLConnectionSPSigningCertificateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateOrganizationResponse | PlainMessage<UpdateOrganizationResponse> | undefined, b: UpdateOrganizationResponse | PlainMessage<UpdateOrganizationResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateOrganizationResponse, a, b);
+  static equals(a: GetSAMLConnectionSPSigningCertificateResponse | PlainMessage<GetSAMLConnectionSPSigningCertificateResponse> | undefined, b: GetSAMLConnectionSPSigningCertificateResponse | PlainMessage<GetSAMLConnectionSPSigningCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(GetSAMLConnectionSPSigningCertificateResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSetupURLRequest
+ * @generated from message ssoready.v1.ListSCIMDirectoriesRequest
  */
-export class CreateSetupURLRequest extends Message<CreateSetupURLRequest> {
+export class ListSCIMDirectoriesRequest extends Message<ListSCIMDirectoriesRequest> {
   /**
-   * The organization that the setup URL is for.
+   * The organization the SCIM directories belong to.
    *
    * @generated from field: string organization_id = 1;
    */
   organizationId = "";
 
   /**
-   * Whether the setup URL lets the user manage SAML connections.
-   *
-   * @generated from field: bool can_manage_saml = 2;
-   */
-  canManageSaml = false;
-
-  /**
-   * Whether the setup URL lets the user manage SCIM directories.
+   * Pagination token. Leave empty to get the first page of results.
    *
-   * @generated from field: bool can_manage_scim = 3;
+   * @generated from field: string page_token = 2;
    */
-  canManageScim = false;
+  pageToken = "";
 
-  constructor(data?: PartialMessage<CreateSetupURLRequest>) {
+  constructor(data?: PartialMessage<ListSCIMDirectoriesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSetupURLRequest";
+  static readonly typeName = "ssoready.v1.ListSCIMDirectoriesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "can_manage_saml", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "can_manage_scim", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSetupURLRequest {
-    return new CreateSetupURLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMDirectoriesRequest {
+    return new ListSCIMDirectoriesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSetupURLRequest {
-    return new CreateSetupURLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesRequest {
+    return new ListSCIMDirectoriesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSetupURLRequest {
-    return new CreateSetupURLRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesRequest {
+    return new ListSCIMDirectoriesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSetupURLRequest | PlainMessage<CreateSetupURLRequest> | undefined, b: CreateSetupURLRequest | PlainMessage<CreateSetupURLRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSetupURLRequest, a, b);
+  static equals(a: ListSCIMDirectoriesRequest | PlainMessage<ListSCIMDirectoriesRequest> | undefined, b: ListSCIMDirectoriesRequest | PlainMessage<ListSCIMDirectoriesRequest> | undefined): boolean {
+    return proto3.util.equals(ListSCIMDirectoriesRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSetupURLResponse
+ * @generated from message ssoready.v1.ListSCIMDirectoriesResponse
  */
-export class CreateSetupURLResponse extends Message<CreateSetupURLResponse> {
+export class ListSCIMDirectoriesResponse extends Message<ListSCIMDirectoriesResponse> {
   /**
-   * The one-time, short-lived self-serve setup URL.
+   * The list of SCIM directories.
    *
-   * Do not log or store this URL. Because this URL is one-time, loading it yourself means your customer will not be
-   * able to load it after you.
+   * @generated from field: repeated ssoready.v1.SCIMDirectory scim_directories = 1;
+   */
+  scimDirectories: SCIMDirectory[] = [];
+
+  /**
+   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
    *
-   * @generated from field: string url = 1;
+   * @generated from field: string next_page_token = 2;
    */
-  url = "";
+  nextPageToken = "";
 
-  constructor(data?: PartialMessage<CreateSetupURLResponse>) {
+  constructor(data?: PartialMessage<ListSCIMDirectoriesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSetupURLResponse";
+  static readonly typeName = "ssoready.v1.ListSCIMDirectoriesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "scim_directories", kind: "message", T: SCIMDirectory, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSetupURLResponse {
-    return new CreateSetupURLResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMDirectoriesResponse {
+    return new ListSCIMDirectoriesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSetupURLResponse {
-    return new CreateSetupURLResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesResponse {
+    return new ListSCIMDirectoriesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSetupURLResponse {
-    return new CreateSetupURLResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesResponse {
+    return new ListSCIMDirectoriesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSetupURLResponse | PlainMessage<CreateSetupURLResponse> | undefined, b: CreateSetupURLResponse | PlainMessage<CreateSetupURLResponse> | undefined): boolean {
-    return proto3.util.equals(CreateSetupURLResponse, a, b);
+  static equals(a: ListSCIMDirectoriesResponse | PlainMessage<ListSCIMDirectoriesResponse> | undefined, b: ListSCIMDirectoriesResponse | PlainMessage<ListSCIMDirectoriesResponse> | undefined): boolean {
+    return proto3.util.equals(ListSCIMDirectoriesResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSAMLConnectionsRequest
+ * @generated from message ssoready.v1.GetSCIMDirectoryRequest
  */
-export class ListSAMLConnectionsRequest extends Message<ListSAMLConnectionsRequest> {
-  /**
-   * The organization the SAML connections belong to.
-   *
-   * @generated from field: string organization_id = 1;
-   */
-  organizationId = "";
-
+export class GetSCIMDirectoryRequest extends Message<GetSCIMDirectoryRequest> {
   /**
-   * Pagination token. Leave empty to get the first page of results.
+   * The ID of the SCIM directory.
    *
-   * @generated from field: string page_token = 2;
+   * @generated from field: string id = 1;
    */
-  pageToken = "";
+  id = "";
 
-  constructor(data?: PartialMessage<ListSAMLConnectionsRequest>) {
+  constructor(data?: PartialMessage<GetSCIMDirectoryRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSAMLConnectionsRequest";
+  static readonly typeName = "ssoready.v1.GetSCIMDirectoryRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLConnectionsRequest {
-    return new ListSAMLConnectionsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMDirectoryRequest {
+    return new GetSCIMDirectoryRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLConnectionsRequest {
-    return new ListSAMLConnectionsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMDirectoryRequest {
+    return new GetSCIMDirectoryRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLConnectionsRequest {
-    return new ListSAMLConnectionsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMDirectoryRequest {
+    return new GetSCIMDirectoryRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSAMLConnectionsRequest | PlainMessage<ListSAMLConnectionsRequest> | undefined, b: ListSAMLConnectionsRequest | PlainMessage<ListSAMLConnectionsRequest> | undefined): boolean {
-    return proto3.util.equals(ListSAMLConnectionsRequest, a, b);
+  static equals(a: GetSCIMDirectoryRequest | PlainMessage<GetSCIMDirectoryRequest> | undefined, b: GetSCIMDirectoryRequest | PlainMessage<GetSCIMDirectoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetSCIMDirectoryRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSAMLConnectionsResponse
+ * @generated from message ssoready.v1.GetSCIMDirectoryResponse
  */
-export class ListSAMLConnectionsResponse extends Message<ListSAMLConnectionsResponse> {
+export class GetSCIMDirectoryResponse extends Message<GetSCIMDirectoryResponse> {
   /**
-   * The list of SAML connections.
+   * The requested SCIM directory.
    *
-   * @generated from field: repeated ssoready.v1.SAMLConnection saml_connections = 1;
+   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
    */
-  samlConnections: SAMLConnection[] = [];
+  scimDirectory?: SCIMDirectory;
+
+  constructor(data?: PartialMessage<GetSCIMDirectoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.GetSCIMDirectoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMDirectoryResponse {
+    return new GetSCIMDirectoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMDirectoryResponse {
+    return new GetSCIMDirectoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMDirectoryResponse {
+    return new GetSCIMDirectoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetSCIMDirectoryResponse | PlainMessage<GetSCIMDirectoryResponse> | undefined, b: GetSCIMDirectoryResponse | PlainMessage<GetSCIMDirectoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetSCIMDirectoryResponse, a, b);
+  }
+}
 
+/**
+ * @generated from message ssoready.v1.CreateSCIMDirectoryRequest
+ */
+export class CreateSCIMDirectoryRequest extends Message<CreateSCIMDirectoryRequest> {
   /**
-   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
+   * The SCIM directory to create.
    *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
    */
-  nextPageToken = "";
+  scimDirectory?: SCIMDirectory;
 
-  constructor(data?: PartialMessage<ListSAMLConnectionsResponse>) {
+  constructor(data?: PartialMessage<CreateSCIMDirectoryRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSAMLConnectionsResponse";
+  static readonly typeName = "ssoready.v1.CreateSCIMDirectoryRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connections", kind: "message", T: SAMLConnection, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLConnectionsResponse {
-    return new ListSAMLConnectionsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSCIMDirectoryRequest {
+    return new CreateSCIMDirectoryRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLConnectionsResponse {
-    return new ListSAMLConnectionsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryRequest {
+    return new CreateSCIMDirectoryRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLConnectionsResponse {
-    return new ListSAMLConnectionsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryRequest {
+    return new CreateSCIMDirectoryRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSAMLConnectionsResponse | PlainMessage<ListSAMLConnectionsResponse> | undefined, b: ListSAMLConnectionsResponse | PlainMessage<ListSAMLConnectionsResponse> | undefined): boolean {
-    return proto3.util.equals(ListSAMLConnectionsResponse, a, b);
+  static equals(a: CreateSCIMDirectoryRequest | PlainMessage<CreateSCIMDirectoryRequest> | undefined, b: CreateSCIMDirectoryRequest | PlainMessage<CreateSCIMDirectoryRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSCIMDirectoryRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSAMLConnectionRequest
+ * @generated from message ssoready.v1.CreateSCIMDirectoryResponse
  */
-export class GetSAMLConnectionRequest extends Message<GetSAMLConnectionRequest> {
+export class CreateSCIMDirectoryResponse extends Message<CreateSCIMDirectoryResponse> {
   /**
-   * ID of the SAML connection to get.
+   * The updated SCIM directory.
    *
-   * @generated from field: string id = 1;
+   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
    */
-  id = "";
+  scimDirectory?: SCIMDirectory;
 
-  constructor(data?: PartialMessage<GetSAMLConnectionRequest>) {
+  constructor(data?: PartialMessage<CreateSCIMDirectoryResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.CreateSCIMDirectoryResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLConnectionRequest {
-    return new GetSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSCIMDirectoryResponse {
+    return new CreateSCIMDirectoryResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLConnectionRequest {
-    return new GetSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryResponse {
+    return new CreateSCIMDirectoryResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLConnectionRequest {
-    return new GetSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryResponse {
+    return new CreateSCIMDirectoryResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSAMLConnectionRequest | PlainMessage<GetSAMLConnectionRequest> | undefined, b: GetSAMLConnectionRequest | PlainMessage<GetSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(GetSAMLConnectionRequest, a, b);
+  static equals(a: CreateSCIMDirectoryResponse | PlainMessage<CreateSCIMDirectoryResponse> | undefined, b: CreateSCIMDirectoryResponse | PlainMessage<CreateSCIMDirectoryResponse> | undefined): boolean {
+    return proto3.util.equals(CreateSCIMDirectoryResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSAMLConnectionResponse
+ * @generated from message ssoready.v1.UpdateSCIMDirectoryRequest
  */
-export class GetSAMLConnectionResponse extends Message<GetSAMLConnectionResponse> {
+export class UpdateSCIMDirectoryRequest extends Message<UpdateSCIMDirectoryRequest> {
   /**
-   * The requested SAML connection.
+   * The ID of the SCIM directory to update.
    *
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
+   * @generated from field: string id = 1;
    */
-  samlConnection?: SAMLConnection;
+  id = "";
 
-  constructor(data?: PartialMessage<GetSAMLConnectionResponse>) {
+  /**
+   * The updated SCIM directory.
+   *
+   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 2;
+   */
+  scimDirectory?: SCIMDirectory;
+
+  constructor(data?: PartialMessage<UpdateSCIMDirectoryRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSAMLConnectionResponse";
+  static readonly typeName = "ssoready.v1.UpdateSCIMDirectoryRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLConnectionResponse {
-    return new GetSAMLConnectionResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSCIMDirectoryRequest {
+    return new UpdateSCIMDirectoryRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLConnectionResponse {
-    return new GetSAMLConnectionResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryRequest {
+    return new UpdateSCIMDirectoryRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLConnectionResponse {
-    return new GetSAMLConnectionResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryRequest {
+    return new UpdateSCIMDirectoryRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSAMLConnectionResponse | PlainMessage<GetSAMLConnectionResponse> | undefined, b: GetSAMLConnectionResponse | PlainMessage<GetSAMLConnectionResponse> | undefined): boolean {
-    return proto3.util.equals(GetSAMLConnectionResponse, a, b);
+  static equals(a: UpdateSCIMDirectoryRequest | PlainMessage<UpdateSCIMDirectoryRequest> | undefined, b: UpdateSCIMDirectoryRequest | PlainMessage<UpdateSCIMDirectoryRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSCIMDirectoryRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSAMLConnectionRequest
+ * @generated from message ssoready.v1.UpdateSCIMDirectoryResponse
  */
-export class CreateSAMLConnectionRequest extends Message<CreateSAMLConnectionRequest> {
+export class UpdateSCIMDirectoryResponse extends Message<UpdateSCIMDirectoryResponse> {
   /**
-   * The SAML connection to create.
+   * The updated SCIM directory.
    *
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
+   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
    */
-  samlConnection?: SAMLConnection;
+  scimDirectory?: SCIMDirectory;
 
-  constructor(data?: PartialMessage<CreateSAMLConnectionRequest>) {
+  constructor(data?: PartialMessage<UpdateSCIMDirectoryResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.UpdateSCIMDirectoryResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSAMLConnectionRequest {
-    return new CreateSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSCIMDirectoryResponse {
+    return new UpdateSCIMDirectoryResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSAMLConnectionRequest {
-    return new CreateSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryResponse {
+    return new UpdateSCIMDirectoryResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSAMLConnectionRequest {
-    return new CreateSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryResponse {
+    return new UpdateSCIMDirectoryResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSAMLConnectionRequest | PlainMessage<CreateSAMLConnectionRequest> | undefined, b: CreateSAMLConnectionRequest | PlainMessage<CreateSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSAMLConnectionRequest, a, b);
+  static equals(a: UpdateSCIMDirectoryResponse | PlainMessage<UpdateSCIMDirectoryResponse> | undefined, b: UpdateSCIMDirectoryResponse | PlainMessage<UpdateSCIMDirectoryResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSCIMDirectoryResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSAMLConnectionResponse
+ * @generated from message ssoready.v1.RotateSCIMDirectoryBearerTokenRequest
  */
-export class CreateSAMLConnectionResponse extends Message<CreateSAMLConnectionResponse> {
+export class RotateSCIMDirectoryBearerTokenRequest extends Message<RotateSCIMDirectoryBearerTokenRequest> {
   /**
-   * The created SAML connection.
+   * The ID of the SCIM directory whose bearer token to rotate.
    *
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
+   * @generated from field: string id = 1;
    */
-  samlConnection?: SAMLConnection;
+  id = "";
 
-  constructor(data?: PartialMessage<CreateSAMLConnectionResponse>) {
+  constructor(data?: PartialMessage<RotateSCIMDirectoryBearerTokenRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSAMLConnectionResponse";
+  static readonly typeName = "ssoready.v1.RotateSCIMDirectoryBearerTokenRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSAMLConnectionResponse {
-    return new CreateSAMLConnectionResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateSCIMDirectoryBearerTokenRequest {
+    return new RotateSCIMDirectoryBearerTokenRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSAMLConnectionResponse {
-    return new CreateSAMLConnectionResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenRequest {
+    return new RotateSCIMDirectoryBearerTokenRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSAMLConnectionResponse {
-    return new CreateSAMLConnectionResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenRequest {
+    return new RotateSCIMDirectoryBearerTokenRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSAMLConnectionResponse | PlainMessage<CreateSAMLConnectionResponse> | undefined, b: CreateSAMLConnectionResponse | PlainMessage<CreateSAMLConnectionResponse> | undefined): boolean {
-    return proto3.util.equals(CreateSAMLConnectionResponse, a, b);
+  static equals(a: RotateSCIMDirectoryBearerTokenRequest | PlainMessage<RotateSCIMDirectoryBearerTokenRequest> | undefined, b: RotateSCIMDirectoryBearerTokenRequest | PlainMessage<RotateSCIMDirectoryBearerTokenRequest> | undefined): boolean {
+    return proto3.util.equals(RotateSCIMDirectoryBearerTokenRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateSAMLConnectionRequest
+ * @generated from message ssoready.v1.RotateSCIMDirectoryBearerTokenResponse
  */
-export class UpdateSAMLConnectionRequest extends Message<UpdateSAMLConnectionRequest> {
+export class RotateSCIMDirectoryBearerTokenResponse extends Message<RotateSCIMDirectoryBearerTokenResponse> {
   /**
-   * The ID of the SAML connection to update.
+   * The new, updated bearer token.
    *
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  /**
-   * The updated SAML connection.
+   * Do not log or store this bearer token. It is an authentication token that your customer should securely input into
+   * their Identity Provider.
    *
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 2;
+   * @generated from field: string bearer_token = 1;
    */
-  samlConnection?: SAMLConnection;
+  bearerToken = "";
 
-  constructor(data?: PartialMessage<UpdateSAMLConnectionRequest>) {
+  constructor(data?: PartialMessage<RotateSCIMDirectoryBearerTokenResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.RotateSCIMDirectoryBearerTokenResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "bearer_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSAMLConnectionRequest {
-    return new UpdateSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateSCIMDirectoryBearerTokenResponse {
+    return new RotateSCIMDirectoryBearerTokenResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionRequest {
-    return new UpdateSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenResponse {
+    return new RotateSCIMDirectoryBearerTokenResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionRequest {
-    return new UpdateSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenResponse {
+    return new RotateSCIMDirectoryBearerTokenResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateSAMLConnectionRequest | PlainMessage<UpdateSAMLConnectionRequest> | undefined, b: UpdateSAMLConnectionRequest | PlainMessage<UpdateSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateSAMLConnectionRequest, a, b);
+  static equals(a: RotateSCIMDirectoryBearerTokenResponse | PlainMessage<RotateSCIMDirectoryBearerTokenResponse> | undefined, b: RotateSCIMDirectoryBearerTokenResponse | PlainMessage<RotateSCIMDirectoryBearerTokenResponse> | undefined): boolean {
+    return proto3.util.equals(RotateSCIMDirectoryBearerTokenResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateSAMLConnectionResponse
+ * @generated from message ssoready.v1.VerifyEmailRequest
  */
-export class UpdateSAMLConnectionResponse extends Message<UpdateSAMLConnectionResponse> {
+export class VerifyEmailRequest extends Message<VerifyEmailRequest> {
   /**
-   * The updated SAML connection.
-   *
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
+   * @generated from field: string email = 1;
    */
-  samlConnection?: SAMLConnection;
+  email = "";
 
-  constructor(data?: PartialMessage<UpdateSAMLConnectionResponse>) {
+  constructor(data?: PartialMessage<VerifyEmailRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateSAMLConnectionResponse";
+  static readonly typeName = "ssoready.v1.VerifyEmailRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSAMLConnectionResponse {
-    return new UpdateSAMLConnectionResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyEmailRequest {
+    return new VerifyEmailRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionResponse {
-    return new UpdateSAMLConnectionResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyEmailRequest {
+    return new VerifyEmailRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSAMLConnectionResponse {
-    return new UpdateSAMLConnectionResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyEmailRequest {
+    return new VerifyEmailRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateSAMLConnectionResponse | PlainMessage<UpdateSAMLConnectionResponse> | undefined, b: UpdateSAMLConnectionResponse | PlainMessage<UpdateSAMLConnectionResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateSAMLConnectionResponse, a, b);
+  static equals(a: VerifyEmailRequest | PlainMessage<VerifyEmailRequest> | undefined, b: VerifyEmailRequest | PlainMessage<VerifyEmailRequest> | undefined): boolean {
+    return proto3.util.equals(VerifyEmailRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSCIMDirectoriesRequest
+ * @generated from message ssoready.v1.SignInRequest
  */
-export class ListSCIMDirectoriesRequest extends Message<ListSCIMDirectoriesRequest> {
+export class SignInRequest extends Message<SignInRequest> {
   /**
-   * The organization the SCIM directories belong to.
-   *
-   * @generated from field: string organization_id = 1;
+   * @generated from field: string google_credential = 1;
    */
-  organizationId = "";
+  googleCredential = "";
 
   /**
-   * Pagination token. Leave empty to get the first page of results.
-   *
-   * @generated from field: string page_token = 2;
+   * @generated from field: string email_verify_token = 2;
    */
-  pageToken = "";
+  emailVerifyToken = "";
 
-  constructor(data?: PartialMessage<ListSCIMDirectoriesRequest>) {
+  /**
+   * @generated from field: string microsoft_code = 3;
+   */
+  microsoftCode = "";
+
+  constructor(data?: PartialMessage<SignInRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSCIMDirectoriesRequest";
+  static readonly typeName = "ssoready.v1.SignInRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "google_credential", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "email_verify_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "microsoft_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMDirectoriesRequest {
-    return new ListSCIMDirectoriesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignInRequest {
+    return new SignInRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesRequest {
-    return new ListSCIMDirectoriesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignInRequest {
+    return new SignInRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesRequest {
-    return new ListSCIMDirectoriesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignInRequest {
+    return new SignInRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSCIMDirectoriesRequest | PlainMessage<ListSCIMDirectoriesRequest> | undefined, b: ListSCIMDirectoriesRequest | PlainMessage<ListSCIMDirectoriesRequest> | undefined): boolean {
-    return proto3.util.equals(ListSCIMDirectoriesRequest, a, b);
+  static equals(a: SignInRequest | PlainMessage<SignInRequest> | undefined, b: SignInRequest | PlainMessage<SignInRequest> | undefined): boolean {
+    return proto3.util.equals(SignInRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSCIMDirectoriesResponse
+ * @generated from message ssoready.v1.SignInResponse
  */
-export class ListSCIMDirectoriesResponse extends Message<ListSCIMDirectoriesResponse> {
-  /**
-   * The list of SCIM directories.
-   *
-   * @generated from field: repeated ssoready.v1.SCIMDirectory scim_directories = 1;
-   */
-  scimDirectories: SCIMDirectory[] = [];
-
+export class SignInResponse extends Message<SignInResponse> {
   /**
-   * Value to use as `pageToken` for the next page of data. Empty if there is no more data.
-   *
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string session_token = 1;
    */
-  nextPageToken = "";
+  sessionToken = "";
 
-  constructor(data?: PartialMessage<ListSCIMDirectoriesResponse>) {
+  constructor(data?: PartialMessage<SignInResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSCIMDirectoriesResponse";
+  static readonly typeName = "ssoready.v1.SignInResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directories", kind: "message", T: SCIMDirectory, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSCIMDirectoriesResponse {
-    return new ListSCIMDirectoriesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignInResponse {
+    return new SignInResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesResponse {
-    return new ListSCIMDirectoriesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignInResponse {
+    return new SignInResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSCIMDirectoriesResponse {
-    return new ListSCIMDirectoriesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignInResponse {
+    return new SignInResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSCIMDirectoriesResponse | PlainMessage<ListSCIMDirectoriesResponse> | undefined, b: ListSCIMDirectoriesResponse | PlainMessage<ListSCIMDirectoriesResponse> | undefined): boolean {
-    return proto3.util.equals(ListSCIMDirectoriesResponse, a, b);
+  static equals(a: SignInResponse | PlainMessage<SignInResponse> | undefined, b: SignInResponse | PlainMessage<SignInResponse> | undefined): boolean {
+    return proto3.util.equals(SignInResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSCIMDirectoryRequest
+ * @generated from message ssoready.v1.SignOutRequest
  */
-export class GetSCIMDirectoryRequest extends Message<GetSCIMDirectoryRequest> {
-  /**
-   * The ID of the SCIM directory.
-   *
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  constructor(data?: PartialMessage<GetSCIMDirectoryRequest>) {
+export class SignOutRequest extends Message<SignOutRequest> {
+  constructor(data?: PartialMessage<SignOutRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSCIMDirectoryRequest";
+  static readonly typeName = "ssoready.v1.SignOutRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMDirectoryRequest {
-    return new GetSCIMDirectoryRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignOutRequest {
+    return new SignOutRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMDirectoryRequest {
-    return new GetSCIMDirectoryRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignOutRequest {
+    return new SignOutRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMDirectoryRequest {
-    return new GetSCIMDirectoryRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignOutRequest {
+    return new SignOutRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSCIMDirectoryRequest | PlainMessage<GetSCIMDirectoryRequest> | undefined, b: GetSCIMDirectoryRequest | PlainMessage<GetSCIMDirectoryRequest> | undefined): boolean {
-    return proto3.util.equals(GetSCIMDirectoryRequest, a, b);
+  static equals(a: SignOutRequest | PlainMessage<SignOutRequest> | undefined, b: SignOutRequest | PlainMessage<SignOutRequest> | undefined): boolean {
+    return proto3.util.equals(SignOutRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetSCIMDirectoryResponse
+ * @generated from message ssoready.v1.SignOutResponse
  */
-export class GetSCIMDirectoryResponse extends Message<GetSCIMDirectoryResponse> {
-  /**
-   * The requested SCIM directory.
-   *
-   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
-   */
-  scimDirectory?: SCIMDirectory;
-
-  constructor(data?: PartialMessage<GetSCIMDirectoryResponse>) {
+export class SignOutResponse extends Message<SignOutResponse> {
+  constructor(data?: PartialMessage<SignOutResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSCIMDirectoryResponse";
+  static readonly typeName = "ssoready.v1.SignOutResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSCIMDirectoryResponse {
-    return new GetSCIMDirectoryResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignOutResponse {
+    return new SignOutResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSCIMDirectoryResponse {
-    return new GetSCIMDirectoryResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignOutResponse {
+    return new SignOutResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSCIMDirectoryResponse {
-    return new GetSCIMDirectoryResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignOutResponse {
+    return new SignOutResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSCIMDirectoryResponse | PlainMessage<GetSCIMDirectoryResponse> | undefined, b: GetSCIMDirectoryResponse | PlainMessage<GetSCIMDirectoryResponse> | undefined): boolean {
-    return proto3.util.equals(GetSCIMDirectoryResponse, a, b);
+  static equals(a: SignOutResponse | PlainMessage<SignOutResponse> | undefined, b: SignOutResponse | PlainMessage<SignOutResponse> | undefined): boolean {
+    return proto3.util.equals(SignOutResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSCIMDirectoryRequest
+ * @generated from message ssoready.v1.WhoamiRequest
  */
-export class CreateSCIMDirectoryRequest extends Message<CreateSCIMDirectoryRequest> {
-  /**
-   * The SCIM directory to create.
-   *
-   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
-   */
-  scimDirectory?: SCIMDirectory;
-
-  constructor(data?: PartialMessage<CreateSCIMDirectoryRequest>) {
+export class WhoamiRequest extends Message<WhoamiRequest> {
+  constructor(data?: PartialMessage<WhoamiRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSCIMDirectoryRequest";
+  static readonly typeName = "ssoready.v1.WhoamiRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSCIMDirectoryRequest {
-    return new CreateSCIMDirectoryRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WhoamiRequest {
+    return new WhoamiRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryRequest {
-    return new CreateSCIMDirectoryRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WhoamiRequest {
+    return new WhoamiRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryRequest {
-    return new CreateSCIMDirectoryRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WhoamiRequest {
+    return new WhoamiRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSCIMDirectoryRequest | PlainMessage<CreateSCIMDirectoryRequest> | undefined, b: CreateSCIMDirectoryRequest | PlainMessage<CreateSCIMDirectoryRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSCIMDirectoryRequest, a, b);
+  static equals(a: WhoamiRequest | PlainMessage<WhoamiRequest> | undefined, b: WhoamiRequest | PlainMessage<WhoamiRequest> | undefined): boolean {
+    return proto3.util.equals(WhoamiRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSCIMDirectoryResponse
+ * @generated from message ssoready.v1.WhoamiResponse
  */
-export class CreateSCIMDirectoryResponse extends Message<CreateSCIMDirectoryResponse> {
+export class WhoamiResponse extends Message<WhoamiResponse> {
   /**
-   * The updated SCIM directory.
-   *
-   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
+   * @generated from field: string app_user_id = 1;
    */
-  scimDirectory?: SCIMDirectory;
+  appUserId = "";
 
-  constructor(data?: PartialMessage<CreateSCIMDirectoryResponse>) {
+  /**
+   * @generated from field: string display_name = 2;
+   */
+  displayName = "";
+
+  /**
+   * @generated from field: string email = 3;
+   */
+  email = "";
+
+  constructor(data?: PartialMessage<WhoamiResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSCIMDirectoryResponse";
+  static readonly typeName = "ssoready.v1.WhoamiResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
+    { no: 1, name: "app_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSCIMDirectoryResponse {
-    return new CreateSCIMDirectoryResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WhoamiResponse {
+    return new WhoamiResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryResponse {
-    return new CreateSCIMDirectoryResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WhoamiResponse {
+    return new WhoamiResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSCIMDirectoryResponse {
-    return new CreateSCIMDirectoryResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WhoamiResponse {
+    return new WhoamiResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSCIMDirectoryResponse | PlainMessage<CreateSCIMDirectoryResponse> | undefined, b: CreateSCIMDirectoryResponse | PlainMessage<CreateSCIMDirectoryResponse> | undefined): boolean {
-    return proto3.util.equals(CreateSCIMDirectoryResponse, a, b);
+  static equals(a: WhoamiResponse | PlainMessage<WhoamiResponse> | undefined, b: WhoamiResponse | PlainMessage<WhoamiResponse> | undefined): boolean {
+    return proto3.util.equals(WhoamiResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateSCIMDirectoryRequest
+ * @generated from message ssoready.v1.GetOnboardingStateRequest
  */
-export class UpdateSCIMDirectoryRequest extends Message<UpdateSCIMDirectoryRequest> {
-  /**
-   * The ID of the SCIM directory to update.
-   *
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  /**
-   * The updated SCIM directory.
-   *
-   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 2;
-   */
-  scimDirectory?: SCIMDirectory;
-
-  constructor(data?: PartialMessage<UpdateSCIMDirectoryRequest>) {
+export class GetOnboardingStateRequest extends Message<GetOnboardingStateRequest> {
+  constructor(data?: PartialMessage<GetOnboardingStateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateSCIMDirectoryRequest";
+  static readonly typeName = "ssoready.v1.GetOnboardingStateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "scim_directory", kind: "message", T: SCIMDirectory },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSCIMDirectoryRequest {
-    return new UpdateSCIMDirectoryRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOnboardingStateRequest {
+    return new GetOnboardingStateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryRequest {
-    return new UpdateSCIMDirectoryRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOnboardingStateRequest {
+    return new GetOnboardingStateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryRequest {
-    return new UpdateSCIMDirectoryRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOnboardingStateRequest {
+    return new GetOnboardingStateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateSCIMDirectoryRequest | PlainMessage<UpdateSCIMDirectoryRequest> | undefined, b: UpdateSCIMDirectoryRequest | PlainMessage<UpdateSCIMDirectoryRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateSCIMDirectoryRequest, a, b);
+  static equals(a: GetOnboardingStateRequest | PlainMessage<GetOnboardingStateRequest> | undefined, b: GetOnboardingStateRequest | PlainMessage<GetOnboardingStateRequest> | undefined): boolean {
+    return proto3.util.equals(GetOnboardingStateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateSCIMDirectoryResponse
+ * @generated from message ssoready.v1.GetOnboardingStateResponse
  */
-export class UpdateSCIMDirectoryResponse extends Message<UpdateSCIMDirectoryResponse> {
+export class GetOnboardingStateResponse extends Message<GetOnboardingStateResponse> {
   /**
-   * The updated SCIM directory.
-   *
-   * @generated from field: ssoready.v1.SCIMDirectory scim_directory = 1;
+   * @generated from field: string dummyidp_app_id = 1;
    */
-  scimDirectory?: SCIMDirectory;
+  dummyidpAppId = "";
 
-  constructor(data?: PartialMessage<UpdateSCIMDirectoryResponse>) {
+  /**
+   * @generated from field: string onboarding_environment_id = 2;
+   */
+  onboardingEnvironmentId = "";
+
+  /**
+   * @generated from field: string onboarding_organization_id = 3;
+   */
+  onboardingOrganizationId = "";
+
+  /**
+   * @generated from field: string onboarding_saml_connection_id = 4;
+   */
+  onboardingSamlConnectionId = "";
+
+  constructor(data?: PartialMessage<GetOnboardingStateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateSCIMDirectoryResponse";
+  static readonly typeName = "ssoready.v1.GetOnboardingStateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "scim_directory", kind: "message", T: SCIMDirectory },
+    { no: 1, name: "dummyidp_app_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "onboarding_environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "onboarding_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "onboarding_saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSCIMDirectoryResponse {
-    return new UpdateSCIMDirectoryResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOnboardingStateResponse {
+    return new GetOnboardingStateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryResponse {
-    return new UpdateSCIMDirectoryResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOnboardingStateResponse {
+    return new GetOnboardingStateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSCIMDirectoryResponse {
-    return new UpdateSCIMDirectoryResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOnboardingStateResponse {
+    return new GetOnboardingStateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateSCIMDirectoryResponse | PlainMessage<UpdateSCIMDirectoryResponse> | undefined, b: UpdateSCIMDirectoryResponse | PlainMessage<UpdateSCIMDirectoryResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateSCIMDirectoryResponse, a, b);
+  static equals(a: GetOnboardingStateResponse | PlainMessage<GetOnboardingStateResponse> | undefined, b: GetOnboardingStateResponse | PlainMessage<GetOnboardingStateResponse> | undefined): boolean {
+    return proto3.util.equals(GetOnboardingStateResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.RotateSCIMDirectoryBearerTokenRequest
+ * @generated from message ssoready.v1.UpdateOnboardingStateRequest
  */
-export class RotateSCIMDirectoryBearerTokenRequest extends Message<RotateSCIMDirectoryBearerTokenRequest> {
+export class UpdateOnboardingStateRequest extends Message<UpdateOnboardingStateRequest> {
+  /**
+   * @generated from field: string dummyidp_app_id = 1;
+   */
+  dummyidpAppId = "";
+
+  /**
+   * @generated from field: string onboarding_environment_id = 2;
+   */
+  onboardingEnvironmentId = "";
+
+  /**
+   * @generated from field: string onboarding_organization_id = 3;
+   */
+  onboardingOrganizationId = "";
+
   /**
-   * The ID of the SCIM directory whose bearer token to rotate.
-   *
-   * @generated from field: string id = 1;
+   * @generated from field: string onboarding_saml_connection_id = 4;
    */
-  id = "";
+  onboardingSamlConnectionId = "";
 
-  constructor(data?: PartialMessage<RotateSCIMDirectoryBearerTokenRequest>) {
+  constructor(data?: PartialMessage<UpdateOnboardingStateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.RotateSCIMDirectoryBearerTokenRequest";
+  static readonly typeName = "ssoready.v1.UpdateOnboardingStateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "dummyidp_app_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "onboarding_environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "onboarding_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "onboarding_saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateSCIMDirectoryBearerTokenRequest {
-    return new RotateSCIMDirectoryBearerTokenRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOnboardingStateRequest {
+    return new UpdateOnboardingStateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenRequest {
-    return new RotateSCIMDirectoryBearerTokenRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOnboardingStateRequest {
+    return new UpdateOnboardingStateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenRequest {
-    return new RotateSCIMDirectoryBearerTokenRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOnboardingStateRequest {
+    return new UpdateOnboardingStateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RotateSCIMDirectoryBearerTokenRequest | PlainMessage<RotateSCIMDirectoryBearerTokenRequest> | undefined, b: RotateSCIMDirectoryBearerTokenRequest | PlainMessage<RotateSCIMDirectoryBearerTokenRequest> | undefined): boolean {
-    return proto3.util.equals(RotateSCIMDirectoryBearerTokenRequest, a, b);
+  static equals(a: UpdateOnboardingStateRequest | PlainMessage<UpdateOnboardingStateRequest> | undefined, b: UpdateOnboardingStateRequest | PlainMessage<UpdateOnboardingStateRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateOnboardingStateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.RotateSCIMDirectoryBearerTokenResponse
+ * @generated from message ssoready.v1.OnboardingGetSAMLRedirectURLRequest
  */
-export class RotateSCIMDirectoryBearerTokenResponse extends Message<RotateSCIMDirectoryBearerTokenResponse> {
+export class OnboardingGetSAMLRedirectURLRequest extends Message<OnboardingGetSAMLRedirectURLRequest> {
   /**
-   * The new, updated bearer token.
-   *
-   * Do not log or store this bearer token. It is an authentication token that your customer should securely input into
-   * their Identity Provider.
-   *
-   * @generated from field: string bearer_token = 1;
+   * @generated from field: string api_key_secret_token = 1;
    */
-  bearerToken = "";
+  apiKeySecretToken = "";
 
-  constructor(data?: PartialMessage<RotateSCIMDirectoryBearerTokenResponse>) {
+  /**
+   * @generated from field: string saml_connection_id = 2;
+   */
+  samlConnectionId = "";
+
+  constructor(data?: PartialMessage<OnboardingGetSAMLRedirectURLRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.RotateSCIMDirectoryBearerTokenResponse";
+  static readonly typeName = "ssoready.v1.OnboardingGetSAMLRedirectURLRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "bearer_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "api_key_secret_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RotateSCIMDirectoryBearerTokenResponse {
-    return new RotateSCIMDirectoryBearerTokenResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OnboardingGetSAMLRedirectURLRequest {
+    return new OnboardingGetSAMLRedirectURLRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenResponse {
-    return new RotateSCIMDirectoryBearerTokenResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OnboardingGetSAMLRedirectURLRequest {
+    return new OnboardingGetSAMLRedirectURLRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RotateSCIMDirectoryBearerTokenResponse {
-    return new RotateSCIMDirectoryBearerTokenResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OnboardingGetSAMLRedirectURLRequest {
+    return new OnboardingGetSAMLRedirectURLRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: RotateSCIMDirectoryBearerTokenResponse | PlainMessage<RotateSCIMDirectoryBearerTokenResponse> | undefined, b: RotateSCIMDirectoryBearerTokenResponse | PlainMessage<RotateSCIMDirectoryBearerTokenResponse> | undefined): boolean {
-    return proto3.util.equals(RotateSCIMDirectoryBearerTokenResponse, a, b);
+  static equals(a: OnboardingGetSAMLRedirectURLRequest | PlainMessage<OnboardingGetSAMLRedirectURLRequest> | undefined, b: OnboardingGetSAMLRedirectURLRequest | PlainMessage<OnboardingGetSAMLRedirectURLRequest> | undefined): boolean {
+    return proto3.util.equals(OnboardingGetSAMLRedirectURLRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.VerifyEmailRequest
+ * @generated from message ssoready.v1.OnboardingRedeemSAMLAccessCodeRequest
  */
-export class VerifyEmailRequest extends Message<VerifyEmailRequest> {
+export class OnboardingRedeemSAMLAccessCodeRequest extends Message<OnboardingRedeemSAMLAccessCodeRequest> {
   /**
-   * @generated from field: string email = 1;
+   * @generated from field: string api_key_secret_token = 1;
    */
-  email = "";
+  apiKeySecretToken = "";
 
-  constructor(data?: PartialMessage<VerifyEmailRequest>) {
+  /**
+   * @generated from field: string saml_access_code = 2;
+   */
+  samlAccessCode = "";
+
+  constructor(data?: PartialMessage<OnboardingRedeemSAMLAccessCodeRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.VerifyEmailRequest";
+  static readonly typeName = "ssoready.v1.OnboardingRedeemSAMLAccessCodeRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "api_key_secret_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "saml_access_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VerifyEmailRequest {
-    return new VerifyEmailRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OnboardingRedeemSAMLAccessCodeRequest {
+    return new OnboardingRedeemSAMLAccessCodeRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VerifyEmailRequest {
-    return new VerifyEmailRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OnboardingRedeemSAMLAccessCodeRequest {
+    return new OnboardingRedeemSAMLAccessCodeRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VerifyEmailRequest {
-    return new VerifyEmailRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OnboardingRedeemSAMLAccessCodeRequest {
+    return new OnboardingRedeemSAMLAccessCodeRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: VerifyEmailRequest | PlainMessage<VerifyEmailRequest> | undefined, b: VerifyEmailRequest | PlainMessage<VerifyEmailRequest> | undefined): boolean {
-    return proto3.util.equals(VerifyEmailRequest, a, b);
+  static equals(a: OnboardingRedeemSAMLAccessCodeRequest | PlainMessage<OnboardingRedeemSAMLAccessCodeRequest> | undefined, b: OnboardingRedeemSAMLAccessCodeRequest | PlainMessage<OnboardingRedeemSAMLAccessCodeRequest> | undefined): boolean {
+    return proto3.util.equals(OnboardingRedeemSAMLAccessCodeRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SignInRequest
+ * @generated from message ssoready.v1.GetAppOrganizationRequest
  */
-export class SignInRequest extends Message<SignInRequest> {
-  /**
-   * @generated from field: string google_credential = 1;
-   */
-  googleCredential = "";
-
-  /**
-   * @generated from field: string email_verify_token = 2;
-   */
-  emailVerifyToken = "";
-
-  /**
-   * @generated from field: string microsoft_code = 3;
-   */
-  microsoftCode = "";
-
-  constructor(data?: PartialMessage<SignInRequest>) {
+export class GetAppOrganizationRequest extends Message<GetAppOrganizationRequest> {
+  constructor(data?: PartialMessage<GetAppOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SignInRequest";
+  static readonly typeName = "ssoready.v1.GetAppOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "google_credential", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "email_verify_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "microsoft_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignInRequest {
-    return new SignInRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAppOrganizationRequest {
+    return new GetAppOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignInRequest {
-    return new SignInRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAppOrganizationRequest {
+    return new GetAppOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignInRequest {
-    return new SignInRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAppOrganizationRequest {
+    return new GetAppOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SignInRequest | PlainMessage<SignInRequest> | undefined, b: SignInRequest | PlainMessage<SignInRequest> | undefined): boolean {
-    return proto3.util.equals(SignInRequest, a, b);
+  static equals(a: GetAppOrganizationRequest | PlainMessage<GetAppOrganizationRequest> | undefined, b: GetAppOrganizationRequest | PlainMessage<GetAppOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(GetAppOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SignInResponse
+ * @generated from message ssoready.v1.GetAppOrganizationResponse
  */
-export class SignInResponse extends Message<SignInResponse> {
+export class GetAppOrganizationResponse extends Message<GetAppOrganizationResponse> {
   /**
-   * @generated from field: string session_token = 1;
+   * @generated from field: string google_hosted_domain = 1;
    */
-  sessionToken = "";
+  googleHostedDomain = "";
 
-  constructor(data?: PartialMessage<SignInResponse>) {
+  /**
+   * @generated from field: bool entitled_management_api = 2;
+   */
+  entitledManagementApi = false;
+
+  /**
+   * @generated from field: bool entitled_custom_domains = 4;
+   */
+  entitledCustomDomains = false;
+
+  constructor(data?: PartialMessage<GetAppOrganizationResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SignInResponse";
+  static readonly typeName = "ssoready.v1.GetAppOrganizationResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "session_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "google_hosted_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "entitled_management_api", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "entitled_custom_domains", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignInResponse {
-    return new SignInResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAppOrganizationResponse {
+    return new GetAppOrganizationResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignInResponse {
-    return new SignInResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAppOrganizationResponse {
+    return new GetAppOrganizationResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignInResponse {
-    return new SignInResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAppOrganizationResponse {
+    return new GetAppOrganizationResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SignInResponse | PlainMessage<SignInResponse> | undefined, b: SignInResponse | PlainMessage<SignInResponse> | undefined): boolean {
-    return proto3.util.equals(SignInResponse, a, b);
+  static equals(a: GetAppOrganizationResponse | PlainMessage<GetAppOrganizationResponse> | undefined, b: GetAppOrganizationResponse | PlainMessage<GetAppOrganizationResponse> | undefined): boolean {
+    return proto3.util.equals(GetAppOrganizationResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SignOutRequest
+ * @generated from message ssoready.v1.ListAppUsersRequest
  */
-export class SignOutRequest extends Message<SignOutRequest> {
-  constructor(data?: PartialMessage<SignOutRequest>) {
+export class ListAppUsersRequest extends Message<ListAppUsersRequest> {
+  constructor(data?: PartialMessage<ListAppUsersRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SignOutRequest";
+  static readonly typeName = "ssoready.v1.ListAppUsersRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignOutRequest {
-    return new SignOutRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAppUsersRequest {
+    return new ListAppUsersRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignOutRequest {
-    return new SignOutRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAppUsersRequest {
+    return new ListAppUsersRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignOutRequest {
-    return new SignOutRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAppUsersRequest {
+    return new ListAppUsersRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SignOutRequest | PlainMessage<SignOutRequest> | undefined, b: SignOutRequest | PlainMessage<SignOutRequest> | undefined): boolean {
-    return proto3.util.equals(SignOutRequest, a, b);
+  static equals(a: ListAppUsersRequest | PlainMessage<ListAppUsersRequest> | undefined, b: ListAppUsersRequest | PlainMessage<ListAppUsersRequest> | undefined): boolean {
+    return proto3.util.equals(ListAppUsersRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.SignOutResponse
+ * @generated from message ssoready.v1.ListAppUsersResponse
  */
-export class SignOutResponse extends Message<SignOutResponse> {
-  constructor(data?: PartialMessage<SignOutResponse>) {
+export class ListAppUsersResponse extends Message<ListAppUsersResponse> {
+  /**
+   * @generated from field: repeated ssoready.v1.AppUser app_users = 1;
+   */
+  appUsers: AppUser[] = [];
+
+  constructor(data?: PartialMessage<ListAppUsersResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.SignOutResponse";
+  static readonly typeName = "ssoready.v1.ListAppUsersResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "app_users", kind: "message", T: AppUser, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SignOutResponse {
-    return new SignOutResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAppUsersResponse {
+    return new ListAppUsersResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SignOutResponse {
-    return new SignOutResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAppUsersResponse {
+    return new ListAppUsersResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SignOutResponse {
-    return new SignOutResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAppUsersResponse {
+    return new ListAppUsersResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: SignOutResponse | PlainMessage<SignOutResponse> | undefined, b: SignOutResponse | PlainMessage<SignOutResponse> | undefined): boolean {
-    return proto3.util.equals(SignOutResponse, a, b);
+  static equals(a: ListAppUsersResponse | PlainMessage<ListAppUsersResponse> | undefined, b: ListAppUsersResponse | PlainMessage<ListAppUsersResponse> | undefined): boolean {
+    return proto3.util.equals(ListAppUsersResponse, a, b);
   }
 }
 
-/**
- * @generated from message ssoready.v1.WhoamiRequest
- */
-export class WhoamiRequest extends Message<WhoamiRequest> {
-  constructor(data?: PartialMessage<WhoamiRequest>) {
+/**
+ * @generated from message ssoready.v1.ListEnvironmentsRequest
+ */
+export class ListEnvironmentsRequest extends Message<ListEnvironmentsRequest> {
+  /**
+   * @generated from field: string page_token = 1;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<ListEnvironmentsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.WhoamiRequest";
+  static readonly typeName = "ssoready.v1.ListEnvironmentsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WhoamiRequest {
-    return new WhoamiRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEnvironmentsRequest {
+    return new ListEnvironmentsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WhoamiRequest {
-    return new WhoamiRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEnvironmentsRequest {
+    return new ListEnvironmentsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WhoamiRequest {
-    return new WhoamiRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEnvironmentsRequest {
+    return new ListEnvironmentsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WhoamiRequest | PlainMessage<WhoamiRequest> | undefined, b: WhoamiRequest | PlainMessage<WhoamiRequest> | undefined): boolean {
-    return proto3.util.equals(WhoamiRequest, a, b);
+  static equals(a: ListEnvironmentsRequest | PlainMessage<ListEnvironmentsRequest> | undefined, b: ListEnvironmentsRequest | PlainMessage<ListEnvironmentsRequest> | undefined): boolean {
+    return proto3.util.equals(ListEnvironmentsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.WhoamiResponse
+ * @generated from message ssoready.v1.ListEnvironmentsResponse
  */
-export class WhoamiResponse extends Message<WhoamiResponse> {
-  /**
-   * @generated from field: string app_user_id = 1;
-   */
-  appUserId = "";
-
+export class ListEnvironmentsResponse extends Message<ListEnvironmentsResponse> {
   /**
-   * @generated from field: string display_name = 2;
+   * @generated from field: repeated ssoready.v1.Environment environments = 1;
    */
-  displayName = "";
+  environments: Environment[] = [];
 
   /**
-   * @generated from field: string email = 3;
+   * @generated from field: string next_page_token = 2;
    */
-  email = "";
+  nextPageToken = "";
 
-  constructor(data?: PartialMessage<WhoamiResponse>) {
+  constructor(data?: PartialMessage<ListEnvironmentsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.WhoamiResponse";
+  static readonly typeName = "ssoready.v1.ListEnvironmentsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "app_user_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "display_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "email", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environments", kind: "message", T: Environment, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): WhoamiResponse {
-    return new WhoamiResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEnvironmentsResponse {
+    return new ListEnvironmentsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): WhoamiResponse {
-    return new WhoamiResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEnvironmentsResponse {
+    return new ListEnvironmentsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): WhoamiResponse {
-    return new WhoamiResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEnvironmentsResponse {
+    return new ListEnvironmentsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: WhoamiResponse | PlainMessage<WhoamiResponse> | undefined, b: WhoamiResponse | PlainMessage<WhoamiResponse> | undefined): boolean {
-    return proto3.util.equals(WhoamiResponse, a, b);
+  static equals(a: ListEnvironmentsResponse | PlainMessage<ListEnvironmentsResponse> | undefined, b: ListEnvironmentsResponse | PlainMessage<ListEnvironmentsResponse> | undefined): boolean {
+    return proto3.util.equals(ListEnvironmentsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetOnboardingStateRequest
+ * @generated from message ssoready.v1.GetEnvironmentRequest
  */
-export class GetOnboardingStateRequest extends Message<GetOnboardingStateRequest> {
-  constructor(data?: PartialMessage<GetOnboardingStateRequest>) {
+export class GetEnvironmentRequest extends Message<GetEnvironmentRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetEnvironmentRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetOnboardingStateRequest";
+  static readonly typeName = "ssoready.v1.GetEnvironmentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOnboardingStateRequest {
-    return new GetOnboardingStateRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnvironmentRequest {
+    return new GetEnvironmentRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOnboardingStateRequest {
-    return new GetOnboardingStateRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnvironmentRequest {
+    return new GetEnvironmentRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOnboardingStateRequest {
-    return new GetOnboardingStateRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnvironmentRequest {
+    return new GetEnvironmentRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetOnboardingStateRequest | PlainMessage<GetOnboardingStateRequest> | undefined, b: GetOnboardingStateRequest | PlainMessage<GetOnboardingStateRequest> | undefined): boolean {
-    return proto3.util.equals(GetOnboardingStateRequest, a, b);
+  static equals(a: GetEnvironmentRequest | PlainMessage<GetEnvironmentRequest> | undefined, b: GetEnvironmentRequest | PlainMessage<GetEnvironmentRequest> | undefined): boolean {
+    return proto3.util.equals(GetEnvironmentRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetOnboardingStateResponse
+ * @generated from message ssoready.v1.CreateEnvironmentRequest
  */
-export class GetOnboardingStateResponse extends Message<GetOnboardingStateResponse> {
-  /**
-   * @generated from field: string dummyidp_app_id = 1;
-   */
-  dummyidpAppId = "";
-
-  /**
-   * @generated from field: string onboarding_environment_id = 2;
-   */
-  onboardingEnvironmentId = "";
-
-  /**
-   * @generated from field: string onboarding_organization_id = 3;
-   */
-  onboardingOrganizationId = "";
-
+export class CreateEnvironmentRequest extends Message<CreateEnvironmentRequest> {
   /**
-   * @generated from field: string onboarding_saml_connection_id = 4;
+   * @generated from field: ssoready.v1.Environment environment = 1;
    */
-  onboardingSamlConnectionId = "";
+  environment?: Environment;
 
-  constructor(data?: PartialMessage<GetOnboardingStateResponse>) {
+  constructor(data?: PartialMessage<CreateEnvironmentRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetOnboardingStateResponse";
+  static readonly typeName = "ssoready.v1.CreateEnvironmentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "dummyidp_app_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "onboarding_environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "onboarding_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "onboarding_saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environment", kind: "message", T: Environment },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetOnboardingStateResponse {
-    return new GetOnboardingStateResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateEnvironmentRequest {
+    return new CreateEnvironmentRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetOnboardingStateResponse {
-    return new GetOnboardingStateResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateEnvironmentRequest {
+    return new CreateEnvironmentRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetOnboardingStateResponse {
-    return new GetOnboardingStateResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateEnvironmentRequest {
+    return new CreateEnvironmentRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetOnboardingStateResponse | PlainMessage<GetOnboardingStateResponse> | undefined, b: GetOnboardingStateResponse | PlainMessage<GetOnboardingStateResponse> | undefined): boolean {
-    return proto3.util.equals(GetOnboardingStateResponse, a, b);
+  static equals(a: CreateEnvironmentRequest | PlainMessage<CreateEnvironmentRequest> | undefined, b: CreateEnvironmentRequest | PlainMessage<CreateEnvironmentRequest> | undefined): boolean {
+    return proto3.util.equals(CreateEnvironmentRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateOnboardingStateRequest
+ * @generated from message ssoready.v1.UpdateEnvironmentRequest
  */
-export class UpdateOnboardingStateRequest extends Message<UpdateOnboardingStateRequest> {
-  /**
-   * @generated from field: string dummyidp_app_id = 1;
-   */
-  dummyidpAppId = "";
-
-  /**
-   * @generated from field: string onboarding_environment_id = 2;
-   */
-  onboardingEnvironmentId = "";
-
-  /**
-   * @generated from field: string onboarding_organization_id = 3;
-   */
-  onboardingOrganizationId = "";
-
+export class UpdateEnvironmentRequest extends Message<UpdateEnvironmentRequest> {
   /**
-   * @generated from field: string onboarding_saml_connection_id = 4;
+   * @generated from field: ssoready.v1.Environment environment = 1;
    */
-  onboardingSamlConnectionId = "";
+  environment?: Environment;
 
-  constructor(data?: PartialMessage<UpdateOnboardingStateRequest>) {
+  constructor(data?: PartialMessage<UpdateEnvironmentRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateOnboardingStateRequest";
+  static readonly typeName = "ssoready.v1.UpdateEnvironmentRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "dummyidp_app_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "onboarding_environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "onboarding_organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "onboarding_saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environment", kind: "message", T: Environment },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateOnboardingStateRequest {
-    return new UpdateOnboardingStateRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnvironmentRequest {
+    return new UpdateEnvironmentRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateOnboardingStateRequest {
-    return new UpdateOnboardingStateRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnvironmentRequest {
+    return new UpdateEnvironmentRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateOnboardingStateRequest {
-    return new UpdateOnboardingStateRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnvironmentRequest {
+    return new UpdateEnvironmentRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateOnboardingStateRequest | PlainMessage<UpdateOnboardingStateRequest> | undefined, b: UpdateOnboardingStateRequest | PlainMessage<UpdateOnboardingStateRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateOnboardingStateRequest, a, b);
+  static equals(a: UpdateEnvironmentRequest | PlainMessage<UpdateEnvironmentRequest> | undefined, b: UpdateEnvironmentRequest | PlainMessage<UpdateEnvironmentRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateEnvironmentRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.OnboardingGetSAMLRedirectURLRequest
+ * @generated from message ssoready.v1.GetEnvironmentCustomDomainSettingsRequest
  */
-export class OnboardingGetSAMLRedirectURLRequest extends Message<OnboardingGetSAMLRedirectURLRequest> {
-  /**
-   * @generated from field: string api_key_secret_token = 1;
-   */
-  apiKeySecretToken = "";
-
+export class GetEnvironmentCustomDomainSettingsRequest extends Message<GetEnvironmentCustomDomainSettingsRequest> {
   /**
-   * @generated from field: string saml_connection_id = 2;
+   * @generated from field: string environment_id = 1;
    */
-  samlConnectionId = "";
+  environmentId = "";
 
-  constructor(data?: PartialMessage<OnboardingGetSAMLRedirectURLRequest>) {
+  constructor(data?: PartialMessage<GetEnvironmentCustomDomainSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.OnboardingGetSAMLRedirectURLRequest";
+  static readonly typeName = "ssoready.v1.GetEnvironmentCustomDomainSettingsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_key_secret_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OnboardingGetSAMLRedirectURLRequest {
-    return new OnboardingGetSAMLRedirectURLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnvironmentCustomDomainSettingsRequest {
+    return new GetEnvironmentCustomDomainSettingsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OnboardingGetSAMLRedirectURLRequest {
-    return new OnboardingGetSAMLRedirectURLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsRequest {
+    return new GetEnvironmentCustomDomainSettingsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OnboardingGetSAMLRedirectURLRequest {
-    return new OnboardingGetSAMLRedirectURLRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsRequest {
+    return new GetEnvironmentCustomDomainSettingsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: OnboardingGetSAMLRedirectURLRequest | PlainMessage<OnboardingGetSAMLRedirectURLRequest> | undefined, b: OnboardingGetSAMLRedirectURLRequest | PlainMessage<OnboardingGetSAMLRedirectURLRequest> | undefined): boolean {
-    return proto3.util.equals(OnboardingGetSAMLRedirectURLRequest, a, b);
+  static equals(a: GetEnvironmentCustomDomainSettingsRequest | PlainMessage<GetEnvironmentCustomDomainSettingsRequest> | undefined, b: GetEnvironmentCustomDomainSettingsRequest | PlainMessage<GetEnvironmentCustomDomainSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(GetEnvironmentCustomDomainSettingsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.OnboardingRedeemSAMLAccessCodeRequest
+ * @generated from message ssoready.v1.GetEnvironmentCustomDomainSettingsResponse
  */
-export class OnboardingRedeemSAMLAccessCodeRequest extends Message<OnboardingRedeemSAMLAccessCodeRequest> {
+export class GetEnvironmentCustomDomainSettingsResponse extends Message<GetEnvironmentCustomDomainSettingsResponse> {
   /**
-   * @generated from field: string api_key_secret_token = 1;
+   * @generated from field: string custom_auth_domain = 1;
    */
-  apiKeySecretToken = "";
+  customAuthDomain = "";
 
   /**
-   * @generated from field: string saml_access_code = 2;
+   * @generated from field: bool custom_auth_domain_configured = 2;
    */
-  samlAccessCode = "";
-
-  constructor(data?: PartialMessage<OnboardingRedeemSAMLAccessCodeRequest>) {
-    super();
-    proto3.util.initPartial(data, this);
-  }
-
-  static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.OnboardingRedeemSAMLAccessCodeRequest";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_key_secret_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "saml_access_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-  ]);
+  customAuthDomainConfigured = false;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): OnboardingRedeemSAMLAccessCodeRequest {
-    return new OnboardingRedeemSAMLAccessCodeRequest().fromBinary(bytes, options);
-  }
+  /**
+   * @generated from field: string custom_auth_domain_cname_value = 3;
+   */
+  customAuthDomainCnameValue = "";
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): OnboardingRedeemSAMLAccessCodeRequest {
-    return new OnboardingRedeemSAMLAccessCodeRequest().fromJson(jsonValue, options);
-  }
+  /**
+   * @generated from field: string custom_admin_domain = 4;
+   */
+  customAdminDomain = "";
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): OnboardingRedeemSAMLAccessCodeRequest {
-    return new OnboardingRedeemSAMLAccessCodeRequest().fromJsonString(jsonString, options);
-  }
+  /**
+   * @generated from field: bool custom_admin_domain_configured = 5;
+   */
+  customAdminDomainConfigured = false;
 
-  static equals(a: OnboardingRedeemSAMLAccessCodeRequest | PlainMessage<OnboardingRedeemSAMLAccessCodeRequest> | undefined, b: OnboardingRedeemSAMLAccessCodeRequest | PlainMessage<OnboardingRedeemSAMLAccessCodeRequest> | undefined): boolean {
-    return proto3.util.equals(OnboardingRedeemSAMLAccessCodeRequest, a, b);
-  }
-}
+  /**
+   * @generated from field: string custom_admin_domain_cname_value = 6;
+   */
+  customAdminDomainCnameValue = "";
 
-/**
- * @generated from message ssoready.v1.GetAppOrganizationRequest
- */
-export class GetAppOrganizationRequest extends Message<GetAppOrganizationRequest> {
-  constructor(data?: PartialMessage<GetAppOrganizationRequest>) {
+  constructor(data?: PartialMessage<GetEnvironmentCustomDomainSettingsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetAppOrganizationRequest";
+  static readonly typeName = "ssoready.v1.GetEnvironmentCustomDomainSettingsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "custom_auth_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "custom_auth_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "custom_auth_domain_cname_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "custom_admin_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "custom_admin_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 6, name: "custom_admin_domain_cname_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAppOrganizationRequest {
-    return new GetAppOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnvironmentCustomDomainSettingsResponse {
+    return new GetEnvironmentCustomDomainSettingsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAppOrganizationRequest {
-    return new GetAppOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsResponse {
+    return new GetEnvironmentCustomDomainSettingsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAppOrganizationRequest {
-    return new GetAppOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsResponse {
+    return new GetEnvironmentCustomDomainSettingsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAppOrganizationRequest | PlainMessage<GetAppOrganizationRequest> | undefined, b: GetAppOrganizationRequest | PlainMessage<GetAppOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(GetAppOrganizationRequest, a, b);
+  static equals(a: GetEnvironmentCustomDomainSettingsResponse | PlainMessage<GetEnvironmentCustomDomainSettingsResponse> | undefined, b: GetEnvironmentCustomDomainSettingsResponse | PlainMessage<GetEnvironmentCustomDomainSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(GetEnvironmentCustomDomainSettingsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetAppOrganizationResponse
+ * @generated from message ssoready.v1.UpdateEnvironmentCustomDomainSettingsRequest
  */
-export class GetAppOrganizationResponse extends Message<GetAppOrganizationResponse> {
+export class UpdateEnvironmentCustomDomainSettingsRequest extends Message<UpdateEnvironmentCustomDomainSettingsRequest> {
   /**
-   * @generated from field: string google_hosted_domain = 1;
+   * @generated from field: string environment_id = 1;
    */
-  googleHostedDomain = "";
+  environmentId = "";
 
   /**
-   * @generated from field: bool entitled_management_api = 2;
+   * @generated from field: string custom_auth_domain = 2;
    */
-  entitledManagementApi = false;
+  customAuthDomain = "";
 
   /**
-   * @generated from field: bool entitled_custom_domains = 4;
+   * @generated from field: string custom_admin_domain = 3;
    */
-  entitledCustomDomains = false;
+  customAdminDomain = "";
 
-  constructor(data?: PartialMessage<GetAppOrganizationResponse>) {
+  constructor(data?: PartialMessage<UpdateEnvironmentCustomDomainSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetAppOrganizationResponse";
+  static readonly typeName = "ssoready.v1.UpdateEnvironmentCustomDomainSettingsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "google_hosted_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "entitled_management_api", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 4, name: "entitled_custom_domains", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "custom_auth_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "custom_admin_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAppOrganizationResponse {
-    return new GetAppOrganizationResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnvironmentCustomDomainSettingsRequest {
+    return new UpdateEnvironmentCustomDomainSettingsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAppOrganizationResponse {
-    return new GetAppOrganizationResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsRequest {
+    return new UpdateEnvironmentCustomDomainSettingsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAppOrganizationResponse {
-    return new GetAppOrganizationResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsRequest {
+    return new UpdateEnvironmentCustomDomainSettingsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAppOrganizationResponse | PlainMessage<GetAppOrganizationResponse> | undefined, b: GetAppOrganizationResponse | PlainMessage<GetAppOrganizationResponse> | undefined): boolean {
-    return proto3.util.equals(GetAppOrganizationResponse, a, b);
+  static equals(a: UpdateEnvironmentCustomDomainSettingsRequest | PlainMessage<UpdateEnvironmentCustomDomainSettingsRequest> | undefined, b: UpdateEnvironmentCustomDomainSettingsRequest | PlainMessage<UpdateEnvironmentCustomDomainSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateEnvironmentCustomDomainSettingsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListAppUsersRequest
+ * @generated from message ssoready.v1.UpdateEnvironmentCustomDomainSettingsResponse
  */
-export class ListAppUsersRequest extends Message<ListAppUsersRequest> {
-  constructor(data?: PartialMessage<ListAppUsersRequest>) {
+export class UpdateEnvironmentCustomDomainSettingsResponse extends Message<UpdateEnvironmentCustomDomainSettingsResponse> {
+  constructor(data?: PartialMessage<UpdateEnvironmentCustomDomainSettingsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListAppUsersRequest";
+  static readonly typeName = "ssoready.v1.UpdateEnvironmentCustomDomainSettingsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAppUsersRequest {
-    return new ListAppUsersRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnvironmentCustomDomainSettingsResponse {
+    return new UpdateEnvironmentCustomDomainSettingsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAppUsersRequest {
-    return new ListAppUsersRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsResponse {
+    return new UpdateEnvironmentCustomDomainSettingsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAppUsersRequest {
-    return new ListAppUsersRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsResponse {
+    return new UpdateEnvironmentCustomDomainSettingsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListAppUsersRequest | PlainMessage<ListAppUsersRequest> | undefined, b: ListAppUsersRequest | PlainMessage<ListAppUsersRequest> | undefined): boolean {
-    return proto3.util.equals(ListAppUsersRequest, a, b);
+  static equals(a: UpdateEnvironmentCustomDomainSettingsResponse | PlainMessage<UpdateEnvironmentCustomDomainSettingsResponse> | undefined, b: UpdateEnvironmentCustomDomainSettingsResponse | PlainMessage<UpdateEnvironmentCustomDomainSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateEnvironmentCustomDomainSettingsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListAppUsersResponse
+ * @generated from message ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesRequest
  */
-export class ListAppUsersResponse extends Message<ListAppUsersResponse> {
+export class CheckEnvironmentCustomDomainSettingsCertificatesRequest extends Message<CheckEnvironmentCustomDomainSettingsCertificatesRequest> {
   /**
-   * @generated from field: repeated ssoready.v1.AppUser app_users = 1;
+   * @generated from field: string environment_id = 1;
    */
-  appUsers: AppUser[] = [];
+  environmentId = "";
 
-  constructor(data?: PartialMessage<ListAppUsersResponse>) {
+  constructor(data?: PartialMessage<CheckEnvironmentCustomDomainSettingsCertificatesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListAppUsersResponse";
+  static readonly typeName = "ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "app_users", kind: "message", T: AppUser, repeated: true },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAppUsersResponse {
-    return new ListAppUsersResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesRequest {
+    return new CheckEnvironmentCustomDomainSettingsCertificatesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAppUsersResponse {
-    return new ListAppUsersResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesRequest {
+    return new CheckEnvironmentCustomDomainSettingsCertificatesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAppUsersResponse {
-    return new ListAppUsersResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesRequest {
+    return new CheckEnvironmentCustomDomainSettingsCertificatesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListAppUsersResponse | PlainMessage<ListAppUsersResponse> | undefined, b: ListAppUsersResponse | PlainMessage<ListAppUsersResponse> | undefined): boolean {
-    return proto3.util.equals(ListAppUsersResponse, a, b);
+  static equals(a: CheckEnvironmentCustomDomainSettingsCertificatesRequest | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesRequest> | undefined, b: CheckEnvironmentCustomDomainSettingsCertificatesRequest | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesRequest> | undefined): boolean {
+    return proto3.util.equals(CheckEnvironmentCustomDomainSettingsCertificatesRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListEnvironmentsRequest
+ * @generated from message ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesResponse
  */
-export class ListEnvironmentsRequest extends Message<ListEnvironmentsRequest> {
+export class CheckEnvironmentCustomDomainSettingsCertificatesResponse extends Message<CheckEnvironmentCustomDomainSettingsCertificatesResponse> {
   /**
-   * @generated from field: string page_token = 1;
+   * @generated from field: bool custom_auth_domain_configured = 1;
    */
-  pageToken = "";
+  customAuthDomainConfigured = false;
 
-  constructor(data?: PartialMessage<ListEnvironmentsRequest>) {
+  /**
+   * @generated from field: bool custom_admin_domain_configured = 2;
+   */
+  customAdminDomainConfigured = false;
+
+  constructor(data?: PartialMessage<CheckEnvironmentCustomDomainSettingsCertificatesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListEnvironmentsRequest";
+  static readonly typeName = "ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "custom_auth_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "custom_admin_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEnvironmentsRequest {
-    return new ListEnvironmentsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesResponse {
+    return new CheckEnvironmentCustomDomainSettingsCertificatesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEnvironmentsRequest {
-    return new ListEnvironmentsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesResponse {
+    return new CheckEnvironmentCustomDomainSettingsCertificatesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEnvironmentsRequest {
-    return new ListEnvironmentsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesResponse {
+    return new CheckEnvironmentCustomDomainSettingsCertificatesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListEnvironmentsRequest | PlainMessage<ListEnvironmentsRequest> | undefined, b: ListEnvironmentsRequest | PlainMessage<ListEnvironmentsRequest> | undefined): boolean {
-    return proto3.util.equals(ListEnvironmentsRequest, a, b);
+  static equals(a: CheckEnvironmentCustomDomainSettingsCertificatesResponse | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesResponse> | undefined, b: CheckEnvironmentCustomDomainSettingsCertificatesResponse | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesResponse> | undefined): boolean {
+    return proto3.util.equals(CheckEnvironmentCustomDomainSettingsCertificatesResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListEnvironmentsResponse
+ * @generated from message ssoready.v1.ListAPIKeysRequest
  */
-export class ListEnvironmentsResponse extends Message<ListEnvironmentsResponse> {
+export class ListAPIKeysRequest extends Message<ListAPIKeysRequest> {
   /**
-   * @generated from field: repeated ssoready.v1.Environment environments = 1;
+   * @generated from field: string environment_id = 1;
    */
-  environments: Environment[] = [];
+  environmentId = "";
 
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string page_token = 2;
    */
-  nextPageToken = "";
+  pageToken = "";
 
-  constructor(data?: PartialMessage<ListEnvironmentsResponse>) {
+  constructor(data?: PartialMessage<ListAPIKeysRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListEnvironmentsResponse";
+  static readonly typeName = "ssoready.v1.ListAPIKeysRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environments", kind: "message", T: Environment, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListEnvironmentsResponse {
-    return new ListEnvironmentsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPIKeysRequest {
+    return new ListAPIKeysRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListEnvironmentsResponse {
-    return new ListEnvironmentsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPIKeysRequest {
+    return new ListAPIKeysRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListEnvironmentsResponse {
-    return new ListEnvironmentsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPIKeysRequest {
+    return new ListAPIKeysRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListEnvironmentsResponse | PlainMessage<ListEnvironmentsResponse> | undefined, b: ListEnvironmentsResponse | PlainMessage<ListEnvironmentsResponse> | undefined): boolean {
-    return proto3.util.equals(ListEnvironmentsResponse, a, b);
+  static equals(a: ListAPIKeysRequest | PlainMessage<ListAPIKeysRequest> | undefined, b: ListAPIKeysRequest | PlainMessage<ListAPIKeysRequest> | undefined): boolean {
+    return proto3.util.equals(ListAPIKeysRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetEnvironmentRequest
+ * @generated from message ssoready.v1.ListAPIKeysResponse
  */
-export class GetEnvironmentRequest extends Message<GetEnvironmentRequest> {
+export class ListAPIKeysResponse extends Message<ListAPIKeysResponse> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: repeated ssoready.v1.APIKey api_keys = 1;
    */
-  id = "";
+  apiKeys: APIKey[] = [];
 
-  constructor(data?: PartialMessage<GetEnvironmentRequest>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListAPIKeysResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetEnvironmentRequest";
+  static readonly typeName = "ssoready.v1.ListAPIKeysResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "api_keys", kind: "message", T: APIKey, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnvironmentRequest {
-    return new GetEnvironmentRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPIKeysResponse {
+    return new ListAPIKeysResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnvironmentRequest {
-    return new GetEnvironmentRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPIKeysResponse {
+    return new ListAPIKeysResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnvironmentRequest {
-    return new GetEnvironmentRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPIKeysResponse {
+    return new ListAPIKeysResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetEnvironmentRequest | PlainMessage<GetEnvironmentRequest> | undefined, b: GetEnvironmentRequest | PlainMessage<GetEnvironmentRequest> | undefined): boolean {
-    return proto3.util.equals(GetEnvironmentRequest, a, b);
+  static equals(a: ListAPIKeysResponse | PlainMessage<ListAPIKeysResponse> | undefined, b: ListAPIKeysResponse | PlainMessage<ListAPIKeysResponse> | undefined): boolean {
+    return proto3.util.equals(ListAPIKeysResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateEnvironmentRequest
+ * @generated from message ssoready.v1.GetAPIKeyRequest
  */
-export class CreateEnvironmentRequest extends Message<CreateEnvironmentRequest> {
+export class GetAPIKeyRequest extends Message<GetAPIKeyRequest> {
   /**
-   * @generated from field: ssoready.v1.Environment environment = 1;
+   * @generated from field: string id = 1;
    */
-  environment?: Environment;
+  id = "";
 
-  constructor(data?: PartialMessage<CreateEnvironmentRequest>) {
+  constructor(data?: PartialMessage<GetAPIKeyRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateEnvironmentRequest";
+  static readonly typeName = "ssoready.v1.GetAPIKeyRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment", kind: "message", T: Environment },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateEnvironmentRequest {
-    return new CreateEnvironmentRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAPIKeyRequest {
+    return new GetAPIKeyRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateEnvironmentRequest {
-    return new CreateEnvironmentRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAPIKeyRequest {
+    return new GetAPIKeyRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateEnvironmentRequest {
-    return new CreateEnvironmentRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAPIKeyRequest {
+    return new GetAPIKeyRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateEnvironmentRequest | PlainMessage<CreateEnvironmentRequest> | undefined, b: CreateEnvironmentRequest | PlainMessage<CreateEnvironmentRequest> | undefined): boolean {
-    return proto3.util.equals(CreateEnvironmentRequest, a, b);
+  static equals(a: GetAPIKeyRequest | PlainMessage<GetAPIKeyRequest> | undefined, b: GetAPIKeyRequest | PlainMessage<GetAPIKeyRequest> | undefined): boolean {
+    return proto3.util.equals(GetAPIKeyRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateEnvironmentRequest
+ * @generated from message ssoready.v1.CreateAPIKeyRequest
  */
-export class UpdateEnvironmentRequest extends Message<UpdateEnvironmentRequest> {
+export class CreateAPIKeyRequest extends Message<CreateAPIKeyRequest> {
   /**
-   * @generated from field: ssoready.v1.Environment environment = 1;
+   * @generated from field: ssoready.v1.APIKey api_key = 1;
    */
-  environment?: Environment;
+  apiKey?: APIKey;
 
-  constructor(data?: PartialMessage<UpdateEnvironmentRequest>) {
+  constructor(data?: PartialMessage<CreateAPIKeyRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateEnvironmentRequest";
+  static readonly typeName = "ssoready.v1.CreateAPIKeyRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment", kind: "message", T: Environment },
+    { no: 1, name: "api_key", kind: "message", T: APIKey },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnvironmentRequest {
-    return new UpdateEnvironmentRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPIKeyRequest {
+    return new CreateAPIKeyRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnvironmentRequest {
-    return new UpdateEnvironmentRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPIKeyRequest {
+    return new CreateAPIKeyRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnvironmentRequest {
-    return new UpdateEnvironmentRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPIKeyRequest {
+    return new CreateAPIKeyRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateEnvironmentRequest | PlainMessage<UpdateEnvironmentRequest> | undefined, b: UpdateEnvironmentRequest | PlainMessage<UpdateEnvironmentRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateEnvironmentRequest, a, b);
+  static equals(a: CreateAPIKeyRequest | PlainMessage<CreateAPIKeyRequest> | undefined, b: CreateAPIKeyRequest | PlainMessage<CreateAPIKeyRequest> | undefined): boolean {
+    return proto3.util.equals(CreateAPIKeyRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetEnvironmentCustomDomainSettingsRequest
+ * @generated from message ssoready.v1.DeleteAPIKeyRequest
  */
-export class GetEnvironmentCustomDomainSettingsRequest extends Message<GetEnvironmentCustomDomainSettingsRequest> {
+export class DeleteAPIKeyRequest extends Message<DeleteAPIKeyRequest> {
   /**
-   * @generated from field: string environment_id = 1;
+   * @generated from field: string id = 1;
    */
-  environmentId = "";
+  id = "";
 
-  constructor(data?: PartialMessage<GetEnvironmentCustomDomainSettingsRequest>) {
+  constructor(data?: PartialMessage<DeleteAPIKeyRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetEnvironmentCustomDomainSettingsRequest";
+  static readonly typeName = "ssoready.v1.DeleteAPIKeyRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnvironmentCustomDomainSettingsRequest {
-    return new GetEnvironmentCustomDomainSettingsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAPIKeyRequest {
+    return new DeleteAPIKeyRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsRequest {
-    return new GetEnvironmentCustomDomainSettingsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAPIKeyRequest {
+    return new DeleteAPIKeyRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsRequest {
-    return new GetEnvironmentCustomDomainSettingsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAPIKeyRequest {
+    return new DeleteAPIKeyRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetEnvironmentCustomDomainSettingsRequest | PlainMessage<GetEnvironmentCustomDomainSettingsRequest> | undefined, b: GetEnvironmentCustomDomainSettingsRequest | PlainMessage<GetEnvironmentCustomDomainSettingsRequest> | undefined): boolean {
-    return proto3.util.equals(GetEnvironmentCustomDomainSettingsRequest, a, b);
+  static equals(a: DeleteAPIKeyRequest | PlainMessage<DeleteAPIKeyRequest> | undefined, b: DeleteAPIKeyRequest | PlainMessage<DeleteAPIKeyRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteAPIKeyRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetEnvironmentCustomDomainSettingsResponse
+ * @generated from message ssoready.v1.ListSAMLOAuthClientsRequest
  */
-export class GetEnvironmentCustomDomainSettingsResponse extends Message<GetEnvironmentCustomDomainSettingsResponse> {
-  /**
-   * @generated from field: string custom_auth_domain = 1;
-   */
-  customAuthDomain = "";
-
-  /**
-   * @generated from field: bool custom_auth_domain_configured = 2;
-   */
-  customAuthDomainConfigured = false;
-
-  /**
-   * @generated from field: string custom_auth_domain_cname_value = 3;
-   */
-  customAuthDomainCnameValue = "";
-
-  /**
-   * @generated from field: string custom_admin_domain = 4;
-   */
-  customAdminDomain = "";
-
+export class ListSAMLOAuthClientsRequest extends Message<ListSAMLOAuthClientsRequest> {
   /**
-   * @generated from field: bool custom_admin_domain_configured = 5;
+   * @generated from field: string environment_id = 1;
    */
-  customAdminDomainConfigured = false;
+  environmentId = "";
 
   /**
-   * @generated from field: string custom_admin_domain_cname_value = 6;
+   * @generated from field: string page_token = 2;
    */
-  customAdminDomainCnameValue = "";
+  pageToken = "";
 
-  constructor(data?: PartialMessage<GetEnvironmentCustomDomainSettingsResponse>) {
+  constructor(data?: PartialMessage<ListSAMLOAuthClientsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetEnvironmentCustomDomainSettingsResponse";
+  static readonly typeName = "ssoready.v1.ListSAMLOAuthClientsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "custom_auth_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "custom_auth_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "custom_auth_domain_cname_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 4, name: "custom_admin_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 5, name: "custom_admin_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 6, name: "custom_admin_domain_cname_value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnvironmentCustomDomainSettingsResponse {
-    return new GetEnvironmentCustomDomainSettingsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLOAuthClientsRequest {
+    return new ListSAMLOAuthClientsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsResponse {
-    return new GetEnvironmentCustomDomainSettingsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsRequest {
+    return new ListSAMLOAuthClientsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnvironmentCustomDomainSettingsResponse {
-    return new GetEnvironmentCustomDomainSettingsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsRequest {
+    return new ListSAMLOAuthClientsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetEnvironmentCustomDomainSettingsResponse | PlainMessage<GetEnvironmentCustomDomainSettingsResponse> | undefined, b: GetEnvironmentCustomDomainSettingsResponse | PlainMessage<GetEnvironmentCustomDomainSettingsResponse> | undefined): boolean {
-    return proto3.util.equals(GetEnvironmentCustomDomainSettingsResponse, a, b);
+  static equals(a: ListSAMLOAuthClientsRequest | PlainMessage<ListSAMLOAuthClientsRequest> | undefined, b: ListSAMLOAuthClientsRequest | PlainMessage<ListSAMLOAuthClientsRequest> | undefined): boolean {
+    return proto3.util.equals(ListSAMLOAuthClientsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateEnvironmentCustomDomainSettingsRequest
+ * @generated from message ssoready.v1.ListSAMLOAuthClientsResponse
  */
-export class UpdateEnvironmentCustomDomainSettingsRequest extends Message<UpdateEnvironmentCustomDomainSettingsRequest> {
-  /**
-   * @generated from field: string environment_id = 1;
-   */
-  environmentId = "";
-
+export class ListSAMLOAuthClientsResponse extends Message<ListSAMLOAuthClientsResponse> {
   /**
-   * @generated from field: string custom_auth_domain = 2;
+   * @generated from field: repeated ssoready.v1.SAMLOAuthClient saml_oauth_clients = 1;
    */
-  customAuthDomain = "";
+  samlOauthClients: SAMLOAuthClient[] = [];
 
   /**
-   * @generated from field: string custom_admin_domain = 3;
+   * @generated from field: string next_page_token = 2;
    */
-  customAdminDomain = "";
+  nextPageToken = "";
 
-  constructor(data?: PartialMessage<UpdateEnvironmentCustomDomainSettingsRequest>) {
+  constructor(data?: PartialMessage<ListSAMLOAuthClientsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateEnvironmentCustomDomainSettingsRequest";
+  static readonly typeName = "ssoready.v1.ListSAMLOAuthClientsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "custom_auth_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "custom_admin_domain", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_oauth_clients", kind: "message", T: SAMLOAuthClient, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnvironmentCustomDomainSettingsRequest {
-    return new UpdateEnvironmentCustomDomainSettingsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLOAuthClientsResponse {
+    return new ListSAMLOAuthClientsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsRequest {
-    return new UpdateEnvironmentCustomDomainSettingsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsResponse {
+    return new ListSAMLOAuthClientsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsRequest {
-    return new UpdateEnvironmentCustomDomainSettingsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsResponse {
+    return new ListSAMLOAuthClientsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateEnvironmentCustomDomainSettingsRequest | PlainMessage<UpdateEnvironmentCustomDomainSettingsRequest> | undefined, b: UpdateEnvironmentCustomDomainSettingsRequest | PlainMessage<UpdateEnvironmentCustomDomainSettingsRequest> | undefined): boolean {
-    return proto3.util.equals(UpdateEnvironmentCustomDomainSettingsRequest, a, b);
+  static equals(a: ListSAMLOAuthClientsResponse | PlainMessage<ListSAMLOAuthClientsResponse> | undefined, b: ListSAMLOAuthClientsResponse | PlainMessage<ListSAMLOAuthClientsResponse> | undefined): boolean {
+    return proto3.util.equals(ListSAMLOAuthClientsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.UpdateEnvironmentCustomDomainSettingsResponse
+ * @generated from message ssoready.v1.GetSAMLOAuthClientRequest
  */
-export class UpdateEnvironmentCustomDomainSettingsResponse extends Message<UpdateEnvironmentCustomDomainSettingsResponse> {
-  constructor(data?: PartialMessage<UpdateEnvironmentCustomDomainSettingsResponse>) {
+export class GetSAMLOAuthClientRequest extends Message<GetSAMLOAuthClientRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  constructor(data?: PartialMessage<GetSAMLOAuthClientRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.UpdateEnvironmentCustomDomainSettingsResponse";
+  static readonly typeName = "ssoready.v1.GetSAMLOAuthClientRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateEnvironmentCustomDomainSettingsResponse {
-    return new UpdateEnvironmentCustomDomainSettingsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLOAuthClientRequest {
+    return new GetSAMLOAuthClientRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsResponse {
-    return new UpdateEnvironmentCustomDomainSettingsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLOAuthClientRequest {
+    return new GetSAMLOAuthClientRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateEnvironmentCustomDomainSettingsResponse {
-    return new UpdateEnvironmentCustomDomainSettingsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLOAuthClientRequest {
+    return new GetSAMLOAuthClientRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: UpdateEnvironmentCustomDomainSettingsResponse | PlainMessage<UpdateEnvironmentCustomDomainSettingsResponse> | undefined, b: UpdateEnvironmentCustomDomainSettingsResponse | PlainMessage<UpdateEnvironmentCustomDomainSettingsResponse> | undefined): boolean {
-    return proto3.util.equals(UpdateEnvironmentCustomDomainSettingsResponse, a, b);
+  static equals(a: GetSAMLOAuthClientRequest | PlainMessage<GetSAMLOAuthClientRequest> | undefined, b: GetSAMLOAuthClientRequest | PlainMessage<GetSAMLOAuthClientRequest> | undefined): boolean {
+    return proto3.util.equals(GetSAMLOAuthClientRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesRequest
+ * @generated from message ssoready.v1.CreateSAMLOAuthClientRequest
  */
-export class CheckEnvironmentCustomDomainSettingsCertificatesRequest extends Message<CheckEnvironmentCustomDomainSettingsCertificatesRequest> {
+export class CreateSAMLOAuthClientRequest extends Message<CreateSAMLOAuthClientRequest> {
   /**
-   * @generated from field: string environment_id = 1;
+   * @generated from field: ssoready.v1.SAMLOAuthClient saml_oauth_client = 1;
    */
-  environmentId = "";
+  samlOauthClient?: SAMLOAuthClient;
 
-  constructor(data?: PartialMessage<CheckEnvironmentCustomDomainSettingsCertificatesRequest>) {
+  constructor(data?: PartialMessage<CreateSAMLOAuthClientRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesRequest";
+  static readonly typeName = "ssoready.v1.CreateSAMLOAuthClientRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_oauth_client", kind: "message", T: SAMLOAuthClient },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesRequest {
-    return new CheckEnvironmentCustomDomainSettingsCertificatesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSAMLOAuthClientRequest {
+    return new CreateSAMLOAuthClientRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesRequest {
-    return new CheckEnvironmentCustomDomainSettingsCertificatesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSAMLOAuthClientRequest {
+    return new CreateSAMLOAuthClientRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesRequest {
-    return new CheckEnvironmentCustomDomainSettingsCertificatesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSAMLOAuthClientRequest {
+    return new CreateSAMLOAuthClientRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CheckEnvironmentCustomDomainSettingsCertificatesRequest | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesRequest> | undefined, b: CheckEnvironmentCustomDomainSettingsCertificatesRequest | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesRequest> | undefined): boolean {
-    return proto3.util.equals(CheckEnvironmentCustomDomainSettingsCertificatesRequest, a, b);
+  static equals(a: CreateSAMLOAuthClientRequest | PlainMessage<CreateSAMLOAuthClientRequest> | undefined, b: CreateSAMLOAuthClientRequest | PlainMessage<CreateSAMLOAuthClientRequest> | undefined): boolean {
+    return proto3.util.equals(CreateSAMLOAuthClientRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesResponse
+ * @generated from message ssoready.v1.DeleteSAMLOAuthClientRequest
  */
-export class CheckEnvironmentCustomDomainSettingsCertificatesResponse extends Message<CheckEnvironmentCustomDomainSettingsCertificatesResponse> {
-  /**
-   * @generated from field: bool custom_auth_domain_configured = 1;
-   */
-  customAuthDomainConfigured = false;
-
+export class DeleteSAMLOAuthClientRequest extends Message<DeleteSAMLOAuthClientRequest> {
   /**
-   * @generated from field: bool custom_admin_domain_configured = 2;
+   * @generated from field: string id = 1;
    */
-  customAdminDomainConfigured = false;
+  id = "";
 
-  constructor(data?: PartialMessage<CheckEnvironmentCustomDomainSettingsCertificatesResponse>) {
+  constructor(data?: PartialMessage<DeleteSAMLOAuthClientRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CheckEnvironmentCustomDomainSettingsCertificatesResponse";
+  static readonly typeName = "ssoready.v1.DeleteSAMLOAuthClientRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "custom_auth_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 2, name: "custom_admin_domain_configured", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesResponse {
-    return new CheckEnvironmentCustomDomainSettingsCertificatesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSAMLOAuthClientRequest {
+    return new DeleteSAMLOAuthClientRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesResponse {
-    return new CheckEnvironmentCustomDomainSettingsCertificatesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSAMLOAuthClientRequest {
+    return new DeleteSAMLOAuthClientRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CheckEnvironmentCustomDomainSettingsCertificatesResponse {
-    return new CheckEnvironmentCustomDomainSettingsCertificatesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSAMLOAuthClientRequest {
+    return new DeleteSAMLOAuthClientRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CheckEnvironmentCustomDomainSettingsCertificatesResponse | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesResponse> | undefined, b: CheckEnvironmentCustomDomainSettingsCertificatesResponse | PlainMessage<CheckEnvironmentCustomDomainSettingsCertificatesResponse> | undefined): boolean {
-    return proto3.util.equals(CheckEnvironmentCustomDomainSettingsCertificatesResponse, a, b);
+  static equals(a: DeleteSAMLOAuthClientRequest | PlainMessage<DeleteSAMLOAuthClientRequest> | undefined, b: DeleteSAMLOAuthClientRequest | PlainMessage<DeleteSAMLOAuthClientRequest> | undefined): boolean {
+    return proto3.util.equals(DeleteSAMLOAuthClientRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListAPIKeysRequest
+ * @generated from message ssoready.v1.AppListOrganizationsRequest
  */
-export class ListAPIKeysRequest extends Message<ListAPIKeysRequest> {
+export class AppListOrganizationsRequest extends Message<AppListOrganizationsRequest> {
   /**
    * @generated from field: string environment_id = 1;
    */
@@ -3996,1177 +6278,1165 @@ export class ListAPIKeysRequest extends Message<ListAPIKeysRequest> {
    */
   pageToken = "";
 
-  constructor(data?: PartialMessage<ListAPIKeysRequest>) {
+  constructor(data?: PartialMessage<AppListOrganizationsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListAPIKeysRequest";
+  static readonly typeName = "ssoready.v1.AppListOrganizationsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPIKeysRequest {
-    return new ListAPIKeysRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListOrganizationsRequest {
+    return new AppListOrganizationsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPIKeysRequest {
-    return new ListAPIKeysRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListOrganizationsRequest {
+    return new AppListOrganizationsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPIKeysRequest {
-    return new ListAPIKeysRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListOrganizationsRequest {
+    return new AppListOrganizationsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListAPIKeysRequest | PlainMessage<ListAPIKeysRequest> | undefined, b: ListAPIKeysRequest | PlainMessage<ListAPIKeysRequest> | undefined): boolean {
-    return proto3.util.equals(ListAPIKeysRequest, a, b);
+  static equals(a: AppListOrganizationsRequest | PlainMessage<AppListOrganizationsRequest> | undefined, b: AppListOrganizationsRequest | PlainMessage<AppListOrganizationsRequest> | undefined): boolean {
+    return proto3.util.equals(AppListOrganizationsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListAPIKeysResponse
+ * @generated from message ssoready.v1.AppListOrganizationsResponse
  */
-export class ListAPIKeysResponse extends Message<ListAPIKeysResponse> {
+export class AppListOrganizationsResponse extends Message<AppListOrganizationsResponse> {
   /**
-   * @generated from field: repeated ssoready.v1.APIKey api_keys = 1;
+   * @generated from field: repeated ssoready.v1.Organization organizations = 1;
    */
-  apiKeys: APIKey[] = [];
+  organizations: Organization[] = [];
 
   /**
    * @generated from field: string next_page_token = 2;
    */
   nextPageToken = "";
 
-  constructor(data?: PartialMessage<ListAPIKeysResponse>) {
+  constructor(data?: PartialMessage<AppListOrganizationsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListAPIKeysResponse";
+  static readonly typeName = "ssoready.v1.AppListOrganizationsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_keys", kind: "message", T: APIKey, repeated: true },
+    { no: 1, name: "organizations", kind: "message", T: Organization, repeated: true },
     { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListAPIKeysResponse {
-    return new ListAPIKeysResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListOrganizationsResponse {
+    return new AppListOrganizationsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListAPIKeysResponse {
-    return new ListAPIKeysResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListOrganizationsResponse {
+    return new AppListOrganizationsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListAPIKeysResponse {
-    return new ListAPIKeysResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListOrganizationsResponse {
+    return new AppListOrganizationsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListAPIKeysResponse | PlainMessage<ListAPIKeysResponse> | undefined, b: ListAPIKeysResponse | PlainMessage<ListAPIKeysResponse> | undefined): boolean {
-    return proto3.util.equals(ListAPIKeysResponse, a, b);
+  static equals(a: AppListOrganizationsResponse | PlainMessage<AppListOrganizationsResponse> | undefined, b: AppListOrganizationsResponse | PlainMessage<AppListOrganizationsResponse> | undefined): boolean {
+    return proto3.util.equals(AppListOrganizationsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.GetAPIKeyRequest
+ * @generated from message ssoready.v1.AppGetOrganizationRequest
  */
-export class GetAPIKeyRequest extends Message<GetAPIKeyRequest> {
+export class AppGetOrganizationRequest extends Message<AppGetOrganizationRequest> {
   /**
    * @generated from field: string id = 1;
    */
   id = "";
 
-  constructor(data?: PartialMessage<GetAPIKeyRequest>) {
+  constructor(data?: PartialMessage<AppGetOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetAPIKeyRequest";
+  static readonly typeName = "ssoready.v1.AppGetOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetAPIKeyRequest {
-    return new GetAPIKeyRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetOrganizationRequest {
+    return new AppGetOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetAPIKeyRequest {
-    return new GetAPIKeyRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetOrganizationRequest {
+    return new AppGetOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetAPIKeyRequest {
-    return new GetAPIKeyRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetOrganizationRequest {
+    return new AppGetOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetAPIKeyRequest | PlainMessage<GetAPIKeyRequest> | undefined, b: GetAPIKeyRequest | PlainMessage<GetAPIKeyRequest> | undefined): boolean {
-    return proto3.util.equals(GetAPIKeyRequest, a, b);
+  static equals(a: AppGetOrganizationRequest | PlainMessage<AppGetOrganizationRequest> | undefined, b: AppGetOrganizationRequest | PlainMessage<AppGetOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(AppGetOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateAPIKeyRequest
+ * @generated from message ssoready.v1.AppCreateOrganizationRequest
  */
-export class CreateAPIKeyRequest extends Message<CreateAPIKeyRequest> {
+export class AppCreateOrganizationRequest extends Message<AppCreateOrganizationRequest> {
   /**
-   * @generated from field: ssoready.v1.APIKey api_key = 1;
+   * @generated from field: ssoready.v1.Organization organization = 1;
    */
-  apiKey?: APIKey;
+  organization?: Organization;
 
-  constructor(data?: PartialMessage<CreateAPIKeyRequest>) {
+  constructor(data?: PartialMessage<AppCreateOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateAPIKeyRequest";
+  static readonly typeName = "ssoready.v1.AppCreateOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "api_key", kind: "message", T: APIKey },
+    { no: 1, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateAPIKeyRequest {
-    return new CreateAPIKeyRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateOrganizationRequest {
+    return new AppCreateOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateAPIKeyRequest {
-    return new CreateAPIKeyRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateOrganizationRequest {
+    return new AppCreateOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateAPIKeyRequest {
-    return new CreateAPIKeyRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateOrganizationRequest {
+    return new AppCreateOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateAPIKeyRequest | PlainMessage<CreateAPIKeyRequest> | undefined, b: CreateAPIKeyRequest | PlainMessage<CreateAPIKeyRequest> | undefined): boolean {
-    return proto3.util.equals(CreateAPIKeyRequest, a, b);
+  static equals(a: AppCreateOrganizationRequest | PlainMessage<AppCreateOrganizationRequest> | undefined, b: AppCreateOrganizationRequest | PlainMessage<AppCreateOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(AppCreateOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.DeleteAPIKeyRequest
+ * @generated from message ssoready.v1.AppUpdateOrganizationRequest
  */
-export class DeleteAPIKeyRequest extends Message<DeleteAPIKeyRequest> {
+export class AppUpdateOrganizationRequest extends Message<AppUpdateOrganizationRequest> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: ssoready.v1.Organization organization = 1;
    */
-  id = "";
+  organization?: Organization;
 
-  constructor(data?: PartialMessage<DeleteAPIKeyRequest>) {
+  constructor(data?: PartialMessage<AppUpdateOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.DeleteAPIKeyRequest";
+  static readonly typeName = "ssoready.v1.AppUpdateOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization", kind: "message", T: Organization },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteAPIKeyRequest {
-    return new DeleteAPIKeyRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateOrganizationRequest {
+    return new AppUpdateOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteAPIKeyRequest {
-    return new DeleteAPIKeyRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateOrganizationRequest {
+    return new AppUpdateOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteAPIKeyRequest {
-    return new DeleteAPIKeyRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateOrganizationRequest {
+    return new AppUpdateOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteAPIKeyRequest | PlainMessage<DeleteAPIKeyRequest> | undefined, b: DeleteAPIKeyRequest | PlainMessage<DeleteAPIKeyRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteAPIKeyRequest, a, b);
+  static equals(a: AppUpdateOrganizationRequest | PlainMessage<AppUpdateOrganizationRequest> | undefined, b: AppUpdateOrganizationRequest | PlainMessage<AppUpdateOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(AppUpdateOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSAMLOAuthClientsRequest
+ * @generated from message ssoready.v1.AppDeleteOrganizationRequest
  */
-export class ListSAMLOAuthClientsRequest extends Message<ListSAMLOAuthClientsRequest> {
-  /**
-   * @generated from field: string environment_id = 1;
-   */
-  environmentId = "";
-
+export class AppDeleteOrganizationRequest extends Message<AppDeleteOrganizationRequest> {
   /**
-   * @generated from field: string page_token = 2;
+   * @generated from field: string organization_id = 1;
    */
-  pageToken = "";
+  organizationId = "";
 
-  constructor(data?: PartialMessage<ListSAMLOAuthClientsRequest>) {
+  constructor(data?: PartialMessage<AppDeleteOrganizationRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSAMLOAuthClientsRequest";
+  static readonly typeName = "ssoready.v1.AppDeleteOrganizationRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLOAuthClientsRequest {
-    return new ListSAMLOAuthClientsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppDeleteOrganizationRequest {
+    return new AppDeleteOrganizationRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsRequest {
-    return new ListSAMLOAuthClientsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppDeleteOrganizationRequest {
+    return new AppDeleteOrganizationRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsRequest {
-    return new ListSAMLOAuthClientsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppDeleteOrganizationRequest {
+    return new AppDeleteOrganizationRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ListSAMLOAuthClientsRequest | PlainMessage<ListSAMLOAuthClientsRequest> | undefined, b: ListSAMLOAuthClientsRequest | PlainMessage<ListSAMLOAuthClientsRequest> | undefined): boolean {
-    return proto3.util.equals(ListSAMLOAuthClientsRequest, a, b);
+  static equals(a: AppDeleteOrganizationRequest | PlainMessage<AppDeleteOrganizationRequest> | undefined, b: AppDeleteOrganizationRequest | PlainMessage<AppDeleteOrganizationRequest> | undefined): boolean {
+    return proto3.util.equals(AppDeleteOrganizationRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.ListSAMLOAuthClientsResponse
+ * @generated from message ssoready.v1.AppGetAdminSettingsRequest
  */
-export class ListSAMLOAuthClientsResponse extends Message<ListSAMLOAuthClientsResponse> {
-  /**
-   * @generated from field: repeated ssoready.v1.SAMLOAuthClient saml_oauth_clients = 1;
-   */
-  samlOauthClients: SAMLOAuthClient[] = [];
-
+export class AppGetAdminSettingsRequest extends Message<AppGetAdminSettingsRequest> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string environment_id = 1;
    */
-  nextPageToken = "";
+  environmentId = "";
 
-  constructor(data?: PartialMessage<ListSAMLOAuthClientsResponse>) {
+  constructor(data?: PartialMessage<AppGetAdminSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.ListSAMLOAuthClientsResponse";
+  static readonly typeName = "ssoready.v1.AppGetAdminSettingsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_oauth_clients", kind: "message", T: SAMLOAuthClient, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListSAMLOAuthClientsResponse {
-    return new ListSAMLOAuthClientsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetAdminSettingsRequest {
+    return new AppGetAdminSettingsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsResponse {
-    return new ListSAMLOAuthClientsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetAdminSettingsRequest {
+    return new AppGetAdminSettingsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListSAMLOAuthClientsResponse {
-    return new ListSAMLOAuthClientsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetAdminSettingsRequest {
+    return new AppGetAdminSettingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AppGetAdminSettingsRequest | PlainMessage<AppGetAdminSettingsRequest> | undefined, b: AppGetAdminSettingsRequest | PlainMessage<AppGetAdminSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(AppGetAdminSettingsRequest, a, b);
   }
+}
+
+/**
+ * @generated from message ssoready.v1.AppGetAdminSettingsResponse
+ */
+export class AppGetAdminSettingsResponse extends Message<AppGetAdminSettingsResponse> {
+  /**
+   * @generated from field: string admin_application_name = 1;
+   */
+  adminApplicationName = "";
 
-  static equals(a: ListSAMLOAuthClientsResponse | PlainMessage<ListSAMLOAuthClientsResponse> | undefined, b: ListSAMLOAuthClientsResponse | PlainMessage<ListSAMLOAuthClientsResponse> | undefined): boolean {
-    return proto3.util.equals(ListSAMLOAuthClientsResponse, a, b);
-  }
-}
+  /**
+   * @generated from field: string admin_return_url = 2;
+   */
+  adminReturnUrl = "";
 
-/**
- * @generated from message ssoready.v1.GetSAMLOAuthClientRequest
- */
-export class GetSAMLOAuthClientRequest extends Message<GetSAMLOAuthClientRequest> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string admin_logo_url = 3;
    */
-  id = "";
+  adminLogoUrl = "";
 
-  constructor(data?: PartialMessage<GetSAMLOAuthClientRequest>) {
+  constructor(data?: PartialMessage<AppGetAdminSettingsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.GetSAMLOAuthClientRequest";
+  static readonly typeName = "ssoready.v1.AppGetAdminSettingsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "admin_application_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "admin_return_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "admin_logo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetSAMLOAuthClientRequest {
-    return new GetSAMLOAuthClientRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetAdminSettingsResponse {
+    return new AppGetAdminSettingsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetSAMLOAuthClientRequest {
-    return new GetSAMLOAuthClientRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetAdminSettingsResponse {
+    return new AppGetAdminSettingsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetSAMLOAuthClientRequest {
-    return new GetSAMLOAuthClientRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetAdminSettingsResponse {
+    return new AppGetAdminSettingsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: GetSAMLOAuthClientRequest | PlainMessage<GetSAMLOAuthClientRequest> | undefined, b: GetSAMLOAuthClientRequest | PlainMessage<GetSAMLOAuthClientRequest> | undefined): boolean {
-    return proto3.util.equals(GetSAMLOAuthClientRequest, a, b);
+  static equals(a: AppGetAdminSettingsResponse | PlainMessage<AppGetAdminSettingsResponse> | undefined, b: AppGetAdminSettingsResponse | PlainMessage<AppGetAdminSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(AppGetAdminSettingsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.CreateSAMLOAuthClientRequest
+ * @generated from message ssoready.v1.AppUpdateAdminSettingsRequest
  */
-export class CreateSAMLOAuthClientRequest extends Message<CreateSAMLOAuthClientRequest> {
+export class AppUpdateAdminSettingsRequest extends Message<AppUpdateAdminSettingsRequest> {
   /**
-   * @generated from field: ssoready.v1.SAMLOAuthClient saml_oauth_client = 1;
+   * @generated from field: string environment_id = 1;
    */
-  samlOauthClient?: SAMLOAuthClient;
+  environmentId = "";
 
-  constructor(data?: PartialMessage<CreateSAMLOAuthClientRequest>) {
+  /**
+   * @generated from field: string admin_application_name = 2;
+   */
+  adminApplicationName = "";
+
+  /**
+   * @generated from field: string admin_return_url = 3;
+   */
+  adminReturnUrl = "";
+
+  constructor(data?: PartialMessage<AppUpdateAdminSettingsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.CreateSAMLOAuthClientRequest";
+  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_oauth_client", kind: "message", T: SAMLOAuthClient },
+    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "admin_application_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "admin_return_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateSAMLOAuthClientRequest {
-    return new CreateSAMLOAuthClientRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsRequest {
+    return new AppUpdateAdminSettingsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateSAMLOAuthClientRequest {
-    return new CreateSAMLOAuthClientRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsRequest {
+    return new AppUpdateAdminSettingsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateSAMLOAuthClientRequest {
-    return new CreateSAMLOAuthClientRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsRequest {
+    return new AppUpdateAdminSettingsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateSAMLOAuthClientRequest | PlainMessage<CreateSAMLOAuthClientRequest> | undefined, b: CreateSAMLOAuthClientRequest | PlainMessage<CreateSAMLOAuthClientRequest> | undefined): boolean {
-    return proto3.util.equals(CreateSAMLOAuthClientRequest, a, b);
+  static equals(a: AppUpdateAdminSettingsRequest | PlainMessage<AppUpdateAdminSettingsRequest> | undefined, b: AppUpdateAdminSettingsRequest | PlainMessage<AppUpdateAdminSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(AppUpdateAdminSettingsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.DeleteSAMLOAuthClientRequest
+ * @generated from message ssoready.v1.AppUpdateAdminSettingsResponse
  */
-export class DeleteSAMLOAuthClientRequest extends Message<DeleteSAMLOAuthClientRequest> {
-  /**
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
-  constructor(data?: PartialMessage<DeleteSAMLOAuthClientRequest>) {
+export class AppUpdateAdminSettingsResponse extends Message<AppUpdateAdminSettingsResponse> {
+  constructor(data?: PartialMessage<AppUpdateAdminSettingsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.DeleteSAMLOAuthClientRequest";
+  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DeleteSAMLOAuthClientRequest {
-    return new DeleteSAMLOAuthClientRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsResponse {
+    return new AppUpdateAdminSettingsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DeleteSAMLOAuthClientRequest {
-    return new DeleteSAMLOAuthClientRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsResponse {
+    return new AppUpdateAdminSettingsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DeleteSAMLOAuthClientRequest {
-    return new DeleteSAMLOAuthClientRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsResponse {
+    return new AppUpdateAdminSettingsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: DeleteSAMLOAuthClientRequest | PlainMessage<DeleteSAMLOAuthClientRequest> | undefined, b: DeleteSAMLOAuthClientRequest | PlainMessage<DeleteSAMLOAuthClientRequest> | undefined): boolean {
-    return proto3.util.equals(DeleteSAMLOAuthClientRequest, a, b);
+  static equals(a: AppUpdateAdminSettingsResponse | PlainMessage<AppUpdateAdminSettingsResponse> | undefined, b: AppUpdateAdminSettingsResponse | PlainMessage<AppUpdateAdminSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(AppUpdateAdminSettingsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppListOrganizationsRequest
+ * @generated from message ssoready.v1.AppUpdateAdminSettingsLogoRequest
  */
-export class AppListOrganizationsRequest extends Message<AppListOrganizationsRequest> {
+export class AppUpdateAdminSettingsLogoRequest extends Message<AppUpdateAdminSettingsLogoRequest> {
   /**
    * @generated from field: string environment_id = 1;
    */
   environmentId = "";
 
-  /**
-   * @generated from field: string page_token = 2;
-   */
-  pageToken = "";
-
-  constructor(data?: PartialMessage<AppListOrganizationsRequest>) {
+  constructor(data?: PartialMessage<AppUpdateAdminSettingsLogoRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppListOrganizationsRequest";
+  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsLogoRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListOrganizationsRequest {
-    return new AppListOrganizationsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsLogoRequest {
+    return new AppUpdateAdminSettingsLogoRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListOrganizationsRequest {
-    return new AppListOrganizationsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoRequest {
+    return new AppUpdateAdminSettingsLogoRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListOrganizationsRequest {
-    return new AppListOrganizationsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoRequest {
+    return new AppUpdateAdminSettingsLogoRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppListOrganizationsRequest | PlainMessage<AppListOrganizationsRequest> | undefined, b: AppListOrganizationsRequest | PlainMessage<AppListOrganizationsRequest> | undefined): boolean {
-    return proto3.util.equals(AppListOrganizationsRequest, a, b);
+  static equals(a: AppUpdateAdminSettingsLogoRequest | PlainMessage<AppUpdateAdminSettingsLogoRequest> | undefined, b: AppUpdateAdminSettingsLogoRequest | PlainMessage<AppUpdateAdminSettingsLogoRequest> | undefined): boolean {
+    return proto3.util.equals(AppUpdateAdminSettingsLogoRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppListOrganizationsResponse
+ * @generated from message ssoready.v1.AppUpdateAdminSettingsLogoResponse
  */
-export class AppListOrganizationsResponse extends Message<AppListOrganizationsResponse> {
-  /**
-   * @generated from field: repeated ssoready.v1.Organization organizations = 1;
-   */
-  organizations: Organization[] = [];
-
+export class AppUpdateAdminSettingsLogoResponse extends Message<AppUpdateAdminSettingsLogoResponse> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: string upload_url = 1;
    */
-  nextPageToken = "";
+  uploadUrl = "";
 
-  constructor(data?: PartialMessage<AppListOrganizationsResponse>) {
+  constructor(data?: PartialMessage<AppUpdateAdminSettingsLogoResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppListOrganizationsResponse";
+  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsLogoResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organizations", kind: "message", T: Organization, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "upload_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListOrganizationsResponse {
-    return new AppListOrganizationsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsLogoResponse {
+    return new AppUpdateAdminSettingsLogoResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListOrganizationsResponse {
-    return new AppListOrganizationsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoResponse {
+    return new AppUpdateAdminSettingsLogoResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListOrganizationsResponse {
-    return new AppListOrganizationsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoResponse {
+    return new AppUpdateAdminSettingsLogoResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppListOrganizationsResponse | PlainMessage<AppListOrganizationsResponse> | undefined, b: AppListOrganizationsResponse | PlainMessage<AppListOrganizationsResponse> | undefined): boolean {
-    return proto3.util.equals(AppListOrganizationsResponse, a, b);
+  static equals(a: AppUpdateAdminSettingsLogoResponse | PlainMessage<AppUpdateAdminSettingsLogoResponse> | undefined, b: AppUpdateAdminSettingsLogoResponse | PlainMessage<AppUpdateAdminSettingsLogoResponse> | undefined): boolean {
+    return proto3.util.equals(AppUpdateAdminSettingsLogoResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppGetOrganizationRequest
+ * @generated from message ssoready.v1.AppCreateAdminSetupURLRequest
  */
-export class AppGetOrganizationRequest extends Message<AppGetOrganizationRequest> {
+export class AppCreateAdminSetupURLRequest extends Message<AppCreateAdminSetupURLRequest> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string organization_id = 1;
    */
-  id = "";
+  organizationId = "";
 
-  constructor(data?: PartialMessage<AppGetOrganizationRequest>) {
+  /**
+   * @generated from field: bool can_manage_saml = 2;
+   */
+  canManageSaml = false;
+
+  /**
+   * @generated from field: bool can_manage_scim = 3;
+   */
+  canManageScim = false;
+
+  constructor(data?: PartialMessage<AppCreateAdminSetupURLRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppGetOrganizationRequest";
+  static readonly typeName = "ssoready.v1.AppCreateAdminSetupURLRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "can_manage_saml", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "can_manage_scim", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetOrganizationRequest {
-    return new AppGetOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateAdminSetupURLRequest {
+    return new AppCreateAdminSetupURLRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetOrganizationRequest {
-    return new AppGetOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLRequest {
+    return new AppCreateAdminSetupURLRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetOrganizationRequest {
-    return new AppGetOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLRequest {
+    return new AppCreateAdminSetupURLRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppGetOrganizationRequest | PlainMessage<AppGetOrganizationRequest> | undefined, b: AppGetOrganizationRequest | PlainMessage<AppGetOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(AppGetOrganizationRequest, a, b);
+  static equals(a: AppCreateAdminSetupURLRequest | PlainMessage<AppCreateAdminSetupURLRequest> | undefined, b: AppCreateAdminSetupURLRequest | PlainMessage<AppCreateAdminSetupURLRequest> | undefined): boolean {
+    return proto3.util.equals(AppCreateAdminSetupURLRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppCreateOrganizationRequest
+ * @generated from message ssoready.v1.AppCreateAdminSetupURLResponse
  */
-export class AppCreateOrganizationRequest extends Message<AppCreateOrganizationRequest> {
+export class AppCreateAdminSetupURLResponse extends Message<AppCreateAdminSetupURLResponse> {
   /**
-   * @generated from field: ssoready.v1.Organization organization = 1;
+   * @generated from field: string url = 1;
    */
-  organization?: Organization;
+  url = "";
 
-  constructor(data?: PartialMessage<AppCreateOrganizationRequest>) {
+  constructor(data?: PartialMessage<AppCreateAdminSetupURLResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppCreateOrganizationRequest";
+  static readonly typeName = "ssoready.v1.AppCreateAdminSetupURLResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
+    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateOrganizationRequest {
-    return new AppCreateOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateAdminSetupURLResponse {
+    return new AppCreateAdminSetupURLResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateOrganizationRequest {
-    return new AppCreateOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLResponse {
+    return new AppCreateAdminSetupURLResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateOrganizationRequest {
-    return new AppCreateOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLResponse {
+    return new AppCreateAdminSetupURLResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppCreateOrganizationRequest | PlainMessage<AppCreateOrganizationRequest> | undefined, b: AppCreateOrganizationRequest | PlainMessage<AppCreateOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(AppCreateOrganizationRequest, a, b);
+  static equals(a: AppCreateAdminSetupURLResponse | PlainMessage<AppCreateAdminSetupURLResponse> | undefined, b: AppCreateAdminSetupURLResponse | PlainMessage<AppCreateAdminSetupURLResponse> | undefined): boolean {
+    return proto3.util.equals(AppCreateAdminSetupURLResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppUpdateOrganizationRequest
+ * @generated from message ssoready.v1.AppListSAMLConnectionsRequest
  */
-export class AppUpdateOrganizationRequest extends Message<AppUpdateOrganizationRequest> {
+export class AppListSAMLConnectionsRequest extends Message<AppListSAMLConnectionsRequest> {
+  /**
+   * @generated from field: string organization_id = 1;
+   */
+  organizationId = "";
+
   /**
-   * @generated from field: ssoready.v1.Organization organization = 1;
+   * @generated from field: string page_token = 2;
    */
-  organization?: Organization;
+  pageToken = "";
 
-  constructor(data?: PartialMessage<AppUpdateOrganizationRequest>) {
+  constructor(data?: PartialMessage<AppListSAMLConnectionsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppUpdateOrganizationRequest";
+  static readonly typeName = "ssoready.v1.AppListSAMLConnectionsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization", kind: "message", T: Organization },
+    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateOrganizationRequest {
-    return new AppUpdateOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionsRequest {
+    return new AppListSAMLConnectionsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateOrganizationRequest {
-    return new AppUpdateOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsRequest {
+    return new AppListSAMLConnectionsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateOrganizationRequest {
-    return new AppUpdateOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsRequest {
+    return new AppListSAMLConnectionsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppUpdateOrganizationRequest | PlainMessage<AppUpdateOrganizationRequest> | undefined, b: AppUpdateOrganizationRequest | PlainMessage<AppUpdateOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(AppUpdateOrganizationRequest, a, b);
+  static equals(a: AppListSAMLConnectionsRequest | PlainMessage<AppListSAMLConnectionsRequest> | undefined, b: AppListSAMLConnectionsRequest | PlainMessage<AppListSAMLConnectionsRequest> | undefined): boolean {
+    return proto3.util.equals(AppListSAMLConnectionsRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppDeleteOrganizationRequest
+ * @generated from message ssoready.v1.AppListSAMLConnectionsResponse
  */
-export class AppDeleteOrganizationRequest extends Message<AppDeleteOrganizationRequest> {
+export class AppListSAMLConnectionsResponse extends Message<AppListSAMLConnectionsResponse> {
   /**
-   * @generated from field: string organization_id = 1;
+   * @generated from field: repeated ssoready.v1.SAMLConnection saml_connections = 1;
    */
-  organizationId = "";
+  samlConnections: SAMLConnection[] = [];
 
-  constructor(data?: PartialMessage<AppDeleteOrganizationRequest>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<AppListSAMLConnectionsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppDeleteOrganizationRequest";
+  static readonly typeName = "ssoready.v1.AppListSAMLConnectionsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connections", kind: "message", T: SAMLConnection, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppDeleteOrganizationRequest {
-    return new AppDeleteOrganizationRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionsResponse {
+    return new AppListSAMLConnectionsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppDeleteOrganizationRequest {
-    return new AppDeleteOrganizationRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsResponse {
+    return new AppListSAMLConnectionsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppDeleteOrganizationRequest {
-    return new AppDeleteOrganizationRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsResponse {
+    return new AppListSAMLConnectionsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppDeleteOrganizationRequest | PlainMessage<AppDeleteOrganizationRequest> | undefined, b: AppDeleteOrganizationRequest | PlainMessage<AppDeleteOrganizationRequest> | undefined): boolean {
-    return proto3.util.equals(AppDeleteOrganizationRequest, a, b);
+  static equals(a: AppListSAMLConnectionsResponse | PlainMessage<AppListSAMLConnectionsResponse> | undefined, b: AppListSAMLConnectionsResponse | PlainMessage<AppListSAMLConnectionsResponse> | undefined): boolean {
+    return proto3.util.equals(AppListSAMLConnectionsResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppGetAdminSettingsRequest
+ * @generated from message ssoready.v1.AppGetSAMLConnectionRequest
  */
-export class AppGetAdminSettingsRequest extends Message<AppGetAdminSettingsRequest> {
+export class AppGetSAMLConnectionRequest extends Message<AppGetSAMLConnectionRequest> {
   /**
-   * @generated from field: string environment_id = 1;
+   * @generated from field: string id = 1;
    */
-  environmentId = "";
+  id = "";
 
-  constructor(data?: PartialMessage<AppGetAdminSettingsRequest>) {
+  constructor(data?: PartialMessage<AppGetSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppGetAdminSettingsRequest";
+  static readonly typeName = "ssoready.v1.AppGetSAMLConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetAdminSettingsRequest {
-    return new AppGetAdminSettingsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetSAMLConnectionRequest {
+    return new AppGetSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetAdminSettingsRequest {
-    return new AppGetAdminSettingsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionRequest {
+    return new AppGetSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetAdminSettingsRequest {
-    return new AppGetAdminSettingsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionRequest {
+    return new AppGetSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppGetAdminSettingsRequest | PlainMessage<AppGetAdminSettingsRequest> | undefined, b: AppGetAdminSettingsRequest | PlainMessage<AppGetAdminSettingsRequest> | undefined): boolean {
-    return proto3.util.equals(AppGetAdminSettingsRequest, a, b);
+  static equals(a: AppGetSAMLConnectionRequest | PlainMessage<AppGetSAMLConnectionRequest> | undefined, b: AppGetSAMLConnectionRequest | PlainMessage<AppGetSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(AppGetSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppGetAdminSettingsResponse
+ * @generated from message ssoready.v1.AppCreateSAMLConnectionRequest
  */
-export class AppGetAdminSettingsResponse extends Message<AppGetAdminSettingsResponse> {
-  /**
-   * @generated from field: string admin_application_name = 1;
-   */
-  adminApplicationName = "";
-
-  /**
-   * @generated from field: string admin_return_url = 2;
-   */
-  adminReturnUrl = "";
-
+export class AppCreateSAMLConnectionRequest extends Message<AppCreateSAMLConnectionRequest> {
   /**
-   * @generated from field: string admin_logo_url = 3;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  adminLogoUrl = "";
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<AppGetAdminSettingsResponse>) {
+  constructor(data?: PartialMessage<AppCreateSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppGetAdminSettingsResponse";
+  static readonly typeName = "ssoready.v1.AppCreateSAMLConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "admin_application_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "admin_return_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "admin_logo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetAdminSettingsResponse {
-    return new AppGetAdminSettingsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateSAMLConnectionRequest {
+    return new AppCreateSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetAdminSettingsResponse {
-    return new AppGetAdminSettingsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateSAMLConnectionRequest {
+    return new AppCreateSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetAdminSettingsResponse {
-    return new AppGetAdminSettingsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateSAMLConnectionRequest {
+    return new AppCreateSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppGetAdminSettingsResponse | PlainMessage<AppGetAdminSettingsResponse> | undefined, b: AppGetAdminSettingsResponse | PlainMessage<AppGetAdminSettingsResponse> | undefined): boolean {
-    return proto3.util.equals(AppGetAdminSettingsResponse, a, b);
+  static equals(a: AppCreateSAMLConnectionRequest | PlainMessage<AppCreateSAMLConnectionRequest> | undefined, b: AppCreateSAMLConnectionRequest | PlainMessage<AppCreateSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(AppCreateSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppUpdateAdminSettingsRequest
+ * @generated from message ssoready.v1.AppUpdateSAMLConnectionRequest
  */
-export class AppUpdateAdminSettingsRequest extends Message<AppUpdateAdminSettingsRequest> {
-  /**
-   * @generated from field: string environment_id = 1;
-   */
-  environmentId = "";
-
-  /**
-   * @generated from field: string admin_application_name = 2;
-   */
-  adminApplicationName = "";
-
+export class AppUpdateSAMLConnectionRequest extends Message<AppUpdateSAMLConnectionRequest> {
   /**
-   * @generated from field: string admin_return_url = 3;
+   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
    */
-  adminReturnUrl = "";
+  samlConnection?: SAMLConnection;
 
-  constructor(data?: PartialMessage<AppUpdateAdminSettingsRequest>) {
+  constructor(data?: PartialMessage<AppUpdateSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsRequest";
+  static readonly typeName = "ssoready.v1.AppUpdateSAMLConnectionRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "admin_application_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 3, name: "admin_return_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsRequest {
-    return new AppUpdateAdminSettingsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateSAMLConnectionRequest {
+    return new AppUpdateSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsRequest {
-    return new AppUpdateAdminSettingsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateSAMLConnectionRequest {
+    return new AppUpdateSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsRequest {
-    return new AppUpdateAdminSettingsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateSAMLConnectionRequest {
+    return new AppUpdateSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppUpdateAdminSettingsRequest | PlainMessage<AppUpdateAdminSettingsRequest> | undefined, b: AppUpdateAdminSettingsRequest | PlainMessage<AppUpdateAdminSettingsRequest> | undefined): boolean {
-    return proto3.util.equals(AppUpdateAdminSettingsRequest, a, b);
+  static equals(a: AppUpdateSAMLConnectionRequest | PlainMessage<AppUpdateSAMLConnectionRequest> | undefined, b: AppUpdateSAMLConnectionRequest | PlainMessage<AppUpdateSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(AppUpdateSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppUpdateAdminSettingsResponse
+ * @generated from message ssoready.v1.AppRotateSAMLConnectionSPCertificateRequest
  */
-export class AppUpdateAdminSettingsResponse extends Message<AppUpdateAdminSettingsResponse> {
-  constructor(data?: PartialMessage<AppUpdateAdminSettingsResponse>) {
+export class AppRotateSAMLConnectionSPCertificateRequest extends Message<AppRotateSAMLConnectionSPCertificateRequest> {
+  /**
+   * @generated from field: string saml_connection_id = 1;
+   */
+  samlConnectionId = "";
+
+  constructor(data?: PartialMessage<AppRotateSAMLConnectionSPCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsResponse";
+  static readonly typeName = "ssoready.v1.AppRotateSAMLConnectionSPCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsResponse {
-    return new AppUpdateAdminSettingsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppRotateSAMLConnectionSPCertificateRequest {
+    return new AppRotateSAMLConnectionSPCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsResponse {
-    return new AppUpdateAdminSettingsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppRotateSAMLConnectionSPCertificateRequest {
+    return new AppRotateSAMLConnectionSPCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsResponse {
-    return new AppUpdateAdminSettingsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppRotateSAMLConnectionSPCertificateRequest {
+    return new AppRotateSAMLConnectionSPCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppUpdateAdminSettingsResponse | PlainMessage<AppUpdateAdminSettingsResponse> | undefined, b: AppUpdateAdminSettingsResponse | PlainMessage<AppUpdateAdminSettingsResponse> | undefined): boolean {
-    return proto3.util.equals(AppUpdateAdminSettingsResponse, a, b);
+  static equals(a: AppRotateSAMLConnectionSPCertificateRequest | PlainMessage<AppRotateSAMLConnectionSPCertificateRequest> | undefined, b: AppRotateSAMLConnectionSPCertificateRequest | PlainMessage<AppRotateSAMLConnectionSPCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(AppRotateSAMLConnectionSPCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppUpdateAdminSettingsLogoRequest
+ * @generated from message ssoready.v1.AppGetSAMLConnectionSPSigningCertificateRequest
  */
-export class AppUpdateAdminSettingsLogoRequest extends Message<AppUpdateAdminSettingsLogoRequest> {
+export class AppGetSAMLConnectionSPSigningCertificateRequest extends Message<AppGetSAMLConnectionSPSigningCertificateRequest> {
   /**
-   * @generated from field: string environment_id = 1;
+   * @generated from field: string saml_connection_id = 1;
    */
-  environmentId = "";
+  samlConnectionId = "";
 
-  constructor(data?: PartialMessage<AppUpdateAdminSettingsLogoRequest>) {
+  constructor(data?: PartialMessage<AppGetSAMLConnectionSPSigningCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsLogoRequest";
+  static readonly typeName = "ssoready.v1.AppGetSAMLConnectionSPSigningCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "environment_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsLogoRequest {
-    return new AppUpdateAdminSettingsLogoRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetSAMLConnectionSPSigningCertificateRequest {
+    return new AppGetSAMLConnectionSPSigningCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoRequest {
-    return new AppUpdateAdminSettingsLogoRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionSPSigningCertificateRequest {
+    return new AppGetSAMLConnectionSPSigningCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoRequest {
-    return new AppUpdateAdminSettingsLogoRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionSPSigningCertificateRequest {
+    return new AppGetSAMLConnectionSPSigningCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppUpdateAdminSettingsLogoRequest | PlainMessage<AppUpdateAdminSettingsLogoRequest> | undefined, b: AppUpdateAdminSettingsLogoRequest | PlainMessage<AppUpdateAdminSettingsLogoRequest> | undefined): boolean {
-    return proto3.util.equals(AppUpdateAdminSettingsLogoRequest, a, b);
+  static equals(a: AppGetSAMLConnectionSPSigningCertificateRequest | PlainMessage<AppGetSAMLConnectionSPSigningCertificateRequest> | undefined, b: AppGetSAMLConnectionSPSigningCertificateRequest | PlainMessage<AppGetSAMLConnectionSPSigningCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(AppGetSAMLConnectionSPSigningCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppUpdateAdminSettingsLogoResponse
+ * @generated from message ssoready.v1.AppGetSAMLConnectionSPSigningCertificateResponse
  */
-export class AppUpdateAdminSettingsLogoResponse extends Message<AppUpdateAdminSettingsLogoResponse> {
+export class AppGetSAMLConnectionSPSigningCertificateResponse extends Message<AppGetSAMLConnectionSPSigningCertificateResponse> {
   /**
-   * @generated from field: string upload_url = 1;
+   * @generated from field: string certificate = 1;
    */
-  uploadUrl = "";
+  certificate = "";
 
-  constructor(data?: PartialMessage<AppUpdateAdminSettingsLogoResponse>) {
+  constructor(data?: PartialMessage<AppGetSAMLConnectionSPSigningCertificateResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppUpdateAdminSettingsLogoResponse";
+  static readonly typeName = "ssoready.v1.AppGetSAMLConnectionSPSigningCertificateResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "upload_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateAdminSettingsLogoResponse {
-    return new AppUpdateAdminSettingsLogoResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetSAMLConnectionSPSigningCertificateResponse {
+    return new AppGetSAMLConnectionSPSigningCertificateResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoResponse {
-    return new AppUpdateAdminSettingsLogoResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionSPSigningCertificateResponse {
+    return new AppGetSAMLConnectionSPSigningCertificateResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateAdminSettingsLogoResponse {
-    return new AppUpdateAdminSettingsLogoResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionSPSigningCertificateResponse {
+    return new AppGetSAMLConnectionSPSigningCertificateResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppUpdateAdminSettingsLogoResponse | PlainMessage<AppUpdateAdminSettingsLogoResponse> | undefined, b: AppUpdateAdminSettingsLogoResponse | PlainMessage<AppUpdateAdminSettingsLogoResponse> | undefined): boolean {
-    return proto3.util.equals(AppUpdateAdminSettingsLogoResponse, a, b);
+  static equals(a: AppGetSAMLConnectionSPSigningCertificateResponse | PlainMessage<AppGetSAMLConnectionSPSigningCertificateResponse> | undefined, b: AppGetSAMLConnectionSPSigningCertificateResponse | PlainMessage<AppGetSAMLConnectionSPSigningCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(AppGetSAMLConnectionSPSigningCertificateResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppCreateAdminSetupURLRequest
+ * @generated from message ssoready.v1.AppDeleteSAMLConnectionRequest
  */
-export class AppCreateAdminSetupURLRequest extends Message<AppCreateAdminSetupURLRequest> {
-  /**
-   * @generated from field: string organization_id = 1;
-   */
-  organizationId = "";
-
-  /**
-   * @generated from field: bool can_manage_saml = 2;
-   */
-  canManageSaml = false;
-
+export class AppDeleteSAMLConnectionRequest extends Message<AppDeleteSAMLConnectionRequest> {
   /**
-   * @generated from field: bool can_manage_scim = 3;
+   * @generated from field: string saml_connection_id = 1;
    */
-  canManageScim = false;
+  samlConnectionId = "";
 
-  constructor(data?: PartialMessage<AppCreateAdminSetupURLRequest>) {
+  constructor(data?: PartialMessage<AppDeleteSAMLConnectionRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppCreateAdminSetupURLRequest";
-  static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "can_manage_saml", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
-    { no: 3, name: "can_manage_scim", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  static readonly typeName = "ssoready.v1.AppDeleteSAMLConnectionRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateAdminSetupURLRequest {
-    return new AppCreateAdminSetupURLRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppDeleteSAMLConnectionRequest {
+    return new AppDeleteSAMLConnectionRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLRequest {
-    return new AppCreateAdminSetupURLRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppDeleteSAMLConnectionRequest {
+    return new AppDeleteSAMLConnectionRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLRequest {
-    return new AppCreateAdminSetupURLRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppDeleteSAMLConnectionRequest {
+    return new AppDeleteSAMLConnectionRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppCreateAdminSetupURLRequest | PlainMessage<AppCreateAdminSetupURLRequest> | undefined, b: AppCreateAdminSetupURLRequest | PlainMessage<AppCreateAdminSetupURLRequest> | undefined): boolean {
-    return proto3.util.equals(AppCreateAdminSetupURLRequest, a, b);
+  static equals(a: AppDeleteSAMLConnectionRequest | PlainMessage<AppDeleteSAMLConnectionRequest> | undefined, b: AppDeleteSAMLConnectionRequest | PlainMessage<AppDeleteSAMLConnectionRequest> | undefined): boolean {
+    return proto3.util.equals(AppDeleteSAMLConnectionRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppCreateAdminSetupURLResponse
+ * @generated from message ssoready.v1.AppListSAMLConnectionIDPCertificatesRequest
  */
-export class AppCreateAdminSetupURLResponse extends Message<AppCreateAdminSetupURLResponse> {
+export class AppListSAMLConnectionIDPCertificatesRequest extends Message<AppListSAMLConnectionIDPCertificatesRequest> {
   /**
-   * @generated from field: string url = 1;
+   * @generated from field: string saml_connection_id = 1;
    */
-  url = "";
+  samlConnectionId = "";
 
-  constructor(data?: PartialMessage<AppCreateAdminSetupURLResponse>) {
+  constructor(data?: PartialMessage<AppListSAMLConnectionIDPCertificatesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppCreateAdminSetupURLResponse";
+  static readonly typeName = "ssoready.v1.AppListSAMLConnectionIDPCertificatesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateAdminSetupURLResponse {
-    return new AppCreateAdminSetupURLResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionIDPCertificatesRequest {
+    return new AppListSAMLConnectionIDPCertificatesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLResponse {
-    return new AppCreateAdminSetupURLResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPCertificatesRequest {
+    return new AppListSAMLConnectionIDPCertificatesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateAdminSetupURLResponse {
-    return new AppCreateAdminSetupURLResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPCertificatesRequest {
+    return new AppListSAMLConnectionIDPCertificatesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppCreateAdminSetupURLResponse | PlainMessage<AppCreateAdminSetupURLResponse> | undefined, b: AppCreateAdminSetupURLResponse | PlainMessage<AppCreateAdminSetupURLResponse> | undefined): boolean {
-    return proto3.util.equals(AppCreateAdminSetupURLResponse, a, b);
+  static equals(a: AppListSAMLConnectionIDPCertificatesRequest | PlainMessage<AppListSAMLConnectionIDPCertificatesRequest> | undefined, b: AppListSAMLConnectionIDPCertificatesRequest | PlainMessage<AppListSAMLConnectionIDPCertificatesRequest> | undefined): boolean {
+    return proto3.util.equals(AppListSAMLConnectionIDPCertificatesRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppListSAMLConnectionsRequest
+ * @generated from message ssoready.v1.AppListSAMLConnectionIDPCertificatesResponse
  */
-export class AppListSAMLConnectionsRequest extends Message<AppListSAMLConnectionsRequest> {
-  /**
-   * @generated from field: string organization_id = 1;
-   */
-  organizationId = "";
-
+export class AppListSAMLConnectionIDPCertificatesResponse extends Message<AppListSAMLConnectionIDPCertificatesResponse> {
   /**
-   * @generated from field: string page_token = 2;
+   * @generated from field: repeated ssoready.v1.SAMLConnectionIDPCertificate saml_connection_idp_certificates = 1;
    */
-  pageToken = "";
+  samlConnectionIdpCertificates: SAMLConnectionIDPCertificate[] = [];
 
-  constructor(data?: PartialMessage<AppListSAMLConnectionsRequest>) {
+  constructor(data?: PartialMessage<AppListSAMLConnectionIDPCertificatesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppListSAMLConnectionsRequest";
+  static readonly typeName = "ssoready.v1.AppListSAMLConnectionIDPCertificatesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "organization_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_idp_certificates", kind: "message", T: SAMLConnectionIDPCertificate, repeated: true },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionsRequest {
-    return new AppListSAMLConnectionsRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionIDPCertificatesResponse {
+    return new AppListSAMLConnectionIDPCertificatesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsRequest {
-    return new AppListSAMLConnectionsRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPCertificatesResponse {
+    return new AppListSAMLConnectionIDPCertificatesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsRequest {
-    return new AppListSAMLConnectionsRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPCertificatesResponse {
+    return new AppListSAMLConnectionIDPCertificatesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppListSAMLConnectionsRequest | PlainMessage<AppListSAMLConnectionsRequest> | undefined, b: AppListSAMLConnectionsRequest | PlainMessage<AppListSAMLConnectionsRequest> | undefined): boolean {
-    return proto3.util.equals(AppListSAMLConnectionsRequest, a, b);
+  static equals(a: AppListSAMLConnectionIDPCertificatesResponse | PlainMessage<AppListSAMLConnectionIDPCertificatesResponse> | undefined, b: AppListSAMLConnectionIDPCertificatesResponse | PlainMessage<AppListSAMLConnectionIDPCertificatesResponse> | undefined): boolean {
+    return proto3.util.equals(AppListSAMLConnectionIDPCertificatesResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppListSAMLConnectionsResponse
+ * @generated from message ssoready.v1.AppCreateSAMLConnectionIDPCertificateRequest
  */
-export class AppListSAMLConnectionsResponse extends Message<AppListSAMLConnectionsResponse> {
-  /**
-   * @generated from field: repeated ssoready.v1.SAMLConnection saml_connections = 1;
-   */
-  samlConnections: SAMLConnection[] = [];
-
+export class AppCreateSAMLConnectionIDPCertificateRequest extends Message<AppCreateSAMLConnectionIDPCertificateRequest> {
   /**
-   * @generated from field: string next_page_token = 2;
+   * @generated from field: ssoready.v1.SAMLConnectionIDPCertificate saml_connection_idp_certificate = 1;
    */
-  nextPageToken = "";
+  samlConnectionIdpCertificate?: SAMLConnectionIDPCertificate;
 
-  constructor(data?: PartialMessage<AppListSAMLConnectionsResponse>) {
+  constructor(data?: PartialMessage<AppCreateSAMLConnectionIDPCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppListSAMLConnectionsResponse";
+  static readonly typeName = "ssoready.v1.AppCreateSAMLConnectionIDPCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connections", kind: "message", T: SAMLConnection, repeated: true },
-    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_idp_certificate", kind: "message", T: SAMLConnectionIDPCertificate },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionsResponse {
-    return new AppListSAMLConnectionsResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateSAMLConnectionIDPCertificateRequest {
+    return new AppCreateSAMLConnectionIDPCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsResponse {
-    return new AppListSAMLConnectionsResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateSAMLConnectionIDPCertificateRequest {
+    return new AppCreateSAMLConnectionIDPCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionsResponse {
-    return new AppListSAMLConnectionsResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateSAMLConnectionIDPCertificateRequest {
+    return new AppCreateSAMLConnectionIDPCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppListSAMLConnectionsResponse | PlainMessage<AppListSAMLConnectionsResponse> | undefined, b: AppListSAMLConnectionsResponse | PlainMessage<AppListSAMLConnectionsResponse> | undefined): boolean {
-    return proto3.util.equals(AppListSAMLConnectionsResponse, a, b);
+  static equals(a: AppCreateSAMLConnectionIDPCertificateRequest | PlainMessage<AppCreateSAMLConnectionIDPCertificateRequest> | undefined, b: AppCreateSAMLConnectionIDPCertificateRequest | PlainMessage<AppCreateSAMLConnectionIDPCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(AppCreateSAMLConnectionIDPCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppGetSAMLConnectionRequest
+ * @generated from message ssoready.v1.AppDeleteSAMLConnectionIDPCertificateRequest
  */
-export class AppGetSAMLConnectionRequest extends Message<AppGetSAMLConnectionRequest> {
+export class AppDeleteSAMLConnectionIDPCertificateRequest extends Message<AppDeleteSAMLConnectionIDPCertificateRequest> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string saml_connection_idp_certificate_id = 1;
    */
-  id = "";
+  samlConnectionIdpCertificateId = "";
 
-  constructor(data?: PartialMessage<AppGetSAMLConnectionRequest>) {
+  constructor(data?: PartialMessage<AppDeleteSAMLConnectionIDPCertificateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppGetSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.AppDeleteSAMLConnectionIDPCertificateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_idp_certificate_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppGetSAMLConnectionRequest {
-    return new AppGetSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppDeleteSAMLConnectionIDPCertificateRequest {
+    return new AppDeleteSAMLConnectionIDPCertificateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionRequest {
-    return new AppGetSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppDeleteSAMLConnectionIDPCertificateRequest {
+    return new AppDeleteSAMLConnectionIDPCertificateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppGetSAMLConnectionRequest {
-    return new AppGetSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppDeleteSAMLConnectionIDPCertificateRequest {
+    return new AppDeleteSAMLConnectionIDPCertificateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppGetSAMLConnectionRequest | PlainMessage<AppGetSAMLConnectionRequest> | undefined, b: AppGetSAMLConnectionRequest | PlainMessage<AppGetSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(AppGetSAMLConnectionRequest, a, b);
+  static equals(a: AppDeleteSAMLConnectionIDPCertificateRequest | PlainMessage<AppDeleteSAMLConnectionIDPCertificateRequest> | undefined, b: AppDeleteSAMLConnectionIDPCertificateRequest | PlainMessage<AppDeleteSAMLConnectionIDPCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(AppDeleteSAMLConnectionIDPCertificateRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppCreateSAMLConnectionRequest
+ * @generated from message ssoready.v1.AppListSAMLConnectionIDPMetadataUpdatesRequest
  */
-export class AppCreateSAMLConnectionRequest extends Message<AppCreateSAMLConnectionRequest> {
+export class AppListSAMLConnectionIDPMetadataUpdatesRequest extends Message<AppListSAMLConnectionIDPMetadataUpdatesRequest> {
   /**
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
+   * @generated from field: string saml_connection_id = 1;
    */
-  samlConnection?: SAMLConnection;
+  samlConnectionId = "";
 
-  constructor(data?: PartialMessage<AppCreateSAMLConnectionRequest>) {
+  /**
+   * @generated from field: string page_token = 2;
+   */
+  pageToken = "";
+
+  constructor(data?: PartialMessage<AppListSAMLConnectionIDPMetadataUpdatesRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppCreateSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.AppListSAMLConnectionIDPMetadataUpdatesRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppCreateSAMLConnectionRequest {
-    return new AppCreateSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionIDPMetadataUpdatesRequest {
+    return new AppListSAMLConnectionIDPMetadataUpdatesRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppCreateSAMLConnectionRequest {
-    return new AppCreateSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPMetadataUpdatesRequest {
+    return new AppListSAMLConnectionIDPMetadataUpdatesRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppCreateSAMLConnectionRequest {
-    return new AppCreateSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPMetadataUpdatesRequest {
+    return new AppListSAMLConnectionIDPMetadataUpdatesRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppCreateSAMLConnectionRequest | PlainMessage<AppCreateSAMLConnectionRequest> | undefined, b: AppCreateSAMLConnectionRequest | PlainMessage<AppCreateSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(AppCreateSAMLConnectionRequest, a, b);
+  static equals(a: AppListSAMLConnectionIDPMetadataUpdatesRequest | PlainMessage<AppListSAMLConnectionIDPMetadataUpdatesRequest> | undefined, b: AppListSAMLConnectionIDPMetadataUpdatesRequest | PlainMessage<AppListSAMLConnectionIDPMetadataUpdatesRequest> | undefined): boolean {
+    return proto3.util.equals(AppListSAMLConnectionIDPMetadataUpdatesRequest, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppUpdateSAMLConnectionRequest
+ * @generated from message ssoready.v1.AppListSAMLConnectionIDPMetadataUpdatesResponse
  */
-export class AppUpdateSAMLConnectionRequest extends Message<AppUpdateSAMLConnectionRequest> {
+export class AppListSAMLConnectionIDPMetadataUpdatesResponse extends Message<AppListSAMLConnectionIDPMetadataUpdatesResponse> {
   /**
-   * @generated from field: ssoready.v1.SAMLConnection saml_connection = 1;
+   * @generated from field: repeated ssoready.v1.SAMLConnectionIDPMetadataUpdate saml_connection_idp_metadata_updates = 1;
    */
-  samlConnection?: SAMLConnection;
+  samlConnectionIdpMetadataUpdates: SAMLConnectionIDPMetadataUpdate[] = [];
 
-  constructor(data?: PartialMessage<AppUpdateSAMLConnectionRequest>) {
+  /**
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<AppListSAMLConnectionIDPMetadataUpdatesResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppUpdateSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.AppListSAMLConnectionIDPMetadataUpdatesResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection", kind: "message", T: SAMLConnection },
+    { no: 1, name: "saml_connection_idp_metadata_updates", kind: "message", T: SAMLConnectionIDPMetadataUpdate, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppUpdateSAMLConnectionRequest {
-    return new AppUpdateSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppListSAMLConnectionIDPMetadataUpdatesResponse {
+    return new AppListSAMLConnectionIDPMetadataUpdatesResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppUpdateSAMLConnectionRequest {
-    return new AppUpdateSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPMetadataUpdatesResponse {
+    return new AppListSAMLConnectionIDPMetadataUpdatesResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppUpdateSAMLConnectionRequest {
-    return new AppUpdateSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppListSAMLConnectionIDPMetadataUpdatesResponse {
+    return new AppListSAMLConnectionIDPMetadataUpdatesResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppUpdateSAMLConnectionRequest | PlainMessage<AppUpdateSAMLConnectionRequest> | undefined, b: AppUpdateSAMLConnectionRequest | PlainMessage<AppUpdateSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(AppUpdateSAMLConnectionRequest, a, b);
+  static equals(a: AppListSAMLConnectionIDPMetadataUpdatesResponse | PlainMessage<AppListSAMLConnectionIDPMetadataUpdatesResponse> | undefined, b: AppListSAMLConnectionIDPMetadataUpdatesResponse | PlainMessage<AppListSAMLConnectionIDPMetadataUpdatesResponse> | undefined): boolean {
+    return proto3.util.equals(AppListSAMLConnectionIDPMetadataUpdatesResponse, a, b);
   }
 }
 
 /**
- * @generated from message ssoready.v1.AppDeleteSAMLConnectionRequest
+ * @generated from message ssoready.v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest
  */
-export class AppDeleteSAMLConnectionRequest extends Message<AppDeleteSAMLConnectionRequest> {
+export class AppAcceptSAMLConnectionIDPMetadataUpdateRequest extends Message<AppAcceptSAMLConnectionIDPMetadataUpdateRequest> {
   /**
-   * @generated from field: string saml_connection_id = 1;
+   * @generated from field: string saml_connection_idp_metadata_update_id = 1;
    */
-  samlConnectionId = "";
+  samlConnectionIdpMetadataUpdateId = "";
 
-  constructor(data?: PartialMessage<AppDeleteSAMLConnectionRequest>) {
+  constructor(data?: PartialMessage<AppAcceptSAMLConnectionIDPMetadataUpdateRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "ssoready.v1.AppDeleteSAMLConnectionRequest";
+  static readonly typeName = "ssoready.v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "saml_connection_idp_metadata_update_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppDeleteSAMLConnectionRequest {
-    return new AppDeleteSAMLConnectionRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AppAcceptSAMLConnectionIDPMetadataUpdateRequest {
+    return new AppAcceptSAMLConnectionIDPMetadataUpdateRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppDeleteSAMLConnectionRequest {
-    return new AppDeleteSAMLConnectionRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AppAcceptSAMLConnectionIDPMetadataUpdateRequest {
+    return new AppAcceptSAMLConnectionIDPMetadataUpdateRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppDeleteSAMLConnectionRequest {
-    return new AppDeleteSAMLConnectionRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AppAcceptSAMLConnectionIDPMetadataUpdateRequest {
+    return new AppAcceptSAMLConnectionIDPMetadataUpdateRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: AppDeleteSAMLConnectionRequest | PlainMessage<AppDeleteSAMLConnectionRequest> | undefined, b: AppDeleteSAMLConnectionRequest | PlainMessage<AppDeleteSAMLConnectionRequest> | undefined): boolean {
-    return proto3.util.equals(AppDeleteSAMLConnectionRequest, a, b);
+  static equals(a: AppAcceptSAMLConnectionIDPMetadataUpdateRequest | PlainMessage<AppAcceptSAMLConnectionIDPMetadataUpdateRequest> | undefined, b: AppAcceptSAMLConnectionIDPMetadataUpdateRequest | PlainMessage<AppAcceptSAMLConnectionIDPMetadataUpdateRequest> | undefined): boolean {
+    return proto3.util.equals(AppAcceptSAMLConnectionIDPMetadataUpdateRequest, a, b);
   }
 }
 
@@ -5302,6 +7572,11 @@ export class ParseSAMLMetadataRequest extends Message<ParseSAMLMetadataRequest>
    */
   url = "";
 
+  /**
+   * @generated from field: string entity_id = 2;
+   */
+  entityId = "";
+
   constructor(data?: PartialMessage<ParseSAMLMetadataRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -5311,6 +7586,7 @@ export class ParseSAMLMetadataRequest extends Message<ParseSAMLMetadataRequest>
   static readonly typeName = "ssoready.v1.ParseSAMLMetadataRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParseSAMLMetadataRequest {
@@ -5349,6 +7625,31 @@ export class ParseSAMLMetadataResponse extends Message<ParseSAMLMetadataResponse
    */
   idpEntityId = "";
 
+  /**
+   * @generated from field: ssoready.v1.SAMLBinding idp_binding = 4;
+   */
+  idpBinding = SAMLBinding.SAML_BINDING_UNSPECIFIED;
+
+  /**
+   * @generated from field: string idp_slo_url = 5;
+   */
+  idpSloUrl = "";
+
+  /**
+   * @generated from field: repeated ssoready.v1.SAMLMetadataWarning warnings = 6;
+   */
+  warnings: SAMLMetadataWarning[] = [];
+
+  /**
+   * @generated from field: string idp_artifact_resolution_url = 7;
+   */
+  idpArtifactResolutionUrl = "";
+
+  /**
+   * @generated from field: ssoready.v1.SAMLIDPVendor idp_vendor = 8;
+   */
+  idpVendor = SAMLIDPVendor.SAML_IDP_VENDOR_UNSPECIFIED;
+
   constructor(data?: PartialMessage<ParseSAMLMetadataResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -5360,6 +7661,11 @@ export class ParseSAMLMetadataResponse extends Message<ParseSAMLMetadataResponse
     { no: 1, name: "idp_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "idp_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "idp_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "idp_binding", kind: "enum", T: proto3.getEnumType(SAMLBinding) },
+    { no: 5, name: "idp_slo_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "warnings", kind: "message", T: SAMLMetadataWarning, repeated: true },
+    { no: 7, name: "idp_artifact_resolution_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "idp_vendor", kind: "enum", T: proto3.getEnumType(SAMLIDPVendor) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParseSAMLMetadataResponse {
@@ -5379,6 +7685,49 @@ export class ParseSAMLMetadataResponse extends Message<ParseSAMLMetadataResponse
   }
 }
 
+/**
+ * @generated from message ssoready.v1.SAMLMetadataWarning
+ */
+export class SAMLMetadataWarning extends Message<SAMLMetadataWarning> {
+  /**
+   * @generated from field: string code = 1;
+   */
+  code = "";
+
+  /**
+   * @generated from field: string message = 2;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<SAMLMetadataWarning>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.SAMLMetadataWarning";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SAMLMetadataWarning {
+    return new SAMLMetadataWarning().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SAMLMetadataWarning {
+    return new SAMLMetadataWarning().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SAMLMetadataWarning {
+    return new SAMLMetadataWarning().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SAMLMetadataWarning | PlainMessage<SAMLMetadataWarning> | undefined, b: SAMLMetadataWarning | PlainMessage<SAMLMetadataWarning> | undefined): boolean {
+    return proto3.util.equals(SAMLMetadataWarning, a, b);
+  }
+}
+
 /**
  * @generated from message ssoready.v1.AppListSCIMDirectoriesRequest
  */
@@ -6653,6 +9002,80 @@ export class AdminUpdateSAMLConnectionResponse extends Message<AdminUpdateSAMLCo
   }
 }
 
+/**
+ * @generated from message ssoready.v1.AdminGetSAMLConnectionSPSigningCertificateRequest
+ */
+export class AdminGetSAMLConnectionSPSigningCertificateRequest extends Message<AdminGetSAMLConnectionSPSigningCertificateRequest> {
+  /**
+   * @generated from field: string saml_connection_id = 1;
+   */
+  samlConnectionId = "";
+
+  constructor(data?: PartialMessage<AdminGetSAMLConnectionSPSigningCertificateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.AdminGetSAMLConnectionSPSigningCertificateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "saml_connection_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminGetSAMLConnectionSPSigningCertificateRequest {
+    return new AdminGetSAMLConnectionSPSigningCertificateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminGetSAMLConnectionSPSigningCertificateRequest {
+    return new AdminGetSAMLConnectionSPSigningCertificateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminGetSAMLConnectionSPSigningCertificateRequest {
+    return new AdminGetSAMLConnectionSPSigningCertificateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdminGetSAMLConnectionSPSigningCertificateRequest | PlainMessage<AdminGetSAMLConnectionSPSigningCertificateRequest> | undefined, b: AdminGetSAMLConnectionSPSigningCertificateRequest | PlainMessage<AdminGetSAMLConnectionSPSigningCertificateRequest> | undefined): boolean {
+    return proto3.util.equals(AdminGetSAMLConnectionSPSigningCertificateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message ssoready.v1.AdminGetSAMLConnectionSPSigningCertificateResponse
+ */
+export class AdminGetSAMLConnectionSPSigningCertificateResponse extends Message<AdminGetSAMLConnectionSPSigningCertificateResponse> {
+  /**
+   * @generated from field: string certificate = 1;
+   */
+  certificate = "";
+
+  constructor(data?: PartialMessage<AdminGetSAMLConnectionSPSigningCertificateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "ssoready.v1.AdminGetSAMLConnectionSPSigningCertificateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminGetSAMLConnectionSPSigningCertificateResponse {
+    return new AdminGetSAMLConnectionSPSigningCertificateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AdminGetSAMLConnectionSPSigningCertificateResponse {
+    return new AdminGetSAMLConnectionSPSigningCertificateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AdminGetSAMLConnectionSPSigningCertificateResponse {
+    return new AdminGetSAMLConnectionSPSigningCertificateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AdminGetSAMLConnectionSPSigningCertificateResponse | PlainMessage<AdminGetSAMLConnectionSPSigningCertificateResponse> | undefined, b: AdminGetSAMLConnectionSPSigningCertificateResponse | PlainMessage<AdminGetSAMLConnectionSPSigningCertificateResponse> | undefined): boolean {
+    return proto3.util.equals(AdminGetSAMLConnectionSPSigningCertificateResponse, a, b);
+  }
+}
+
 /**
  * @generated from message ssoready.v1.AdminParseSAMLMetadataRequest
  */
@@ -6667,6 +9090,11 @@ export class AdminParseSAMLMetadataRequest extends Message<AdminParseSAMLMetadat
    */
   xml = "";
 
+  /**
+   * @generated from field: string entity_id = 3;
+   */
+  entityId = "";
+
   constructor(data?: PartialMessage<AdminParseSAMLMetadataRequest>) {
     super();
     proto3.util.initPartial(data, this);
@@ -6677,6 +9105,7 @@ export class AdminParseSAMLMetadataRequest extends Message<AdminParseSAMLMetadat
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "xml", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminParseSAMLMetadataRequest {
@@ -6715,6 +9144,21 @@ export class AdminParseSAMLMetadataResponse extends Message<AdminParseSAMLMetada
    */
   idpEntityId = "";
 
+  /**
+   * @generated from field: ssoready.v1.SAMLBinding idp_binding = 4;
+   */
+  idpBinding = SAMLBinding.SAML_BINDING_UNSPECIFIED;
+
+  /**
+   * @generated from field: repeated ssoready.v1.SAMLMetadataWarning warnings = 5;
+   */
+  warnings: SAMLMetadataWarning[] = [];
+
+  /**
+   * @generated from field: ssoready.v1.SAMLIDPVendor idp_vendor = 6;
+   */
+  idpVendor = SAMLIDPVendor.SAML_IDP_VENDOR_UNSPECIFIED;
+
   constructor(data?: PartialMessage<AdminParseSAMLMetadataResponse>) {
     super();
     proto3.util.initPartial(data, this);
@@ -6726,6 +9170,9 @@ export class AdminParseSAMLMetadataResponse extends Message<AdminParseSAMLMetada
     { no: 1, name: "idp_redirect_url", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "idp_certificate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "idp_entity_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "idp_binding", kind: "enum", T: proto3.getEnumType(SAMLBinding) },
+    { no: 5, name: "warnings", kind: "message", T: SAMLMetadataWarning, repeated: true },
+    { no: 6, name: "idp_vendor", kind: "enum", T: proto3.getEnumType(SAMLIDPVendor) },
   ]);
 
   static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AdminParseSAMLMetadataResponse {
@@ -0,0 +1,13 @@
+import { SAMLIDPVendor } from "@/gen/ssoready/v1/ssoready_pb";
+
+export const IDP_VENDOR_LABELS: Record<SAMLIDPVendor, string> = {
+  [SAMLIDPVendor.SAML_IDP_VENDOR_UNSPECIFIED]: "Not detected",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_OKTA]: "Okta",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_ENTRA]: "Microsoft Entra ID",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_GOOGLE]: "Google Workspace",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_ADFS]: "ADFS",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_JUMPCLOUD]: "JumpCloud",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_PING]: "Ping Identity",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_KEYCLOAK]: "Keycloak",
+  [SAMLIDPVendor.SAML_IDP_VENDOR_ONELOGIN]: "OneLogin",
+};
@@ -745,7 +745,7 @@ function OktaCopyMetadataURLStep() {
   ) => {
     e.preventDefault();
 
-    const { idpRedirectUrl, idpCertificate, idpEntityId } =
+    const { idpRedirectUrl, idpCertificate, idpEntityId, idpBinding } =
       await parseSAMLMetadataMutation.mutateAsync({ url: data.metadataUrl });
 
     await updateSAMLConnectionMutation.mutateAsync({
@@ -755,6 +755,7 @@ function OktaCopyMetadataURLStep() {
         idpRedirectUrl,
         idpCertificate,
         idpEntityId,
+        idpBinding,
       },
     });
 
@@ -906,7 +907,7 @@ function OktaTestSuccessPage() {
   const email = searchParams.get("email")!;
   const attributes = JSON.parse(searchParams.get("attributes")!) as Record<
     string,
-    string
+    string[]
   >;
 
   return (
@@ -928,7 +929,7 @@ function OktaTestSuccessPage() {
             {Object.entries(attributes).map(([key, value]) => (
               <div key={key} className="bg-muted p-2 rounded-md">
                 <p className="font-medium text-muted-foreground">{key}</p>
-                <p className="font-semibold">{value}</p>
+                <p className="font-semibold">{value.join(", ")}</p>
               </div>
             ))}
           </div>
@@ -1045,7 +1046,7 @@ function GoogleDownloadMetadataStep() {
   ) => {
     e.preventDefault();
 
-    const { idpRedirectUrl, idpCertificate, idpEntityId } =
+    const { idpRedirectUrl, idpCertificate, idpEntityId, idpBinding } =
       await parseSAMLMetadataMutation.mutateAsync({ xml: data.metadata });
 
     await updateSAMLConnectionMutation.mutateAsync({
@@ -1055,6 +1056,7 @@ function GoogleDownloadMetadataStep() {
         idpRedirectUrl,
         idpCertificate,
         idpEntityId,
+        idpBinding,
       },
     });
 
@@ -1296,7 +1298,7 @@ function GoogleTestSuccessPage() {
   const email = searchParams.get("email")!;
   const attributes = JSON.parse(searchParams.get("attributes")!) as Record<
     string,
-    string
+    string[]
   >;
 
   return (
@@ -1318,7 +1320,7 @@ function GoogleTestSuccessPage() {
             {Object.entries(attributes).map(([key, value]) => (
               <div key={key} className="bg-muted p-2 rounded-md">
                 <p className="font-medium text-muted-foreground">{key}</p>
-                <p className="font-semibold">{value}</p>
+                <p className="font-semibold">{value.join(", ")}</p>
               </div>
             ))}
           </div>
@@ -1514,7 +1516,7 @@ function EntraDownloadMetadataStep() {
   ) => {
     e.preventDefault();
 
-    const { idpRedirectUrl, idpCertificate, idpEntityId } =
+    const { idpRedirectUrl, idpCertificate, idpEntityId, idpBinding } =
       await parseSAMLMetadataMutation.mutateAsync({ xml: data.metadata });
 
     await updateSAMLConnectionMutation.mutateAsync({
@@ -1524,6 +1526,7 @@ function EntraDownloadMetadataStep() {
         idpRedirectUrl,
         idpCertificate,
         idpEntityId,
+        idpBinding,
       },
     });
 
@@ -1700,7 +1703,7 @@ function EntraTestSuccessPage() {
   const email = searchParams.get("email")!;
   const attributes = JSON.parse(searchParams.get("attributes")!) as Record<
     string,
-    string
+    string[]
   >;
 
   return (
@@ -1722,7 +1725,7 @@ function EntraTestSuccessPage() {
             {Object.entries(attributes).map(([key, value]) => (
               <div key={key} className="bg-muted p-2 rounded-md">
                 <p className="font-medium text-muted-foreground">{key}</p>
-                <p className="font-semibold">{value}</p>
+                <p className="font-semibold">{value.join(", ")}</p>
               </div>
             ))}
           </div>
@@ -1874,7 +1877,7 @@ function OtherDownloadMetadataStep() {
   ) => {
     e.preventDefault();
 
-    const { idpRedirectUrl, idpCertificate, idpEntityId } =
+    const { idpRedirectUrl, idpCertificate, idpEntityId, idpBinding } =
       await parseSAMLMetadataMutation.mutateAsync({ xml: data.metadata });
 
     await updateSAMLConnectionMutation.mutateAsync({
@@ -1884,6 +1887,7 @@ function OtherDownloadMetadataStep() {
         idpRedirectUrl,
         idpCertificate,
         idpEntityId,
+        idpBinding,
       },
     });
 
@@ -2029,7 +2033,7 @@ function OtherTestSuccessPage() {
   const email = searchParams.get("email")!;
   const attributes = JSON.parse(searchParams.get("attributes")!) as Record<
     string,
-    string
+    string[]
   >;
 
   return (
@@ -2053,7 +2057,7 @@ function OtherTestSuccessPage() {
             {Object.entries(attributes).map(([key, value]) => (
               <div key={key} className="bg-muted p-2 rounded-md">
                 <p className="font-medium text-muted-foreground">{key}</p>
-                <p className="font-semibold">{value}</p>
+                <p className="font-semibold">{value.join(", ")}</p>
               </div>
             ))}
           </div>
@@ -3,6 +3,7 @@ import { useMatch, useParams } from "react-router";
 import { useInfiniteQuery, useQuery } from "@connectrpc/connect-query";
 import {
   adminGetSAMLConnection,
+  adminGetSAMLConnectionSPSigningCertificate,
   adminListSAMLFlows,
   adminParseSAMLMetadata,
   adminUpdateSAMLConnection,
@@ -47,6 +48,7 @@ import {
   Organization,
   SAMLConnection,
   SAMLFlowStatus,
+  SAMLMetadataWarning,
 } from "@/gen/ssoready/v1/ssoready_pb";
 import { useForm } from "react-hook-form";
 import { zodResolver } from "@hookform/resolvers/zod";
@@ -179,6 +181,27 @@ export function ViewSAMLConnectionPage() {
               </div>
             </div>
           </div>
+
+          {samlConnection?.samlConnection?.spCertificate && (
+            <Collapsible className="mt-1.5">
+              <CollapsibleTrigger className="text-sm text-muted-foreground">
+                Encryption Certificate (optional, click to show)
+              </CollapsibleTrigger>
+              <CollapsibleContent>
+                <div className="bg-black rounded-lg px-6 py-4 mt-4 inline-block">
+                  <code className="text-sm text-white">
+                    <pre>{samlConnection.samlConnection.spCertificate}</pre>
+                  </code>
+                </div>
+              </CollapsibleContent>
+            </Collapsible>
+          )}
+
+          {samlConnection?.samlConnection?.signAuthnRequests && (
+            <SPSigningCertificate
+              samlConnectionId={samlConnection.samlConnection.id}
+            />
+          )}
         </CardContent>
       </Card>
 
@@ -327,6 +350,7 @@ function EditSAMLConnectionAlertDialog({
           idpEntityId: samlConnection.idpEntityId,
           idpRedirectUrl: samlConnection.idpRedirectUrl,
           idpCertificate: samlConnection.idpCertificate,
+          idpBinding: samlConnection.idpBinding,
         },
       });
 
@@ -414,6 +438,8 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
     },
   });
 
+  // the binding isn't editable by hand here, but loading metadata may change it
+  const [idpBinding, setIdpBinding] = useState(samlConnection.idpBinding);
   const [open, setOpen] = useState(false);
   const updateSAMLConnectionMutation = useMutation(adminUpdateSAMLConnection);
   const queryClient = useQueryClient();
@@ -428,6 +454,7 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
           idpEntityId: data.idpEntityId,
           idpRedirectUrl: data.idpRedirectUrl,
           idpCertificate: data.idpCertificate,
+          idpBinding,
         },
       });
 
@@ -439,19 +466,36 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
 
       setOpen(false);
     },
-    [samlConnection.id, updateSAMLConnectionMutation, queryClient, setOpen],
+    [
+      samlConnection.id,
+      idpBinding,
+      updateSAMLConnectionMutation,
+      queryClient,
+      setOpen,
+    ],
   );
 
   const id = useId();
   const [metadataUrl, setMetadataUrl] = useState("");
+  const [metadataWarnings, setMetadataWarnings] = useState<
+    SAMLMetadataWarning[]
+  >([]);
   const parseSAMLMetadataMutation = useMutation(adminParseSAMLMetadata);
   const handleLoadMetadata = useCallback(async () => {
-    const { idpRedirectUrl, idpCertificate, idpEntityId } =
-      await parseSAMLMetadataMutation.mutateAsync({ url: metadataUrl });
+    const {
+      idpRedirectUrl,
+      idpCertificate,
+      idpEntityId,
+      idpBinding,
+      warnings,
+    } = await parseSAMLMetadataMutation.mutateAsync({ url: metadataUrl });
+
+    setMetadataWarnings(warnings);
 
     form.setValue("idpRedirectUrl", idpRedirectUrl);
     form.setValue("idpCertificate", idpCertificate);
     form.setValue("idpEntityId", idpEntityId);
+    setIdpBinding(idpBinding);
   }, [parseSAMLMetadataMutation, metadataUrl, form]);
 
   return (
@@ -485,6 +529,13 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
                 </Button>
               </div>
               <FormDescription>IDP Metadata URL.</FormDescription>
+              {metadataWarnings.length > 0 && (
+                <ul className="text-sm text-muted-foreground list-disc list-inside">
+                  {metadataWarnings.map((warning) => (
+                    <li key={warning.code}>{warning.message}</li>
+                  ))}
+                </ul>
+              )}
             </FormItem>
 
             <div className="relative">
@@ -564,3 +615,31 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
     </AlertDialog>
   );
 }
+
+function SPSigningCertificate({
+  samlConnectionId,
+}: {
+  samlConnectionId: string;
+}) {
+  const { data: spSigningCertificate } = useQuery(
+    adminGetSAMLConnectionSPSigningCertificate,
+    {
+      samlConnectionId,
+    },
+  );
+
+  return (
+    <Collapsible className="mt-1.5">
+      <CollapsibleTrigger className="text-sm text-muted-foreground">
+        Signing Certificate (required, click to show)
+      </CollapsibleTrigger>
+      <CollapsibleContent>
+        <div className="bg-black rounded-lg px-6 py-4 mt-4 inline-block">
+          <code className="text-sm text-white">
+            <pre>{spSigningCertificate?.certificate}</pre>
+          </code>
+        </div>
+      </CollapsibleContent>
+    </Collapsible>
+  );
+}
@@ -27,7 +27,7 @@ import { Link } from "react-router-dom";
 import { SAMLFlowStatus } from "@/gen/ssoready/v1/ssoready_pb";
 import { Badge } from "@/components/ui/badge";
 import { Alert, AlertDescription, AlertTitle } from "@/components/ui/alert";
-import { OctagonX } from "lucide-react";
+import { CircleAlert, OctagonX } from "lucide-react";
 import { LayoutMain } from "@/components/Layout";
 import { Helmet } from "react-helmet";
 import { useTitle } from "@/useTitle";
@@ -36,6 +36,7 @@ import {
   CollapsibleContent,
   CollapsibleTrigger,
 } from "@/components/ui/collapsible";
+import { IDP_VENDOR_LABELS } from "@/lib/idpVendors";
 
 export function ViewSAMLFlowPage() {
   const { samlConnectionId, samlFlowId } = useParams();
@@ -146,12 +147,31 @@ export function ViewSAMLFlowPage() {
               {samlFlow?.samlFlow?.email}
             </div>
 
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Identity Provider
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.samlFlow?.idpVendor ? (
+                IDP_VENDOR_LABELS[samlFlow.samlFlow.idpVendor]
+              ) : (
+                <span className="text-sm text-muted-foreground">
+                  Not detected
+                </span>
+              )}
+            </div>
+
             <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
               User Attributes
             </div>
             <div className="text-xs col-span-3">
               <span className="font-mono bg-gray-100 py-1 px-2 rounded-sm">
-                {JSON.stringify(samlFlow?.samlFlow?.attributes)}
+                {JSON.stringify(
+                  Object.fromEntries(
+                    Object.entries(
+                      samlFlow?.samlFlow?.attributeValues ?? {},
+                    ).map(([key, value]) => [key, value.values]),
+                  ),
+                )}
               </span>
             </div>
           </div>
@@ -171,9 +191,9 @@ export function ViewSAMLFlowPage() {
               </p>
 
               <p className="mt-4">
-                We require that SAML assertions be signed. This can be fixed by
-                enabling SAML assertion signing in your identity provider
-                settings.
+                We require that either the SAML response or the SAML assertion
+                be signed. This can be fixed by enabling signing in your
+                identity provider settings.
               </p>
             </AlertDescription>
           )}
@@ -330,6 +350,207 @@ export function ViewSAMLFlowPage() {
               email, which is outside of your organization's allowed domains.
             </AlertDescription>
           )}
+
+          {samlFlow.samlFlow.error.case === "idpInitiatedNotAllowed" && (
+            <AlertDescription>
+              <p>
+                Your identity provider started this login, instead of it being
+                started from the application you're logging into.
+              </p>
+
+              <p className="mt-4">
+                Logins started from your identity provider are not enabled for
+                this SAML connection. Start the login from the application
+                instead.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "idpStatus" && (
+            <AlertDescription>
+              <p>
+                Your identity provider did not log the user in. It responded
+                with a status of{" "}
+                <span className="font-semibold">
+                  {samlFlow.samlFlow.error.value.subStatusCode ||
+                    samlFlow.samlFlow.error.value.statusCode}
+                </span>
+                .
+              </p>
+
+              {samlFlow.samlFlow.error.value.statusMessage && (
+                <p className="mt-4">
+                  The identity provider's message was:{" "}
+                  <span className="font-semibold">
+                    {samlFlow.samlFlow.error.value.statusMessage}
+                  </span>
+                </p>
+              )}
+
+              <p className="mt-4">
+                This usually means the user failed to authenticate, or isn't
+                assigned to this application. You may need to investigate this
+                in your identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "artifactResolutionFailed" && (
+            <AlertDescription>
+              <p>
+                Your identity provider sent a SAML artifact, but it could not
+                be exchanged for a SAML assertion. The error was:{" "}
+                <span className="font-semibold">
+                  {samlFlow.samlFlow.error.value}
+                </span>
+              </p>
+
+              <p className="mt-4">
+                You may need to investigate this in your identity provider, or
+                contact support to check the SAML connection's artifact
+                resolution URL.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "expiredAssertion" && (
+            <AlertDescription>
+              <p>
+                Your identity provider provided a SAML assertion that is
+                expired or not yet valid.
+              </p>
+
+              <p className="mt-4">
+                This usually means your identity provider's clock is out of
+                sync. You need to fix the clock in your identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "expiredIdpInitiatedAssertion" && (
+            <AlertDescription>
+              <p>
+                Your identity provider started this login, but issued its SAML
+                assertion more than five minutes before it was received.
+              </p>
+
+              <p className="mt-4">
+                Start the login from your identity provider again. If this
+                keeps happening, your identity provider's clock may be out of
+                sync.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "expiredSubjectConfirmation" && (
+            <AlertDescription>
+              <p>
+                Your identity provider provided a SAML assertion whose subject
+                confirmation is expired.
+              </p>
+
+              <p className="mt-4">
+                This usually means your identity provider's clock is out of
+                sync. You need to fix the clock in your identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "badDestination" && (
+            <AlertDescription>
+              <p>
+                Your identity provider sent a SAML response with a Destination
+                of{" "}
+                <span className="font-semibold">
+                  {samlFlow.samlFlow.error.value}
+                </span>
+                , which is not the correct value.
+              </p>
+
+              <p className="mt-4">
+                You need to change the value to{" "}
+                <span className="font-semibold">
+                  {samlConnection?.samlConnection?.spAcsUrl}
+                </span>{" "}
+                in your identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "badRecipient" && (
+            <AlertDescription>
+              <p>
+                Your identity provider provided a SAML subject confirmation
+                Recipient of{" "}
+                <span className="font-semibold">
+                  {samlFlow.samlFlow.error.value}
+                </span>
+                , which is not the correct value.
+              </p>
+
+              <p className="mt-4">
+                You need to change the value to{" "}
+                <span className="font-semibold">
+                  {samlConnection?.samlConnection?.spAcsUrl}
+                </span>{" "}
+                in your identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.samlFlow.error.case === "badSubjectConfirmationMethod" && (
+            <AlertDescription>
+              <p>
+                Your identity provider provided a SAML subject confirmation
+                method of{" "}
+                <span className="font-semibold">
+                  {samlFlow.samlFlow.error.value}
+                </span>
+                , which SSOReady does not accept.
+              </p>
+
+              <p className="mt-4">
+                You need to change the value to{" "}
+                <span className="font-semibold">
+                  urn:oasis:names:tc:SAML:2.0:cm:bearer
+                </span>{" "}
+                in your identity provider.
+              </p>
+            </AlertDescription>
+          )}
+        </Alert>
+      )}
+
+      {samlFlow?.samlFlow?.unverifiedIdpStatus && (
+        <Alert className="mt-8 bg-white shadow-sm">
+          <CircleAlert className="h-4 w-4" />
+          <AlertTitle>An unverified failure response was received</AlertTitle>
+          <AlertDescription>
+            <p>
+              A response for this login was received with a status of{" "}
+              <span className="font-semibold">
+                {samlFlow.samlFlow.unverifiedIdpStatus.subStatusCode ||
+                  samlFlow.samlFlow.unverifiedIdpStatus.statusCode}
+              </span>
+              {samlFlow.samlFlow.unverifiedIdpStatus.statusMessage && (
+                <>
+                  {" "}
+                  and a message of{" "}
+                  <span className="font-semibold">
+                    {samlFlow.samlFlow.unverifiedIdpStatus.statusMessage}
+                  </span>
+                </>
+              )}
+              .
+            </p>
+
+            <p className="mt-4">
+              The response was not signed by your identity provider, so it did
+              not affect the outcome of this login. If your identity provider
+              doesn't sign failure responses, this may explain why a login did
+              not complete.
+            </p>
+          </AlertDescription>
         </Alert>
       )}
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { AdminCreateSAMLConnectionRequest, AdminCreateSAMLConnectionResponse, AdminCreateSCIMDirectoryRequest, AdminCreateSCIMDirectoryResponse, AdminCreateTestModeSAMLFlowRequest, AdminCreateTestModeSAMLFlowResponse, AdminGetSAMLConnectionRequest, AdminGetSAMLConnectionResponse, AdminGetSAMLFlowRequest, AdminGetSAMLFlowResponse, AdminGetSCIMDirectoryRequest, AdminGetSCIMDirectoryResponse, AdminListSAMLConnectionsRequest, AdminListSAMLConnectionsResponse, AdminListSAMLFlowsRequest, AdminListSAMLFlowsResponse, AdminListSCIMDirectoriesRequest, AdminListSCIMDirectoriesResponse, AdminParseSAMLMetadataRequest, AdminParseSAMLMetadataResponse, AdminRedeemOneTimeTokenRequest, AdminRedeemOneTimeTokenResponse, AdminRotateSCIMDirectoryBearerTokenRequest, AdminRotateSCIMDirectoryBearerTokenResponse, AdminUpdateSAMLConnectionRequest, AdminUpdateSAMLConnectionResponse, AdminUpdateSCIMDirectoryRequest, AdminUpdateSCIMDirectoryResponse, AdminWhoamiRequest, AdminWhoamiResponse, APIKey, AppCreateAdminSetupURLRequest, AppCreateAdminSetupURLResponse, AppCreateOrganizationRequest, AppCreateSAMLConnectionRequest, AppCreateSCIMDirectoryRequest, AppDeleteOrganizationRequest, AppDeleteSAMLConnectionRequest, AppDeleteSCIMDirectoryRequest, AppGetAdminSettingsRequest, AppGetAdminSettingsResponse, AppGetOrganizationRequest, AppGetSAMLConnectionRequest, AppGetSAMLFlowRequest, AppGetSCIMDirectoryRequest, AppGetSCIMGroupRequest, AppGetSCIMRequestRequest, AppGetSCIMRequestResponse, AppGetSCIMUserRequest, AppListOrganizationsRequest, AppListOrganizationsResponse, AppListSAMLConnectionsRequest, AppListSAMLConnectionsResponse, AppListSAMLFlowsRequest, AppListSAMLFlowsResponse, AppListSCIMDirectoriesRequest, AppListSCIMDirectoriesResponse, AppListSCIMGroupsRequest, AppListSCIMGroupsResponse, AppListSCIMRequestsRequest, AppListSCIMRequestsResponse, AppListSCIMUsersRequest, AppListSCIMUsersResponse, AppRotateSCIMDirectoryBearerTokenRequest, AppRotateSCIMDirectoryBearerTokenResponse, AppUpdateAdminSettingsLogoRequest, AppUpdateAdminSettingsLogoResponse, AppUpdateAdminSettingsRequest, AppUpdateAdminSettingsResponse, AppUpdateOrganizationRequest, AppUpdateSAMLConnectionRequest, AppUpdateSCIMDirectoryRequest, CheckEnvironmentCustomDomainSettingsCertificatesRequest, CheckEnvironmentCustomDomainSettingsCertificatesResponse, CreateAPIKeyRequest, CreateEnvironmentRequest, CreateOrganizationRequest, CreateOrganizationResponse, CreateSAMLConnectionRequest, CreateSAMLConnectionResponse, CreateSAMLOAuthClientRequest, CreateSCIMDirectoryRequest, CreateSCIMDirectoryResponse, CreateSetupURLRequest, CreateSetupURLResponse, DeleteAPIKeyRequest, DeleteSAMLOAuthClientRequest, Environment, GetAPIKeyRequest, GetAppOrganizationRequest, GetAppOrganizationResponse, GetEnvironmentCustomDomainSettingsRequest, GetEnvironmentCustomDomainSettingsResponse, GetEnvironmentRequest, GetOnboardingStateRequest, GetOnboardingStateResponse, GetOrganizationRequest, GetOrganizationResponse, GetSAMLConnectionRequest, GetSAMLConnectionResponse, GetSAMLOAuthClientRequest, GetSAMLRedirectURLRequest, GetSAMLRedirectURLResponse, GetSCIMDirectoryRequest, GetSCIMDirectoryResponse, GetSCIMGroupRequest, GetSCIMGroupResponse, GetSCIMUserRequest, GetSCIMUserResponse, ListAPIKeysRequest, ListAPIKeysResponse, ListAppUsersRequest, ListAppUsersResponse, ListEnvironmentsRequest, ListEnvironmentsResponse, ListOrganizationsRequest, ListOrganizationsResponse, ListSAMLConnectionsRequest, ListSAMLConnectionsResponse, ListSAMLOAuthClientsRequest, ListSAMLOAuthClientsResponse, ListSCIMDirectoriesRequest, ListSCIMDirectoriesResponse, ListSCIMGroupsRequest, ListSCIMGroupsResponse, ListSCIMUsersRequest, ListSCIMUsersResponse, OnboardingGetSAMLRedirectURLRequest, OnboardingRedeemSAMLAccessCodeRequest, Organization, ParseSAMLMetadataRequest, ParseSAMLMetadataResponse, RedeemSAMLAccessCodeRequest, RedeemSAMLAccessCodeResponse, RotateSCIMDirectoryBearerTokenRequest, RotateSCIMDirectoryBearerTokenResponse, SAMLConnection, SAMLFlow, SAMLOAuthClient, SCIMDirectory, SCIMGroup, SCIMUser, SignInRequest, SignInResponse, SignOutRequest, SignOutResponse, UpdateEnvironmentCustomDomainSettingsRequest, UpdateEnvironmentCustomDomainSettingsResponse, UpdateEnvironmentRequest, UpdateOnboardingStateRequest, UpdateOrganizationRequest, UpdateOrganizationResponse, UpdateSAMLConnectionRequest, UpdateSAMLConnectionResponse, UpdateSCIMDirectoryRequest, UpdateSCIMDirectoryResponse, VerifyEmailRequest, WhoamiRequest, WhoamiResponse } from "./ssoready_pb.js";
+import { AdminCreateSAMLConnectionRequest, AdminCreateSAMLConnectionResponse, AdminCreateSCIMDirectoryRequest, AdminCreateSCIMDirectoryResponse, AdminCreateTestModeSAMLFlowRequest, AdminCreateTestModeSAMLFlowResponse, AdminGetSAMLConnectionRequest, AdminGetSAMLConnectionResponse, AdminGetSAMLConnectionSPSigningCertificateRequest, AdminGetSAMLConnectionSPSigningCertificateResponse, AdminGetSAMLFlowRequest, AdminGetSAMLFlowResponse, AdminGetSCIMDirectoryRequest, AdminGetSCIMDirectoryResponse, AdminListSAMLConnectionsRequest, AdminListSAMLConnectionsResponse, AdminListSAMLFlowsRequest, AdminListSAMLFlowsResponse, AdminListSCIMDirectoriesRequest, AdminListSCIMDirectoriesResponse, AdminParseSAMLMetadataRequest, AdminParseSAMLMetadataResponse, AdminRedeemOneTimeTokenRequest, AdminRedeemOneTimeTokenResponse, AdminRotateSCIMDirectoryBearerTokenRequest, AdminRotateSCIMDirectoryBearerTokenResponse, AdminUpdateSAMLConnectionRequest, AdminUpdateSAMLConnectionResponse, AdminUpdateSCIMDirectoryRequest, AdminUpdateSCIMDirectoryResponse, AdminWhoamiRequest, AdminWhoamiResponse, APIKey, AppAcceptSAMLConnectionIDPMetadataUpdateRequest, AppCreateAdminSetupURLRequest, AppCreateAdminSetupURLResponse, AppCreateOrganizationRequest, AppCreateSAMLConnectionIDPCertificateRequest, AppCreateSAMLConnectionRequest, AppCreateSCIMDirectoryRequest, AppDeleteOrganizationRequest, AppDeleteSAMLConnectionIDPCertificateRequest, AppDeleteSAMLConnectionRequest, AppDeleteSCIMDirectoryRequest, AppGetAdminSettingsRequest, AppGetAdminSettingsResponse, AppGetOrganizationRequest, AppGetSAMLConnectionRequest, AppGetSAMLConnectionSPSigningCertificateRequest, AppGetSAMLConnectionSPSigningCertificateResponse, AppGetSAMLFlowRequest, AppGetSCIMDirectoryRequest, AppGetSCIMGroupRequest, AppGetSCIMRequestRequest, AppGetSCIMRequestResponse, AppGetSCIMUserRequest, AppListOrganizationsRequest, AppListOrganizationsResponse, AppListSAMLConnectionIDPCertificatesRequest, AppListSAMLConnectionIDPCertificatesResponse, AppListSAMLConnectionIDPMetadataUpdatesRequest, AppListSAMLConnectionIDPMetadataUpdatesResponse, AppListSAMLConnectionsRequest, AppListSAMLConnectionsResponse, AppListSAMLFlowsRequest, AppListSAMLFlowsResponse, AppListSCIMDirectoriesRequest, AppListSCIMDirectoriesResponse, AppListSCIMGroupsRequest, AppListSCIMGroupsResponse, AppListSCIMRequestsRequest, AppListSCIMRequestsResponse, AppListSCIMUsersRequest, AppListSCIMUsersResponse, AppRotateSAMLConnectionSPCertificateRequest, AppRotateSCIMDirectoryBearerTokenRequest, AppRotateSCIMDirectoryBearerTokenResponse, AppUpdateAdminSettingsLogoRequest, AppUpdateAdminSettingsLogoResponse, AppUpdateAdminSettingsRequest, AppUpdateAdminSettingsResponse, AppUpdateOrganizationRequest, AppUpdateSAMLConnectionRequest, AppUpdateSCIMDirectoryRequest, CheckEnvironmentCustomDomainSettingsCertificatesRequest, CheckEnvironmentCustomDomainSettingsCertificatesResponse, CreateAPIKeyRequest, CreateEnvironmentRequest, CreateOrganizationRequest, CreateOrganizationResponse, CreateSAMLConnectionRequest, CreateSAMLConnectionResponse, CreateSAMLOAuthClientRequest, CreateSCIMDirectoryRequest, CreateSCIMDirectoryResponse, CreateSetupURLRequest, CreateSetupURLResponse, DeleteAPIKeyRequest, DeleteSAMLOAuthClientRequest, Environment, GetAPIKeyRequest, GetAppOrganizationRequest, GetAppOrganizationResponse, GetEnvironmentCustomDomainSettingsRequest, GetEnvironmentCustomDomainSettingsResponse, GetEnvironmentRequest, GetOnboardingStateRequest, GetOnboardingStateResponse, GetOrganizationRequest, GetOrganizationResponse, GetSAMLConnectionRequest, GetSAMLConnectionResponse, GetSAMLConnectionSPSigningCertificateRequest, GetSAMLConnectionSPSigningCertificateResponse, GetSAMLLogoutRedirectURLRequest, GetSAMLLogoutRedirectURLResponse, GetSAMLOAuthClientRequest, GetSAMLRedirectURLRequest, GetSAMLRedirectURLResponse, GetSCIMDirectoryRequest, GetSCIMDirectoryResponse, GetSCIMGroupRequest, GetSCIMGroupResponse, GetSCIMUserRequest, GetSCIMUserResponse, ListAPIKeysRequest, ListAPIKeysResponse, ListAppUsersRequest, ListAppUsersResponse, ListEnvironmentsRequest, ListEnvironmentsResponse, ListExpiringSAMLConnectionsRequest, ListExpiringSAMLConnectionsResponse, ListOrganizationsRequest, ListOrganizationsResponse, ListSAMLConnectionsRequest, ListSAMLConnectionsResponse, ListSAMLLogoutsRequest, ListSAMLLogoutsResponse, ListSAMLOAuthClientsRequest, ListSAMLOAuthClientsResponse, ListSCIMDirectoriesRequest, ListSCIMDirectoriesResponse, ListSCIMGroupsRequest, ListSCIMGroupsResponse, ListSCIMUsersRequest, ListSCIMUsersResponse, OnboardingGetSAMLRedirectURLRequest, OnboardingRedeemSAMLAccessCodeRequest, Organization, ParseSAMLMetadataRequest, ParseSAMLMetadataResponse, RedeemSAMLAccessCodeRequest, RedeemSAMLAccessCodeResponse, RotateSAMLConnectionSPCertificateRequest, RotateSAMLConnectionSPCertificateResponse, RotateSCIMDirectoryBearerTokenRequest, RotateSCIMDirectoryBearerTokenResponse, SAMLConnection, SAMLConnectionIDPCertificate, SAMLConnectionIDPMetadataUpdate, SAMLFlow, SAMLOAuthClient, SCIMDirectory, SCIMGroup, SCIMUser, SignInRequest, SignInResponse, SignOutRequest, SignOutResponse, UpdateEnvironmentCustomDomainSettingsRequest, UpdateEnvironmentCustomDomainSettingsResponse, UpdateEnvironmentRequest, UpdateOnboardingStateRequest, UpdateOrganizationRequest, UpdateOrganizationResponse, UpdateSAMLConnectionRequest, UpdateSAMLConnectionResponse, UpdateSCIMDirectoryRequest, UpdateSCIMDirectoryResponse, VerifyEmailRequest, WhoamiRequest, WhoamiResponse } from "./ssoready_pb.js";
 
 /**
  * Gets a SAML initiation URL to redirect your users to.
@@ -38,6 +38,40 @@ export const redeemSAMLAccessCode = {
   }
 } as const;
 
+/**
+ * Gets a URL to redirect your users to in order to log them out of their Identity Provider.
+ *
+ * @generated from rpc ssoready.v1.SSOReadyService.GetSAMLLogoutRedirectURL
+ */
+export const getSAMLLogoutRedirectURL = {
+  localName: "getSAMLLogoutRedirectURL",
+  name: "GetSAMLLogoutRedirectURL",
+  kind: MethodKind.Unary,
+  I: GetSAMLLogoutRedirectURLRequest,
+  O: GetSAMLLogoutRedirectURLResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * Gets a list of SAML logouts in a SAML connection.
+ *
+ * Poll this endpoint to find out which of your users' sessions their Identity Provider has asked you to terminate.
+ *
+ * @generated from rpc ssoready.v1.SSOReadyService.ListSAMLLogouts
+ */
+export const listSAMLLogouts = {
+  localName: "listSAMLLogouts",
+  name: "ListSAMLLogouts",
+  kind: MethodKind.Unary,
+  I: ListSAMLLogoutsRequest,
+  O: ListSAMLLogoutsResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
 /**
  * Gets a list of SCIM users in a SCIM directory.
  *
@@ -200,6 +234,25 @@ export const listSAMLConnections = {
   }
 } as const;
 
+/**
+ * Lists SAML connections whose IDP certificate expires soon, across all organizations.
+ *
+ * Once a SAML connection's IDP certificate expires, your customer's users can no longer log in until your customer
+ * gives you a new one. Use this endpoint to find those customers ahead of time.
+ *
+ * @generated from rpc ssoready.v1.SSOReadyService.ListExpiringSAMLConnections
+ */
+export const listExpiringSAMLConnections = {
+  localName: "listExpiringSAMLConnections",
+  name: "ListExpiringSAMLConnections",
+  kind: MethodKind.Unary,
+  I: ListExpiringSAMLConnectionsRequest,
+  O: ListExpiringSAMLConnectionsResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
 /**
  * Gets a SAML connection.
  *
@@ -248,6 +301,44 @@ export const updateSAMLConnection = {
   }
 } as const;
 
+/**
+ * Rotates a SAML connection's SP certificate.
+ *
+ * SSOReady continues to accept assertions encrypted using the previous SP certificate until the next rotation, so
+ * that your customer has time to update their Identity Provider with the new value.
+ *
+ * @generated from rpc ssoready.v1.SSOReadyService.RotateSAMLConnectionSPCertificate
+ */
+export const rotateSAMLConnectionSPCertificate = {
+  localName: "rotateSAMLConnectionSPCertificate",
+  name: "RotateSAMLConnectionSPCertificate",
+  kind: MethodKind.Unary,
+  I: RotateSAMLConnectionSPCertificateRequest,
+  O: RotateSAMLConnectionSPCertificateResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * Gets a SAML connection's SP signing certificate.
+ *
+ * If the SAML connection has sign_authn_requests enabled, your customer needs to input this certificate into their
+ * Identity Provider, so that it can verify the SAML requests SSOReady sends it.
+ *
+ * @generated from rpc ssoready.v1.SSOReadyService.GetSAMLConnectionSPSigningCertificate
+ */
+export const getSAMLConnectionSPSigningCertificate = {
+  localName: "getSAMLConnectionSPSigningCertificate",
+  name: "GetSAMLConnectionSPSigningCertificate",
+  kind: MethodKind.Unary,
+  I: GetSAMLConnectionSPSigningCertificateRequest,
+  O: GetSAMLConnectionSPSigningCertificateResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
 /**
  * Gets a list of SCIM directories in an organization.
  *
@@ -870,6 +961,34 @@ export const appUpdateSAMLConnection = {
   }
 } as const;
 
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppRotateSAMLConnectionSPCertificate
+ */
+export const appRotateSAMLConnectionSPCertificate = {
+  localName: "appRotateSAMLConnectionSPCertificate",
+  name: "AppRotateSAMLConnectionSPCertificate",
+  kind: MethodKind.Unary,
+  I: AppRotateSAMLConnectionSPCertificateRequest,
+  O: SAMLConnection,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppGetSAMLConnectionSPSigningCertificate
+ */
+export const appGetSAMLConnectionSPSigningCertificate = {
+  localName: "appGetSAMLConnectionSPSigningCertificate",
+  name: "AppGetSAMLConnectionSPSigningCertificate",
+  kind: MethodKind.Unary,
+  I: AppGetSAMLConnectionSPSigningCertificateRequest,
+  O: AppGetSAMLConnectionSPSigningCertificateResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
 /**
  * @generated from rpc ssoready.v1.SSOReadyService.AppDeleteSAMLConnection
  */
@@ -884,6 +1003,76 @@ export const appDeleteSAMLConnection = {
   }
 } as const;
 
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPCertificates
+ */
+export const appListSAMLConnectionIDPCertificates = {
+  localName: "appListSAMLConnectionIDPCertificates",
+  name: "AppListSAMLConnectionIDPCertificates",
+  kind: MethodKind.Unary,
+  I: AppListSAMLConnectionIDPCertificatesRequest,
+  O: AppListSAMLConnectionIDPCertificatesResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppCreateSAMLConnectionIDPCertificate
+ */
+export const appCreateSAMLConnectionIDPCertificate = {
+  localName: "appCreateSAMLConnectionIDPCertificate",
+  name: "AppCreateSAMLConnectionIDPCertificate",
+  kind: MethodKind.Unary,
+  I: AppCreateSAMLConnectionIDPCertificateRequest,
+  O: SAMLConnectionIDPCertificate,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppDeleteSAMLConnectionIDPCertificate
+ */
+export const appDeleteSAMLConnectionIDPCertificate = {
+  localName: "appDeleteSAMLConnectionIDPCertificate",
+  name: "AppDeleteSAMLConnectionIDPCertificate",
+  kind: MethodKind.Unary,
+  I: AppDeleteSAMLConnectionIDPCertificateRequest,
+  O: Empty,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPMetadataUpdates
+ */
+export const appListSAMLConnectionIDPMetadataUpdates = {
+  localName: "appListSAMLConnectionIDPMetadataUpdates",
+  name: "AppListSAMLConnectionIDPMetadataUpdates",
+  kind: MethodKind.Unary,
+  I: AppListSAMLConnectionIDPMetadataUpdatesRequest,
+  O: AppListSAMLConnectionIDPMetadataUpdatesResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AppAcceptSAMLConnectionIDPMetadataUpdate
+ */
+export const appAcceptSAMLConnectionIDPMetadataUpdate = {
+  localName: "appAcceptSAMLConnectionIDPMetadataUpdate",
+  name: "AppAcceptSAMLConnectionIDPMetadataUpdate",
+  kind: MethodKind.Unary,
+  I: AppAcceptSAMLConnectionIDPMetadataUpdateRequest,
+  O: SAMLConnectionIDPMetadataUpdate,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
 /**
  * @generated from rpc ssoready.v1.SSOReadyService.AppListSAMLFlows
  */
@@ -1192,6 +1381,20 @@ export const adminUpdateSAMLConnection = {
   }
 } as const;
 
+/**
+ * @generated from rpc ssoready.v1.SSOReadyService.AdminGetSAMLConnectionSPSigningCertificate
+ */
+export const adminGetSAMLConnectionSPSigningCertificate = {
+  localName: "adminGetSAMLConnectionSPSigningCertificate",
+  name: "AdminGetSAMLConnectionSPSigningCertificate",
+  kind: MethodKind.Unary,
+  I: AdminGetSAMLConnectionSPSigningCertificateRequest,
+  O: AdminGetSAMLConnectionSPSigningCertificateResponse,
+  service: {
+    typeName: "ssoready.v1.SSOReadyService"
+  }
+} as const;
+
 /**
  * @generated from rpc ssoready.v1.SSOReadyService.AdminParseSAMLMetadata
  */
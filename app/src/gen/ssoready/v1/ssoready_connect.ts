@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { AdminCreateSAMLConnectionRequest, AdminCreateSAMLConnectionResponse, AdminCreateSCIMDirectoryRequest, AdminCreateSCIMDirectoryResponse, AdminCreateTestModeSAMLFlowRequest, AdminCreateTestModeSAMLFlowResponse, AdminGetSAMLConnectionRequest, AdminGetSAMLConnectionResponse, AdminGetSAMLFlowRequest, AdminGetSAMLFlowResponse, AdminGetSCIMDirectoryRequest, AdminGetSCIMDirectoryResponse, AdminListSAMLConnectionsRequest, AdminListSAMLConnectionsResponse, AdminListSAMLFlowsRequest, AdminListSAMLFlowsResponse, AdminListSCIMDirectoriesRequest, AdminListSCIMDirectoriesResponse, AdminParseSAMLMetadataRequest, AdminParseSAMLMetadataResponse, AdminRedeemOneTimeTokenRequest, AdminRedeemOneTimeTokenResponse, AdminRotateSCIMDirectoryBearerTokenRequest, AdminRotateSCIMDirectoryBearerTokenResponse, AdminUpdateSAMLConnectionRequest, AdminUpdateSAMLConnectionResponse, AdminUpdateSCIMDirectoryRequest, AdminUpdateSCIMDirectoryResponse, AdminWhoamiRequest, AdminWhoamiResponse, APIKey, AppCreateAdminSetupURLRequest, AppCreateAdminSetupURLResponse, AppCreateOrganizationRequest, AppCreateSAMLConnectionRequest, AppCreateSCIMDirectoryRequest, AppDeleteOrganizationRequest, AppDeleteSAMLConnectionRequest, AppDeleteSCIMDirectoryRequest, AppGetAdminSettingsRequest, AppGetAdminSettingsResponse, AppGetOrganizationRequest, AppGetSAMLConnectionRequest, AppGetSAMLFlowRequest, AppGetSCIMDirectoryRequest, AppGetSCIMGroupRequest, AppGetSCIMRequestRequest, AppGetSCIMRequestResponse, AppGetSCIMUserRequest, AppListOrganizationsRequest, AppListOrganizationsResponse, AppListSAMLConnectionsRequest, AppListSAMLConnectionsResponse, AppListSAMLFlowsRequest, AppListSAMLFlowsResponse, AppListSCIMDirectoriesRequest, AppListSCIMDirectoriesResponse, AppListSCIMGroupsRequest, AppListSCIMGroupsResponse, AppListSCIMRequestsRequest, AppListSCIMRequestsResponse, AppListSCIMUsersRequest, AppListSCIMUsersResponse, AppRotateSCIMDirectoryBearerTokenRequest, AppRotateSCIMDirectoryBearerTokenResponse, AppUpdateAdminSettingsLogoRequest, AppUpdateAdminSettingsLogoResponse, AppUpdateAdminSettingsRequest, AppUpdateAdminSettingsResponse, AppUpdateOrganizationRequest, AppUpdateSAMLConnectionRequest, AppUpdateSCIMDirectoryRequest, CheckEnvironmentCustomDomainSettingsCertificatesRequest, CheckEnvironmentCustomDomainSettingsCertificatesResponse, CreateAPIKeyRequest, CreateEnvironmentRequest, CreateOrganizationRequest, CreateOrganizationResponse, CreateSAMLConnectionRequest, CreateSAMLConnectionResponse, CreateSAMLOAuthClientRequest, CreateSCIMDirectoryRequest, CreateSCIMDirectoryResponse, CreateSetupURLRequest, CreateSetupURLResponse, DeleteAPIKeyRequest, DeleteSAMLOAuthClientRequest, Environment, GetAPIKeyRequest, GetAppOrganizationRequest, GetAppOrganizationResponse, GetEnvironmentCustomDomainSettingsRequest, GetEnvironmentCustomDomainSettingsResponse, GetEnvironmentRequest, GetOnboardingStateRequest, GetOnboardingStateResponse, GetOrganizationRequest, GetOrganizationResponse, GetSAMLConnectionRequest, GetSAMLConnectionResponse, GetSAMLOAuthClientRequest, GetSAMLRedirectURLRequest, GetSAMLRedirectURLResponse, GetSCIMDirectoryRequest, GetSCIMDirectoryResponse, GetSCIMGroupRequest, GetSCIMGroupResponse, GetSCIMUserRequest, GetSCIMUserResponse, ListAPIKeysRequest, ListAPIKeysResponse, ListAppUsersRequest, ListAppUsersResponse, ListEnvironmentsRequest, ListEnvironmentsResponse, ListOrganizationsRequest, ListOrganizationsResponse, ListSAMLConnectionsRequest, ListSAMLConnectionsResponse, ListSAMLOAuthClientsRequest, ListSAMLOAuthClientsResponse, ListSCIMDirectoriesRequest, ListSCIMDirectoriesResponse, ListSCIMGroupsRequest, ListSCIMGroupsResponse, ListSCIMUsersRequest, ListSCIMUsersResponse, OnboardingGetSAMLRedirectURLRequest, OnboardingRedeemSAMLAccessCodeRequest, Organization, ParseSAMLMetadataRequest, ParseSAMLMetadataResponse, RedeemSAMLAccessCodeRequest, RedeemSAMLAccessCodeResponse, RotateSCIMDirectoryBearerTokenRequest, RotateSCIMDirectoryBearerTokenResponse, SAMLConnection, SAMLFlow, SAMLOAuthClient, SCIMDirectory, SCIMGroup, SCIMUser, SignInRequest, SignInResponse, SignOutRequest, SignOutResponse, UpdateEnvironmentCustomDomainSettingsRequest, UpdateEnvironmentCustomDomainSettingsResponse, UpdateEnvironmentRequest, UpdateOnboardingStateRequest, UpdateOrganizationRequest, UpdateOrganizationResponse, UpdateSAMLConnectionRequest, UpdateSAMLConnectionResponse, UpdateSCIMDirectoryRequest, UpdateSCIMDirectoryResponse, VerifyEmailRequest, WhoamiRequest, WhoamiResponse } from "./ssoready_pb.js";
+import { AdminCreateSAMLConnectionRequest, AdminCreateSAMLConnectionResponse, AdminCreateSCIMDirectoryRequest, AdminCreateSCIMDirectoryResponse, AdminCreateTestModeSAMLFlowRequest, AdminCreateTestModeSAMLFlowResponse, AdminGetSAMLConnectionRequest, AdminGetSAMLConnectionResponse, AdminGetSAMLConnectionSPSigningCertificateRequest, AdminGetSAMLConnectionSPSigningCertificateResponse, AdminGetSAMLFlowRequest, AdminGetSAMLFlowResponse, AdminGetSCIMDirectoryRequest, AdminGetSCIMDirectoryResponse, AdminListSAMLConnectionsRequest, AdminListSAMLConnectionsResponse, AdminListSAMLFlowsRequest, AdminListSAMLFlowsResponse, AdminListSCIMDirectoriesRequest, AdminListSCIMDirectoriesResponse, AdminParseSAMLMetadataRequest, AdminParseSAMLMetadataResponse, AdminRedeemOneTimeTokenRequest, AdminRedeemOneTimeTokenResponse, AdminRotateSCIMDirectoryBearerTokenRequest, AdminRotateSCIMDirectoryBearerTokenResponse, AdminUpdateSAMLConnectionRequest, AdminUpdateSAMLConnectionResponse, AdminUpdateSCIMDirectoryRequest, AdminUpdateSCIMDirectoryResponse, AdminWhoamiRequest, AdminWhoamiResponse, APIKey, AppAcceptSAMLConnectionIDPMetadataUpdateRequest, AppCreateAdminSetupURLRequest, AppCreateAdminSetupURLResponse, AppCreateOrganizationRequest, AppCreateSAMLConnectionIDPCertificateRequest, AppCreateSAMLConnectionRequest, AppCreateSCIMDirectoryRequest, AppDeleteOrganizationRequest, AppDeleteSAMLConnectionIDPCertificateRequest, AppDeleteSAMLConnectionRequest, AppDeleteSCIMDirectoryRequest, AppGetAdminSettingsRequest, AppGetAdminSettingsResponse, AppGetOrganizationRequest, AppGetSAMLConnectionRequest, AppGetSAMLConnectionSPSigningCertificateRequest, AppGetSAMLConnectionSPSigningCertificateResponse, AppGetSAMLFlowRequest, AppGetSCIMDirectoryRequest, AppGetSCIMGroupRequest, AppGetSCIMRequestRequest, AppGetSCIMRequestResponse, AppGetSCIMUserRequest, AppListOrganizationsRequest, AppListOrganizationsResponse, AppListSAMLConnectionIDPCertificatesRequest, AppListSAMLConnectionIDPCertificatesResponse, AppListSAMLConnectionIDPMetadataUpdatesRequest, AppListSAMLConnectionIDPMetadataUpdatesResponse, AppListSAMLConnectionsRequest, AppListSAMLConnectionsResponse, AppListSAMLFlowsRequest, AppListSAMLFlowsResponse, AppListSCIMDirectoriesRequest, AppListSCIMDirectoriesResponse, AppListSCIMGroupsRequest, AppListSCIMGroupsResponse, AppListSCIMRequestsRequest, AppListSCIMRequestsResponse, AppListSCIMUsersRequest, AppListSCIMUsersResponse, AppRotateSAMLConnectionSPCertificateRequest, AppRotateSCIMDirectoryBearerTokenRequest, AppRotateSCIMDirectoryBearerTokenResponse, AppUpdateAdminSettingsLogoRequest, AppUpdateAdminSettingsLogoResponse, AppUpdateAdminSettingsRequest, AppUpdateAdminSettingsResponse, AppUpdateOrganizationRequest, AppUpdateSAMLConnectionRequest, AppUpdateSCIMDirectoryRequest, CheckEnvironmentCustomDomainSettingsCertificatesRequest, CheckEnvironmentCustomDomainSettingsCertificatesResponse, CreateAPIKeyRequest, CreateEnvironmentRequest, CreateOrganizationRequest, CreateOrganizationResponse, CreateSAMLConnectionRequest, CreateSAMLConnectionResponse, CreateSAMLOAuthClientRequest, CreateSCIMDirectoryRequest, CreateSCIMDirectoryResponse, CreateSetupURLRequest, CreateSetupURLResponse, DeleteAPIKeyRequest, DeleteSAMLOAuthClientRequest, Environment, GetAPIKeyRequest, GetAppOrganizationRequest, GetAppOrganizationResponse, GetEnvironmentCustomDomainSettingsRequest, GetEnvironmentCustomDomainSettingsResponse, GetEnvironmentRequest, GetOnboardingStateRequest, GetOnboardingStateResponse, GetOrganizationRequest, GetOrganizationResponse, GetSAMLConnectionRequest, GetSAMLConnectionResponse, GetSAMLConnectionSPSigningCertificateRequest, GetSAMLConnectionSPSigningCertificateResponse, GetSAMLLogoutRedirectURLRequest, GetSAMLLogoutRedirectURLResponse, GetSAMLOAuthClientRequest, GetSAMLRedirectURLRequest, GetSAMLRedirectURLResponse, GetSCIMDirectoryRequest, GetSCIMDirectoryResponse, GetSCIMGroupRequest, GetSCIMGroupResponse, GetSCIMUserRequest, GetSCIMUserResponse, ListAPIKeysRequest, ListAPIKeysResponse, ListAppUsersRequest, ListAppUsersResponse, ListEnvironmentsRequest, ListEnvironmentsResponse, ListExpiringSAMLConnectionsRequest, ListExpiringSAMLConnectionsResponse, ListOrganizationsRequest, ListOrganizationsResponse, ListSAMLConnectionsRequest, ListSAMLConnectionsResponse, ListSAMLLogoutsRequest, ListSAMLLogoutsResponse, ListSAMLOAuthClientsRequest, ListSAMLOAuthClientsResponse, ListSCIMDirectoriesRequest, ListSCIMDirectoriesResponse, ListSCIMGroupsRequest, ListSCIMGroupsResponse, ListSCIMUsersRequest, ListSCIMUsersResponse, OnboardingGetSAMLRedirectURLRequest, OnboardingRedeemSAMLAccessCodeRequest, Organization, ParseSAMLMetadataRequest, ParseSAMLMetadataResponse, RedeemSAMLAccessCodeRequest, RedeemSAMLAccessCodeResponse, RotateSAMLConnectionSPCertificateRequest, RotateSAMLConnectionSPCertificateResponse, RotateSCIMDirectoryBearerTokenRequest, RotateSCIMDirectoryBearerTokenResponse, SAMLConnection, SAMLConnectionIDPCertificate, SAMLConnectionIDPMetadataUpdate, SAMLFlow, SAMLOAuthClient, SCIMDirectory, SCIMGroup, SCIMUser, SignInRequest, SignInResponse, SignOutRequest, SignOutResponse, UpdateEnvironmentCustomDomainSettingsRequest, UpdateEnvironmentCustomDomainSettingsResponse, UpdateEnvironmentRequest, UpdateOnboardingStateRequest, UpdateOrganizationRequest, UpdateOrganizationResponse, UpdateSAMLConnectionRequest, UpdateSAMLConnectionResponse, UpdateSCIMDirectoryRequest, UpdateSCIMDirectoryResponse, VerifyEmailRequest, WhoamiRequest, WhoamiResponse } from "./ssoready_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -34,6 +34,30 @@ export const SSOReadyService = {
       O: RedeemSAMLAccessCodeResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Gets a URL to redirect your users to in order to log them out of their Identity Provider.
+     *
+     * @generated from rpc ssoready.v1.SSOReadyService.GetSAMLLogoutRedirectURL
+     */
+    getSAMLLogoutRedirectURL: {
+      name: "GetSAMLLogoutRedirectURL",
+      I: GetSAMLLogoutRedirectURLRequest,
+      O: GetSAMLLogoutRedirectURLResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets a list of SAML logouts in a SAML connection.
+     *
+     * Poll this endpoint to find out which of your users' sessions their Identity Provider has asked you to terminate.
+     *
+     * @generated from rpc ssoready.v1.SSOReadyService.ListSAMLLogouts
+     */
+    listSAMLLogouts: {
+      name: "ListSAMLLogouts",
+      I: ListSAMLLogoutsRequest,
+      O: ListSAMLLogoutsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Gets a list of SCIM users in a SCIM directory.
      *
@@ -146,6 +170,20 @@ export const SSOReadyService = {
       O: ListSAMLConnectionsResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Lists SAML connections whose IDP certificate expires soon, across all organizations.
+     *
+     * Once a SAML connection's IDP certificate expires, your customer's users can no longer log in until your customer
+     * gives you a new one. Use this endpoint to find those customers ahead of time.
+     *
+     * @generated from rpc ssoready.v1.SSOReadyService.ListExpiringSAMLConnections
+     */
+    listExpiringSAMLConnections: {
+      name: "ListExpiringSAMLConnections",
+      I: ListExpiringSAMLConnectionsRequest,
+      O: ListExpiringSAMLConnectionsResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Gets a SAML connection.
      *
@@ -179,6 +217,34 @@ export const SSOReadyService = {
       O: UpdateSAMLConnectionResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Rotates a SAML connection's SP certificate.
+     *
+     * SSOReady continues to accept assertions encrypted using the previous SP certificate until the next rotation, so
+     * that your customer has time to update their Identity Provider with the new value.
+     *
+     * @generated from rpc ssoready.v1.SSOReadyService.RotateSAMLConnectionSPCertificate
+     */
+    rotateSAMLConnectionSPCertificate: {
+      name: "RotateSAMLConnectionSPCertificate",
+      I: RotateSAMLConnectionSPCertificateRequest,
+      O: RotateSAMLConnectionSPCertificateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Gets a SAML connection's SP signing certificate.
+     *
+     * If the SAML connection has sign_authn_requests enabled, your customer needs to input this certificate into their
+     * Identity Provider, so that it can verify the SAML requests SSOReady sends it.
+     *
+     * @generated from rpc ssoready.v1.SSOReadyService.GetSAMLConnectionSPSigningCertificate
+     */
+    getSAMLConnectionSPSigningCertificate: {
+      name: "GetSAMLConnectionSPSigningCertificate",
+      I: GetSAMLConnectionSPSigningCertificateRequest,
+      O: GetSAMLConnectionSPSigningCertificateResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Gets a list of SCIM directories in an organization.
      *
@@ -586,6 +652,24 @@ export const SSOReadyService = {
       O: SAMLConnection,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppRotateSAMLConnectionSPCertificate
+     */
+    appRotateSAMLConnectionSPCertificate: {
+      name: "AppRotateSAMLConnectionSPCertificate",
+      I: AppRotateSAMLConnectionSPCertificateRequest,
+      O: SAMLConnection,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppGetSAMLConnectionSPSigningCertificate
+     */
+    appGetSAMLConnectionSPSigningCertificate: {
+      name: "AppGetSAMLConnectionSPSigningCertificate",
+      I: AppGetSAMLConnectionSPSigningCertificateRequest,
+      O: AppGetSAMLConnectionSPSigningCertificateResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc ssoready.v1.SSOReadyService.AppDeleteSAMLConnection
      */
@@ -595,6 +679,51 @@ export const SSOReadyService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPCertificates
+     */
+    appListSAMLConnectionIDPCertificates: {
+      name: "AppListSAMLConnectionIDPCertificates",
+      I: AppListSAMLConnectionIDPCertificatesRequest,
+      O: AppListSAMLConnectionIDPCertificatesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppCreateSAMLConnectionIDPCertificate
+     */
+    appCreateSAMLConnectionIDPCertificate: {
+      name: "AppCreateSAMLConnectionIDPCertificate",
+      I: AppCreateSAMLConnectionIDPCertificateRequest,
+      O: SAMLConnectionIDPCertificate,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppDeleteSAMLConnectionIDPCertificate
+     */
+    appDeleteSAMLConnectionIDPCertificate: {
+      name: "AppDeleteSAMLConnectionIDPCertificate",
+      I: AppDeleteSAMLConnectionIDPCertificateRequest,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPMetadataUpdates
+     */
+    appListSAMLConnectionIDPMetadataUpdates: {
+      name: "AppListSAMLConnectionIDPMetadataUpdates",
+      I: AppListSAMLConnectionIDPMetadataUpdatesRequest,
+      O: AppListSAMLConnectionIDPMetadataUpdatesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AppAcceptSAMLConnectionIDPMetadataUpdate
+     */
+    appAcceptSAMLConnectionIDPMetadataUpdate: {
+      name: "AppAcceptSAMLConnectionIDPMetadataUpdate",
+      I: AppAcceptSAMLConnectionIDPMetadataUpdateRequest,
+      O: SAMLConnectionIDPMetadataUpdate,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc ssoready.v1.SSOReadyService.AppListSAMLFlows
      */
@@ -793,6 +922,15 @@ export const SSOReadyService = {
       O: AdminUpdateSAMLConnectionResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * @generated from rpc ssoready.v1.SSOReadyService.AdminGetSAMLConnectionSPSigningCertificate
+     */
+    adminGetSAMLConnectionSPSigningCertificate: {
+      name: "AdminGetSAMLConnectionSPSigningCertificate",
+      I: AdminGetSAMLConnectionSPSigningCertificateRequest,
+      O: AdminGetSAMLConnectionSPSigningCertificateResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * @generated from rpc ssoready.v1.SSOReadyService.AdminParseSAMLMetadata
      */
@@ -2,11 +2,18 @@ import React, { useCallback, useId, useState } from "react";
 import { useMatch, useNavigate, useParams } from "react-router";
 import { useInfiniteQuery, useQuery } from "@connectrpc/connect-query";
 import {
+  appAcceptSAMLConnectionIDPMetadataUpdate,
+  appCreateSAMLConnectionIDPCertificate,
   appDeleteSAMLConnection,
+  appDeleteSAMLConnectionIDPCertificate,
   appDeleteSCIMDirectory,
   appGetOrganization,
   appGetSAMLConnection,
+  appGetSAMLConnectionSPSigningCertificate,
+  appListSAMLConnectionIDPCertificates,
+  appListSAMLConnectionIDPMetadataUpdates,
   appListSAMLFlows,
+  appRotateSAMLConnectionSPCertificate,
   appUpdateSAMLConnection,
   parseSAMLMetadata,
 } from "@/gen/ssoready/v1/ssoready-SSOReadyService_connectquery";
@@ -39,8 +46,15 @@ import moment from "moment";
 import { z } from "zod";
 import {
   Organization,
+  SAMLAttributeMappingPreset,
   SAMLConnection,
+  SAMLBinding,
+  SAMLConnectionIDPCertificate,
+  SAMLConnectionIDPMetadataUpdate,
+  SAMLDigestAlgorithm,
   SAMLFlowStatus,
+  SAMLMetadataWarning,
+  SAMLSignatureAlgorithm,
 } from "@/gen/ssoready/v1/ssoready_pb";
 import { useForm } from "react-hook-form";
 import { zodResolver } from "@hookform/resolvers/zod";
@@ -76,12 +90,21 @@ import {
   BreadcrumbSeparator,
 } from "@/components/ui/breadcrumb";
 import { Label } from "@/components/ui/label";
+import {
+  DropdownMenu,
+  DropdownMenuContent,
+  DropdownMenuRadioGroup,
+  DropdownMenuRadioItem,
+  DropdownMenuTrigger,
+} from "@/components/ui/dropdown-menu";
 import { InputTags } from "@/components/InputTags";
 import { Switch } from "@/components/ui/switch";
 import { DocsLink } from "@/components/DocsLink";
 import { Title } from "@/components/Title";
 import { InfoTooltip } from "@/components/InfoTooltip";
 import { toast } from "sonner";
+import { Timestamp } from "@bufbuild/protobuf";
+import { IDP_VENDOR_LABELS } from "@/lib/idpVendors";
 
 export function ViewSAMLConnectionPage() {
   const { environmentId, organizationId, samlConnectionId } = useParams();
@@ -156,6 +179,55 @@ export function ViewSAMLConnectionPage() {
             <div className="text-sm col-span-3">
               {samlConnection?.primary ? "Yes" : "No"}
             </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Sign Requests
+              <InfoTooltip>
+                Whether SSOReady signs the SAML requests it sends to the IDP.
+                Some IDPs can be configured to require this.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.signAuthnRequests ? "Yes" : "No"}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Allow IDP-initiated Logins
+              <InfoTooltip>
+                Whether users can log in by clicking on your app in their IDP,
+                instead of starting from your product.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.allowIdpInitiated ? "Yes" : "No"}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Allowed Clock Skew
+              <InfoTooltip>
+                How many seconds the IDP's clock may be ahead of or behind
+                SSOReady's when checking whether an assertion has expired.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.allowedClockSkewSeconds ?? 0} seconds
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Email Attributes
+              <InfoTooltip>
+                The SAML attributes SSOReady takes users' email addresses from,
+                in order of preference. If not configured, the IDP's Subject ID
+                must be an email address.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.emailAttributeNames.length ? (
+                samlConnection.emailAttributeNames.join(", ")
+              ) : (
+                <div className="text-sm text-muted-foreground">Subject ID</div>
+              )}
+            </div>
           </div>
         </CardContent>
       </Card>
@@ -194,6 +266,73 @@ export function ViewSAMLConnectionPage() {
             <div className="text-sm col-span-3">
               {samlConnection?.spEntityId}
             </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              SP Metadata URL
+              <InfoTooltip>
+                A URL serving SAML metadata with the settings above. Many IDPs
+                can be configured from this URL directly.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.spMetadataUrl}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Single Logout (SLO) URL
+              <InfoTooltip>
+                An HTTP endpoint that receives SAML logout requests and
+                responses from the IDP.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.spSloUrl}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2 self-start">
+              Encryption Certificate
+              <InfoTooltip>
+                An X.509 certificate the IDP can use to encrypt assertions.
+                Configuring this is optional.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.spCertificate ? (
+                <>
+                  <div className="bg-black rounded-lg px-6 py-4 inline-block">
+                    <code className="text-sm text-white">
+                      <pre>{samlConnection.spCertificate}</pre>
+                    </code>
+                  </div>
+                  <div className="mt-2">
+                    <RotateSPCertificateAlertDialog
+                      samlConnection={samlConnection}
+                    />
+                  </div>
+                </>
+              ) : (
+                <div className="text-sm text-muted-foreground">
+                  Not configured
+                </div>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2 self-start">
+              Signing Certificate
+              <InfoTooltip>
+                An X.509 certificate the IDP can use to verify SAML requests
+                from SSOReady. Only required if "Sign Requests" is enabled.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.signAuthnRequests ? (
+                <SPSigningCertificate samlConnection={samlConnection} />
+              ) : (
+                <div className="text-sm text-muted-foreground">
+                  Not enabled
+                </div>
+              )}
+            </div>
           </div>
         </CardContent>
       </Card>
@@ -221,6 +360,22 @@ export function ViewSAMLConnectionPage() {
         </CardHeader>
         <CardContent>
           <div className="grid grid-cols-5 gap-y-2 items-center">
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Identity Provider
+              <InfoTooltip>
+                The IDP product SSOReady detected from the configuration below.
+                When first detected, it sets a default attribute mapping preset.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.idpVendor ? (
+                IDP_VENDOR_LABELS[samlConnection.idpVendor]
+              ) : (
+                <div className="text-sm text-muted-foreground">
+                  Not detected
+                </div>
+              )}
+            </div>
             <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
               IDP Entity ID
               <InfoTooltip>
@@ -250,6 +405,77 @@ export function ViewSAMLConnectionPage() {
                 </div>
               )}
             </div>
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Single Logout (SLO) URL
+              <InfoTooltip>
+                An HTTP endpoint on the IDP that accepts SAML logout requests.
+                Optional; if not configured, single logout is not supported.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.idpSloUrl || (
+                <div className="text-sm text-muted-foreground">
+                  Not configured
+                </div>
+              )}
+            </div>
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Artifact Resolution URL
+              <InfoTooltip>
+                An HTTP endpoint on the IDP that resolves SAML artifacts.
+                Optional; if not configured, the HTTP-Artifact binding is not
+                supported.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.idpArtifactResolutionUrl || (
+                <div className="text-sm text-muted-foreground">
+                  Not configured
+                </div>
+              )}
+            </div>
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Binding
+              <InfoTooltip>
+                How SSOReady sends SAML initiation requests to the IDP's
+                Redirect URL.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.idpBinding ===
+              SAMLBinding.SAML_BINDING_HTTP_REDIRECT
+                ? "HTTP-Redirect"
+                : "HTTP-POST"}
+            </div>
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Metadata URL
+              <InfoTooltip>
+                If configured, SSOReady periodically fetches the IDP's metadata
+                from this URL, and keeps the IDP Entity ID, Redirect URL, and
+                Certificate in sync with it.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.idpMetadataUrl ? (
+                <>
+                  <div>{samlConnection.idpMetadataUrl}</div>
+                  <div className="text-muted-foreground">
+                    {samlConnection.idpMetadataRefreshTime
+                      ? `Last fetched ${moment(samlConnection.idpMetadataRefreshTime.toDate()).format()}`
+                      : "Not yet fetched"}
+                  </div>
+                  {samlConnection.idpMetadataRefreshError && (
+                    <div className="text-destructive">
+                      {samlConnection.idpMetadataRefreshError}
+                    </div>
+                  )}
+                </>
+              ) : (
+                <div className="text-sm text-muted-foreground">
+                  Not configured
+                </div>
+              )}
+            </div>
 
             <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2 self-start">
               Certificate
@@ -260,28 +486,146 @@ export function ViewSAMLConnectionPage() {
             </div>
             <div className="text-sm col-span-3">
               {samlConnection?.idpCertificate ? (
-                <div className="bg-black rounded-lg px-6 py-4 mt-4 inline-block">
-                  <code className="text-sm text-white">
-                    <pre>{samlConnection?.idpCertificate}</pre>
-                  </code>
-                </div>
+                <>
+                  <div className="grid grid-cols-4 gap-y-1">
+                    <div className="text-muted-foreground">Subject</div>
+                    <div className="col-span-3">
+                      {samlConnection.idpCertificateSubject}
+                    </div>
+                    <div className="text-muted-foreground">Issuer</div>
+                    <div className="col-span-3">
+                      {samlConnection.idpCertificateIssuer}
+                    </div>
+                    <div className="text-muted-foreground">Not Before</div>
+                    <div className="col-span-3">
+                      {samlConnection.idpCertificateNotBefore &&
+                        moment(
+                          samlConnection.idpCertificateNotBefore.toDate(),
+                        ).format()}
+                    </div>
+                    <div className="text-muted-foreground">Not After</div>
+                    <div className="col-span-3">
+                      {samlConnection.idpCertificateNotAfter && (
+                        <IDPCertificateNotAfter
+                          notAfter={samlConnection.idpCertificateNotAfter}
+                        />
+                      )}
+                    </div>
+                  </div>
+                  <div className="bg-black rounded-lg px-6 py-4 mt-4 inline-block">
+                    <code className="text-sm text-white">
+                      <pre>{samlConnection?.idpCertificate}</pre>
+                    </code>
+                  </div>
+                </>
               ) : (
                 <div className="text-sm text-muted-foreground">
                   Not configured
                 </div>
               )}
             </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Signature Algorithms
+              <InfoTooltip>
+                The signature algorithms SSOReady accepts from the IDP. By
+                default, only SHA-256 and stronger are accepted.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.allowedSignatureAlgorithms.length ? (
+                samlConnection.allowedSignatureAlgorithms
+                  .map((alg) => SIGNATURE_ALGORITHM_LABELS[alg])
+                  .join(", ")
+              ) : (
+                <div className="text-sm text-muted-foreground">Default</div>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Digest Algorithms
+              <InfoTooltip>
+                The digest algorithms SSOReady accepts from the IDP. By
+                default, only SHA-256 and stronger are accepted.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlConnection?.allowedDigestAlgorithms.length ? (
+                samlConnection.allowedDigestAlgorithms
+                  .map((alg) => DIGEST_ALGORITHM_LABELS[alg])
+                  .join(", ")
+              ) : (
+                <div className="text-sm text-muted-foreground">Default</div>
+              )}
+            </div>
           </div>
         </CardContent>
       </Card>
+      {samlConnection && (
+        <AdditionalIDPCertificatesCard samlConnection={samlConnection} />
+      )}
+      {samlConnection && (
+        <AttributeMappingCard samlConnection={samlConnection} />
+      )}
+      {samlConnection && (
+        <AuthnRequestOptionsCard samlConnection={samlConnection} />
+      )}
+      {samlConnection?.idpMetadataUrl && (
+        <IDPMetadataUpdatesCard samlConnection={samlConnection} />
+      )}
       <ListLoginFlowsTabContent />
       <DangerZoneCard />
     </div>
   );
 }
 
+function IDPCertificateNotAfter({ notAfter }: { notAfter: Timestamp }) {
+  const expiresAt = moment(notAfter.toDate());
+  if (expiresAt.isBefore(moment())) {
+    return (
+      <span className="text-destructive">
+        {expiresAt.format()} (expired; logins will fail)
+      </span>
+    );
+  }
+
+  if (expiresAt.isBefore(moment().add(30, "days"))) {
+    return (
+      <span className="text-destructive">
+        {expiresAt.format()} (expires {expiresAt.fromNow()})
+      </span>
+    );
+  }
+
+  return <>{expiresAt.format()}</>;
+}
+
+const SIGNATURE_ALGORITHM_LABELS: Record<SAMLSignatureAlgorithm, string> = {
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_UNSPECIFIED]: "Unspecified",
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_RSA_SHA1]: "RSA-SHA1",
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_RSA_SHA256]: "RSA-SHA256",
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_RSA_SHA384]: "RSA-SHA384",
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_RSA_SHA512]: "RSA-SHA512",
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256]:
+    "ECDSA-SHA256",
+  [SAMLSignatureAlgorithm.SAML_SIGNATURE_ALGORITHM_ECDSA_SHA384]:
+    "ECDSA-SHA384",
+};
+
+const DIGEST_ALGORITHM_LABELS: Record<SAMLDigestAlgorithm, string> = {
+  [SAMLDigestAlgorithm.SAML_DIGEST_ALGORITHM_UNSPECIFIED]: "Unspecified",
+  [SAMLDigestAlgorithm.SAML_DIGEST_ALGORITHM_SHA1]: "SHA-1",
+  [SAMLDigestAlgorithm.SAML_DIGEST_ALGORITHM_SHA256]: "SHA-256",
+  [SAMLDigestAlgorithm.SAML_DIGEST_ALGORITHM_SHA384]: "SHA-384",
+  [SAMLDigestAlgorithm.SAML_DIGEST_ALGORITHM_SHA512]: "SHA-512",
+};
+
 const FormSchema = z.object({
   primary: z.boolean(),
+  signAuthnRequests: z.boolean(),
+  allowIdpInitiated: z.boolean(),
+  emailAttributeNames: z.array(z.string()),
+  allowedClockSkewSeconds: z.coerce.number().int().min(0).max(600),
 });
 
 function EditSAMLConnectionAlertDialog({
@@ -293,6 +637,10 @@ function EditSAMLConnectionAlertDialog({
     resolver: zodResolver(FormSchema),
     defaultValues: {
       primary: samlConnection.primary,
+      signAuthnRequests: samlConnection.signAuthnRequests,
+      allowIdpInitiated: samlConnection.allowIdpInitiated,
+      emailAttributeNames: samlConnection.emailAttributeNames,
+      allowedClockSkewSeconds: samlConnection.allowedClockSkewSeconds,
     },
   });
 
@@ -308,7 +656,20 @@ function EditSAMLConnectionAlertDialog({
           primary: values.primary,
           idpEntityId: samlConnection.idpEntityId,
           idpRedirectUrl: samlConnection.idpRedirectUrl,
+          idpSloUrl: samlConnection.idpSloUrl,
+          idpArtifactResolutionUrl: samlConnection.idpArtifactResolutionUrl,
           idpCertificate: samlConnection.idpCertificate,
+          allowedSignatureAlgorithms: samlConnection.allowedSignatureAlgorithms,
+          allowedDigestAlgorithms: samlConnection.allowedDigestAlgorithms,
+          signAuthnRequests: values.signAuthnRequests,
+          idpBinding: samlConnection.idpBinding,
+          allowIdpInitiated: values.allowIdpInitiated,
+          idpMetadataUrl: samlConnection.idpMetadataUrl,
+          emailAttributeNames: values.emailAttributeNames,
+          attributeMappingPreset: samlConnection.attributeMappingPreset,
+          attributeMapping: samlConnection.attributeMapping,
+          authnRequestOptions: samlConnection.authnRequestOptions,
+          allowedClockSkewSeconds: values.allowedClockSkewSeconds,
         },
       });
 
@@ -359,6 +720,92 @@ function EditSAMLConnectionAlertDialog({
                   </FormItem>
                 )}
               />
+
+              <FormField
+                control={form.control}
+                name="signAuthnRequests"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Sign Requests</FormLabel>
+                    <FormControl className="block">
+                      <Switch
+                        name={field.name}
+                        id={field.name}
+                        checked={field.value}
+                        onCheckedChange={field.onChange}
+                      />
+                    </FormControl>
+                    <FormDescription>
+                      If enabled, SSOReady signs the SAML requests it sends to
+                      the IDP. Your customer's IT admin will need to upload the
+                      signing certificate to their IDP.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="allowIdpInitiated"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Allow IDP-initiated Logins</FormLabel>
+                    <FormControl className="block">
+                      <Switch
+                        name={field.name}
+                        id={field.name}
+                        checked={field.value}
+                        onCheckedChange={field.onChange}
+                      />
+                    </FormControl>
+                    <FormDescription>
+                      If enabled, users can log in by clicking on your app in
+                      their IDP. These logins are redirected to your
+                      environment's redirect URL without a state.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="emailAttributeNames"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Email Attributes</FormLabel>
+                    <FormControl>
+                      <InputTags {...field} />
+                    </FormControl>
+                    <FormDescription>
+                      SAML attributes to take users' email addresses from, in
+                      order of preference, such as "email" or "mail". Leave
+                      empty if the IDP's Subject ID is the user's email
+                      address.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="allowedClockSkewSeconds"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Allowed Clock Skew (seconds)</FormLabel>
+                    <FormControl>
+                      <Input type="number" min={0} max={600} {...field} />
+                    </FormControl>
+                    <FormDescription>
+                      How far the IDP's clock may drift from SSOReady's before
+                      assertions are rejected as expired. At most 600 seconds.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
             </div>
             <AlertDialogFooter>
               <AlertDialogCancel>Cancel</AlertDialogCancel>
@@ -371,6 +818,100 @@ function EditSAMLConnectionAlertDialog({
   );
 }
 
+function SPSigningCertificate({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const { data: spSigningCertificate } = useQuery(
+    appGetSAMLConnectionSPSigningCertificate,
+    {
+      samlConnectionId: samlConnection.id,
+    },
+  );
+
+  const handleDownload = () => {
+    const blob = new Blob([spSigningCertificate!.certificate], {
+      type: "application/x-pem-file",
+    });
+    const url = URL.createObjectURL(blob);
+    const a = document.createElement("a");
+    a.href = url;
+    a.download = `${samlConnection.id}-signing-certificate.pem`;
+    a.click();
+    URL.revokeObjectURL(url);
+  };
+
+  if (!spSigningCertificate) {
+    return null;
+  }
+
+  return (
+    <>
+      <div className="bg-black rounded-lg px-6 py-4 inline-block">
+        <code className="text-sm text-white">
+          <pre>{spSigningCertificate.certificate}</pre>
+        </code>
+      </div>
+      <div className="mt-2">
+        <Button variant="outline" onClick={handleDownload}>
+          Download
+        </Button>
+      </div>
+    </>
+  );
+}
+
+function RotateSPCertificateAlertDialog({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const [open, setOpen] = useState(false);
+  const rotateSAMLConnectionSPCertificateMutation = useMutation(
+    appRotateSAMLConnectionSPCertificate,
+  );
+  const queryClient = useQueryClient();
+
+  const handleRotate = async () => {
+    await rotateSAMLConnectionSPCertificateMutation.mutateAsync({
+      samlConnectionId: samlConnection.id,
+    });
+
+    await queryClient.invalidateQueries({
+      queryKey: createConnectQueryKey(appGetSAMLConnection, {
+        id: samlConnection.id,
+      }),
+    });
+
+    toast.success("Encryption certificate rotated");
+    setOpen(false);
+  };
+
+  return (
+    <AlertDialog open={open} onOpenChange={setOpen}>
+      <AlertDialogTrigger asChild>
+        <Button variant="outline">Rotate</Button>
+      </AlertDialogTrigger>
+      <AlertDialogContent>
+        <AlertDialogHeader>
+          <AlertDialogTitle>Rotate encryption certificate?</AlertDialogTitle>
+          <AlertDialogDescription>
+            SSOReady will generate a new encryption certificate. Assertions
+            encrypted with the current certificate will continue to work until
+            the next rotation, so your customer's IT admin has time to upload
+            the new certificate to their IDP.
+          </AlertDialogDescription>
+        </AlertDialogHeader>
+        <AlertDialogFooter>
+          <AlertDialogCancel>Cancel</AlertDialogCancel>
+          <Button onClick={handleRotate}>Rotate</Button>
+        </AlertDialogFooter>
+      </AlertDialogContent>
+    </AlertDialog>
+  );
+}
+
 function ListLoginFlowsTabContent() {
   const { environmentId, organizationId, samlConnectionId } = useParams();
   const {
@@ -453,17 +994,486 @@ function ListLoginFlowsTabContent() {
   );
 }
 
-const IDPSettingsFormSchema = z.object({
-  idpEntityId: z.string().min(1, {
-    message: "IDP Entity ID must be non-empty.",
-  }),
-  idpRedirectUrl: z.string().url({
-    message: "IDP Redirect URL must be a valid URL.",
-  }),
-  idpCertificate: z.string().startsWith("-----BEGIN CERTIFICATE-----", {
-    message: "IDP Certificate must be a PEM-encoded X.509 certificate.",
-  }),
-});
+function AdditionalIDPCertificatesCard({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const { data: listIDPCertificatesResponse } = useQuery(
+    appListSAMLConnectionIDPCertificates,
+    {
+      samlConnectionId: samlConnection.id,
+    },
+  );
+
+  return (
+    <Card>
+      <CardHeader>
+        <div className="flex justify-between items-center">
+          <div className="flex flex-col space-y-1.5">
+            <CardTitle>Additional IDP Certificates</CardTitle>
+            <CardDescription>
+              Certificates SSOReady trusts alongside the IDP certificate above.
+              Add your customer's next certificate here ahead of a rollover,
+              and assertions signed with either certificate will be accepted.
+            </CardDescription>
+          </div>
+
+          <CreateIDPCertificateAlertDialog samlConnection={samlConnection} />
+        </div>
+      </CardHeader>
+      <CardContent>
+        <Table>
+          <TableHeader>
+            <TableRow>
+              <TableHead>Certificate</TableHead>
+              <TableHead>Not Before</TableHead>
+              <TableHead>Not After</TableHead>
+              <TableHead />
+            </TableRow>
+          </TableHeader>
+
+          <TableBody>
+            {listIDPCertificatesResponse?.samlConnectionIdpCertificates.map(
+              (idpCertificate) => (
+                <TableRow key={idpCertificate.id}>
+                  <TableCell>
+                    <Collapsible>
+                      <CollapsibleTrigger className="text-sm underline underline-offset-4 decoration-muted-foreground">
+                        {idpCertificate.id}
+                      </CollapsibleTrigger>
+                      {idpCertificate.fromIdpMetadata && (
+                        <Badge variant="secondary" className="ml-2">
+                          From IDP metadata
+                        </Badge>
+                      )}
+                      <CollapsibleContent>
+                        <div className="bg-black rounded-lg px-6 py-4 mt-4 inline-block">
+                          <code className="text-sm text-white">
+                            <pre>{idpCertificate.certificate}</pre>
+                          </code>
+                        </div>
+                      </CollapsibleContent>
+                    </Collapsible>
+                  </TableCell>
+                  <TableCell>
+                    {idpCertificate.notBefore ? (
+                      moment(idpCertificate.notBefore.toDate()).format()
+                    ) : (
+                      <span className="text-muted-foreground">None</span>
+                    )}
+                  </TableCell>
+                  <TableCell>
+                    {idpCertificate.notAfter ? (
+                      moment(idpCertificate.notAfter.toDate()).format()
+                    ) : (
+                      <span className="text-muted-foreground">None</span>
+                    )}
+                  </TableCell>
+                  <TableCell className="text-right">
+                    <DeleteIDPCertificateAlertDialog
+                      samlConnection={samlConnection}
+                      idpCertificate={idpCertificate}
+                    />
+                  </TableCell>
+                </TableRow>
+              ),
+            )}
+          </TableBody>
+        </Table>
+      </CardContent>
+    </Card>
+  );
+}
+
+function IDPMetadataUpdatesCard({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const {
+    data: listIDPMetadataUpdatesResponses,
+    fetchNextPage,
+    hasNextPage,
+    refetch,
+  } = useInfiniteQuery(
+    appListSAMLConnectionIDPMetadataUpdates,
+    { samlConnectionId: samlConnection.id, pageToken: "" },
+    {
+      pageParamKey: "pageToken",
+      getNextPageParam: (lastPage) => lastPage.nextPageToken || undefined,
+    },
+  );
+
+  return (
+    <Card>
+      <CardHeader>
+        <CardTitle>IDP Metadata Updates</CardTitle>
+        <CardDescription>
+          Changes SSOReady found after fetching this SAML connection's IDP
+          Metadata URL. Changes to the IDP Entity ID are not applied until you
+          accept them, in case the URL now describes a different Identity
+          Provider.
+        </CardDescription>
+      </CardHeader>
+      <CardContent>
+        <Table>
+          <TableHeader>
+            <TableRow>
+              <TableHead>Timestamp</TableHead>
+              <TableHead>Changes</TableHead>
+              <TableHead>Status</TableHead>
+            </TableRow>
+          </TableHeader>
+
+          <TableBody>
+            {listIDPMetadataUpdatesResponses?.pages
+              .flatMap((page) => page.samlConnectionIdpMetadataUpdates)
+              .map((update) => (
+                <TableRow key={update.id}>
+                  <TableCell className="align-top">
+                    {update.createTime &&
+                      moment(update.createTime.toDate()).format()}
+                  </TableCell>
+                  <TableCell>
+                    {update.idpEntityId && (
+                      <div>
+                        Entity ID: {update.previousIdpEntityId} &rarr;{" "}
+                        {update.idpEntityId}
+                      </div>
+                    )}
+                    {update.idpRedirectUrl && (
+                      <div>
+                        Redirect URL: {update.previousIdpRedirectUrl} &rarr;{" "}
+                        {update.idpRedirectUrl}
+                      </div>
+                    )}
+                    {update.idpCertificate && (
+                      <Collapsible>
+                        <CollapsibleTrigger className="text-sm underline underline-offset-4 decoration-muted-foreground">
+                          Certificate changed
+                        </CollapsibleTrigger>
+                        <CollapsibleContent>
+                          <div className="bg-black rounded-lg px-6 py-4 mt-4 inline-block">
+                            <code className="text-sm text-white">
+                              <pre>{update.idpCertificate}</pre>
+                            </code>
+                          </div>
+                        </CollapsibleContent>
+                      </Collapsible>
+                    )}
+                  </TableCell>
+                  <TableCell className="align-top">
+                    {update.pending ? (
+                      <AcceptIDPMetadataUpdateAlertDialog
+                        samlConnection={samlConnection}
+                        idpMetadataUpdate={update}
+                        onAccept={refetch}
+                      />
+                    ) : update.acceptTime ? (
+                      <Badge variant="secondary">
+                        Accepted {moment(update.acceptTime.toDate()).format()}
+                      </Badge>
+                    ) : (
+                      <Badge variant="secondary">Applied</Badge>
+                    )}
+                  </TableCell>
+                </TableRow>
+              ))}
+          </TableBody>
+        </Table>
+
+        {hasNextPage && (
+          <Button variant="secondary" onClick={() => fetchNextPage()}>
+            Load more
+          </Button>
+        )}
+      </CardContent>
+    </Card>
+  );
+}
+
+function AcceptIDPMetadataUpdateAlertDialog({
+  samlConnection,
+  idpMetadataUpdate,
+  onAccept,
+}: {
+  samlConnection: SAMLConnection;
+  idpMetadataUpdate: SAMLConnectionIDPMetadataUpdate;
+  onAccept: () => void;
+}) {
+  const acceptIDPMetadataUpdateMutation = useMutation(
+    appAcceptSAMLConnectionIDPMetadataUpdate,
+  );
+  const queryClient = useQueryClient();
+  const handleAccept = async () => {
+    await acceptIDPMetadataUpdateMutation.mutateAsync({
+      samlConnectionIdpMetadataUpdateId: idpMetadataUpdate.id,
+    });
+
+    await queryClient.invalidateQueries({
+      queryKey: createConnectQueryKey(appGetSAMLConnection, {
+        id: samlConnection.id,
+      }),
+    });
+    onAccept();
+
+    toast.success("IDP metadata update accepted");
+  };
+
+  return (
+    <AlertDialog>
+      <AlertDialogTrigger asChild>
+        <Button variant="outline">Accept</Button>
+      </AlertDialogTrigger>
+      <AlertDialogContent>
+        <AlertDialogHeader>
+          <AlertDialogTitle>Accept IDP metadata update?</AlertDialogTitle>
+          <AlertDialogDescription>
+            SSOReady will change this SAML connection's IDP Entity ID to{" "}
+            {idpMetadataUpdate.idpEntityId}, along with any other changes in
+            this update. Only accept it if you expected your customer's
+            Identity Provider to change.
+          </AlertDialogDescription>
+        </AlertDialogHeader>
+        <AlertDialogFooter>
+          <AlertDialogCancel>Cancel</AlertDialogCancel>
+          <Button onClick={handleAccept}>Accept</Button>
+        </AlertDialogFooter>
+      </AlertDialogContent>
+    </AlertDialog>
+  );
+}
+
+const CreateIDPCertificateFormSchema = z.object({
+  certificate: z.string().startsWith("-----BEGIN CERTIFICATE-----", {
+    message:
+      "Certificate must be a PEM-encoded X.509 certificate, starting with '-----BEGIN CERTIFICATE-----'.",
+  }),
+  notBefore: z.string(),
+  notAfter: z.string(),
+});
+
+function CreateIDPCertificateAlertDialog({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const form = useForm<z.infer<typeof CreateIDPCertificateFormSchema>>({
+    resolver: zodResolver(CreateIDPCertificateFormSchema),
+    defaultValues: {
+      certificate: "",
+      notBefore: "",
+      notAfter: "",
+    },
+  });
+
+  const [open, setOpen] = useState(false);
+  const createIDPCertificateMutation = useMutation(
+    appCreateSAMLConnectionIDPCertificate,
+  );
+  const queryClient = useQueryClient();
+  const handleSubmit = useCallback(
+    async (values: z.infer<typeof CreateIDPCertificateFormSchema>) => {
+      await createIDPCertificateMutation.mutateAsync({
+        samlConnectionIdpCertificate: {
+          samlConnectionId: samlConnection.id,
+          certificate: values.certificate,
+          notBefore: values.notBefore
+            ? Timestamp.fromDate(new Date(values.notBefore))
+            : undefined,
+          notAfter: values.notAfter
+            ? Timestamp.fromDate(new Date(values.notAfter))
+            : undefined,
+        },
+      });
+
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey(appListSAMLConnectionIDPCertificates, {
+          samlConnectionId: samlConnection.id,
+        }),
+      });
+
+      form.reset();
+      setOpen(false);
+    },
+    [
+      samlConnection.id,
+      createIDPCertificateMutation,
+      queryClient,
+      form,
+      setOpen,
+    ],
+  );
+
+  return (
+    <AlertDialog open={open} onOpenChange={setOpen}>
+      <AlertDialogTrigger asChild>
+        <Button variant="outline">Add certificate</Button>
+      </AlertDialogTrigger>
+      <AlertDialogContent>
+        <Form {...form}>
+          <form
+            onSubmit={form.handleSubmit(handleSubmit)}
+            className="w-full space-y-6"
+          >
+            <AlertDialogHeader>
+              <AlertDialogTitle>Add IDP certificate</AlertDialogTitle>
+            </AlertDialogHeader>
+
+            <FormField
+              control={form.control}
+              name="certificate"
+              render={({ field: { onChange } }) => (
+                <FormItem>
+                  <FormLabel>Certificate</FormLabel>
+                  <FormControl>
+                    <Input
+                      type="file"
+                      onChange={async (e) => {
+                        if (e.target.files) {
+                          onChange(await e.target.files[0].text());
+                        }
+                      }}
+                    />
+                  </FormControl>
+                  <FormDescription>
+                    A PEM-encoded X.509 certificate. These start with
+                    '-----BEGIN CERTIFICATE-----' and end with '-----END
+                    CERTIFICATE-----'.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+            <FormField
+              control={form.control}
+              name="notBefore"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Not Before</FormLabel>
+                  <FormControl>
+                    <Input type="datetime-local" {...field} />
+                  </FormControl>
+                  <FormDescription>
+                    Optional. If set, the certificate is not trusted before
+                    this time.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+            <FormField
+              control={form.control}
+              name="notAfter"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Not After</FormLabel>
+                  <FormControl>
+                    <Input type="datetime-local" {...field} />
+                  </FormControl>
+                  <FormDescription>
+                    Optional. If set, the certificate is no longer trusted
+                    after this time.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+
+            <AlertDialogFooter>
+              <AlertDialogCancel>Cancel</AlertDialogCancel>
+              <Button type="submit">Save</Button>
+            </AlertDialogFooter>
+          </form>
+        </Form>
+      </AlertDialogContent>
+    </AlertDialog>
+  );
+}
+
+function DeleteIDPCertificateAlertDialog({
+  samlConnection,
+  idpCertificate,
+}: {
+  samlConnection: SAMLConnection;
+  idpCertificate: SAMLConnectionIDPCertificate;
+}) {
+  const deleteIDPCertificateMutation = useMutation(
+    appDeleteSAMLConnectionIDPCertificate,
+  );
+  const queryClient = useQueryClient();
+  const handleDelete = async () => {
+    await deleteIDPCertificateMutation.mutateAsync({
+      samlConnectionIdpCertificateId: idpCertificate.id,
+    });
+
+    await queryClient.invalidateQueries({
+      queryKey: createConnectQueryKey(appListSAMLConnectionIDPCertificates, {
+        samlConnectionId: samlConnection.id,
+      }),
+    });
+
+    toast.success("IDP certificate deleted");
+  };
+
+  return (
+    <AlertDialog>
+      <AlertDialogTrigger asChild>
+        <Button variant="outline">Delete</Button>
+      </AlertDialogTrigger>
+      <AlertDialogContent>
+        <AlertDialogHeader>
+          <AlertDialogTitle>Delete IDP certificate?</AlertDialogTitle>
+          <AlertDialogDescription>
+            SSOReady will stop accepting assertions signed with this
+            certificate.
+          </AlertDialogDescription>
+        </AlertDialogHeader>
+        <AlertDialogFooter>
+          <AlertDialogCancel>Cancel</AlertDialogCancel>
+          <Button variant="destructive" onClick={handleDelete}>
+            Delete
+          </Button>
+        </AlertDialogFooter>
+      </AlertDialogContent>
+    </AlertDialog>
+  );
+}
+
+const IDPSettingsFormSchema = z.object({
+  idpEntityId: z.string().min(1, {
+    message: "IDP Entity ID must be non-empty.",
+  }),
+  idpRedirectUrl: z.string().url({
+    message: "IDP Redirect URL must be a valid URL.",
+  }),
+  idpSloUrl: z
+    .string()
+    .url({
+      message: "IDP SLO URL must be a valid URL.",
+    })
+    .or(z.literal("")),
+  idpArtifactResolutionUrl: z
+    .string()
+    .url({
+      message: "IDP Artifact Resolution URL must be a valid URL.",
+    })
+    .or(z.literal("")),
+  idpCertificate: z.string().startsWith("-----BEGIN CERTIFICATE-----", {
+    message: "IDP Certificate must be a PEM-encoded X.509 certificate.",
+  }),
+  idpBindingRedirect: z.boolean(),
+  idpMetadataUrl: z
+    .string()
+    .url({
+      message: "IDP Metadata URL must be a valid URL.",
+    })
+    .startsWith("https://", {
+      message: "IDP Metadata URL must be an https URL.",
+    })
+    .or(z.literal("")),
+});
 
 function EditSAMLConnectionIDPSettingsAlertDialog({
   samlConnection,
@@ -475,7 +1485,12 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
     defaultValues: {
       idpEntityId: samlConnection.idpEntityId,
       idpRedirectUrl: samlConnection.idpRedirectUrl,
+      idpSloUrl: samlConnection.idpSloUrl,
+      idpArtifactResolutionUrl: samlConnection.idpArtifactResolutionUrl,
       idpCertificate: samlConnection.idpCertificate,
+      idpBindingRedirect:
+        samlConnection.idpBinding === SAMLBinding.SAML_BINDING_HTTP_REDIRECT,
+      idpMetadataUrl: samlConnection.idpMetadataUrl,
     },
   });
 
@@ -492,7 +1507,22 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
           primary: samlConnection.primary,
           idpEntityId: data.idpEntityId,
           idpRedirectUrl: data.idpRedirectUrl,
+          idpSloUrl: data.idpSloUrl,
+          idpArtifactResolutionUrl: data.idpArtifactResolutionUrl,
           idpCertificate: data.idpCertificate,
+          allowedSignatureAlgorithms: samlConnection.allowedSignatureAlgorithms,
+          allowedDigestAlgorithms: samlConnection.allowedDigestAlgorithms,
+          signAuthnRequests: samlConnection.signAuthnRequests,
+          idpBinding: data.idpBindingRedirect
+            ? SAMLBinding.SAML_BINDING_HTTP_REDIRECT
+            : SAMLBinding.SAML_BINDING_HTTP_POST,
+          allowIdpInitiated: samlConnection.allowIdpInitiated,
+          idpMetadataUrl: data.idpMetadataUrl,
+          emailAttributeNames: samlConnection.emailAttributeNames,
+          attributeMappingPreset: samlConnection.attributeMappingPreset,
+          attributeMapping: samlConnection.attributeMapping,
+          authnRequestOptions: samlConnection.authnRequestOptions,
+          allowedClockSkewSeconds: samlConnection.allowedClockSkewSeconds,
         },
       });
 
@@ -509,14 +1539,33 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
 
   const id = useId();
   const [metadataUrl, setMetadataUrl] = useState("");
+  const [metadataWarnings, setMetadataWarnings] = useState<
+    SAMLMetadataWarning[]
+  >([]);
   const parseSAMLMetadataMutation = useMutation(parseSAMLMetadata);
   const handleLoadMetadata = useCallback(async () => {
-    const { idpRedirectUrl, idpCertificate, idpEntityId } =
-      await parseSAMLMetadataMutation.mutateAsync({ url: metadataUrl });
+    const {
+      idpRedirectUrl,
+      idpCertificate,
+      idpEntityId,
+      idpBinding,
+      idpSloUrl,
+      idpArtifactResolutionUrl,
+      warnings,
+    } = await parseSAMLMetadataMutation.mutateAsync({ url: metadataUrl });
+
+    setMetadataWarnings(warnings);
 
     form.setValue("idpRedirectUrl", idpRedirectUrl);
+    form.setValue("idpSloUrl", idpSloUrl);
+    form.setValue("idpArtifactResolutionUrl", idpArtifactResolutionUrl);
     form.setValue("idpCertificate", idpCertificate);
     form.setValue("idpEntityId", idpEntityId);
+    form.setValue("idpMetadataUrl", metadataUrl);
+    form.setValue(
+      "idpBindingRedirect",
+      idpBinding === SAMLBinding.SAML_BINDING_HTTP_REDIRECT,
+    );
   }, [parseSAMLMetadataMutation, metadataUrl, form]);
 
   return (
@@ -550,6 +1599,13 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
                 </Button>
               </div>
               <FormDescription>IDP Metadata URL.</FormDescription>
+              {metadataWarnings.length > 0 && (
+                <ul className="text-sm text-muted-foreground list-disc list-inside">
+                  {metadataWarnings.map((warning) => (
+                    <li key={warning.code}>{warning.message}</li>
+                  ))}
+                </ul>
+              )}
             </FormItem>
 
             <div className="relative">
@@ -593,17 +1649,92 @@ function EditSAMLConnectionIDPSettingsAlertDialog({
             />
             <FormField
               control={form.control}
-              name="idpCertificate"
-              render={({ field: { onChange } }) => (
+              name="idpSloUrl"
+              render={({ field }) => (
                 <FormItem>
-                  <FormLabel>IDP Certificate</FormLabel>
+                  <FormLabel>IDP SLO URL</FormLabel>
                   <FormControl>
-                    <Input
-                      type="file"
-                      onChange={async (e) => {
-                        // File inputs are special; they are necessarily "uncontrolled", and their value is a FileList.
-                        // We just copy over the file's contents to the react-form-hook state manually on input change.
-                        if (e.target.files) {
+                    <Input {...field} />
+                  </FormControl>
+                  <FormDescription>
+                    IDP Single Logout URL. Optional; leave empty if the IDP does
+                    not support single logout.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+            <FormField
+              control={form.control}
+              name="idpArtifactResolutionUrl"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>IDP Artifact Resolution URL</FormLabel>
+                  <FormControl>
+                    <Input {...field} />
+                  </FormControl>
+                  <FormDescription>
+                    IDP Artifact Resolution URL. Optional; leave empty if the
+                    IDP does not use the HTTP-Artifact binding.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+            <FormField
+              control={form.control}
+              name="idpMetadataUrl"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Keep in sync with IDP Metadata URL</FormLabel>
+                  <FormControl>
+                    <Input {...field} />
+                  </FormControl>
+                  <FormDescription>
+                    Optional. If set, SSOReady periodically fetches this URL and
+                    updates the IDP Entity ID, Redirect URL, and Certificate to
+                    match.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+            <FormField
+              control={form.control}
+              name="idpBindingRedirect"
+              render={({ field }) => (
+                <FormItem>
+                  <FormLabel>Use HTTP-Redirect Binding</FormLabel>
+                  <FormControl className="block">
+                    <Switch
+                      name={field.name}
+                      id={field.name}
+                      checked={field.value}
+                      onCheckedChange={field.onChange}
+                    />
+                  </FormControl>
+                  <FormDescription>
+                    If enabled, SSOReady sends requests to the IDP Redirect URL
+                    as query parameters instead of as a form POST. Only enable
+                    this if the IDP does not support HTTP-POST.
+                  </FormDescription>
+                  <FormMessage />
+                </FormItem>
+              )}
+            />
+            <FormField
+              control={form.control}
+              name="idpCertificate"
+              render={({ field: { onChange } }) => (
+                <FormItem>
+                  <FormLabel>IDP Certificate</FormLabel>
+                  <FormControl>
+                    <Input
+                      type="file"
+                      onChange={async (e) => {
+                        // File inputs are special; they are necessarily "uncontrolled", and their value is a FileList.
+                        // We just copy over the file's contents to the react-form-hook state manually on input change.
+                        if (e.target.files) {
                           onChange(await e.target.files[0].text());
                         }
                       }}
@@ -693,3 +1824,544 @@ function DangerZoneCard() {
     </>
   );
 }
+
+const ATTRIBUTE_MAPPING_PRESET_LABELS: Record<
+  SAMLAttributeMappingPreset,
+  string
+> = {
+  [SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_UNSPECIFIED]:
+    "None",
+  [SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_OKTA]: "Okta",
+  [SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_ENTRA]:
+    "Microsoft Entra ID",
+  [SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_GOOGLE]:
+    "Google Workspace",
+  [SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_ADFS]: "ADFS",
+  [SAMLAttributeMappingPreset.SAML_ATTRIBUTE_MAPPING_PRESET_OID]:
+    "LDAP / X.500 (urn:oid)",
+};
+
+const ATTRIBUTE_MAPPING_FIELDS = [
+  { name: "firstName", label: "First Name" },
+  { name: "lastName", label: "Last Name" },
+  { name: "displayName", label: "Display Name" },
+  { name: "groups", label: "Groups" },
+  { name: "department", label: "Department" },
+  { name: "jobTitle", label: "Job Title" },
+  { name: "employeeId", label: "Employee ID" },
+  { name: "phoneNumber", label: "Phone Number" },
+] as const;
+
+function AttributeMappingCard({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  return (
+    <Card>
+      <CardHeader>
+        <div className="flex justify-between items-center">
+          <div className="flex flex-col space-y-1.5">
+            <CardTitle>Attribute Mapping</CardTitle>
+            <CardDescription>
+              How SSOReady populates users' profiles from the attributes the
+              IDP sends. Fields not set here are taken from the preset.
+            </CardDescription>
+          </div>
+
+          <EditAttributeMappingAlertDialog samlConnection={samlConnection} />
+        </div>
+      </CardHeader>
+
+      <CardContent>
+        <div className="grid grid-cols-4 gap-y-2">
+          <div className="text-sm col-span-1 text-muted-foreground">
+            Preset
+          </div>
+          <div className="text-sm col-span-3">
+            {
+              ATTRIBUTE_MAPPING_PRESET_LABELS[
+                samlConnection.attributeMappingPreset
+              ]
+            }
+          </div>
+
+          {ATTRIBUTE_MAPPING_FIELDS.map(({ name, label }) => (
+            <React.Fragment key={name}>
+              <div className="text-sm col-span-1 text-muted-foreground">
+                {label}
+              </div>
+              <div className="text-sm col-span-3">
+                {samlConnection.attributeMapping?.[name].length ? (
+                  samlConnection.attributeMapping[name].join(", ")
+                ) : (
+                  <div className="text-sm text-muted-foreground">
+                    From preset
+                  </div>
+                )}
+              </div>
+            </React.Fragment>
+          ))}
+        </div>
+      </CardContent>
+    </Card>
+  );
+}
+
+const AttributeMappingFormSchema = z.object({
+  attributeMappingPreset: z.nativeEnum(SAMLAttributeMappingPreset),
+  firstName: z.array(z.string()),
+  lastName: z.array(z.string()),
+  displayName: z.array(z.string()),
+  groups: z.array(z.string()),
+  department: z.array(z.string()),
+  jobTitle: z.array(z.string()),
+  employeeId: z.array(z.string()),
+  phoneNumber: z.array(z.string()),
+});
+
+function EditAttributeMappingAlertDialog({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const form = useForm<z.infer<typeof AttributeMappingFormSchema>>({
+    resolver: zodResolver(AttributeMappingFormSchema),
+    defaultValues: {
+      attributeMappingPreset: samlConnection.attributeMappingPreset,
+      firstName: samlConnection.attributeMapping?.firstName ?? [],
+      lastName: samlConnection.attributeMapping?.lastName ?? [],
+      displayName: samlConnection.attributeMapping?.displayName ?? [],
+      groups: samlConnection.attributeMapping?.groups ?? [],
+      department: samlConnection.attributeMapping?.department ?? [],
+      jobTitle: samlConnection.attributeMapping?.jobTitle ?? [],
+      employeeId: samlConnection.attributeMapping?.employeeId ?? [],
+      phoneNumber: samlConnection.attributeMapping?.phoneNumber ?? [],
+    },
+  });
+
+  const [open, setOpen] = useState(false);
+  const updateSAMLConnectionMutation = useMutation(appUpdateSAMLConnection);
+  const queryClient = useQueryClient();
+  const handleSubmit = useCallback(
+    async (values: z.infer<typeof AttributeMappingFormSchema>, e: any) => {
+      e.preventDefault();
+      const { attributeMappingPreset, ...attributeMapping } = values;
+      await updateSAMLConnectionMutation.mutateAsync({
+        samlConnection: {
+          id: samlConnection.id,
+          primary: samlConnection.primary,
+          idpEntityId: samlConnection.idpEntityId,
+          idpRedirectUrl: samlConnection.idpRedirectUrl,
+          idpSloUrl: samlConnection.idpSloUrl,
+          idpArtifactResolutionUrl: samlConnection.idpArtifactResolutionUrl,
+          idpCertificate: samlConnection.idpCertificate,
+          allowedSignatureAlgorithms: samlConnection.allowedSignatureAlgorithms,
+          allowedDigestAlgorithms: samlConnection.allowedDigestAlgorithms,
+          signAuthnRequests: samlConnection.signAuthnRequests,
+          idpBinding: samlConnection.idpBinding,
+          allowIdpInitiated: samlConnection.allowIdpInitiated,
+          idpMetadataUrl: samlConnection.idpMetadataUrl,
+          emailAttributeNames: samlConnection.emailAttributeNames,
+          attributeMappingPreset,
+          attributeMapping,
+          authnRequestOptions: samlConnection.authnRequestOptions,
+          allowedClockSkewSeconds: samlConnection.allowedClockSkewSeconds,
+        },
+      });
+
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey(appGetSAMLConnection, {
+          id: samlConnection.id,
+        }),
+      });
+
+      setOpen(false);
+    },
+    [setOpen, samlConnection, updateSAMLConnectionMutation, queryClient],
+  );
+
+  return (
+    <AlertDialog open={open} onOpenChange={setOpen}>
+      <AlertDialogTrigger asChild>
+        <Button variant="outline">Edit</Button>
+      </AlertDialogTrigger>
+      <AlertDialogContent className="max-h-screen overflow-y-auto">
+        <Form {...form}>
+          <form onSubmit={form.handleSubmit(handleSubmit)}>
+            <AlertDialogHeader>
+              <AlertDialogTitle>Edit attribute mapping</AlertDialogTitle>
+            </AlertDialogHeader>
+
+            <div className="my-4 space-y-4">
+              <FormField
+                control={form.control}
+                name="attributeMappingPreset"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Preset</FormLabel>
+                    <FormControl className="block">
+                      <DropdownMenu>
+                        <DropdownMenuTrigger asChild>
+                          <Button variant="outline" type="button">
+                            {ATTRIBUTE_MAPPING_PRESET_LABELS[field.value]}
+                          </Button>
+                        </DropdownMenuTrigger>
+                        <DropdownMenuContent align="start">
+                          <DropdownMenuRadioGroup
+                            value={String(field.value)}
+                            onValueChange={(value) =>
+                              field.onChange(Number(value))
+                            }
+                          >
+                            {Object.entries(
+                              ATTRIBUTE_MAPPING_PRESET_LABELS,
+                            ).map(([preset, label]) => (
+                              <DropdownMenuRadioItem
+                                key={preset}
+                                value={preset}
+                              >
+                                {label}
+                              </DropdownMenuRadioItem>
+                            ))}
+                          </DropdownMenuRadioGroup>
+                        </DropdownMenuContent>
+                      </DropdownMenu>
+                    </FormControl>
+                    <FormDescription>
+                      The attribute naming conventions of the IDP.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              {ATTRIBUTE_MAPPING_FIELDS.map(({ name, label }) => (
+                <FormField
+                  key={name}
+                  control={form.control}
+                  name={name}
+                  render={({ field }) => (
+                    <FormItem>
+                      <FormLabel>{label}</FormLabel>
+                      <FormControl>
+                        <InputTags {...field} />
+                      </FormControl>
+                      <FormMessage />
+                    </FormItem>
+                  )}
+                />
+              ))}
+            </div>
+            <AlertDialogFooter>
+              <AlertDialogCancel>Cancel</AlertDialogCancel>
+              <Button type="submit">Save</Button>
+            </AlertDialogFooter>
+          </form>
+        </Form>
+      </AlertDialogContent>
+    </AlertDialog>
+  );
+}
+
+const AUTHN_CONTEXT_COMPARISON_LABELS: Record<string, string> = {
+  "": "IDP default (exact)",
+  exact: "Exact",
+  minimum: "Minimum",
+  maximum: "Maximum",
+  better: "Better",
+};
+
+function AuthnRequestOptionsCard({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const options = samlConnection.authnRequestOptions;
+
+  return (
+    <Card>
+      <CardHeader>
+        <div className="flex justify-between items-center">
+          <div className="flex flex-col space-y-1.5">
+            <CardTitle>Login Requests</CardTitle>
+            <CardDescription>
+              Options for the SAML requests SSOReady sends to the IDP to start
+              a login. Your application can add to these for individual logins.
+            </CardDescription>
+          </div>
+
+          <EditAuthnRequestOptionsAlertDialog samlConnection={samlConnection} />
+        </div>
+      </CardHeader>
+
+      <CardContent>
+        <div className="grid grid-cols-4 gap-y-2">
+          <div className="text-sm col-span-1 text-muted-foreground">
+            Force Reauthentication
+          </div>
+          <div className="text-sm col-span-3">
+            {options?.forceAuthn ? "Yes" : "No"}
+          </div>
+
+          <div className="text-sm col-span-1 text-muted-foreground">
+            Passive
+          </div>
+          <div className="text-sm col-span-3">
+            {options?.isPassive ? "Yes" : "No"}
+          </div>
+
+          <div className="text-sm col-span-1 text-muted-foreground">
+            NameID Format
+          </div>
+          <div className="text-sm col-span-3">
+            {options?.nameIdPolicyFormat || (
+              <div className="text-sm text-muted-foreground">IDP default</div>
+            )}
+          </div>
+
+          <div className="text-sm col-span-1 text-muted-foreground">
+            Authentication Contexts
+          </div>
+          <div className="text-sm col-span-3">
+            {options?.requestedAuthnContextClassRefs.length ? (
+              <>
+                <div>{options.requestedAuthnContextClassRefs.join(", ")}</div>
+                <div className="text-muted-foreground">
+                  {
+                    AUTHN_CONTEXT_COMPARISON_LABELS[
+                      options.requestedAuthnContextComparison
+                    ]
+                  }
+                </div>
+              </>
+            ) : (
+              <div className="text-sm text-muted-foreground">IDP default</div>
+            )}
+          </div>
+        </div>
+      </CardContent>
+    </Card>
+  );
+}
+
+const AuthnRequestOptionsFormSchema = z.object({
+  forceAuthn: z.boolean(),
+  isPassive: z.boolean(),
+  nameIdPolicyFormat: z.string(),
+  requestedAuthnContextClassRefs: z.array(z.string()),
+  requestedAuthnContextComparison: z.string(),
+});
+
+function EditAuthnRequestOptionsAlertDialog({
+  samlConnection,
+}: {
+  samlConnection: SAMLConnection;
+}) {
+  const form = useForm<z.infer<typeof AuthnRequestOptionsFormSchema>>({
+    resolver: zodResolver(AuthnRequestOptionsFormSchema),
+    defaultValues: {
+      forceAuthn: samlConnection.authnRequestOptions?.forceAuthn ?? false,
+      isPassive: samlConnection.authnRequestOptions?.isPassive ?? false,
+      nameIdPolicyFormat:
+        samlConnection.authnRequestOptions?.nameIdPolicyFormat ?? "",
+      requestedAuthnContextClassRefs:
+        samlConnection.authnRequestOptions?.requestedAuthnContextClassRefs ??
+        [],
+      requestedAuthnContextComparison:
+        samlConnection.authnRequestOptions?.requestedAuthnContextComparison ??
+        "",
+    },
+  });
+
+  const [open, setOpen] = useState(false);
+  const updateSAMLConnectionMutation = useMutation(appUpdateSAMLConnection);
+  const queryClient = useQueryClient();
+  const handleSubmit = useCallback(
+    async (values: z.infer<typeof AuthnRequestOptionsFormSchema>, e: any) => {
+      e.preventDefault();
+      await updateSAMLConnectionMutation.mutateAsync({
+        samlConnection: {
+          id: samlConnection.id,
+          primary: samlConnection.primary,
+          idpEntityId: samlConnection.idpEntityId,
+          idpRedirectUrl: samlConnection.idpRedirectUrl,
+          idpSloUrl: samlConnection.idpSloUrl,
+          idpArtifactResolutionUrl: samlConnection.idpArtifactResolutionUrl,
+          idpCertificate: samlConnection.idpCertificate,
+          allowedSignatureAlgorithms: samlConnection.allowedSignatureAlgorithms,
+          allowedDigestAlgorithms: samlConnection.allowedDigestAlgorithms,
+          signAuthnRequests: samlConnection.signAuthnRequests,
+          idpBinding: samlConnection.idpBinding,
+          allowIdpInitiated: samlConnection.allowIdpInitiated,
+          idpMetadataUrl: samlConnection.idpMetadataUrl,
+          emailAttributeNames: samlConnection.emailAttributeNames,
+          attributeMappingPreset: samlConnection.attributeMappingPreset,
+          attributeMapping: samlConnection.attributeMapping,
+          authnRequestOptions: values,
+          allowedClockSkewSeconds: samlConnection.allowedClockSkewSeconds,
+        },
+      });
+
+      await queryClient.invalidateQueries({
+        queryKey: createConnectQueryKey(appGetSAMLConnection, {
+          id: samlConnection.id,
+        }),
+      });
+
+      setOpen(false);
+    },
+    [setOpen, samlConnection, updateSAMLConnectionMutation, queryClient],
+  );
+
+  return (
+    <AlertDialog open={open} onOpenChange={setOpen}>
+      <AlertDialogTrigger asChild>
+        <Button variant="outline">Edit</Button>
+      </AlertDialogTrigger>
+      <AlertDialogContent className="max-h-screen overflow-y-auto">
+        <Form {...form}>
+          <form onSubmit={form.handleSubmit(handleSubmit)}>
+            <AlertDialogHeader>
+              <AlertDialogTitle>Edit login requests</AlertDialogTitle>
+            </AlertDialogHeader>
+
+            <div className="my-4 space-y-4">
+              <FormField
+                control={form.control}
+                name="forceAuthn"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Force Reauthentication</FormLabel>
+                    <FormControl className="block">
+                      <Switch
+                        name={field.name}
+                        id={field.name}
+                        checked={field.value}
+                        onCheckedChange={field.onChange}
+                      />
+                    </FormControl>
+                    <FormDescription>
+                      If enabled, the IDP makes users log in again even if they
+                      already have a session with it.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="isPassive"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Passive</FormLabel>
+                    <FormControl className="block">
+                      <Switch
+                        name={field.name}
+                        id={field.name}
+                        checked={field.value}
+                        onCheckedChange={field.onChange}
+                      />
+                    </FormControl>
+                    <FormDescription>
+                      If enabled, the IDP does not interact with users. Logins
+                      fail unless the user already has a session with the IDP.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="nameIdPolicyFormat"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>NameID Format</FormLabel>
+                    <FormControl>
+                      <Input
+                        placeholder="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+                        {...field}
+                      />
+                    </FormControl>
+                    <FormDescription>
+                      The format of Subject ID to ask the IDP for. Leave empty
+                      to let the IDP choose.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="requestedAuthnContextClassRefs"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Authentication Contexts</FormLabel>
+                    <FormControl>
+                      <InputTags {...field} />
+                    </FormControl>
+                    <FormDescription>
+                      Authentication context classes to ask the IDP for, such
+                      as
+                      "urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract"
+                      to require multi-factor authentication.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+
+              <FormField
+                control={form.control}
+                name="requestedAuthnContextComparison"
+                render={({ field }) => (
+                  <FormItem>
+                    <FormLabel>Authentication Context Comparison</FormLabel>
+                    <FormControl className="block">
+                      <DropdownMenu>
+                        <DropdownMenuTrigger asChild>
+                          <Button variant="outline" type="button">
+                            {AUTHN_CONTEXT_COMPARISON_LABELS[field.value]}
+                          </Button>
+                        </DropdownMenuTrigger>
+                        <DropdownMenuContent align="start">
+                          <DropdownMenuRadioGroup
+                            value={field.value}
+                            onValueChange={field.onChange}
+                          >
+                            {Object.entries(
+                              AUTHN_CONTEXT_COMPARISON_LABELS,
+                            ).map(([comparison, label]) => (
+                              <DropdownMenuRadioItem
+                                key={comparison}
+                                value={comparison}
+                              >
+                                {label}
+                              </DropdownMenuRadioItem>
+                            ))}
+                          </DropdownMenuRadioGroup>
+                        </DropdownMenuContent>
+                      </DropdownMenu>
+                    </FormControl>
+                    <FormDescription>
+                      How the IDP compares its authentication of the user
+                      against the authentication contexts above.
+                    </FormDescription>
+                    <FormMessage />
+                  </FormItem>
+                )}
+              />
+            </div>
+            <AlertDialogFooter>
+              <AlertDialogCancel>Cancel</AlertDialogCancel>
+              <Button type="submit">Save</Button>
+            </AlertDialogFooter>
+          </form>
+        </Form>
+      </AlertDialogContent>
+    </AlertDialog>
+  );
+}
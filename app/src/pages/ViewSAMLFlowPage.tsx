@@ -28,7 +28,7 @@ import { Link } from "react-router-dom";
 import { SAMLFlowStatus } from "@/gen/ssoready/v1/ssoready_pb";
 import { Badge } from "@/components/ui/badge";
 import { Alert, AlertDescription, AlertTitle } from "@/components/ui/alert";
-import { OctagonX } from "lucide-react";
+import { CircleAlert, OctagonX } from "lucide-react";
 import { Title } from "@/components/Title";
 import { DocsLink } from "@/components/DocsLink";
 import { InfoTooltip } from "@/components/InfoTooltip";
@@ -37,6 +37,7 @@ import {
   CollapsibleContent,
   CollapsibleTrigger,
 } from "@/components/ui/collapsible";
+import { IDP_VENDOR_LABELS } from "@/lib/idpVendors";
 
 export function ViewSAMLFlowPage() {
   const { environmentId, organizationId, samlConnectionId, samlFlowId } =
@@ -51,6 +52,19 @@ export function ViewSAMLFlowPage() {
     id: organizationId,
   });
 
+  const attributeValues = useMemo(() => {
+    if (!samlFlow) {
+      return;
+    }
+
+    return Object.fromEntries(
+      Object.entries(samlFlow.attributeValues).map(([key, value]) => [
+        key,
+        value.values,
+      ]),
+    );
+  }, [samlFlow]);
+
   const redeemResponse = useMemo(() => {
     if (!samlFlow?.redeemResponse) {
       return;
@@ -59,6 +73,7 @@ export function ViewSAMLFlowPage() {
     const {
       email,
       attributes,
+      attribute_values,
       saml_flow_id,
       organization_id,
       organization_external_id,
@@ -67,12 +82,27 @@ export function ViewSAMLFlowPage() {
     return {
       email,
       attributes,
+      attributeValues:
+        attribute_values &&
+        Object.fromEntries(
+          Object.entries(attribute_values).map(([key, value]) => [
+            key,
+            (value as { values?: string[] }).values ?? [],
+          ]),
+        ),
       samlFlowId: saml_flow_id,
       organizationId: organization_id,
       organizationExternalId: organization_external_id,
     };
   }, [samlFlow]);
 
+  const signedElements = [
+    samlFlow?.responseSigned && "Response",
+    samlFlow?.assertionSigned && "Assertion",
+  ]
+    .filter(Boolean)
+    .join(" and ");
+
   return (
     <div className="flex flex-col gap-8">
       <Title title="SAML Login Flow" />
@@ -193,6 +223,17 @@ export function ViewSAMLFlowPage() {
               )}
             </div>
 
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Initiated By
+              <InfoTooltip>
+                Whether the SAML login flow was started by your application, or
+                by your customer's identity provider.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.idpInitiated ? "Identity Provider" : "Application"}
+            </div>
+
             <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
               User Email
               <InfoTooltip>
@@ -206,6 +247,29 @@ export function ViewSAMLFlowPage() {
               )}
             </div>
 
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Subject ID
+              <InfoTooltip>
+                The IDP's identifier for your end user (its "NameID"). This is
+                the same as their email address unless the SAML connection
+                takes emails from an attribute.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.subjectId ? (
+                <>
+                  <div>{samlFlow.subjectId}</div>
+                  {samlFlow.subjectIdFormat && (
+                    <div className="text-muted-foreground">
+                      {samlFlow.subjectIdFormat}
+                    </div>
+                  )}
+                </>
+              ) : (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
             <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
               User Attributes
               <InfoTooltip>
@@ -215,9 +279,149 @@ export function ViewSAMLFlowPage() {
             </div>
             <div className="text-xs col-span-3">
               <span className="font-mono bg-gray-100 py-1 px-2 rounded-sm">
-                {JSON.stringify(samlFlow?.attributes)}
+                {JSON.stringify(attributeValues)}
               </span>
             </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Profile
+              <InfoTooltip>
+                The user's attributes, normalized according to the SAML
+                connection's attribute mapping.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.profile &&
+              Object.values(samlFlow.profile).some((v) =>
+                Array.isArray(v) ? v.length > 0 : v,
+              ) ? (
+                <div className="grid grid-cols-2 gap-x-2">
+                  {(
+                    [
+                      ["First Name", samlFlow.profile.firstName],
+                      ["Last Name", samlFlow.profile.lastName],
+                      ["Display Name", samlFlow.profile.displayName],
+                      ["Groups", samlFlow.profile.groups.join(", ")],
+                      ["Department", samlFlow.profile.department],
+                      ["Job Title", samlFlow.profile.jobTitle],
+                      ["Employee ID", samlFlow.profile.employeeId],
+                      ["Phone Number", samlFlow.profile.phoneNumber],
+                    ] as const
+                  )
+                    .filter(([, value]) => value)
+                    .map(([label, value]) => (
+                      <React.Fragment key={label}>
+                        <div className="text-muted-foreground">{label}</div>
+                        <div>{value}</div>
+                      </React.Fragment>
+                    ))}
+                </div>
+              ) : (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Session Index
+              <InfoTooltip>
+                The IDP's identifier for the user's session. SSOReady includes
+                it in logout requests for this login.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.sessionIndex || (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Authenticated At
+              <InfoTooltip>
+                When the user authenticated to the IDP. This may be before the
+                login if the user already had a session with the IDP.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.authnInstant ? (
+                moment(samlFlow.authnInstant.toDate()).format()
+              ) : (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Session Expires At
+              <InfoTooltip>
+                When the IDP says the user's session should end.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.sessionNotOnOrAfter ? (
+                moment(samlFlow.sessionNotOnOrAfter.toDate()).format()
+              ) : (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Authentication Context
+              <InfoTooltip>
+                How the user authenticated to the IDP, e.g. with a password.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.authnContextClassRef || (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Identity Provider
+              <InfoTooltip>
+                The IDP product SSOReady detected from the SAML response.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.idpVendor ? (
+                IDP_VENDOR_LABELS[samlFlow.idpVendor]
+              ) : (
+                <span className="text-sm text-muted-foreground">
+                  Not detected
+                </span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2">
+              Signed Elements
+              <InfoTooltip>
+                Which parts of the SAML response the IDP signed. SSOReady
+                accepts a signature on the Response, the Assertion, or both.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {signedElements || (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
+
+            <div className="text-sm col-span-2 text-muted-foreground flex items-center gap-x-2 self-start">
+              Signing Certificate
+              <InfoTooltip>
+                Which of the SAML connection's certificates verified the IDP's
+                signature.
+              </InfoTooltip>
+            </div>
+            <div className="text-sm col-span-3">
+              {samlFlow?.idpCertificate ? (
+                <div className="bg-black rounded-lg px-6 py-4 inline-block">
+                  <code className="text-sm text-white">
+                    <pre>{samlFlow.idpCertificate}</pre>
+                  </code>
+                </div>
+              ) : (
+                <span className="text-sm text-muted-foreground">None</span>
+              )}
+            </div>
           </div>
         </CardContent>
       </Card>
@@ -284,9 +488,8 @@ export function ViewSAMLFlowPage() {
               </p>
 
               <p className="mt-4">
-                Your customer's IT admin needs to enable assertion signing. They
-                may have enable signing of the entire SAML response; SSOReady
-                requires specifically the assertion be signed.
+                Your customer's IT admin needs to enable signing of either the
+                SAML response or the SAML assertion.
               </p>
             </AlertDescription>
           )}
@@ -427,6 +630,40 @@ export function ViewSAMLFlowPage() {
             </AlertDescription>
           )}
 
+          {samlFlow.error.case === "badSubjectId" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider did not provide an email
+                address for this user
+                {samlFlow.error.value && (
+                  <>
+                    {" "}
+                    (it provided{" "}
+                    <span className="font-semibold">
+                      {samlFlow.error.value}
+                    </span>{" "}
+                    instead)
+                  </>
+                )}
+                .
+              </p>
+
+              <p className="mt-4">
+                If your customer's identity provider sends users' email
+                addresses in a SAML attribute rather than as their Subject ID,
+                you need to update{" "}
+                <Link
+                  className="underline underline-offset-4"
+                  to={`/environments/${environmentId}/organizations/${organizationId}/saml-connections/${samlConnectionId}`}
+                >
+                  the SAML connection's
+                </Link>{" "}
+                Email Attributes. Otherwise, your customer's IT admin needs to
+                change the Subject ID to be the user's email address.
+              </p>
+            </AlertDescription>
+          )}
+
           {samlFlow.error.case === "emailOutsideOrganizationDomains" && (
             <AlertDescription>
               Your customer's identity provider returned a{" "}
@@ -434,6 +671,224 @@ export function ViewSAMLFlowPage() {
               email, which is outside of the organization allowed domains.
             </AlertDescription>
           )}
+
+          {samlFlow.error.case === "idpInitiatedNotAllowed" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider started this login, but
+                IDP-initiated logins are not enabled for the SAML connection.
+              </p>
+
+              <p className="mt-4">
+                If you want to accept logins from your customer's identity
+                provider, you need to update{" "}
+                <Link
+                  className="underline underline-offset-4"
+                  to={`/environments/${environmentId}/organizations/${organizationId}/saml-connections/${samlConnectionId}`}
+                >
+                  the SAML connection
+                </Link>{" "}
+                to allow IDP-initiated logins.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "idpStatus" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider did not log the user in. It
+                responded with a status of{" "}
+                <span className="font-semibold">
+                  {samlFlow.error.value.subStatusCode ||
+                    samlFlow.error.value.statusCode}
+                </span>
+                .
+              </p>
+
+              {samlFlow.error.value.statusMessage && (
+                <p className="mt-4">
+                  The identity provider's message was:{" "}
+                  <span className="font-semibold">
+                    {samlFlow.error.value.statusMessage}
+                  </span>
+                </p>
+              )}
+
+              <p className="mt-4">
+                This usually means the user failed to authenticate, or isn't
+                assigned to your application in the identity provider. Your
+                customer's IT admin may need to investigate this in their
+                identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "artifactResolutionFailed" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider sent a SAML artifact, but it
+                could not be exchanged for a SAML assertion. The error was:{" "}
+                <span className="font-semibold">{samlFlow.error.value}</span>
+              </p>
+
+              <p className="mt-4">
+                Check the IDP Artifact Resolution URL on this SAML connection,
+                or your customer's IT admin may need to investigate this in
+                their identity provider.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "expiredAssertion" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider provided a SAML assertion
+                that is expired or not yet valid.
+              </p>
+
+              <p className="mt-4">
+                This usually means the identity provider's clock is out of sync.
+                Your customer's IT admin needs to fix their identity provider's
+                clock, or you can allow for more clock skew on{" "}
+                <Link
+                  className="underline underline-offset-4"
+                  to={`/environments/${environmentId}/organizations/${organizationId}/saml-connections/${samlConnectionId}`}
+                >
+                  the SAML connection
+                </Link>
+                .
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "expiredIdpInitiatedAssertion" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider started this login, but
+                issued its SAML assertion more than five minutes before
+                SSOReady received it.
+              </p>
+
+              <p className="mt-4">
+                Old IDP-initiated assertions are rejected so that they can't be
+                replayed. The user needs to start the login from their identity
+                provider again. If this keeps happening, your customer's
+                identity provider's clock may be out of sync.
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "expiredSubjectConfirmation" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider provided a SAML assertion
+                whose subject confirmation is expired.
+              </p>
+
+              <p className="mt-4">
+                This usually means the identity provider's clock is out of sync.
+                Your customer's IT admin needs to fix their identity provider's
+                clock, or you can allow for more clock skew on{" "}
+                <Link
+                  className="underline underline-offset-4"
+                  to={`/environments/${environmentId}/organizations/${organizationId}/saml-connections/${samlConnectionId}`}
+                >
+                  the SAML connection
+                </Link>
+                .
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "badDestination" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider sent a SAML response with a
+                Destination of{" "}
+                <span className="font-semibold">{samlFlow.error.value}</span>,
+                which is not the correct value.
+              </p>
+
+              <p className="mt-4">
+                Your customer's IT admin needs to change the value to{" "}
+                <span className="font-semibold">
+                  {samlConnection?.spAcsUrl}
+                </span>
+                .
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "badRecipient" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider provided a SAML subject
+                confirmation Recipient of{" "}
+                <span className="font-semibold">{samlFlow.error.value}</span>,
+                which is not the correct value.
+              </p>
+
+              <p className="mt-4">
+                Your customer's IT admin needs to change the value to{" "}
+                <span className="font-semibold">
+                  {samlConnection?.spAcsUrl}
+                </span>
+                .
+              </p>
+            </AlertDescription>
+          )}
+
+          {samlFlow.error.case === "badSubjectConfirmationMethod" && (
+            <AlertDescription>
+              <p>
+                Your customer's identity provider provided a SAML subject
+                confirmation method of{" "}
+                <span className="font-semibold">{samlFlow.error.value}</span>,
+                which SSOReady does not accept.
+              </p>
+
+              <p className="mt-4">
+                Your customer's IT admin needs to change the value to{" "}
+                <span className="font-semibold">
+                  urn:oasis:names:tc:SAML:2.0:cm:bearer
+                </span>
+                .
+              </p>
+            </AlertDescription>
+          )}
+        </Alert>
+      )}
+
+      {samlFlow?.unverifiedIdpStatus && (
+        <Alert className="bg-white shadow-sm">
+          <CircleAlert className="h-4 w-4" />
+          <AlertTitle>An unverified failure response was received</AlertTitle>
+          <AlertDescription>
+            <p>
+              SSOReady received a response for this SAML flow with a status of{" "}
+              <span className="font-semibold">
+                {samlFlow.unverifiedIdpStatus.subStatusCode ||
+                  samlFlow.unverifiedIdpStatus.statusCode}
+              </span>
+              {samlFlow.unverifiedIdpStatus.statusMessage && (
+                <>
+                  {" "}
+                  and a message of{" "}
+                  <span className="font-semibold">
+                    {samlFlow.unverifiedIdpStatus.statusMessage}
+                  </span>
+                </>
+              )}
+              .
+            </p>
+
+            <p className="mt-4">
+              The response was not signed by your customer's identity provider,
+              so SSOReady did not let it affect the outcome of this SAML flow.
+              If your customer's identity provider doesn't sign failure
+              responses, this may explain why a login did not complete.
+            </p>
+          </AlertDescription>
         </Alert>
       )}
 
@@ -6,6 +6,7 @@ import (
 	"log/slog"
 	"net/http"
 	"os"
+	"time"
 
 	"connectrpc.com/connect"
 	"connectrpc.com/vanguard"
@@ -27,6 +28,7 @@ import (
 	"github.com/ssoready/ssoready/internal/gen/ssoready/v1/ssoreadyv1connect"
 	"github.com/ssoready/ssoready/internal/google"
 	"github.com/ssoready/ssoready/internal/hexkey"
+	"github.com/ssoready/ssoready/internal/metadatarefresh"
 	"github.com/ssoready/ssoready/internal/microsoft"
 	"github.com/ssoready/ssoready/internal/pagetoken"
 	"github.com/ssoready/ssoready/internal/secretload"
@@ -43,35 +45,37 @@ func main() {
 	}
 
 	config := struct {
-		SentryDSN                             string `conf:"sentry-dsn,noredact"`
-		SentryEnvironment                     string `conf:"sentry-environment,noredact"`
-		ServeAddr                             string `conf:"serve-addr,noredact"`
-		DB                                    string `conf:"db"`
-		DefaultAuthURL                        string `conf:"default-auth-url,noredact"`
-		DefaultAdminSetupURL                  string `conf:"default-admin-setup-url,noredact"`
-		PageEncodingValue                     string `conf:"page-encoding-value"`
-		SAMLStateSigningKey                   string `conf:"saml-state-signing-key"`
-		GoogleOAuthClientID                   string `conf:"google-oauth-client-id,noredact"`
-		MicrosoftOAuthClientID                string `conf:"microsoft-oauth-client-id,noredact"`
-		MicrosoftOAuthClientSecret            string `conf:"microsoft-oauth-client-secret"`
-		MicrosoftOAuthRedirectURI             string `conf:"microsoft-oauth-redirect-uri,noredact"`
-		ResendAPIKey                          string `conf:"resend-api-key"`
-		EmailChallengeFrom                    string `conf:"email-challenge-from,noredact"`
-		EmailVerificationEndpoint             string `conf:"email-verification-endpoint,noredact"`
-		SegmentWriteKey                       string `conf:"segment-write-key"`
-		CloudflareAPIKey                      string `conf:"cloudflare-api-key"`
-		CustomAuthDomainCloudflareZoneID      string `conf:"custom-auth-domain-cloudflare-zone-id,noredact"`
-		CustomAuthDomainCloudflareCNameValue  string `conf:"custom-auth-domain-cloudflare-cname-value,noredact"`
-		CustomAdminDomainCloudflareZoneID     string `conf:"custom-admin-domain-cloudflare-zone-id,noredact"`
-		CustomAdminDomainCloudflareCNameValue string `conf:"custom-admin-domain-cloudflare-cname-value,noredact"`
-		FlyioAPIKey                           string `conf:"flyio-api-key"`
-		FlyioAuthProxyAppID                   string `conf:"flyio-authproxy-app-id,noredact"`
-		FlyioAuthProxyAppCNAMEValue           string `conf:"flyio-authproxy-app-cname-value,noredact"`
-		FlyioAdminProxyAppID                  string `conf:"flyio-adminproxy-app-id,noredact"`
-		FlyioAdminProxyAppCNAMEValue          string `conf:"flyio-adminproxy-app-cname-value,noredact"`
-		AdminLogosS3BucketName                string `conf:"admin-logos-s3-bucket-name,noredact"`
+		SentryDSN                             string        `conf:"sentry-dsn,noredact"`
+		SentryEnvironment                     string        `conf:"sentry-environment,noredact"`
+		ServeAddr                             string        `conf:"serve-addr,noredact"`
+		DB                                    string        `conf:"db"`
+		DefaultAuthURL                        string        `conf:"default-auth-url,noredact"`
+		DefaultAdminSetupURL                  string        `conf:"default-admin-setup-url,noredact"`
+		PageEncodingValue                     string        `conf:"page-encoding-value"`
+		SAMLStateSigningKey                   string        `conf:"saml-state-signing-key"`
+		GoogleOAuthClientID                   string        `conf:"google-oauth-client-id,noredact"`
+		MicrosoftOAuthClientID                string        `conf:"microsoft-oauth-client-id,noredact"`
+		MicrosoftOAuthClientSecret            string        `conf:"microsoft-oauth-client-secret"`
+		MicrosoftOAuthRedirectURI             string        `conf:"microsoft-oauth-redirect-uri,noredact"`
+		ResendAPIKey                          string        `conf:"resend-api-key"`
+		EmailChallengeFrom                    string        `conf:"email-challenge-from,noredact"`
+		EmailVerificationEndpoint             string        `conf:"email-verification-endpoint,noredact"`
+		SegmentWriteKey                       string        `conf:"segment-write-key"`
+		CloudflareAPIKey                      string        `conf:"cloudflare-api-key"`
+		CustomAuthDomainCloudflareZoneID      string        `conf:"custom-auth-domain-cloudflare-zone-id,noredact"`
+		CustomAuthDomainCloudflareCNameValue  string        `conf:"custom-auth-domain-cloudflare-cname-value,noredact"`
+		CustomAdminDomainCloudflareZoneID     string        `conf:"custom-admin-domain-cloudflare-zone-id,noredact"`
+		CustomAdminDomainCloudflareCNameValue string        `conf:"custom-admin-domain-cloudflare-cname-value,noredact"`
+		FlyioAPIKey                           string        `conf:"flyio-api-key"`
+		FlyioAuthProxyAppID                   string        `conf:"flyio-authproxy-app-id,noredact"`
+		FlyioAuthProxyAppCNAMEValue           string        `conf:"flyio-authproxy-app-cname-value,noredact"`
+		FlyioAdminProxyAppID                  string        `conf:"flyio-adminproxy-app-id,noredact"`
+		FlyioAdminProxyAppCNAMEValue          string        `conf:"flyio-adminproxy-app-cname-value,noredact"`
+		AdminLogosS3BucketName                string        `conf:"admin-logos-s3-bucket-name,noredact"`
+		IDPMetadataRefreshInterval            time.Duration `conf:"idp-metadata-refresh-interval,noredact"`
 	}{
-		PageEncodingValue: "0000000000000000000000000000000000000000000000000000000000000000",
+		PageEncodingValue:          "0000000000000000000000000000000000000000000000000000000000000000",
+		IDPMetadataRefreshInterval: 6 * time.Hour,
 	}
 
 	conf.Load(&config)
@@ -113,6 +117,13 @@ func main() {
 		SAMLStateSigningKey:  samlStateSigningKey,
 	})
 
+	metadataRefreshWorker := metadatarefresh.Worker{
+		Store:      store_,
+		HTTPClient: http.DefaultClient,
+		Interval:   config.IDPMetadataRefreshInterval,
+	}
+	go metadataRefreshWorker.Run(context.Background())
+
 	var analyticsClient analytics.Client = appanalytics.NoopClient{}
 	if config.SegmentWriteKey != "" {
 		analyticsClient = analytics.New(config.SegmentWriteKey)
@@ -196,7 +207,9 @@ func main() {
 	mux.Handle("/v1/scim/", publicAPICamelToSnake(transcoder))
 	mux.Handle("/v1/organizations", publicAPICamelToSnake(transcoder))
 	mux.Handle("/v1/saml-connections", publicAPICamelToSnake(transcoder))
+	mux.Handle("/v1/expiring-saml-connections", publicAPICamelToSnake(transcoder))
 	mux.Handle("/v1/scim-directories", publicAPICamelToSnake(transcoder))
+	mux.Handle("/v1/saml-logouts", publicAPICamelToSnake(transcoder))
 	mux.Handle("/", transcoder)
 
 	slog.Info("serve")
@@ -211,6 +224,8 @@ var publicAPICamelToSnakeMapping = map[string]string{
 	"organizationExternalId": "organization_external_id",
 	"scimGroupId":            "scim_group_id",
 	"pageToken":              "page_token",
+	"expiresWithinDays":      "expires_within_days",
+	"samlConnectionId":       "saml_connection_id",
 }
 
 // publicAPICamelToSnake converts public SCIM-related endpoint parameters from camel to snake.
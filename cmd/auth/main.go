@@ -22,6 +22,7 @@ import (
 	"github.com/ssoready/ssoready/internal/authservice"
 	"github.com/ssoready/ssoready/internal/hexkey"
 	"github.com/ssoready/ssoready/internal/pagetoken"
+	"github.com/ssoready/ssoready/internal/saml"
 	"github.com/ssoready/ssoready/internal/secretload"
 	"github.com/ssoready/ssoready/internal/slogcorrelation"
 	"github.com/ssoready/ssoready/internal/store"
@@ -89,9 +90,10 @@ func main() {
 	}
 
 	service := authservice.Service{
-		Store:                  store_,
-		BaseURL:                config.BaseURL,
-		OAuthIDTokenPrivateKey: idTokenPrivateKey,
+		Store:                        store_,
+		BaseURL:                      config.BaseURL,
+		OAuthIDTokenPrivateKey:       idTokenPrivateKey,
+		ArtifactResolutionHTTPClient: saml.NewArtifactResolutionHTTPClient(),
 	}
 
 	r := mux.NewRouter()
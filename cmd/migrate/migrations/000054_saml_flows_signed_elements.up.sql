@@ -0,0 +1,4 @@
+alter table saml_flows
+    add column response_signed boolean not null default false;
+alter table saml_flows
+    add column assertion_signed boolean not null default false;
@@ -0,0 +1,4 @@
+alter table saml_connections
+    add column allowed_signature_algorithms varchar[] not null default '{}';
+alter table saml_connections
+    add column allowed_digest_algorithms varchar[] not null default '{}';
@@ -0,0 +1,6 @@
+alter table saml_connections
+    add column sp_encryption_private_key bytea;
+alter table saml_connections
+    add column sp_encryption_certificate bytea;
+alter table saml_connections
+    add column sp_previous_encryption_private_key bytea;
@@ -0,0 +1,12 @@
+create table saml_connection_idp_certificates
+(
+    id                 uuid        not null primary key,
+    saml_connection_id uuid        not null references saml_connections (id),
+    x509_certificate   bytea       not null,
+    not_before         timestamptz,
+    not_after          timestamptz,
+    create_time        timestamptz not null
+);
+
+alter table saml_flows
+    add column idp_x509_certificate bytea;
@@ -0,0 +1,6 @@
+alter table saml_connections
+    add column sp_signing_private_key bytea;
+alter table saml_connections
+    add column sp_signing_certificate bytea;
+alter table saml_connections
+    add column sign_authn_requests boolean not null default false;
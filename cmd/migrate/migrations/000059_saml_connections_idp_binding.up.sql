@@ -0,0 +1,2 @@
+alter table saml_connections
+    add column idp_binding varchar;
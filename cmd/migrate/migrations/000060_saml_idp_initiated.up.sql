@@ -0,0 +1,6 @@
+alter table saml_connections
+    add column allow_idp_initiated boolean not null default false;
+alter table saml_flows
+    add column is_idp_initiated boolean not null default false;
+alter table saml_flows
+    add column error_idp_initiated_not_allowed boolean not null default false;
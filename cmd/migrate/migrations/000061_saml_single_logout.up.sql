@@ -0,0 +1,19 @@
+alter table saml_connections
+    add column idp_slo_url varchar;
+alter table saml_flows
+    add column subject_session_index varchar;
+
+create table saml_logouts
+(
+    id                 uuid        not null primary key,
+    saml_connection_id uuid        not null references saml_connections (id),
+    is_idp_initiated   boolean     not null,
+    subject_id         varchar     not null,
+    session_indexes    varchar[]   not null default '{}',
+    saml_flow_ids      uuid[]      not null default '{}',
+    return_url         varchar,
+    create_time        timestamptz not null,
+    initiate_time      timestamptz,
+    complete_time      timestamptz,
+    success            boolean
+);
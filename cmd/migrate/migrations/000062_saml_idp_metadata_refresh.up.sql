@@ -0,0 +1,19 @@
+alter table saml_connections
+    add column idp_metadata_url varchar;
+alter table saml_connections
+    add column idp_metadata_refresh_time timestamptz;
+alter table saml_connections
+    add column idp_metadata_refresh_error varchar;
+
+create table saml_connection_idp_metadata_updates
+(
+    id                            uuid        not null primary key,
+    saml_connection_id            uuid        not null references saml_connections (id),
+    create_time                   timestamptz not null,
+    previous_idp_entity_id        varchar,
+    idp_entity_id                 varchar,
+    previous_idp_redirect_url     varchar,
+    idp_redirect_url              varchar,
+    previous_idp_x509_certificate bytea,
+    idp_x509_certificate          bytea
+);
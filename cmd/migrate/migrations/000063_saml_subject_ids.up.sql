@@ -0,0 +1,8 @@
+alter table saml_connections
+    add column email_attribute_names varchar[] not null default '{}';
+alter table saml_flows
+    add column subject_id varchar;
+alter table saml_flows
+    add column subject_id_format varchar;
+alter table saml_logouts
+    add column subject_id_format varchar;
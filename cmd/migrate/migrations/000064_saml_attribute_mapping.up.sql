@@ -0,0 +1,6 @@
+alter table saml_connections
+    add column attribute_mapping_preset varchar;
+alter table saml_connections
+    add column attribute_mapping jsonb;
+alter table saml_flows
+    add column subject_profile jsonb;
@@ -0,0 +1,4 @@
+alter table saml_connections
+    add column authn_request_options jsonb;
+alter table saml_flows
+    add column authn_request_options jsonb;
@@ -0,0 +1,12 @@
+alter table saml_connections
+    add column allowed_clock_skew_seconds integer not null default 0;
+alter table saml_flows
+    add column error_expired_assertion boolean not null default false;
+alter table saml_flows
+    add column error_expired_subject_confirmation boolean not null default false;
+alter table saml_flows
+    add column error_bad_destination varchar;
+alter table saml_flows
+    add column error_bad_recipient varchar;
+alter table saml_flows
+    add column error_bad_subject_confirmation_method varchar;
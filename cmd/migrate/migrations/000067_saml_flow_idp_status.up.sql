@@ -0,0 +1,6 @@
+alter table saml_flows
+    add column error_idp_status_code varchar;
+alter table saml_flows
+    add column error_idp_sub_status_code varchar;
+alter table saml_flows
+    add column error_idp_status_message varchar;
@@ -0,0 +1,6 @@
+alter table saml_flows
+    add column subject_authn_instant timestamptz;
+alter table saml_flows
+    add column subject_session_not_on_or_after timestamptz;
+alter table saml_flows
+    add column subject_authn_context_class_ref varchar;
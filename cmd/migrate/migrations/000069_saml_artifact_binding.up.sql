@@ -0,0 +1,4 @@
+alter table saml_connections
+    add column idp_artifact_resolution_url varchar;
+alter table saml_flows
+    add column error_artifact_resolution varchar;
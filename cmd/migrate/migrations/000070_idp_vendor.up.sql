@@ -0,0 +1,4 @@
+alter table saml_connections
+    add column idp_vendor varchar;
+alter table saml_flows
+    add column idp_vendor varchar;
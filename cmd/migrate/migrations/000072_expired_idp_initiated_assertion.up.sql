@@ -0,0 +1,2 @@
+alter table saml_flows
+    add column error_expired_idp_initiated_assertion boolean not null default false;
@@ -0,0 +1,4 @@
+alter table saml_connection_idp_metadata_updates
+    add column pending boolean not null default false;
+alter table saml_connection_idp_metadata_updates
+    add column accept_time timestamptz;
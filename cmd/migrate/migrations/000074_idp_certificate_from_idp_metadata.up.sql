@@ -0,0 +1,2 @@
+alter table saml_connection_idp_certificates
+    add column from_idp_metadata boolean not null default false;
@@ -0,0 +1,393 @@
+package main
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/samlalgorithm"
+	"github.com/ucarion/cli"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func main() {
+	cli.Run(context.Background(), debug)
+}
+
+type args struct {
+	SAMLResponse    string    `cli:"saml-response" usage:"file containing the SAMLResponse, base64-encoded or as XML; - for stdin"`
+	IDPCertificates []string  `cli:"--idp-certificate" value:"file" usage:"PEM-encoded IDP certificate; may be repeated"`
+	IDPMetadata     string    `cli:"--idp-metadata" value:"file" usage:"IDP metadata, to take the IDP entity ID and certificates from"`
+	Connection      string    `cli:"--connection" value:"file" usage:"SAML connection as JSON, as returned by the SSOReady API"`
+	IDPEntityID     string    `cli:"--idp-entity-id" usage:"expected IDP entity ID"`
+	SPEntityID      string    `cli:"--sp-entity-id" usage:"expected SP entity ID"`
+	SPACSURL        string    `cli:"--sp-acs-url" usage:"expected SP ACS URL"`
+	SPDecryptionKey string    `cli:"--sp-decryption-key" value:"file" usage:"PEM-encoded RSA key, to decrypt encrypted assertions"`
+	Now             time.Time `cli:"--now" value:"rfc3339" usage:"time to validate the assertion at; defaults to the current time"`
+	ClockSkew       string    `cli:"--clock-skew" value:"duration" usage:"allowed clock skew, e.g. 30s"`
+}
+
+func (args) ExtendedDescription() string {
+	return "Validates a SAML response offline, the same way SSOReady does when receiving it, and prints a report of each step along with every problem found."
+}
+
+func debug(ctx context.Context, args args) error {
+	req, err := validateRequest(args)
+	if err != nil {
+		return err
+	}
+
+	res, err := saml.Inspect(req)
+	if err != nil {
+		return err
+	}
+
+	printReport(os.Stdout, req, res)
+
+	if res.ValidateError != nil {
+		return fmt.Errorf("saml response is not valid: %w", res.ValidateError)
+	}
+	return nil
+}
+
+// validateRequest constructs the saml.ValidateRequest described by args.
+// Explicit flags take precedence over --connection, which takes precedence
+// over --idp-metadata.
+func validateRequest(args args) (*saml.ValidateRequest, error) {
+	samlResponse, err := readSAMLResponse(args.SAMLResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	req := saml.ValidateRequest{
+		SAMLResponse: samlResponse,
+		Now:          args.Now,
+	}
+	if req.Now.IsZero() {
+		req.Now = time.Now()
+	}
+
+	if args.IDPMetadata != "" {
+		b, err := os.ReadFile(args.IDPMetadata)
+		if err != nil {
+			return nil, fmt.Errorf("read idp metadata: %w", err)
+		}
+
+		metadata, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: b})
+		if err != nil {
+			return nil, fmt.Errorf("parse idp metadata: %w", err)
+		}
+
+		req.IDPEntityID = metadata.IDPEntityID
+		for _, cert := range metadata.IDPCertificates {
+			req.IDPCertificates = append(req.IDPCertificates, saml.IDPCertificate{Certificate: cert})
+		}
+	}
+
+	if args.Connection != "" {
+		b, err := os.ReadFile(args.Connection)
+		if err != nil {
+			return nil, fmt.Errorf("read connection: %w", err)
+		}
+
+		var samlConn ssoreadyv1.SAMLConnection
+		if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(b, &samlConn); err != nil {
+			return nil, fmt.Errorf("parse connection: %w", err)
+		}
+
+		if samlConn.IdpEntityId != "" {
+			req.IDPEntityID = samlConn.IdpEntityId
+		}
+		if samlConn.IdpCertificate != "" {
+			cert, err := parseCertificate([]byte(samlConn.IdpCertificate))
+			if err != nil {
+				return nil, fmt.Errorf("parse connection idp certificate: %w", err)
+			}
+			req.IDPCertificates = []saml.IDPCertificate{{Certificate: cert}}
+		}
+
+		req.SPEntityID = samlConn.SpEntityId
+		req.SPACSURL = samlConn.SpAcsUrl
+		req.ClockSkew = time.Duration(samlConn.AllowedClockSkewSeconds) * time.Second
+
+		req.AllowedSignatureAlgorithms, req.AllowedDigestAlgorithms, err = samlalgorithm.Parse(&samlConn)
+		if err != nil {
+			return nil, fmt.Errorf("parse connection: %w", err)
+		}
+	}
+
+	if len(args.IDPCertificates) > 0 {
+		req.IDPCertificates = nil
+		for _, f := range args.IDPCertificates {
+			b, err := os.ReadFile(f)
+			if err != nil {
+				return nil, fmt.Errorf("read idp certificate: %w", err)
+			}
+
+			cert, err := parseCertificate(b)
+			if err != nil {
+				return nil, fmt.Errorf("parse idp certificate %s: %w", f, err)
+			}
+			req.IDPCertificates = append(req.IDPCertificates, saml.IDPCertificate{Certificate: cert})
+		}
+	}
+
+	if args.IDPEntityID != "" {
+		req.IDPEntityID = args.IDPEntityID
+	}
+	if args.SPEntityID != "" {
+		req.SPEntityID = args.SPEntityID
+	}
+	if args.SPACSURL != "" {
+		req.SPACSURL = args.SPACSURL
+	}
+
+	if args.ClockSkew != "" {
+		req.ClockSkew, err = time.ParseDuration(args.ClockSkew)
+		if err != nil {
+			return nil, fmt.Errorf("parse clock skew: %w", err)
+		}
+	}
+
+	if args.SPDecryptionKey != "" {
+		b, err := os.ReadFile(args.SPDecryptionKey)
+		if err != nil {
+			return nil, fmt.Errorf("read sp decryption key: %w", err)
+		}
+
+		key, err := parsePrivateKey(b)
+		if err != nil {
+			return nil, fmt.Errorf("parse sp decryption key: %w", err)
+		}
+		req.SPDecryptionKeys = []*rsa.PrivateKey{key}
+	}
+
+	return &req, nil
+}
+
+// readSAMLResponse reads a SAMLResponse from path, returning it base64-encoded
+// as saml.Validate expects. It accepts the base64 value of the SAMLResponse
+// form parameter, URL-encoded or not, as well as the raw XML.
+func readSAMLResponse(path string) (string, error) {
+	var b []byte
+	var err error
+	if path == "-" {
+		b, err = io.ReadAll(os.Stdin)
+	} else {
+		b, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return "", fmt.Errorf("read saml response: %w", err)
+	}
+
+	s := strings.TrimSpace(string(b))
+	if strings.HasPrefix(s, "<") {
+		return base64.StdEncoding.EncodeToString([]byte(s)), nil
+	}
+
+	s = strings.TrimPrefix(s, "SAMLResponse=")
+	if strings.Contains(s, "%") {
+		s, err = url.QueryUnescape(s)
+		if err != nil {
+			return "", fmt.Errorf("parse saml response: %w", err)
+		}
+	}
+
+	// IDPs commonly wrap their base64
+	return strings.Join(strings.Fields(s), ""), nil
+}
+
+func parseCertificate(b []byte) (*x509.Certificate, error) {
+	blk, _ := pem.Decode(b)
+	if blk == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+	return x509.ParseCertificate(blk.Bytes)
+}
+
+func parsePrivateKey(b []byte) (*rsa.PrivateKey, error) {
+	blk, _ := pem.Decode(b)
+	if blk == nil {
+		return nil, fmt.Errorf("no PEM block found")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(blk.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(blk.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("key is not an RSA key")
+	}
+	return rsaKey, nil
+}
+
+func printReport(w io.Writer, req *saml.ValidateRequest, res *saml.InspectResponse) {
+	var problems []string
+	problem := func(step string, err error) {
+		problems = append(problems, fmt.Sprintf("%s: %s", step, err))
+	}
+
+	fmt.Fprintln(w, "== Response")
+	field(w, "InResponseTo", res.Response.InResponseTo)
+	field(w, "Destination", res.Response.Destination)
+	expected(w, req.SPACSURL)
+	field(w, "Status", res.Response.Status.StatusCode.Value)
+	if sub := res.Response.Status.StatusCode.StatusCode.Value; sub != "" {
+		field(w, "Sub-status", sub)
+	}
+	if msg := res.Response.Status.StatusMessage; msg != "" {
+		field(w, "Status message", msg)
+	}
+	field(w, "Encrypted", fmt.Sprint(res.Encrypted))
+	if res.DecryptError != nil {
+		problem("decrypt assertion", res.DecryptError)
+	}
+
+	assertion := res.Response.Assertion
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "== Assertion")
+	field(w, "ID", assertion.ID)
+	field(w, "IssueInstant", formatTime(assertion.IssueInstant))
+	field(w, "Issuer", assertion.Issuer.Name)
+	expected(w, req.IDPEntityID)
+	field(w, "Audience", assertion.Conditions.AudienceRestriction.Audience.Name)
+	expected(w, req.SPEntityID)
+	field(w, "NameID", assertion.Subject.NameID.Value)
+	field(w, "NameID Format", assertion.Subject.NameID.Format)
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "== Conditions")
+	field(w, "Now", formatTime(req.Now))
+	field(w, "Clock skew", req.ClockSkew.String())
+	field(w, "NotBefore", formatTime(assertion.Conditions.NotBefore))
+	field(w, "NotOnOrAfter", formatTime(assertion.Conditions.NotOnOrAfter))
+
+	subjectConfirmation := assertion.Subject.SubjectConfirmation
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "== SubjectConfirmation")
+	field(w, "Method", subjectConfirmation.Method)
+	field(w, "InResponseTo", subjectConfirmation.SubjectConfirmationData.InResponseTo)
+	field(w, "Recipient", subjectConfirmation.SubjectConfirmationData.Recipient)
+	expected(w, req.SPACSURL)
+	field(w, "NotOnOrAfter", formatTime(subjectConfirmation.SubjectConfirmationData.NotOnOrAfter))
+
+	for _, err := range res.Signatures.Problems {
+		problem("signatures", err)
+	}
+
+	for _, s := range []struct {
+		name   string
+		report dsig.SignatureReport
+	}{
+		{"Response", res.Signatures.Response},
+		{"Assertion", res.Signatures.Assertion},
+	} {
+		fmt.Fprintln(w)
+		fmt.Fprintf(w, "== %s signature\n", s.name)
+		printSignatureReport(w, s.report)
+
+		for _, err := range s.report.Problems {
+			problem(strings.ToLower(s.name)+" signature", err)
+		}
+	}
+
+	for _, err := range res.AssertionErrors {
+		problem("assertion", err)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "== Problems")
+	if len(problems) == 0 {
+		fmt.Fprintln(w, "  (none)")
+	}
+	for _, p := range problems {
+		fmt.Fprintf(w, "  - %s\n", p)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "== Result")
+	if res.ValidateError != nil {
+		field(w, "Error", res.ValidateError.Error())
+		return
+	}
+
+	field(w, "Valid", "true")
+	field(w, "Subject ID", res.ValidateResponse.SubjectID)
+	field(w, "Response signed", fmt.Sprint(res.ValidateResponse.ResponseSigned))
+	field(w, "Assertion signed", fmt.Sprint(res.ValidateResponse.AssertionSigned))
+	field(w, "Certificate", describeCertificate(res.ValidateResponse.IDPCertificate))
+}
+
+func printSignatureReport(w io.Writer, r dsig.SignatureReport) {
+	if !r.Present {
+		fmt.Fprintln(w, "  (unsigned)")
+		return
+	}
+
+	field(w, "Element ID", r.ElementID)
+	field(w, "Reference URI", r.ReferenceURI)
+	field(w, "Canonicalization", r.CanonicalizationMethod)
+	field(w, "Signature method", r.SignatureMethod)
+	field(w, "Digest method", r.DigestMethod)
+	for _, t := range r.Transforms {
+		field(w, "Transform", t)
+	}
+	field(w, "Expected digest", r.ExpectedDigest)
+	field(w, "Computed digest", r.ComputedDigest)
+	if r.KeyInfoCertificate != nil {
+		field(w, "KeyInfo cert", describeCertificate(r.KeyInfoCertificate))
+		field(w, "KeyInfo trusted", fmt.Sprint(r.KeyInfoCertificateTrusted))
+	}
+	if r.SignedBy != nil {
+		field(w, "Signed by", describeCertificate(r.SignedBy))
+	}
+	field(w, "Verified", fmt.Sprint(len(r.Problems) == 0))
+	fmt.Fprintln(w, "  Digest input:")
+	fmt.Fprintf(w, "    %s\n", r.DigestInput)
+}
+
+func field(w io.Writer, name, value string) {
+	if value == "" {
+		value = "(empty)"
+	}
+	fmt.Fprintf(w, "  %-18s %s\n", name+":", value)
+}
+
+// expected annotates the preceding field with the value validation requires of
+// it, if any.
+func expected(w io.Writer, value string) {
+	if value != "" {
+		fmt.Fprintf(w, "  %-18s %s\n", "  (expected)", value)
+	}
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func describeCertificate(cert *x509.Certificate) string {
+	fingerprint := sha256.Sum256(cert.Raw)
+	return fmt.Sprintf("%s (sha256 %s, valid %s to %s)", cert.Subject, hex.EncodeToString(fingerprint[:]), formatTime(cert.NotBefore), formatTime(cert.NotAfter))
+}
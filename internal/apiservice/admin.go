@@ -175,6 +175,14 @@ func (s *Service) AdminUpdateSAMLConnection(ctx context.Context, req *connect.Re
 	return connect.NewResponse(res), nil
 }
 
+func (s *Service) AdminGetSAMLConnectionSPSigningCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.AdminGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[ssoreadyv1.AdminGetSAMLConnectionSPSigningCertificateResponse], error) {
+	res, err := s.Store.AdminGetSAMLConnectionSPSigningCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+	return connect.NewResponse(res), nil
+}
+
 func (s *Service) AdminParseSAMLMetadata(ctx context.Context, req *connect.Request[ssoreadyv1.AdminParseSAMLMetadataRequest]) (*connect.Response[ssoreadyv1.AdminParseSAMLMetadataResponse], error) {
 	var metadata []byte
 	if req.Msg.Url != "" {
@@ -199,9 +207,13 @@ func (s *Service) AdminParseSAMLMetadata(ctx context.Context, req *connect.Reque
 		metadata = []byte(req.Msg.Xml)
 	}
 
-	metadataRes, err := saml.ParseMetadata(metadata)
+	metadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata,
+		EntityID: req.Msg.EntityId,
+		Now:      time.Now(),
+	})
 	if err != nil {
-		return nil, err
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	return connect.NewResponse(&ssoreadyv1.AdminParseSAMLMetadataResponse{
@@ -211,6 +223,9 @@ func (s *Service) AdminParseSAMLMetadata(ctx context.Context, req *connect.Reque
 			Type:  "CERTIFICATE",
 			Bytes: metadataRes.IDPCertificate.Raw,
 		})),
+		IdpBinding: samlBinding(metadataRes.Binding),
+		IdpVendor:  samlIDPVendor(metadataRes.Vendor),
+		Warnings:   samlMetadataWarnings(metadataRes.Warnings),
 	}), nil
 }
 
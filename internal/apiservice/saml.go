@@ -5,6 +5,7 @@ import (
 	"encoding/pem"
 	"io"
 	"net/http"
+	"time"
 
 	"connectrpc.com/connect"
 	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
@@ -29,6 +30,24 @@ func (s *Service) RedeemSAMLAccessCode(ctx context.Context, req *connect.Request
 	return connect.NewResponse(res), nil
 }
 
+func (s *Service) GetSAMLLogoutRedirectURL(ctx context.Context, req *connect.Request[ssoreadyv1.GetSAMLLogoutRedirectURLRequest]) (*connect.Response[ssoreadyv1.GetSAMLLogoutRedirectURLResponse], error) {
+	res, err := s.Store.GetSAMLLogoutRedirectURL(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) ListSAMLLogouts(ctx context.Context, req *connect.Request[ssoreadyv1.ListSAMLLogoutsRequest]) (*connect.Response[ssoreadyv1.ListSAMLLogoutsResponse], error) {
+	res, err := s.Store.ListSAMLLogouts(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
 func (s *Service) ParseSAMLMetadata(ctx context.Context, req *connect.Request[ssoreadyv1.ParseSAMLMetadataRequest]) (*connect.Response[ssoreadyv1.ParseSAMLMetadataResponse], error) {
 	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, req.Msg.Url, nil)
 	if err != nil {
@@ -46,9 +65,13 @@ func (s *Service) ParseSAMLMetadata(ctx context.Context, req *connect.Request[ss
 		return nil, err
 	}
 
-	metadataRes, err := saml.ParseMetadata(body)
+	metadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: body,
+		EntityID: req.Msg.EntityId,
+		Now:      time.Now(),
+	})
 	if err != nil {
-		return nil, err
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
 	}
 
 	return connect.NewResponse(&ssoreadyv1.ParseSAMLMetadataResponse{
@@ -58,5 +81,55 @@ func (s *Service) ParseSAMLMetadata(ctx context.Context, req *connect.Request[ss
 			Type:  "CERTIFICATE",
 			Bytes: metadataRes.IDPCertificate.Raw,
 		})),
+		IdpBinding:               samlBinding(metadataRes.Binding),
+		IdpSloUrl:                metadataRes.SLOURL,
+		IdpArtifactResolutionUrl: metadataRes.ArtifactResolutionURL,
+		IdpVendor:                samlIDPVendor(metadataRes.Vendor),
+		Warnings:                 samlMetadataWarnings(metadataRes.Warnings),
 	}), nil
 }
+
+func samlMetadataWarnings(warnings []saml.MetadataWarning) []*ssoreadyv1.SAMLMetadataWarning {
+	var out []*ssoreadyv1.SAMLMetadataWarning
+	for _, w := range warnings {
+		out = append(out, &ssoreadyv1.SAMLMetadataWarning{
+			Code:    string(w.Code),
+			Message: w.Message,
+		})
+	}
+	return out
+}
+
+func samlBinding(binding string) ssoreadyv1.SAMLBinding {
+	switch binding {
+	case saml.BindingHTTPPost:
+		return ssoreadyv1.SAMLBinding_SAML_BINDING_HTTP_POST
+	case saml.BindingHTTPRedirect:
+		return ssoreadyv1.SAMLBinding_SAML_BINDING_HTTP_REDIRECT
+	default:
+		return ssoreadyv1.SAMLBinding_SAML_BINDING_UNSPECIFIED
+	}
+}
+
+func samlIDPVendor(vendor saml.Vendor) ssoreadyv1.SAMLIDPVendor {
+	switch vendor {
+	case saml.VendorOkta:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_OKTA
+	case saml.VendorEntra:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_ENTRA
+	case saml.VendorGoogle:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_GOOGLE
+	case saml.VendorADFS:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_ADFS
+	case saml.VendorJumpCloud:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_JUMPCLOUD
+	case saml.VendorPing:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_PING
+	case saml.VendorKeycloak:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_KEYCLOAK
+	case saml.VendorOneLogin:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_ONELOGIN
+	default:
+		return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_UNSPECIFIED
+	}
+}
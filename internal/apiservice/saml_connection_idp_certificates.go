@@ -0,0 +1,52 @@
+package apiservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"github.com/segmentio/analytics-go/v3"
+	"github.com/ssoready/ssoready/internal/appanalytics"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func (s *Service) AppListSAMLConnectionIDPCertificates(ctx context.Context, req *connect.Request[ssoreadyv1.AppListSAMLConnectionIDPCertificatesRequest]) (*connect.Response[ssoreadyv1.AppListSAMLConnectionIDPCertificatesResponse], error) {
+	res, err := s.Store.AppListSAMLConnectionIDPCertificates(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) AppCreateSAMLConnectionIDPCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.AppCreateSAMLConnectionIDPCertificateRequest]) (*connect.Response[ssoreadyv1.SAMLConnectionIDPCertificate], error) {
+	res, err := s.Store.AppCreateSAMLConnectionIDPCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	if err := appanalytics.Track(ctx, "SAML Connection IDP Certificate Created", analytics.Properties{
+		"saml_connection_id":                 res.SamlConnectionId,
+		"saml_connection_idp_certificate_id": res.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) AppDeleteSAMLConnectionIDPCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.AppDeleteSAMLConnectionIDPCertificateRequest]) (*connect.Response[emptypb.Empty], error) {
+	res, err := s.Store.AppDeleteSAMLConnectionIDPCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	if err := appanalytics.Track(ctx, "SAML Connection IDP Certificate Deleted", analytics.Properties{
+		"saml_connection_idp_certificate_id": req.Msg.SamlConnectionIdpCertificateId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
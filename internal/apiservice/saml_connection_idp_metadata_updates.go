@@ -0,0 +1,27 @@
+package apiservice
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+)
+
+func (s *Service) AppListSAMLConnectionIDPMetadataUpdates(ctx context.Context, req *connect.Request[ssoreadyv1.AppListSAMLConnectionIDPMetadataUpdatesRequest]) (*connect.Response[ssoreadyv1.AppListSAMLConnectionIDPMetadataUpdatesResponse], error) {
+	res, err := s.Store.AppListSAMLConnectionIDPMetadataUpdates(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) AppAcceptSAMLConnectionIDPMetadataUpdate(ctx context.Context, req *connect.Request[ssoreadyv1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest]) (*connect.Response[ssoreadyv1.SAMLConnectionIDPMetadataUpdate], error) {
+	res, err := s.Store.AppAcceptSAMLConnectionIDPMetadataUpdate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
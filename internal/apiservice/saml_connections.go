@@ -61,6 +61,24 @@ func (s *Service) AppUpdateSAMLConnection(ctx context.Context, req *connect.Requ
 	return connect.NewResponse(res), nil
 }
 
+func (s *Service) AppRotateSAMLConnectionSPCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.AppRotateSAMLConnectionSPCertificateRequest]) (*connect.Response[ssoreadyv1.SAMLConnection], error) {
+	res, err := s.Store.AppRotateSAMLConnectionSPCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) AppGetSAMLConnectionSPSigningCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.AppGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[ssoreadyv1.AppGetSAMLConnectionSPSigningCertificateResponse], error) {
+	res, err := s.Store.AppGetSAMLConnectionSPSigningCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
 func (s *Service) AppDeleteSAMLConnection(ctx context.Context, req *connect.Request[ssoreadyv1.AppDeleteSAMLConnectionRequest]) (*connect.Response[emptypb.Empty], error) {
 	res, err := s.Store.AppDeleteSAMLConnection(ctx, req.Msg)
 	if err != nil {
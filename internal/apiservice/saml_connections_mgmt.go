@@ -17,6 +17,15 @@ func (s *Service) ListSAMLConnections(ctx context.Context, req *connect.Request[
 	return connect.NewResponse(res), nil
 }
 
+func (s *Service) ListExpiringSAMLConnections(ctx context.Context, req *connect.Request[ssoreadyv1.ListExpiringSAMLConnectionsRequest]) (*connect.Response[ssoreadyv1.ListExpiringSAMLConnectionsResponse], error) {
+	res, err := s.Store.ListExpiringSAMLConnections(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
 func (s *Service) GetSAMLConnection(ctx context.Context, req *connect.Request[ssoreadyv1.GetSAMLConnectionRequest]) (*connect.Response[ssoreadyv1.GetSAMLConnectionResponse], error) {
 	res, err := s.Store.GetSAMLConnection(ctx, req.Msg)
 	if err != nil {
@@ -43,3 +52,21 @@ func (s *Service) UpdateSAMLConnection(ctx context.Context, req *connect.Request
 
 	return connect.NewResponse(res), nil
 }
+
+func (s *Service) RotateSAMLConnectionSPCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.RotateSAMLConnectionSPCertificateRequest]) (*connect.Response[ssoreadyv1.RotateSAMLConnectionSPCertificateResponse], error) {
+	res, err := s.Store.RotateSAMLConnectionSPCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+func (s *Service) GetSAMLConnectionSPSigningCertificate(ctx context.Context, req *connect.Request[ssoreadyv1.GetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[ssoreadyv1.GetSAMLConnectionSPSigningCertificateResponse], error) {
+	res, err := s.Store.GetSAMLConnectionSPSigningCertificate(ctx, req.Msg)
+	if err != nil {
+		return nil, fmt.Errorf("store: %w", err)
+	}
+
+	return connect.NewResponse(res), nil
+}
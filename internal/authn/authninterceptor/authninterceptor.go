@@ -20,6 +20,7 @@ var skipRPCs = []string{
 var nonManagementAPIRPCs = []string{
 	"/ssoready.v1.SSOReadyService/GetSAMLRedirectURL",
 	"/ssoready.v1.SSOReadyService/RedeemSAMLAccessCode",
+	"/ssoready.v1.SSOReadyService/GetSAMLLogoutRedirectURL",
 	"/ssoready.v1.SSOReadyService/ListSCIMUsers",
 	"/ssoready.v1.SSOReadyService/GetSCIMUser",
 	"/ssoready.v1.SSOReadyService/ListSCIMGroups",
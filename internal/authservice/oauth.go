@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 	"strings"
 	"time"
 
@@ -136,10 +137,22 @@ func (s *Service) oauthAuthorize(w http.ResponseWriter, r *http.Request) {
 	// there is no "get redirect url" equivalent.
 	samlFlowID := idformat.SAMLFlow.Format(uuid.New())
 
+	authnRequestOptions := dataRes.AuthnRequestOptions.Override(oauthAuthnRequestOptions(r.URL.Query()))
+
+	signingKey, signingCert := parseSPSigningKey(dataRes.SPSigningPrivateKey, dataRes.SPSigningCertificate)
 	initRes := saml.Init(&saml.InitRequest{
-		RequestID:  samlFlowID,
-		SPEntityID: dataRes.SPEntityID,
-		Now:        time.Now(),
+		RequestID:                       samlFlowID,
+		SPEntityID:                      dataRes.SPEntityID,
+		Now:                             time.Now(),
+		Binding:                         dataRes.IDPBinding,
+		RelayState:                      samlFlowID,
+		SigningKey:                      signingKey,
+		SigningCertificate:              signingCert,
+		ForceAuthn:                      authnRequestOptions.ForceAuthn,
+		IsPassive:                       authnRequestOptions.IsPassive,
+		NameIDPolicyFormat:              authnRequestOptions.NameIDPolicyFormat,
+		RequestedAuthnContextClassRefs:  authnRequestOptions.RequestedAuthnContextClassRefs,
+		RequestedAuthnContextComparison: authnRequestOptions.RequestedAuthnContextComparison,
 	})
 
 	if err := s.Store.AuthUpsertOAuthAuthorizeData(ctx, &store.AuthUpsertOAuthAuthorizeDataRequest{
@@ -151,12 +164,33 @@ func (s *Service) oauthAuthorize(w http.ResponseWriter, r *http.Request) {
 		panic(fmt.Errorf("upsert oauth authorize data: %w", err))
 	}
 
-	if err := acsTemplate.Execute(w, &acsTemplateData{
-		SignOnURL:   dataRes.IDPRedirectURL,
-		SAMLRequest: initRes.SAMLRequest,
-	}); err != nil {
-		panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+	sendAuthnRequest(w, r, dataRes.IDPRedirectURL, initRes)
+}
+
+// oauthAuthnRequestOptions returns the AuthnRequest options an OAuth authorize
+// request asks for. prompt=login and max_age=0 ask the IDP to re-authenticate
+// the user, prompt=none asks it not to interact with them, and acr_values are
+// requested as authentication context classes.
+func oauthAuthnRequestOptions(query url.Values) store.AuthnRequestOptions {
+	var options store.AuthnRequestOptions
+	for _, prompt := range strings.Fields(query.Get("prompt")) {
+		switch prompt {
+		case "login":
+			options.ForceAuthn = true
+		case "none":
+			options.IsPassive = true
+		}
+	}
+
+	if query.Get("max_age") == "0" {
+		options.ForceAuthn = true
 	}
+
+	if acrValues := strings.Fields(query.Get("acr_values")); len(acrValues) > 0 {
+		options.RequestedAuthnContextClassRefs = acrValues
+	}
+
+	return options
 }
 
 type tokenResponse struct {
@@ -166,11 +200,25 @@ type tokenResponse struct {
 }
 
 type idTokenClaims struct {
+	// Subject is the user's email address, for compatibility with OAuth
+	// clients that predate Email, SubjectID, and SubjectIDFormat.
 	jwt.Claims
 
-	OrganizationID         string            `json:"organizationId"`
-	OrganizationExternalID string            `json:"organizationExternalId"`
-	Attributes             map[string]string `json:"attributes"`
+	Email                  string              `json:"email"`
+	SubjectID              string              `json:"subjectId"`
+	SubjectIDFormat        string              `json:"subjectIdFormat"`
+	GivenName              string              `json:"given_name,omitempty"`
+	FamilyName             string              `json:"family_name,omitempty"`
+	Name                   string              `json:"name,omitempty"`
+	OrganizationID         string              `json:"organizationId"`
+	OrganizationExternalID string              `json:"organizationExternalId"`
+	Attributes             map[string]string   `json:"attributes"`
+	AttributeValues        map[string][]string `json:"attributeValues"`
+
+	// AuthTime and ACR are the assertion's AuthnInstant and
+	// AuthnContextClassRef, if the IDP provided them.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	ACR      string           `json:"acr,omitempty"`
 }
 
 func (s *Service) oauthToken(w http.ResponseWriter, r *http.Request) {
@@ -236,9 +284,25 @@ func (s *Service) oauthToken(w http.ResponseWriter, r *http.Request) {
 
 			Subject: res.Email,
 		},
+		Email:                  res.Email,
+		SubjectID:              res.SubjectId,
+		SubjectIDFormat:        res.SubjectIdFormat,
+		GivenName:              res.Profile.GetFirstName(),
+		FamilyName:             res.Profile.GetLastName(),
+		Name:                   res.Profile.GetDisplayName(),
 		OrganizationID:         res.OrganizationId,
 		OrganizationExternalID: res.OrganizationExternalId,
 		Attributes:             res.Attributes,
+		AttributeValues:        map[string][]string{},
+		ACR:                    res.AuthnContextClassRef,
+	}
+
+	if res.AuthnInstant != nil {
+		claims.AuthTime = jwt.NewNumericDate(res.AuthnInstant.AsTime())
+	}
+
+	for name, values := range res.AttributeValues {
+		claims.AttributeValues[name] = values.Values
 	}
 
 	idToken, err := jwt.Signed(signer).Claims(claims).Serialize()
@@ -280,10 +344,16 @@ func (s *Service) oauthUserinfo(w http.ResponseWriter, r *http.Request) {
 		Sub           string `json:"sub"`
 		Email         string `json:"email"`
 		EmailVerified bool   `json:"email_verified"`
+		GivenName     string `json:"given_name,omitempty"`
+		FamilyName    string `json:"family_name,omitempty"`
+		Name          string `json:"name,omitempty"`
 	}{
 		Sub:           claims.Subject,
 		Email:         claims.Subject,
 		EmailVerified: true,
+		GivenName:     claims.GivenName,
+		FamilyName:    claims.FamilyName,
+		Name:          claims.Name,
 	}
 
 	if err := json.NewEncoder(w).Encode(userinfo); err != nil {
@@ -0,0 +1,66 @@
+package authservice
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/ssoready/ssoready/internal/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuthAuthnRequestOptions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		query string
+		out   store.AuthnRequestOptions
+	}{
+		{
+			name:  "none",
+			query: "client_id=saml_oauth_client_1",
+			out:   store.AuthnRequestOptions{},
+		},
+		{
+			name:  "prompt login",
+			query: "prompt=login",
+			out:   store.AuthnRequestOptions{ForceAuthn: true},
+		},
+		{
+			name:  "prompt none",
+			query: "prompt=none",
+			out:   store.AuthnRequestOptions{IsPassive: true},
+		},
+		{
+			name:  "multiple prompts",
+			query: "prompt=consent+login",
+			out:   store.AuthnRequestOptions{ForceAuthn: true},
+		},
+		{
+			name:  "max age zero",
+			query: "max_age=0",
+			out:   store.AuthnRequestOptions{ForceAuthn: true},
+		},
+		{
+			name:  "max age nonzero",
+			query: "max_age=3600",
+			out:   store.AuthnRequestOptions{},
+		},
+		{
+			name:  "acr values",
+			query: "acr_values=urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract+urn:oasis:names:tc:SAML:2.0:ac:classes:X509",
+			out: store.AuthnRequestOptions{
+				RequestedAuthnContextClassRefs: []string{
+					"urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract",
+					"urn:oasis:names:tc:SAML:2.0:ac:classes:X509",
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := url.ParseQuery(tt.query)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.out, oauthAuthnRequestOptions(query))
+		})
+	}
+}
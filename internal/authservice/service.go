@@ -19,13 +19,20 @@ import (
 
 	"connectrpc.com/connect"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/mux"
 	"github.com/ssoready/ssoready/internal/emailaddr"
 	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/samlprofile"
 	"github.com/ssoready/ssoready/internal/statesign"
 	"github.com/ssoready/ssoready/internal/store"
 )
 
+// idpInitiatedMaxAge is how old an IDP-initiated assertion may be. Without a
+// SAML flow to tie them to, IDP-initiated assertions are protected from replay
+// only by their assertion ID, so they must be used promptly.
+const idpInitiatedMaxAge = 5 * time.Minute
+
 type acsTemplateData struct {
 	SignOnURL   string
 	SAMLRequest string
@@ -56,7 +63,15 @@ type errorTemplateData struct {
 	WantCertificatePEM      string
 	GotCertificatePEM       string
 	GotSubjectID            string
+	WantEmailAttributes     string
+	GotEmail                string
 	WantEmailDomains        string
+	WantACSURL              string
+	GotACSURL               string
+	GotSubjectConfirmation  string
+	GotIDPStatusCode        string
+	GotIDPSubStatusCode     string
+	GotIDPStatusMessage     string
 }
 
 //go:embed templates/static
@@ -72,14 +87,22 @@ type Service struct {
 	Store                  *store.Store
 	OAuthIDTokenPrivateKey *rsa.PrivateKey
 	StateSigner            statesign.Signer
+
+	// ArtifactResolutionHTTPClient is used to resolve artifacts sent using
+	// the HTTP-Artifact binding. If nil, a client from
+	// saml.NewArtifactResolutionHTTPClient is used.
+	ArtifactResolutionHTTPClient *http.Client
 }
 
 func (s *Service) NewHandler() http.Handler {
 	r := mux.NewRouter()
 
 	r.PathPrefix("/internal/static/").Handler(http.StripPrefix("/internal/static/", http.FileServer(http.FS(staticFS))))
+	r.Handle("/v1/saml/{saml_conn_id}/metadata", logHandlerNoRespHeaders(http.HandlerFunc(s.samlMetadata))).Methods("GET")
 	r.Handle("/v1/saml/{saml_conn_id}/init", logHandlerNoRespHeaders(http.HandlerFunc(s.samlInit))).Methods("GET")
-	r.Handle("/v1/saml/{saml_conn_id}/acs", logHandlerNoRespHeaders(http.HandlerFunc(s.samlAcs))).Methods("POST")
+	r.Handle("/v1/saml/{saml_conn_id}/acs", logHandlerNoRespHeaders(http.HandlerFunc(s.samlAcs))).Methods("GET", "POST")
+	r.Handle("/v1/saml/{saml_conn_id}/slo", logHandlerNoRespHeaders(http.HandlerFunc(s.samlSLO))).Methods("GET", "POST")
+	r.Handle("/v1/saml/{saml_conn_id}/slo/init", logHandlerNoRespHeaders(http.HandlerFunc(s.samlSLOInit))).Methods("GET")
 
 	r.HandleFunc("/v1/oauth/.well-known/openid-configuration", s.oauthOpenIDConfiguration).Methods("GET")
 	r.HandleFunc("/v1/oauth/authorize", s.oauthAuthorize).Methods("GET")
@@ -103,6 +126,55 @@ func (s *Service) NewHandler() http.Handler {
 	return r
 }
 
+func (s *Service) samlMetadata(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	samlConnID := mux.Vars(r)["saml_conn_id"]
+
+	slog.InfoContext(ctx, "metadata", "saml_connection_id", samlConnID)
+
+	// the entity ID and ACS URL are fixed when the SAML connection is created,
+	// so metadata is the same regardless of whether it's requested from a
+	// custom auth domain
+	dataRes, err := s.Store.AuthGetMetadataData(ctx, samlConnID)
+	if err != nil {
+		if errors.Is(err, store.ErrNoSuchSAMLConnection) {
+			http.Error(w, "saml connection not found", http.StatusNotFound)
+			return
+		}
+
+		panic(err)
+	}
+
+	var signingCert, encryptionCert *x509.Certificate
+	if dataRes.SPSigningCertificate != nil {
+		signingCert, err = x509.ParseCertificate(dataRes.SPSigningCertificate)
+		if err != nil {
+			panic(err)
+		}
+	}
+	if dataRes.SPEncryptionCertificate != nil {
+		encryptionCert, err = x509.ParseCertificate(dataRes.SPEncryptionCertificate)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	metadata := saml.SPMetadata(&saml.SPMetadataRequest{
+		SPEntityID:            dataRes.SPEntityID,
+		SPACSURL:              dataRes.SPACSURL,
+		SPSLOURL:              dataRes.SPSLOURL,
+		SignAuthnRequests:     dataRes.SignAuthnRequests,
+		SigningCertificate:    signingCert,
+		EncryptionCertificate: encryptionCert,
+		ArtifactBinding:       dataRes.ArtifactBinding,
+	})
+
+	w.Header().Set("Content-Type", "application/samlmetadata+xml")
+	if _, err := w.Write(metadata); err != nil {
+		panic(err)
+	}
+}
+
 func (s *Service) samlInit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -125,10 +197,20 @@ func (s *Service) samlInit(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 
+	signingKey, signingCert := parseSPSigningKey(dataRes.SPSigningPrivateKey, dataRes.SPSigningCertificate)
 	initRes := saml.Init(&saml.InitRequest{
-		RequestID:  dataRes.RequestID,
-		SPEntityID: dataRes.SPEntityID,
-		Now:        time.Now(),
+		RequestID:                       dataRes.RequestID,
+		SPEntityID:                      dataRes.SPEntityID,
+		Now:                             time.Now(),
+		Binding:                         dataRes.IDPBinding,
+		RelayState:                      dataRes.RequestID,
+		SigningKey:                      signingKey,
+		SigningCertificate:              signingCert,
+		ForceAuthn:                      dataRes.AuthnRequestOptions.ForceAuthn,
+		IsPassive:                       dataRes.AuthnRequestOptions.IsPassive,
+		NameIDPolicyFormat:              dataRes.AuthnRequestOptions.NameIDPolicyFormat,
+		RequestedAuthnContextClassRefs:  dataRes.AuthnRequestOptions.RequestedAuthnContextClassRefs,
+		RequestedAuthnContextComparison: dataRes.AuthnRequestOptions.RequestedAuthnContextComparison,
 	})
 
 	if err := s.Store.AuthUpsertInitiateData(ctx, &store.AuthUpsertInitiateDataRequest{
@@ -138,14 +220,58 @@ func (s *Service) samlInit(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 
+	sendAuthnRequest(w, r, dataRes.IDPRedirectURL, initRes)
+}
+
+// sendAuthnRequest sends the user to the IDP with the AuthnRequest in initRes,
+// using the binding it was built for.
+func sendAuthnRequest(w http.ResponseWriter, r *http.Request, idpRedirectURL string, initRes *saml.InitResponse) {
+	if initRes.RedirectQuery != "" {
+		u, err := url.Parse(idpRedirectURL)
+		if err != nil {
+			panic(fmt.Errorf("parse idp redirect url: %w", err))
+		}
+
+		// some IDPs put parameters in their redirect URL, which must be
+		// preserved
+		if u.RawQuery != "" {
+			u.RawQuery += "&"
+		}
+		u.RawQuery += initRes.RedirectQuery
+
+		http.Redirect(w, r, u.String(), http.StatusFound)
+		return
+	}
+
 	if err := acsTemplate.Execute(w, &acsTemplateData{
-		SignOnURL:   dataRes.IDPRedirectURL,
+		SignOnURL:   idpRedirectURL,
 		SAMLRequest: initRes.SAMLRequest,
 	}); err != nil {
 		panic(fmt.Errorf("acsTemplate.Execute: %w", err))
 	}
 }
 
+// parseSPSigningKey parses the DER-encoded keypair a SAML connection signs its
+// AuthnRequests and logout messages with. It returns nils if the connection
+// does not sign them.
+func parseSPSigningKey(keyDER, certDER []byte) (*rsa.PrivateKey, *x509.Certificate) {
+	if keyDER == nil {
+		return nil, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(keyDER)
+	if err != nil {
+		panic(err)
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		panic(err)
+	}
+
+	return key.(*rsa.PrivateKey), cert
+}
+
 func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	samlConnID := mux.Vars(r)["saml_conn_id"]
@@ -189,18 +315,66 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		panic(err)
 	}
 
-	cert, err := x509.ParseCertificate(dataRes.IDPX509Certificate)
-	if err != nil {
-		panic(err)
+	samlResponse := r.FormValue("SAMLResponse")
+
+	// IDPs using the HTTP-Artifact binding send a reference to the SAML
+	// response instead, which we must resolve with the IDP directly
+	if samlArt := r.FormValue("SAMLart"); samlArt != "" {
+		var errArtifactResolution string
+		if dataRes.IDPArtifactResolutionURL == "" {
+			errArtifactResolution = "saml connection has no idp artifact resolution url"
+		} else {
+			signingKey, signingCert := parseSPSigningKey(dataRes.SPSigningPrivateKey, dataRes.SPSigningCertificate)
+			resolveRes, err := saml.ResolveArtifact(ctx, &saml.ResolveArtifactRequest{
+				HTTPClient:            s.ArtifactResolutionHTTPClient,
+				ArtifactResolutionURL: dataRes.IDPArtifactResolutionURL,
+				Artifact:              samlArt,
+				RequestID:             fmt.Sprintf("_%s", uuid.New()),
+				SPEntityID:            dataRes.SPEntityID,
+				IDPEntityID:           dataRes.IDPEntityID,
+				Now:                   time.Now(),
+				SigningKey:            signingKey,
+				SigningCertificate:    signingCert,
+			})
+
+			slog.InfoContext(ctx, "acs_resolve_artifact", "resolve_res", resolveRes, "resolve_err", err)
+
+			if err != nil {
+				if errors.Is(err, saml.ErrMalformedArtifact) {
+					http.Error(w, "malformed artifact", http.StatusBadRequest)
+					return
+				}
+				if !errors.Is(err, saml.ErrArtifactResolution) {
+					panic(err)
+				}
+
+				errArtifactResolution = err.Error()
+			} else {
+				samlResponse = resolveRes.SAMLResponse
+			}
+		}
+
+		if errArtifactResolution != "" {
+			createSAMLLoginRes, err := s.Store.AuthUpsertReceiveAssertionData(ctx, &store.AuthUpsertSAMLLoginEventRequest{
+				SAMLConnectionID:        samlConnID,
+				ErrorArtifactResolution: &errArtifactResolution,
+			})
+			if err != nil {
+				panic(err)
+			}
+
+			if err := errorTemplate.Execute(w, &errorTemplateData{
+				ErrorMessage: "SAML artifact could not be resolved with the Identity Provider. This may need to be fixed in the Identity Provider, or in the Service Provider's artifact resolution URL.",
+				SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+			}); err != nil {
+				panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+			}
+			return
+		}
 	}
 
-	validateRes, err := saml.Validate(&saml.ValidateRequest{
-		SAMLResponse:   r.FormValue("SAMLResponse"),
-		IDPCertificate: cert,
-		IDPEntityID:    dataRes.IDPEntityID,
-		SPEntityID:     dataRes.SPEntityID,
-		Now:            time.Now(),
-	})
+	now := time.Now()
+	validateRes, err := validateACSResponse(dataRes, samlResponse, now)
 
 	slog.InfoContext(ctx, "acs_validate", "validate_res", validateRes, "validate_err", err)
 
@@ -208,21 +382,32 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 
 	// populated even in the case of validate errors
 	var (
-		requestID   string
-		assertionID string
-		assertion   string
+		requestID       string
+		assertionID     string
+		assertion       string
+		responseSigned  bool
+		assertionSigned bool
+		idpCertificate  *x509.Certificate
+		idpVendor       saml.Vendor
 	)
 
 	// populated when there are validate errors
 	var (
-		malformedAssertion    bool
-		unsignedAssertion     bool
-		expiredAssertion      bool
-		badIssuer             *string
-		badAudience           *string
-		badSignatureAlgorithm *string
-		badDigestAlgorithm    *string
-		badCertificate        *x509.Certificate
+		malformedAssertion           bool
+		undecryptableAssertion       bool
+		unsignedAssertion            bool
+		expiredAssertion             bool
+		expiredSubjectConfirmation   bool
+		badIssuer                    *string
+		badAudience                  *string
+		badSignatureAlgorithm        *string
+		badDigestAlgorithm           *string
+		badCertificate               *x509.Certificate
+		badDestination               *string
+		badRecipient                 *string
+		badSubjectConfirmationMethod *string
+		failedStatus                 *saml.IDPStatus
+		unverifiedFailedStatus       bool
 	)
 
 	// populate validateRes if present; we populate in the unhappy path below
@@ -230,6 +415,10 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		requestID = validateRes.RequestID
 		assertionID = validateRes.AssertionID
 		assertion = validateRes.Assertion
+		responseSigned = validateRes.ResponseSigned
+		assertionSigned = validateRes.AssertionSigned
+		idpCertificate = validateRes.IDPCertificate
+		idpVendor = validateRes.IDPVendor
 	}
 
 	// note: if err is a saml.ValidateError, then this method continues to flow
@@ -244,7 +433,12 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 			requestID = validateError.RequestID
 			assertionID = validateError.AssertionID
 			assertion = validateError.Assertion
+			responseSigned = validateError.ResponseSigned
+			assertionSigned = validateError.AssertionSigned
+			idpCertificate = validateError.IDPCertificate
+			idpVendor = validateError.IDPVendor
 			malformedAssertion = validateError.MalformedAssertion
+			undecryptableAssertion = validateError.UndecryptableAssertion
 			unsignedAssertion = validateError.UnsignedAssertion
 			expiredAssertion = validateError.ExpiredAssertion
 			badIssuer = validateError.BadIDPEntityID
@@ -252,6 +446,12 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 			badSignatureAlgorithm = validateError.BadSignatureAlgorithm
 			badDigestAlgorithm = validateError.BadDigestAlgorithm
 			badCertificate = validateError.BadCertificate
+			expiredSubjectConfirmation = validateError.ExpiredSubjectConfirmation
+			badDestination = validateError.BadDestination
+			badRecipient = validateError.BadRecipient
+			badSubjectConfirmationMethod = validateError.BadSubjectConfirmationMethod
+			failedStatus = validateError.FailedStatus
+			unverifiedFailedStatus = validateError.UnverifiedFailedStatus
 		} else {
 			panic(err)
 		}
@@ -262,11 +462,52 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if expiredAssertion {
-		http.Error(w, "expired assertion", http.StatusBadRequest)
+	if undecryptableAssertion {
+		http.Error(w, "undecryptable assertion", http.StatusBadRequest)
 		return
 	}
 
+	// anyone can send an unsigned failed status, so it must not fail the flow
+	// it claims to respond to; keep it on the flow only to help debugging
+	if failedStatus != nil && unverifiedFailedStatus {
+		if requestID != "" {
+			if err := s.Store.AuthRecordUnverifiedIDPStatus(ctx, &store.AuthRecordUnverifiedIDPStatusRequest{
+				SAMLConnectionID: samlConnID,
+				SAMLFlowID:       requestID,
+				StatusCode:       failedStatus.StatusCode,
+				SubStatusCode:    failedStatus.SubStatusCode,
+				StatusMessage:    failedStatus.StatusMessage,
+			}); err != nil && !errors.Is(err, store.InvalidSAMLRequestID) {
+				panic(err)
+			}
+		}
+
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage:        idpStatusErrorMessage(failedStatus),
+			SAMLFlowID:          requestID,
+			GotIDPStatusCode:    failedStatus.StatusCode,
+			GotIDPSubStatusCode: failedStatus.SubStatusCode,
+			GotIDPStatusMessage: failedStatus.StatusMessage,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+
+	// assertions that aren't in response to a SAML flow we started are
+	// IDP-initiated
+	idpInitiated := requestID == ""
+	idpInitiatedNotAllowed := idpInitiated && !dataRes.AllowIDPInitiated
+	var expiredIDPInitiatedAssertion bool
+	if idpInitiated && !idpInitiatedNotAllowed && validateRes != nil {
+		if assertionID == "" {
+			http.Error(w, "idp-initiated assertion has no id", http.StatusBadRequest)
+			return
+		}
+
+		expiredIDPInitiatedAssertion = idpInitiatedAssertionExpired(validateRes, now)
+	}
+
 	alreadyProcessed, err := s.Store.AuthCheckAssertionAlreadyProcessed(ctx, requestID)
 	if err != nil {
 		if errors.Is(err, store.InvalidSAMLRequestID) {
@@ -290,17 +531,19 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 	)
 
 	if validateRes != nil {
-		// extract a domain from the subject ID
-		subjectEmailDomain, err := emailaddr.Parse(validateRes.SubjectID)
+		// extract a domain from the subject's email, which is either their
+		// subject ID or one of their attributes
+		gotEmail := subjectEmail(validateRes, dataRes.EmailAttributeNames)
+		subjectEmailDomain, err := emailaddr.Parse(gotEmail)
 		if err != nil {
-			// the subject ID isn't an email
-			badSubjectID = &validateRes.SubjectID
+			// the subject's email isn't an email
+			badSubjectID = &gotEmail
 		}
 
-		// the subject ID is an email, validate that it's from the set of
+		// the subject's email is an email, validate that it's from the set of
 		// organization domains
 		if badSubjectID == nil {
-			email = validateRes.SubjectID
+			email = gotEmail
 
 			var domainOk bool
 			for _, domain := range dataRes.OrganizationDomains {
@@ -314,18 +557,45 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	var subjectIDPAttributes map[string]string
+	var idpStatusCode, idpSubStatusCode, idpStatusMessage *string
+	if failedStatus != nil {
+		idpStatusCode = &failedStatus.StatusCode
+		idpSubStatusCode = nilIfEmpty(failedStatus.SubStatusCode)
+		idpStatusMessage = nilIfEmpty(failedStatus.StatusMessage)
+	}
+
+	var subjectID, subjectIDFormat string
+	var subjectIDPAttributes map[string][]string
+	var subjectProfile samlprofile.Profile
+	var subjectSessionIndex string
+	var subjectAuthnInstant, subjectSessionNotOnOrAfter time.Time
+	var subjectAuthnContextClassRef string
 	if validateRes != nil {
+		subjectID = validateRes.SubjectID
+		subjectIDFormat = validateRes.SubjectIDFormat
 		subjectIDPAttributes = validateRes.SubjectAttributes
+		subjectProfile = samlprofile.Map(dataRes.AttributeMapping, validateRes.SubjectAttributes)
+		subjectSessionIndex = validateRes.SessionIndex
+		subjectAuthnInstant = validateRes.AuthnInstant
+		subjectSessionNotOnOrAfter = validateRes.SessionNotOnOrAfter
+		subjectAuthnContextClassRef = validateRes.AuthnContextClassRef
 	}
 
 	createSAMLLoginRes, err := s.Store.AuthUpsertReceiveAssertionData(ctx, &store.AuthUpsertSAMLLoginEventRequest{
 		SAMLConnectionID:                     samlConnID,
-		SAMLAssertionID:                      &assertionID,
+		SAMLAssertionID:                      nilIfEmpty(assertionID),
 		SAMLFlowID:                           requestID,
 		Email:                                email,
+		SubjectID:                            subjectID,
+		SubjectIDFormat:                      subjectIDFormat,
 		SubjectIDPAttributes:                 subjectIDPAttributes,
+		SubjectProfile:                       subjectProfile,
+		SubjectSessionIndex:                  subjectSessionIndex,
+		SubjectAuthnInstant:                  subjectAuthnInstant,
+		SubjectSessionNotOnOrAfter:           subjectSessionNotOnOrAfter,
+		SubjectAuthnContextClassRef:          subjectAuthnContextClassRef,
 		SAMLAssertion:                        assertion,
+		IDPInitiated:                         idpInitiated,
 		ErrorUnsignedAssertion:               unsignedAssertion,
 		ErrorBadIssuer:                       badIssuer,
 		ErrorBadAudience:                     badAudience,
@@ -334,6 +604,20 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		ErrorBadCertificate:                  badCertificate,
 		ErrorBadSubjectID:                    badSubjectID,
 		ErrorEmailOutsideOrganizationDomains: domainMismatchEmail,
+		ErrorIDPInitiatedNotAllowed:          idpInitiatedNotAllowed,
+		ErrorExpiredAssertion:                expiredAssertion,
+		ErrorExpiredIDPInitiatedAssertion:    expiredIDPInitiatedAssertion,
+		ErrorExpiredSubjectConfirmation:      expiredSubjectConfirmation,
+		ErrorBadDestination:                  badDestination,
+		ErrorBadRecipient:                    badRecipient,
+		ErrorBadSubjectConfirmationMethod:    badSubjectConfirmationMethod,
+		ErrorIDPStatusCode:                   idpStatusCode,
+		ErrorIDPSubStatusCode:                idpSubStatusCode,
+		ErrorIDPStatusMessage:                idpStatusMessage,
+		ResponseSigned:                       responseSigned,
+		AssertionSigned:                      assertionSigned,
+		IDPCertificate:                       idpCertificate,
+		IDPVendor:                            string(idpVendor),
 	})
 	if err != nil {
 		if errors.Is(err, store.ErrDuplicateAssertionID) {
@@ -350,6 +634,36 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// present an error to the end user depending on their settings
+	if failedStatus != nil {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage:        idpStatusErrorMessage(failedStatus),
+			SAMLFlowID:          createSAMLLoginRes.SAMLFlowID,
+			GotIDPStatusCode:    failedStatus.StatusCode,
+			GotIDPSubStatusCode: failedStatus.SubStatusCode,
+			GotIDPStatusMessage: failedStatus.StatusMessage,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if idpInitiatedNotAllowed {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage: "IDP-initiated logins are not enabled for this SAML connection. This needs to be fixed in the Service Provider, or the login needs to be started from the Service Provider.",
+			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if expiredIDPInitiatedAssertion {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage: "This IDP-initiated login was started too long ago. Start the login again from the Identity Provider.",
+			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
 	if unsignedAssertion {
 		if err := errorTemplate.Execute(w, &errorTemplateData{
 			ErrorMessage: "SAML assertion is unsigned. This needs to be fixed in the Identity Provider.",
@@ -404,7 +718,7 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 	if badCertificate != nil {
 		wantCertPEM := pem.EncodeToMemory(&pem.Block{
 			Type:  "CERTIFICATE",
-			Bytes: cert.Raw,
+			Bytes: dataRes.IDPX509Certificate,
 		})
 
 		gotCertPEM := pem.EncodeToMemory(&pem.Block{
@@ -422,7 +736,57 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
-	if badSubjectID != nil {
+	if expiredAssertion {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage: "SAML assertion is expired or not yet valid. This may need to be fixed in the Identity Provider, or by allowing for more clock skew in the Service Provider.",
+			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if badDestination != nil {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage: "Incorrect SAML response destination. This needs to be fixed in the Identity Provider.",
+			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+			WantACSURL:   dataRes.SPACSURL,
+			GotACSURL:    *badDestination,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if badSubjectConfirmationMethod != nil {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage:           "SAML subject confirmation method must be bearer. This needs to be fixed in the Identity Provider.",
+			SAMLFlowID:             createSAMLLoginRes.SAMLFlowID,
+			GotSubjectConfirmation: *badSubjectConfirmationMethod,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if badRecipient != nil {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage: "Incorrect SAML subject confirmation recipient. This needs to be fixed in the Identity Provider.",
+			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+			WantACSURL:   dataRes.SPACSURL,
+			GotACSURL:    *badRecipient,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if expiredSubjectConfirmation {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage: "SAML subject confirmation is expired. This may need to be fixed in the Identity Provider, or by allowing for more clock skew in the Service Provider.",
+			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
+	if badSubjectID != nil && len(dataRes.EmailAttributeNames) == 0 {
 		if err := errorTemplate.Execute(w, &errorTemplateData{
 			ErrorMessage: "Subject ID must be an email address. This needs to be fixed in the Identity Provider.",
 			SAMLFlowID:   createSAMLLoginRes.SAMLFlowID,
@@ -432,11 +796,24 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	if badSubjectID != nil {
+		if err := errorTemplate.Execute(w, &errorTemplateData{
+			ErrorMessage:        "Email attribute is missing or is not an email address. This needs to be fixed in the Identity Provider.",
+			SAMLFlowID:          createSAMLLoginRes.SAMLFlowID,
+			GotSubjectID:        validateRes.SubjectID,
+			WantEmailAttributes: strings.Join(dataRes.EmailAttributeNames, ", "),
+			GotEmail:            *badSubjectID,
+		}); err != nil {
+			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
+		}
+		return
+	}
 	if domainMismatchEmail != nil {
 		if err := errorTemplate.Execute(w, &errorTemplateData{
-			ErrorMessage:     "Subject ID email address is not from the list of allowed domains. This needs to be fixed in the Identity Provider.",
+			ErrorMessage:     "Subject email address is not from the list of allowed domains. This needs to be fixed in the Identity Provider.",
 			SAMLFlowID:       createSAMLLoginRes.SAMLFlowID,
 			GotSubjectID:     validateRes.SubjectID,
+			GotEmail:         email,
 			WantEmailDomains: strings.Join(dataRes.OrganizationDomains, ", "),
 		}); err != nil {
 			panic(fmt.Errorf("acsTemplate.Execute: %w", err))
@@ -507,3 +884,111 @@ func (s *Service) samlAcs(w http.ResponseWriter, r *http.Request) {
 
 	http.Redirect(w, r, redirect, http.StatusSeeOther)
 }
+
+// subjectEmail returns the subject's email address: the first non-empty value
+// of emailAttributeNames, tried in order, or the subject ID if
+// emailAttributeNames is empty.
+func subjectEmail(validateRes *saml.ValidateResponse, emailAttributeNames []string) string {
+	if len(emailAttributeNames) == 0 {
+		return validateRes.SubjectID
+	}
+
+	for _, name := range emailAttributeNames {
+		for _, v := range validateRes.SubjectAttributes[name] {
+			if v != "" {
+				return v
+			}
+		}
+	}
+
+	return ""
+}
+
+// validateACSResponse validates a SAML response received by the ACS against
+// the SAML connection it was sent to.
+func validateACSResponse(dataRes *store.AuthGetValidateDataResponse, samlResponse string, now time.Time) (*saml.ValidateResponse, error) {
+	idpCerts, err := parseIDPCertificates(dataRes.IDPX509Certificate, dataRes.AdditionalIDPCertificates)
+	if err != nil {
+		return nil, err
+	}
+
+	var spDecryptionKeys []*rsa.PrivateKey
+	for _, keyDER := range [][]byte{dataRes.SPEncryptionPrivateKey, dataRes.SPPreviousEncryptionPrivateKey} {
+		if keyDER == nil {
+			continue
+		}
+
+		key, err := x509.ParsePKCS8PrivateKey(keyDER)
+		if err != nil {
+			return nil, fmt.Errorf("parse sp encryption private key: %w", err)
+		}
+		spDecryptionKeys = append(spDecryptionKeys, key.(*rsa.PrivateKey))
+	}
+
+	return saml.Validate(&saml.ValidateRequest{
+		SAMLResponse:               samlResponse,
+		IDPCertificates:            idpCerts,
+		IDPEntityID:                dataRes.IDPEntityID,
+		SPEntityID:                 dataRes.SPEntityID,
+		SPACSURL:                   dataRes.SPACSURL,
+		Now:                        now,
+		ClockSkew:                  dataRes.AllowedClockSkew,
+		AllowedSignatureAlgorithms: dataRes.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    dataRes.AllowedDigestAlgorithms,
+		SPDecryptionKeys:           spDecryptionKeys,
+	})
+}
+
+// parseIDPCertificates parses a SAML connection's primary IDP certificate and
+// its additional certificates into the certificates to verify IDP signatures
+// against.
+func parseIDPCertificates(certDER []byte, additionalCerts []store.AuthIDPCertificate) ([]saml.IDPCertificate, error) {
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, fmt.Errorf("parse idp certificate: %w", err)
+	}
+
+	idpCerts := []saml.IDPCertificate{{Certificate: cert}}
+	for _, additionalCert := range additionalCerts {
+		cert, err := x509.ParseCertificate(additionalCert.X509Certificate)
+		if err != nil {
+			return nil, fmt.Errorf("parse additional idp certificate: %w", err)
+		}
+
+		idpCerts = append(idpCerts, saml.IDPCertificate{
+			Certificate: cert,
+			NotBefore:   additionalCert.NotBefore,
+			NotAfter:    additionalCert.NotAfter,
+		})
+	}
+
+	return idpCerts, nil
+}
+
+// idpInitiatedAssertionExpired reports whether an IDP-initiated assertion was
+// issued too long ago to accept. IDP-initiated assertions aren't tied to a
+// SAML flow, so this limits how long they can be replayed.
+func idpInitiatedAssertionExpired(validateRes *saml.ValidateResponse, now time.Time) bool {
+	return now.Sub(validateRes.IssueInstant) > idpInitiatedMaxAge
+}
+
+// idpStatusErrorMessage describes to the end user why the IDP did not log them
+// in, based on the status it responded with.
+func idpStatusErrorMessage(status *saml.IDPStatus) string {
+	switch status.SubStatusCode {
+	case "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed":
+		return "The Identity Provider was unable to authenticate you. Try logging in again, or contact your IT administrator."
+	case "urn:oasis:names:tc:SAML:2.0:status:RequestDenied":
+		return "The Identity Provider denied this login. You may not have been assigned access to this application; contact your IT administrator."
+	case "urn:oasis:names:tc:SAML:2.0:status:NoPassive":
+		return "The Identity Provider could not log you in without prompting you. Log in to the Identity Provider, then try again."
+	}
+	return "The Identity Provider did not log you in. Contact your IT administrator."
+}
+
+func nilIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
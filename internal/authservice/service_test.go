@@ -0,0 +1,204 @@
+package authservice
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/samltest"
+	"github.com/ssoready/ssoready/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubjectEmail(t *testing.T) {
+	validateRes := &saml.ValidateResponse{
+		SubjectID: "00u1a2b3c4d5e6f7g8h9",
+		SubjectAttributes: map[string][]string{
+			"email": {""},
+			"mail":  {"jane.doe@example.com", "jane@example.com"},
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		emailAttributeNames []string
+		out                 string
+	}{
+		{
+			name: "name id",
+			out:  "00u1a2b3c4d5e6f7g8h9",
+		},
+		{
+			name:                "attribute",
+			emailAttributeNames: []string{"mail"},
+			out:                 "jane.doe@example.com",
+		},
+		{
+			name:                "fallback past empty and missing attributes",
+			emailAttributeNames: []string{"emailaddress", "email", "mail"},
+			out:                 "jane.doe@example.com",
+		},
+		{
+			name:                "no such attribute",
+			emailAttributeNames: []string{"emailaddress"},
+			out:                 "",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.out, subjectEmail(validateRes, tt.emailAttributeNames))
+		})
+	}
+}
+
+func TestIDPStatusErrorMessage(t *testing.T) {
+	assert.Contains(t, idpStatusErrorMessage(&saml.IDPStatus{
+		StatusCode:    "urn:oasis:names:tc:SAML:2.0:status:Responder",
+		SubStatusCode: "urn:oasis:names:tc:SAML:2.0:status:NoPassive",
+	}), "without prompting you")
+
+	assert.Equal(t, "The Identity Provider did not log you in. Contact your IT administrator.", idpStatusErrorMessage(&saml.IDPStatus{
+		StatusCode: "urn:oasis:names:tc:SAML:2.0:status:Responder",
+	}))
+}
+
+func TestValidateACSResponse(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	idp := samltest.NewIDP(t)
+	otherIDP := samltest.NewIDP(t)
+
+	spKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	spKeyDER, err := x509.MarshalPKCS8PrivateKey(spKey)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		modify  func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse)
+		err     func(t *testing.T, validateError *saml.ValidateError)
+		subject string
+	}{
+		{
+			name:    "signed",
+			modify:  func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse) {},
+			subject: "jane.doe@example.com",
+		},
+		{
+			name: "encrypted",
+			modify: func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse) {
+				res.EncryptAssertionFor = &spKey.PublicKey
+				dataRes.SPEncryptionPrivateKey = spKeyDER
+			},
+			subject: "jane.doe@example.com",
+		},
+		{
+			name: "encrypted for previous key",
+			modify: func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse) {
+				res.EncryptAssertionFor = &spKey.PublicKey
+				dataRes.SPPreviousEncryptionPrivateKey = spKeyDER
+			},
+			subject: "jane.doe@example.com",
+		},
+		{
+			name: "additional certificate",
+			modify: func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse) {
+				dataRes.IDPX509Certificate = otherIDP.Certificate.Raw
+				dataRes.AdditionalIDPCertificates = []store.AuthIDPCertificate{{X509Certificate: idp.Certificate.Raw}}
+			},
+			subject: "jane.doe@example.com",
+		},
+		{
+			name: "signed by another idp",
+			modify: func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse) {
+				dataRes.IDPX509Certificate = otherIDP.Certificate.Raw
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.Equal(t, idp.Certificate, validateError.BadCertificate)
+			},
+		},
+		{
+			name: "forged unsigned failure",
+			modify: func(res *samltest.Response, dataRes *store.AuthGetValidateDataResponse) {
+				res.InResponseTo = "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd"
+				res.StatusCode = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+				res.SubStatusCode = "urn:oasis:names:tc:SAML:2.0:status:RequestDenied"
+				res.Assertion = nil
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.Equal(t, "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd", validateError.RequestID)
+				assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:status:RequestDenied", validateError.FailedStatus.SubStatusCode)
+				assert.True(t, validateError.UnverifiedFailedStatus)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+			dataRes := validateDataFromIDP(idp)
+			tt.modify(res, dataRes)
+
+			validateRes, err := validateACSResponse(dataRes, idp.SAMLResponse(t, res), now)
+			if tt.err != nil {
+				var validateError *saml.ValidateError
+				require.ErrorAs(t, err, &validateError)
+				tt.err(t, validateError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.subject, subjectEmail(validateRes, dataRes.EmailAttributeNames))
+			assert.Equal(t, idp.Certificate, validateRes.IDPCertificate)
+		})
+	}
+}
+
+func TestValidateACSResponse_IDPInitiatedMaxAge(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	idp := samltest.NewIDP(t)
+
+	testCases := []struct {
+		name         string
+		issueInstant time.Time
+		expired      bool
+	}{
+		{
+			name:         "recent",
+			issueInstant: now.Add(-time.Minute),
+		},
+		{
+			name:         "expired",
+			issueInstant: now.Add(-idpInitiatedMaxAge - time.Second),
+			expired:      true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			// the assertion is still within its validity window, but was
+			// issued too long ago for an idp-initiated login
+			res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+			res.Assertion.IssueInstant = tt.issueInstant
+
+			validateRes, err := validateACSResponse(validateDataFromIDP(idp), idp.SAMLResponse(t, res), now)
+			require.NoError(t, err)
+			assert.Empty(t, validateRes.RequestID)
+			assert.Equal(t, tt.expired, idpInitiatedAssertionExpired(validateRes, now))
+		})
+	}
+}
+
+func validateDataFromIDP(idp *samltest.IDP) *store.AuthGetValidateDataResponse {
+	return &store.AuthGetValidateDataResponse{
+		SPEntityID:         "http://sp.example.com",
+		SPACSURL:           "http://sp.example.com/acs",
+		IDPEntityID:        idp.EntityID,
+		IDPX509Certificate: idp.Certificate.Raw,
+		AllowIDPInitiated:  true,
+	}
+}
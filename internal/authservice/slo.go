@@ -0,0 +1,206 @@
+package authservice
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/gorilla/mux"
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/store"
+)
+
+// samlSLOInit sends the user to the IDP with a LogoutRequest for a SAML logout
+// created by GetSAMLLogoutRedirectURL.
+func (s *Service) samlSLOInit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	samlConnID := mux.Vars(r)["saml_conn_id"]
+	samlLogoutID := r.URL.Query().Get("saml_logout_id")
+
+	slog.InfoContext(ctx, "slo_init", "saml_connection_id", samlConnID, "saml_logout_id", samlLogoutID)
+
+	dataRes, ok := s.getLogoutData(w, r, samlConnID)
+	if !ok {
+		return
+	}
+
+	if dataRes.IDPSLOURL == "" {
+		http.Error(w, "saml connection does not have an idp single logout url", http.StatusBadRequest)
+		return
+	}
+
+	initiateRes, err := s.Store.AuthInitiateLogout(ctx, samlConnID, samlLogoutID)
+	if err != nil {
+		if errors.Is(err, store.ErrNoSuchSAMLConnection) || errors.Is(err, store.ErrNoSuchSAMLLogout) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		panic(err)
+	}
+
+	signingKey, _ := parseSPSigningKey(dataRes.SPSigningPrivateKey, dataRes.SPSigningCertificate)
+	initRes := saml.InitLogout(&saml.InitLogoutRequest{
+		RequestID:    samlLogoutID,
+		SPEntityID:   dataRes.SPEntityID,
+		Destination:  dataRes.IDPSLOURL,
+		NameID:       initiateRes.NameID,
+		NameIDFormat: initiateRes.NameIDFormat,
+		SessionIndex: initiateRes.SessionIndex,
+		Now:          time.Now(),
+		RelayState:   samlLogoutID,
+		SigningKey:   signingKey,
+	})
+
+	sendLogoutMessage(w, r, dataRes.IDPSLOURL, initRes.RedirectQuery)
+}
+
+// samlSLO receives logout messages from the IDP: either a LogoutRequest, for
+// IDP-initiated logouts, or a LogoutResponse, for logouts initiated by
+// samlSLOInit.
+func (s *Service) samlSLO(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	samlConnID := mux.Vars(r)["saml_conn_id"]
+
+	slog.InfoContext(ctx, "slo", "saml_connection_id", samlConnID, "method", r.Method)
+
+	dataRes, ok := s.getLogoutData(w, r, samlConnID)
+	if !ok {
+		return
+	}
+
+	idpCerts, err := parseIDPCertificates(dataRes.IDPX509Certificate, dataRes.AdditionalIDPCertificates)
+	if err != nil {
+		panic(err)
+	}
+
+	receiveReq := saml.ReceiveLogoutRequest{
+		IDPCertificates:            idpCerts,
+		IDPEntityID:                dataRes.IDPEntityID,
+		Now:                        time.Now(),
+		AllowedSignatureAlgorithms: dataRes.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    dataRes.AllowedDigestAlgorithms,
+	}
+
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		receiveReq.Binding = saml.BindingHTTPPost
+		receiveReq.Form = r.PostForm
+	} else {
+		receiveReq.Binding = saml.BindingHTTPRedirect
+		receiveReq.RawQuery = r.URL.RawQuery
+	}
+
+	receiveRes, err := saml.ReceiveLogout(&receiveReq)
+	if err != nil {
+		slog.InfoContext(ctx, "slo_receive_error", "error", err)
+		http.Error(w, fmt.Sprintf("invalid logout message: %s", err.Error()), http.StatusBadRequest)
+		return
+	}
+
+	if receiveRes.LogoutResponse != nil {
+		samlLogoutID := receiveRes.LogoutResponse.InResponseTo
+		if samlLogoutID == "" {
+			samlLogoutID = receiveRes.RelayState
+		}
+
+		success := receiveRes.LogoutResponse.StatusCode == saml.StatusSuccess
+		slog.InfoContext(ctx, "slo_response", "saml_logout_id", samlLogoutID, "status_code", receiveRes.LogoutResponse.StatusCode)
+
+		returnURL, err := s.Store.AuthCompleteLogout(ctx, samlConnID, samlLogoutID, success)
+		if err != nil {
+			if errors.Is(err, store.ErrNoSuchSAMLLogout) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+
+			panic(err)
+		}
+
+		http.Redirect(w, r, returnURL, http.StatusFound)
+		return
+	}
+
+	samlLogoutID, err := s.Store.AuthCreateIDPInitiatedLogout(ctx, &store.AuthCreateIDPInitiatedLogoutRequest{
+		SAMLConnectionID: samlConnID,
+		NameID:           receiveRes.LogoutRequest.NameID,
+		SessionIndexes:   receiveRes.LogoutRequest.SessionIndexes,
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	slog.InfoContext(ctx, "slo_request", "saml_logout_id", samlLogoutID)
+
+	// an IDP that sends LogoutRequests but does not advertise where to send
+	// the LogoutResponse can't be answered; the best we can do is confirm the
+	// logout to the user
+	if dataRes.IDPSLOURL == "" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		if _, err := w.Write([]byte("You have been logged out.")); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	signingKey, _ := parseSPSigningKey(dataRes.SPSigningPrivateKey, dataRes.SPSigningCertificate)
+	respondRes := saml.RespondLogout(&saml.RespondLogoutRequest{
+		ResponseID:   samlLogoutID,
+		InResponseTo: receiveRes.LogoutRequest.ID,
+		SPEntityID:   dataRes.SPEntityID,
+		Destination:  dataRes.IDPSLOURL,
+		Now:          time.Now(),
+		RelayState:   receiveRes.RelayState,
+		SigningKey:   signingKey,
+	})
+
+	sendLogoutMessage(w, r, dataRes.IDPSLOURL, respondRes.RedirectQuery)
+}
+
+// getLogoutData loads the logout configuration for samlConnID. If it returns
+// false, an error has already been written to w.
+func (s *Service) getLogoutData(w http.ResponseWriter, r *http.Request, samlConnID string) (*store.AuthGetLogoutDataResponse, bool) {
+	dataRes, err := s.Store.AuthGetLogoutData(r.Context(), samlConnID)
+	if err != nil {
+		if errors.Is(err, store.ErrNoSuchSAMLConnection) {
+			http.Error(w, "saml connection not found", http.StatusNotFound)
+			return nil, false
+		}
+
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) && connectErr.Code() == connect.CodeFailedPrecondition {
+			http.Error(w, connectErr.Message(), http.StatusBadRequest)
+			return nil, false
+		}
+
+		panic(err)
+	}
+
+	return dataRes, true
+}
+
+// sendLogoutMessage sends the user to the IDP's single logout URL with a
+// logout message. Logout messages are always sent using the HTTP-Redirect
+// binding.
+func sendLogoutMessage(w http.ResponseWriter, r *http.Request, idpSLOURL, redirectQuery string) {
+	u, err := url.Parse(idpSLOURL)
+	if err != nil {
+		panic(fmt.Errorf("parse idp slo url: %w", err))
+	}
+
+	if u.RawQuery != "" {
+		u.RawQuery += "&"
+	}
+	u.RawQuery += redirectQuery
+
+	http.Redirect(w, r, u.String(), http.StatusFound)
+}
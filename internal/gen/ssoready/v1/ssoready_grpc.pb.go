@@ -22,6 +22,8 @@ const _ = grpc.SupportPackageIsVersion9
 const (
 	SSOReadyService_GetSAMLRedirectURL_FullMethodName                               = "/ssoready.v1.SSOReadyService/GetSAMLRedirectURL"
 	SSOReadyService_RedeemSAMLAccessCode_FullMethodName                             = "/ssoready.v1.SSOReadyService/RedeemSAMLAccessCode"
+	SSOReadyService_GetSAMLLogoutRedirectURL_FullMethodName                         = "/ssoready.v1.SSOReadyService/GetSAMLLogoutRedirectURL"
+	SSOReadyService_ListSAMLLogouts_FullMethodName                                  = "/ssoready.v1.SSOReadyService/ListSAMLLogouts"
 	SSOReadyService_ListSCIMUsers_FullMethodName                                    = "/ssoready.v1.SSOReadyService/ListSCIMUsers"
 	SSOReadyService_GetSCIMUser_FullMethodName                                      = "/ssoready.v1.SSOReadyService/GetSCIMUser"
 	SSOReadyService_ListSCIMGroups_FullMethodName                                   = "/ssoready.v1.SSOReadyService/ListSCIMGroups"
@@ -32,9 +34,12 @@ const (
 	SSOReadyService_UpdateOrganization_FullMethodName                               = "/ssoready.v1.SSOReadyService/UpdateOrganization"
 	SSOReadyService_CreateSetupURL_FullMethodName                                   = "/ssoready.v1.SSOReadyService/CreateSetupURL"
 	SSOReadyService_ListSAMLConnections_FullMethodName                              = "/ssoready.v1.SSOReadyService/ListSAMLConnections"
+	SSOReadyService_ListExpiringSAMLConnections_FullMethodName                      = "/ssoready.v1.SSOReadyService/ListExpiringSAMLConnections"
 	SSOReadyService_GetSAMLConnection_FullMethodName                                = "/ssoready.v1.SSOReadyService/GetSAMLConnection"
 	SSOReadyService_CreateSAMLConnection_FullMethodName                             = "/ssoready.v1.SSOReadyService/CreateSAMLConnection"
 	SSOReadyService_UpdateSAMLConnection_FullMethodName                             = "/ssoready.v1.SSOReadyService/UpdateSAMLConnection"
+	SSOReadyService_RotateSAMLConnectionSPCertificate_FullMethodName                = "/ssoready.v1.SSOReadyService/RotateSAMLConnectionSPCertificate"
+	SSOReadyService_GetSAMLConnectionSPSigningCertificate_FullMethodName            = "/ssoready.v1.SSOReadyService/GetSAMLConnectionSPSigningCertificate"
 	SSOReadyService_ListSCIMDirectories_FullMethodName                              = "/ssoready.v1.SSOReadyService/ListSCIMDirectories"
 	SSOReadyService_GetSCIMDirectory_FullMethodName                                 = "/ssoready.v1.SSOReadyService/GetSCIMDirectory"
 	SSOReadyService_CreateSCIMDirectory_FullMethodName                              = "/ssoready.v1.SSOReadyService/CreateSCIMDirectory"
@@ -78,7 +83,14 @@ const (
 	SSOReadyService_AppGetSAMLConnection_FullMethodName                             = "/ssoready.v1.SSOReadyService/AppGetSAMLConnection"
 	SSOReadyService_AppCreateSAMLConnection_FullMethodName                          = "/ssoready.v1.SSOReadyService/AppCreateSAMLConnection"
 	SSOReadyService_AppUpdateSAMLConnection_FullMethodName                          = "/ssoready.v1.SSOReadyService/AppUpdateSAMLConnection"
+	SSOReadyService_AppRotateSAMLConnectionSPCertificate_FullMethodName             = "/ssoready.v1.SSOReadyService/AppRotateSAMLConnectionSPCertificate"
+	SSOReadyService_AppGetSAMLConnectionSPSigningCertificate_FullMethodName         = "/ssoready.v1.SSOReadyService/AppGetSAMLConnectionSPSigningCertificate"
 	SSOReadyService_AppDeleteSAMLConnection_FullMethodName                          = "/ssoready.v1.SSOReadyService/AppDeleteSAMLConnection"
+	SSOReadyService_AppListSAMLConnectionIDPCertificates_FullMethodName             = "/ssoready.v1.SSOReadyService/AppListSAMLConnectionIDPCertificates"
+	SSOReadyService_AppCreateSAMLConnectionIDPCertificate_FullMethodName            = "/ssoready.v1.SSOReadyService/AppCreateSAMLConnectionIDPCertificate"
+	SSOReadyService_AppDeleteSAMLConnectionIDPCertificate_FullMethodName            = "/ssoready.v1.SSOReadyService/AppDeleteSAMLConnectionIDPCertificate"
+	SSOReadyService_AppListSAMLConnectionIDPMetadataUpdates_FullMethodName          = "/ssoready.v1.SSOReadyService/AppListSAMLConnectionIDPMetadataUpdates"
+	SSOReadyService_AppAcceptSAMLConnectionIDPMetadataUpdate_FullMethodName         = "/ssoready.v1.SSOReadyService/AppAcceptSAMLConnectionIDPMetadataUpdate"
 	SSOReadyService_AppListSAMLFlows_FullMethodName                                 = "/ssoready.v1.SSOReadyService/AppListSAMLFlows"
 	SSOReadyService_AppGetSAMLFlow_FullMethodName                                   = "/ssoready.v1.SSOReadyService/AppGetSAMLFlow"
 	SSOReadyService_ParseSAMLMetadata_FullMethodName                                = "/ssoready.v1.SSOReadyService/ParseSAMLMetadata"
@@ -101,6 +113,7 @@ const (
 	SSOReadyService_AdminGetSAMLConnection_FullMethodName                           = "/ssoready.v1.SSOReadyService/AdminGetSAMLConnection"
 	SSOReadyService_AdminCreateSAMLConnection_FullMethodName                        = "/ssoready.v1.SSOReadyService/AdminCreateSAMLConnection"
 	SSOReadyService_AdminUpdateSAMLConnection_FullMethodName                        = "/ssoready.v1.SSOReadyService/AdminUpdateSAMLConnection"
+	SSOReadyService_AdminGetSAMLConnectionSPSigningCertificate_FullMethodName       = "/ssoready.v1.SSOReadyService/AdminGetSAMLConnectionSPSigningCertificate"
 	SSOReadyService_AdminParseSAMLMetadata_FullMethodName                           = "/ssoready.v1.SSOReadyService/AdminParseSAMLMetadata"
 	SSOReadyService_AdminListSAMLFlows_FullMethodName                               = "/ssoready.v1.SSOReadyService/AdminListSAMLFlows"
 	SSOReadyService_AdminGetSAMLFlow_FullMethodName                                 = "/ssoready.v1.SSOReadyService/AdminGetSAMLFlow"
@@ -119,6 +132,12 @@ type SSOReadyServiceClient interface {
 	GetSAMLRedirectURL(ctx context.Context, in *GetSAMLRedirectURLRequest, opts ...grpc.CallOption) (*GetSAMLRedirectURLResponse, error)
 	// Exchanges a SAML access code for details about your user's SAML login details.
 	RedeemSAMLAccessCode(ctx context.Context, in *RedeemSAMLAccessCodeRequest, opts ...grpc.CallOption) (*RedeemSAMLAccessCodeResponse, error)
+	// Gets a URL to redirect your users to in order to log them out of their Identity Provider.
+	GetSAMLLogoutRedirectURL(ctx context.Context, in *GetSAMLLogoutRedirectURLRequest, opts ...grpc.CallOption) (*GetSAMLLogoutRedirectURLResponse, error)
+	// Gets a list of SAML logouts in a SAML connection.
+	//
+	// Poll this endpoint to find out which of your users' sessions their Identity Provider has asked you to terminate.
+	ListSAMLLogouts(ctx context.Context, in *ListSAMLLogoutsRequest, opts ...grpc.CallOption) (*ListSAMLLogoutsResponse, error)
 	// Gets a list of SCIM users in a SCIM directory.
 	ListSCIMUsers(ctx context.Context, in *ListSCIMUsersRequest, opts ...grpc.CallOption) (*ListSCIMUsersResponse, error)
 	// Gets a SCIM user.
@@ -141,12 +160,27 @@ type SSOReadyServiceClient interface {
 	CreateSetupURL(ctx context.Context, in *CreateSetupURLRequest, opts ...grpc.CallOption) (*CreateSetupURLResponse, error)
 	// Lists SAML connections in an organization.
 	ListSAMLConnections(ctx context.Context, in *ListSAMLConnectionsRequest, opts ...grpc.CallOption) (*ListSAMLConnectionsResponse, error)
+	// Lists SAML connections whose IDP certificate expires soon, across all organizations.
+	//
+	// Once a SAML connection's IDP certificate expires, your customer's users can no longer log in until your customer
+	// gives you a new one. Use this endpoint to find those customers ahead of time.
+	ListExpiringSAMLConnections(ctx context.Context, in *ListExpiringSAMLConnectionsRequest, opts ...grpc.CallOption) (*ListExpiringSAMLConnectionsResponse, error)
 	// Gets a SAML connection.
 	GetSAMLConnection(ctx context.Context, in *GetSAMLConnectionRequest, opts ...grpc.CallOption) (*GetSAMLConnectionResponse, error)
 	// Creates a SAML connection.
 	CreateSAMLConnection(ctx context.Context, in *CreateSAMLConnectionRequest, opts ...grpc.CallOption) (*CreateSAMLConnectionResponse, error)
 	// Updates a SAML connection.
 	UpdateSAMLConnection(ctx context.Context, in *UpdateSAMLConnectionRequest, opts ...grpc.CallOption) (*UpdateSAMLConnectionResponse, error)
+	// Rotates a SAML connection's SP certificate.
+	//
+	// SSOReady continues to accept assertions encrypted using the previous SP certificate until the next rotation, so
+	// that your customer has time to update their Identity Provider with the new value.
+	RotateSAMLConnectionSPCertificate(ctx context.Context, in *RotateSAMLConnectionSPCertificateRequest, opts ...grpc.CallOption) (*RotateSAMLConnectionSPCertificateResponse, error)
+	// Gets a SAML connection's SP signing certificate.
+	//
+	// If the SAML connection has sign_authn_requests enabled, your customer needs to input this certificate into their
+	// Identity Provider, so that it can verify the SAML requests SSOReady sends it.
+	GetSAMLConnectionSPSigningCertificate(ctx context.Context, in *GetSAMLConnectionSPSigningCertificateRequest, opts ...grpc.CallOption) (*GetSAMLConnectionSPSigningCertificateResponse, error)
 	// Gets a list of SCIM directories in an organization.
 	ListSCIMDirectories(ctx context.Context, in *ListSCIMDirectoriesRequest, opts ...grpc.CallOption) (*ListSCIMDirectoriesResponse, error)
 	// Gets a SCIM directory.
@@ -205,7 +239,14 @@ type SSOReadyServiceClient interface {
 	AppGetSAMLConnection(ctx context.Context, in *AppGetSAMLConnectionRequest, opts ...grpc.CallOption) (*SAMLConnection, error)
 	AppCreateSAMLConnection(ctx context.Context, in *AppCreateSAMLConnectionRequest, opts ...grpc.CallOption) (*SAMLConnection, error)
 	AppUpdateSAMLConnection(ctx context.Context, in *AppUpdateSAMLConnectionRequest, opts ...grpc.CallOption) (*SAMLConnection, error)
+	AppRotateSAMLConnectionSPCertificate(ctx context.Context, in *AppRotateSAMLConnectionSPCertificateRequest, opts ...grpc.CallOption) (*SAMLConnection, error)
+	AppGetSAMLConnectionSPSigningCertificate(ctx context.Context, in *AppGetSAMLConnectionSPSigningCertificateRequest, opts ...grpc.CallOption) (*AppGetSAMLConnectionSPSigningCertificateResponse, error)
 	AppDeleteSAMLConnection(ctx context.Context, in *AppDeleteSAMLConnectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AppListSAMLConnectionIDPCertificates(ctx context.Context, in *AppListSAMLConnectionIDPCertificatesRequest, opts ...grpc.CallOption) (*AppListSAMLConnectionIDPCertificatesResponse, error)
+	AppCreateSAMLConnectionIDPCertificate(ctx context.Context, in *AppCreateSAMLConnectionIDPCertificateRequest, opts ...grpc.CallOption) (*SAMLConnectionIDPCertificate, error)
+	AppDeleteSAMLConnectionIDPCertificate(ctx context.Context, in *AppDeleteSAMLConnectionIDPCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AppListSAMLConnectionIDPMetadataUpdates(ctx context.Context, in *AppListSAMLConnectionIDPMetadataUpdatesRequest, opts ...grpc.CallOption) (*AppListSAMLConnectionIDPMetadataUpdatesResponse, error)
+	AppAcceptSAMLConnectionIDPMetadataUpdate(ctx context.Context, in *AppAcceptSAMLConnectionIDPMetadataUpdateRequest, opts ...grpc.CallOption) (*SAMLConnectionIDPMetadataUpdate, error)
 	AppListSAMLFlows(ctx context.Context, in *AppListSAMLFlowsRequest, opts ...grpc.CallOption) (*AppListSAMLFlowsResponse, error)
 	AppGetSAMLFlow(ctx context.Context, in *AppGetSAMLFlowRequest, opts ...grpc.CallOption) (*SAMLFlow, error)
 	ParseSAMLMetadata(ctx context.Context, in *ParseSAMLMetadataRequest, opts ...grpc.CallOption) (*ParseSAMLMetadataResponse, error)
@@ -228,6 +269,7 @@ type SSOReadyServiceClient interface {
 	AdminGetSAMLConnection(ctx context.Context, in *AdminGetSAMLConnectionRequest, opts ...grpc.CallOption) (*AdminGetSAMLConnectionResponse, error)
 	AdminCreateSAMLConnection(ctx context.Context, in *AdminCreateSAMLConnectionRequest, opts ...grpc.CallOption) (*AdminCreateSAMLConnectionResponse, error)
 	AdminUpdateSAMLConnection(ctx context.Context, in *AdminUpdateSAMLConnectionRequest, opts ...grpc.CallOption) (*AdminUpdateSAMLConnectionResponse, error)
+	AdminGetSAMLConnectionSPSigningCertificate(ctx context.Context, in *AdminGetSAMLConnectionSPSigningCertificateRequest, opts ...grpc.CallOption) (*AdminGetSAMLConnectionSPSigningCertificateResponse, error)
 	AdminParseSAMLMetadata(ctx context.Context, in *AdminParseSAMLMetadataRequest, opts ...grpc.CallOption) (*AdminParseSAMLMetadataResponse, error)
 	AdminListSAMLFlows(ctx context.Context, in *AdminListSAMLFlowsRequest, opts ...grpc.CallOption) (*AdminListSAMLFlowsResponse, error)
 	AdminGetSAMLFlow(ctx context.Context, in *AdminGetSAMLFlowRequest, opts ...grpc.CallOption) (*AdminGetSAMLFlowResponse, error)
@@ -266,6 +308,26 @@ func (c *sSOReadyServiceClient) RedeemSAMLAccessCode(ctx context.Context, in *Re
 	return out, nil
 }
 
+func (c *sSOReadyServiceClient) GetSAMLLogoutRedirectURL(ctx context.Context, in *GetSAMLLogoutRedirectURLRequest, opts ...grpc.CallOption) (*GetSAMLLogoutRedirectURLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSAMLLogoutRedirectURLResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_GetSAMLLogoutRedirectURL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) ListSAMLLogouts(ctx context.Context, in *ListSAMLLogoutsRequest, opts ...grpc.CallOption) (*ListSAMLLogoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSAMLLogoutsResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_ListSAMLLogouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOReadyServiceClient) ListSCIMUsers(ctx context.Context, in *ListSCIMUsersRequest, opts ...grpc.CallOption) (*ListSCIMUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSCIMUsersResponse)
@@ -366,6 +428,16 @@ func (c *sSOReadyServiceClient) ListSAMLConnections(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *sSOReadyServiceClient) ListExpiringSAMLConnections(ctx context.Context, in *ListExpiringSAMLConnectionsRequest, opts ...grpc.CallOption) (*ListExpiringSAMLConnectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListExpiringSAMLConnectionsResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_ListExpiringSAMLConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOReadyServiceClient) GetSAMLConnection(ctx context.Context, in *GetSAMLConnectionRequest, opts ...grpc.CallOption) (*GetSAMLConnectionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetSAMLConnectionResponse)
@@ -396,6 +468,26 @@ func (c *sSOReadyServiceClient) UpdateSAMLConnection(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *sSOReadyServiceClient) RotateSAMLConnectionSPCertificate(ctx context.Context, in *RotateSAMLConnectionSPCertificateRequest, opts ...grpc.CallOption) (*RotateSAMLConnectionSPCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSAMLConnectionSPCertificateResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_RotateSAMLConnectionSPCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) GetSAMLConnectionSPSigningCertificate(ctx context.Context, in *GetSAMLConnectionSPSigningCertificateRequest, opts ...grpc.CallOption) (*GetSAMLConnectionSPSigningCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSAMLConnectionSPSigningCertificateResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_GetSAMLConnectionSPSigningCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOReadyServiceClient) ListSCIMDirectories(ctx context.Context, in *ListSCIMDirectoriesRequest, opts ...grpc.CallOption) (*ListSCIMDirectoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSCIMDirectoriesResponse)
@@ -826,6 +918,26 @@ func (c *sSOReadyServiceClient) AppUpdateSAMLConnection(ctx context.Context, in
 	return out, nil
 }
 
+func (c *sSOReadyServiceClient) AppRotateSAMLConnectionSPCertificate(ctx context.Context, in *AppRotateSAMLConnectionSPCertificateRequest, opts ...grpc.CallOption) (*SAMLConnection, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SAMLConnection)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppRotateSAMLConnectionSPCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) AppGetSAMLConnectionSPSigningCertificate(ctx context.Context, in *AppGetSAMLConnectionSPSigningCertificateRequest, opts ...grpc.CallOption) (*AppGetSAMLConnectionSPSigningCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppGetSAMLConnectionSPSigningCertificateResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppGetSAMLConnectionSPSigningCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOReadyServiceClient) AppDeleteSAMLConnection(ctx context.Context, in *AppDeleteSAMLConnectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -836,6 +948,56 @@ func (c *sSOReadyServiceClient) AppDeleteSAMLConnection(ctx context.Context, in
 	return out, nil
 }
 
+func (c *sSOReadyServiceClient) AppListSAMLConnectionIDPCertificates(ctx context.Context, in *AppListSAMLConnectionIDPCertificatesRequest, opts ...grpc.CallOption) (*AppListSAMLConnectionIDPCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppListSAMLConnectionIDPCertificatesResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppListSAMLConnectionIDPCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) AppCreateSAMLConnectionIDPCertificate(ctx context.Context, in *AppCreateSAMLConnectionIDPCertificateRequest, opts ...grpc.CallOption) (*SAMLConnectionIDPCertificate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SAMLConnectionIDPCertificate)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppCreateSAMLConnectionIDPCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) AppDeleteSAMLConnectionIDPCertificate(ctx context.Context, in *AppDeleteSAMLConnectionIDPCertificateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppDeleteSAMLConnectionIDPCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) AppListSAMLConnectionIDPMetadataUpdates(ctx context.Context, in *AppListSAMLConnectionIDPMetadataUpdatesRequest, opts ...grpc.CallOption) (*AppListSAMLConnectionIDPMetadataUpdatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AppListSAMLConnectionIDPMetadataUpdatesResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppListSAMLConnectionIDPMetadataUpdates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSOReadyServiceClient) AppAcceptSAMLConnectionIDPMetadataUpdate(ctx context.Context, in *AppAcceptSAMLConnectionIDPMetadataUpdateRequest, opts ...grpc.CallOption) (*SAMLConnectionIDPMetadataUpdate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SAMLConnectionIDPMetadataUpdate)
+	err := c.cc.Invoke(ctx, SSOReadyService_AppAcceptSAMLConnectionIDPMetadataUpdate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOReadyServiceClient) AppListSAMLFlows(ctx context.Context, in *AppListSAMLFlowsRequest, opts ...grpc.CallOption) (*AppListSAMLFlowsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AppListSAMLFlowsResponse)
@@ -1056,6 +1218,16 @@ func (c *sSOReadyServiceClient) AdminUpdateSAMLConnection(ctx context.Context, i
 	return out, nil
 }
 
+func (c *sSOReadyServiceClient) AdminGetSAMLConnectionSPSigningCertificate(ctx context.Context, in *AdminGetSAMLConnectionSPSigningCertificateRequest, opts ...grpc.CallOption) (*AdminGetSAMLConnectionSPSigningCertificateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminGetSAMLConnectionSPSigningCertificateResponse)
+	err := c.cc.Invoke(ctx, SSOReadyService_AdminGetSAMLConnectionSPSigningCertificate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sSOReadyServiceClient) AdminParseSAMLMetadata(ctx context.Context, in *AdminParseSAMLMetadataRequest, opts ...grpc.CallOption) (*AdminParseSAMLMetadataResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AdminParseSAMLMetadataResponse)
@@ -1144,6 +1316,12 @@ type SSOReadyServiceServer interface {
 	GetSAMLRedirectURL(context.Context, *GetSAMLRedirectURLRequest) (*GetSAMLRedirectURLResponse, error)
 	// Exchanges a SAML access code for details about your user's SAML login details.
 	RedeemSAMLAccessCode(context.Context, *RedeemSAMLAccessCodeRequest) (*RedeemSAMLAccessCodeResponse, error)
+	// Gets a URL to redirect your users to in order to log them out of their Identity Provider.
+	GetSAMLLogoutRedirectURL(context.Context, *GetSAMLLogoutRedirectURLRequest) (*GetSAMLLogoutRedirectURLResponse, error)
+	// Gets a list of SAML logouts in a SAML connection.
+	//
+	// Poll this endpoint to find out which of your users' sessions their Identity Provider has asked you to terminate.
+	ListSAMLLogouts(context.Context, *ListSAMLLogoutsRequest) (*ListSAMLLogoutsResponse, error)
 	// Gets a list of SCIM users in a SCIM directory.
 	ListSCIMUsers(context.Context, *ListSCIMUsersRequest) (*ListSCIMUsersResponse, error)
 	// Gets a SCIM user.
@@ -1166,12 +1344,27 @@ type SSOReadyServiceServer interface {
 	CreateSetupURL(context.Context, *CreateSetupURLRequest) (*CreateSetupURLResponse, error)
 	// Lists SAML connections in an organization.
 	ListSAMLConnections(context.Context, *ListSAMLConnectionsRequest) (*ListSAMLConnectionsResponse, error)
+	// Lists SAML connections whose IDP certificate expires soon, across all organizations.
+	//
+	// Once a SAML connection's IDP certificate expires, your customer's users can no longer log in until your customer
+	// gives you a new one. Use this endpoint to find those customers ahead of time.
+	ListExpiringSAMLConnections(context.Context, *ListExpiringSAMLConnectionsRequest) (*ListExpiringSAMLConnectionsResponse, error)
 	// Gets a SAML connection.
 	GetSAMLConnection(context.Context, *GetSAMLConnectionRequest) (*GetSAMLConnectionResponse, error)
 	// Creates a SAML connection.
 	CreateSAMLConnection(context.Context, *CreateSAMLConnectionRequest) (*CreateSAMLConnectionResponse, error)
 	// Updates a SAML connection.
 	UpdateSAMLConnection(context.Context, *UpdateSAMLConnectionRequest) (*UpdateSAMLConnectionResponse, error)
+	// Rotates a SAML connection's SP certificate.
+	//
+	// SSOReady continues to accept assertions encrypted using the previous SP certificate until the next rotation, so
+	// that your customer has time to update their Identity Provider with the new value.
+	RotateSAMLConnectionSPCertificate(context.Context, *RotateSAMLConnectionSPCertificateRequest) (*RotateSAMLConnectionSPCertificateResponse, error)
+	// Gets a SAML connection's SP signing certificate.
+	//
+	// If the SAML connection has sign_authn_requests enabled, your customer needs to input this certificate into their
+	// Identity Provider, so that it can verify the SAML requests SSOReady sends it.
+	GetSAMLConnectionSPSigningCertificate(context.Context, *GetSAMLConnectionSPSigningCertificateRequest) (*GetSAMLConnectionSPSigningCertificateResponse, error)
 	// Gets a list of SCIM directories in an organization.
 	ListSCIMDirectories(context.Context, *ListSCIMDirectoriesRequest) (*ListSCIMDirectoriesResponse, error)
 	// Gets a SCIM directory.
@@ -1230,7 +1423,14 @@ type SSOReadyServiceServer interface {
 	AppGetSAMLConnection(context.Context, *AppGetSAMLConnectionRequest) (*SAMLConnection, error)
 	AppCreateSAMLConnection(context.Context, *AppCreateSAMLConnectionRequest) (*SAMLConnection, error)
 	AppUpdateSAMLConnection(context.Context, *AppUpdateSAMLConnectionRequest) (*SAMLConnection, error)
+	AppRotateSAMLConnectionSPCertificate(context.Context, *AppRotateSAMLConnectionSPCertificateRequest) (*SAMLConnection, error)
+	AppGetSAMLConnectionSPSigningCertificate(context.Context, *AppGetSAMLConnectionSPSigningCertificateRequest) (*AppGetSAMLConnectionSPSigningCertificateResponse, error)
 	AppDeleteSAMLConnection(context.Context, *AppDeleteSAMLConnectionRequest) (*emptypb.Empty, error)
+	AppListSAMLConnectionIDPCertificates(context.Context, *AppListSAMLConnectionIDPCertificatesRequest) (*AppListSAMLConnectionIDPCertificatesResponse, error)
+	AppCreateSAMLConnectionIDPCertificate(context.Context, *AppCreateSAMLConnectionIDPCertificateRequest) (*SAMLConnectionIDPCertificate, error)
+	AppDeleteSAMLConnectionIDPCertificate(context.Context, *AppDeleteSAMLConnectionIDPCertificateRequest) (*emptypb.Empty, error)
+	AppListSAMLConnectionIDPMetadataUpdates(context.Context, *AppListSAMLConnectionIDPMetadataUpdatesRequest) (*AppListSAMLConnectionIDPMetadataUpdatesResponse, error)
+	AppAcceptSAMLConnectionIDPMetadataUpdate(context.Context, *AppAcceptSAMLConnectionIDPMetadataUpdateRequest) (*SAMLConnectionIDPMetadataUpdate, error)
 	AppListSAMLFlows(context.Context, *AppListSAMLFlowsRequest) (*AppListSAMLFlowsResponse, error)
 	AppGetSAMLFlow(context.Context, *AppGetSAMLFlowRequest) (*SAMLFlow, error)
 	ParseSAMLMetadata(context.Context, *ParseSAMLMetadataRequest) (*ParseSAMLMetadataResponse, error)
@@ -1253,6 +1453,7 @@ type SSOReadyServiceServer interface {
 	AdminGetSAMLConnection(context.Context, *AdminGetSAMLConnectionRequest) (*AdminGetSAMLConnectionResponse, error)
 	AdminCreateSAMLConnection(context.Context, *AdminCreateSAMLConnectionRequest) (*AdminCreateSAMLConnectionResponse, error)
 	AdminUpdateSAMLConnection(context.Context, *AdminUpdateSAMLConnectionRequest) (*AdminUpdateSAMLConnectionResponse, error)
+	AdminGetSAMLConnectionSPSigningCertificate(context.Context, *AdminGetSAMLConnectionSPSigningCertificateRequest) (*AdminGetSAMLConnectionSPSigningCertificateResponse, error)
 	AdminParseSAMLMetadata(context.Context, *AdminParseSAMLMetadataRequest) (*AdminParseSAMLMetadataResponse, error)
 	AdminListSAMLFlows(context.Context, *AdminListSAMLFlowsRequest) (*AdminListSAMLFlowsResponse, error)
 	AdminGetSAMLFlow(context.Context, *AdminGetSAMLFlowRequest) (*AdminGetSAMLFlowResponse, error)
@@ -1277,6 +1478,12 @@ func (UnimplementedSSOReadyServiceServer) GetSAMLRedirectURL(context.Context, *G
 func (UnimplementedSSOReadyServiceServer) RedeemSAMLAccessCode(context.Context, *RedeemSAMLAccessCodeRequest) (*RedeemSAMLAccessCodeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RedeemSAMLAccessCode not implemented")
 }
+func (UnimplementedSSOReadyServiceServer) GetSAMLLogoutRedirectURL(context.Context, *GetSAMLLogoutRedirectURLRequest) (*GetSAMLLogoutRedirectURLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSAMLLogoutRedirectURL not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) ListSAMLLogouts(context.Context, *ListSAMLLogoutsRequest) (*ListSAMLLogoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSAMLLogouts not implemented")
+}
 func (UnimplementedSSOReadyServiceServer) ListSCIMUsers(context.Context, *ListSCIMUsersRequest) (*ListSCIMUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSCIMUsers not implemented")
 }
@@ -1307,6 +1514,9 @@ func (UnimplementedSSOReadyServiceServer) CreateSetupURL(context.Context, *Creat
 func (UnimplementedSSOReadyServiceServer) ListSAMLConnections(context.Context, *ListSAMLConnectionsRequest) (*ListSAMLConnectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSAMLConnections not implemented")
 }
+func (UnimplementedSSOReadyServiceServer) ListExpiringSAMLConnections(context.Context, *ListExpiringSAMLConnectionsRequest) (*ListExpiringSAMLConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExpiringSAMLConnections not implemented")
+}
 func (UnimplementedSSOReadyServiceServer) GetSAMLConnection(context.Context, *GetSAMLConnectionRequest) (*GetSAMLConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSAMLConnection not implemented")
 }
@@ -1316,6 +1526,12 @@ func (UnimplementedSSOReadyServiceServer) CreateSAMLConnection(context.Context,
 func (UnimplementedSSOReadyServiceServer) UpdateSAMLConnection(context.Context, *UpdateSAMLConnectionRequest) (*UpdateSAMLConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSAMLConnection not implemented")
 }
+func (UnimplementedSSOReadyServiceServer) RotateSAMLConnectionSPCertificate(context.Context, *RotateSAMLConnectionSPCertificateRequest) (*RotateSAMLConnectionSPCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSAMLConnectionSPCertificate not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) GetSAMLConnectionSPSigningCertificate(context.Context, *GetSAMLConnectionSPSigningCertificateRequest) (*GetSAMLConnectionSPSigningCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSAMLConnectionSPSigningCertificate not implemented")
+}
 func (UnimplementedSSOReadyServiceServer) ListSCIMDirectories(context.Context, *ListSCIMDirectoriesRequest) (*ListSCIMDirectoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSCIMDirectories not implemented")
 }
@@ -1445,9 +1661,30 @@ func (UnimplementedSSOReadyServiceServer) AppCreateSAMLConnection(context.Contex
 func (UnimplementedSSOReadyServiceServer) AppUpdateSAMLConnection(context.Context, *AppUpdateSAMLConnectionRequest) (*SAMLConnection, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppUpdateSAMLConnection not implemented")
 }
+func (UnimplementedSSOReadyServiceServer) AppRotateSAMLConnectionSPCertificate(context.Context, *AppRotateSAMLConnectionSPCertificateRequest) (*SAMLConnection, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppRotateSAMLConnectionSPCertificate not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) AppGetSAMLConnectionSPSigningCertificate(context.Context, *AppGetSAMLConnectionSPSigningCertificateRequest) (*AppGetSAMLConnectionSPSigningCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppGetSAMLConnectionSPSigningCertificate not implemented")
+}
 func (UnimplementedSSOReadyServiceServer) AppDeleteSAMLConnection(context.Context, *AppDeleteSAMLConnectionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppDeleteSAMLConnection not implemented")
 }
+func (UnimplementedSSOReadyServiceServer) AppListSAMLConnectionIDPCertificates(context.Context, *AppListSAMLConnectionIDPCertificatesRequest) (*AppListSAMLConnectionIDPCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppListSAMLConnectionIDPCertificates not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) AppCreateSAMLConnectionIDPCertificate(context.Context, *AppCreateSAMLConnectionIDPCertificateRequest) (*SAMLConnectionIDPCertificate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppCreateSAMLConnectionIDPCertificate not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) AppDeleteSAMLConnectionIDPCertificate(context.Context, *AppDeleteSAMLConnectionIDPCertificateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppDeleteSAMLConnectionIDPCertificate not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) AppListSAMLConnectionIDPMetadataUpdates(context.Context, *AppListSAMLConnectionIDPMetadataUpdatesRequest) (*AppListSAMLConnectionIDPMetadataUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppListSAMLConnectionIDPMetadataUpdates not implemented")
+}
+func (UnimplementedSSOReadyServiceServer) AppAcceptSAMLConnectionIDPMetadataUpdate(context.Context, *AppAcceptSAMLConnectionIDPMetadataUpdateRequest) (*SAMLConnectionIDPMetadataUpdate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppAcceptSAMLConnectionIDPMetadataUpdate not implemented")
+}
 func (UnimplementedSSOReadyServiceServer) AppListSAMLFlows(context.Context, *AppListSAMLFlowsRequest) (*AppListSAMLFlowsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppListSAMLFlows not implemented")
 }
@@ -1514,6 +1751,9 @@ func (UnimplementedSSOReadyServiceServer) AdminCreateSAMLConnection(context.Cont
 func (UnimplementedSSOReadyServiceServer) AdminUpdateSAMLConnection(context.Context, *AdminUpdateSAMLConnectionRequest) (*AdminUpdateSAMLConnectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminUpdateSAMLConnection not implemented")
 }
+func (UnimplementedSSOReadyServiceServer) AdminGetSAMLConnectionSPSigningCertificate(context.Context, *AdminGetSAMLConnectionSPSigningCertificateRequest) (*AdminGetSAMLConnectionSPSigningCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminGetSAMLConnectionSPSigningCertificate not implemented")
+}
 func (UnimplementedSSOReadyServiceServer) AdminParseSAMLMetadata(context.Context, *AdminParseSAMLMetadataRequest) (*AdminParseSAMLMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AdminParseSAMLMetadata not implemented")
 }
@@ -1595,6 +1835,42 @@ func _SSOReadyService_RedeemSAMLAccessCode_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOReadyService_GetSAMLLogoutRedirectURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSAMLLogoutRedirectURLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).GetSAMLLogoutRedirectURL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_GetSAMLLogoutRedirectURL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).GetSAMLLogoutRedirectURL(ctx, req.(*GetSAMLLogoutRedirectURLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_ListSAMLLogouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSAMLLogoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).ListSAMLLogouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_ListSAMLLogouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).ListSAMLLogouts(ctx, req.(*ListSAMLLogoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOReadyService_ListSCIMUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSCIMUsersRequest)
 	if err := dec(in); err != nil {
@@ -1775,6 +2051,24 @@ func _SSOReadyService_ListSAMLConnections_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOReadyService_ListExpiringSAMLConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExpiringSAMLConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).ListExpiringSAMLConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_ListExpiringSAMLConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).ListExpiringSAMLConnections(ctx, req.(*ListExpiringSAMLConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOReadyService_GetSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetSAMLConnectionRequest)
 	if err := dec(in); err != nil {
@@ -1829,6 +2123,42 @@ func _SSOReadyService_UpdateSAMLConnection_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOReadyService_RotateSAMLConnectionSPCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSAMLConnectionSPCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).RotateSAMLConnectionSPCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_RotateSAMLConnectionSPCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).RotateSAMLConnectionSPCertificate(ctx, req.(*RotateSAMLConnectionSPCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_GetSAMLConnectionSPSigningCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSAMLConnectionSPSigningCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).GetSAMLConnectionSPSigningCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_GetSAMLConnectionSPSigningCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).GetSAMLConnectionSPSigningCertificate(ctx, req.(*GetSAMLConnectionSPSigningCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOReadyService_ListSCIMDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSCIMDirectoriesRequest)
 	if err := dec(in); err != nil {
@@ -2603,6 +2933,42 @@ func _SSOReadyService_AppUpdateSAMLConnection_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOReadyService_AppRotateSAMLConnectionSPCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppRotateSAMLConnectionSPCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppRotateSAMLConnectionSPCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppRotateSAMLConnectionSPCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppRotateSAMLConnectionSPCertificate(ctx, req.(*AppRotateSAMLConnectionSPCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_AppGetSAMLConnectionSPSigningCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppGetSAMLConnectionSPSigningCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppGetSAMLConnectionSPSigningCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppGetSAMLConnectionSPSigningCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppGetSAMLConnectionSPSigningCertificate(ctx, req.(*AppGetSAMLConnectionSPSigningCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOReadyService_AppDeleteSAMLConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppDeleteSAMLConnectionRequest)
 	if err := dec(in); err != nil {
@@ -2621,6 +2987,96 @@ func _SSOReadyService_AppDeleteSAMLConnection_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOReadyService_AppListSAMLConnectionIDPCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppListSAMLConnectionIDPCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppListSAMLConnectionIDPCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppListSAMLConnectionIDPCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppListSAMLConnectionIDPCertificates(ctx, req.(*AppListSAMLConnectionIDPCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_AppCreateSAMLConnectionIDPCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppCreateSAMLConnectionIDPCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppCreateSAMLConnectionIDPCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppCreateSAMLConnectionIDPCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppCreateSAMLConnectionIDPCertificate(ctx, req.(*AppCreateSAMLConnectionIDPCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_AppDeleteSAMLConnectionIDPCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppDeleteSAMLConnectionIDPCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppDeleteSAMLConnectionIDPCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppDeleteSAMLConnectionIDPCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppDeleteSAMLConnectionIDPCertificate(ctx, req.(*AppDeleteSAMLConnectionIDPCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_AppListSAMLConnectionIDPMetadataUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppListSAMLConnectionIDPMetadataUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppListSAMLConnectionIDPMetadataUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppListSAMLConnectionIDPMetadataUpdates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppListSAMLConnectionIDPMetadataUpdates(ctx, req.(*AppListSAMLConnectionIDPMetadataUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSOReadyService_AppAcceptSAMLConnectionIDPMetadataUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AppAcceptSAMLConnectionIDPMetadataUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AppAcceptSAMLConnectionIDPMetadataUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AppAcceptSAMLConnectionIDPMetadataUpdate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AppAcceptSAMLConnectionIDPMetadataUpdate(ctx, req.(*AppAcceptSAMLConnectionIDPMetadataUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOReadyService_AppListSAMLFlows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AppListSAMLFlowsRequest)
 	if err := dec(in); err != nil {
@@ -3017,6 +3473,24 @@ func _SSOReadyService_AdminUpdateSAMLConnection_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SSOReadyService_AdminGetSAMLConnectionSPSigningCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminGetSAMLConnectionSPSigningCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSOReadyServiceServer).AdminGetSAMLConnectionSPSigningCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SSOReadyService_AdminGetSAMLConnectionSPSigningCertificate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSOReadyServiceServer).AdminGetSAMLConnectionSPSigningCertificate(ctx, req.(*AdminGetSAMLConnectionSPSigningCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SSOReadyService_AdminParseSAMLMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminParseSAMLMetadataRequest)
 	if err := dec(in); err != nil {
@@ -3176,6 +3650,14 @@ var SSOReadyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RedeemSAMLAccessCode",
 			Handler:    _SSOReadyService_RedeemSAMLAccessCode_Handler,
 		},
+		{
+			MethodName: "GetSAMLLogoutRedirectURL",
+			Handler:    _SSOReadyService_GetSAMLLogoutRedirectURL_Handler,
+		},
+		{
+			MethodName: "ListSAMLLogouts",
+			Handler:    _SSOReadyService_ListSAMLLogouts_Handler,
+		},
 		{
 			MethodName: "ListSCIMUsers",
 			Handler:    _SSOReadyService_ListSCIMUsers_Handler,
@@ -3216,6 +3698,10 @@ var SSOReadyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListSAMLConnections",
 			Handler:    _SSOReadyService_ListSAMLConnections_Handler,
 		},
+		{
+			MethodName: "ListExpiringSAMLConnections",
+			Handler:    _SSOReadyService_ListExpiringSAMLConnections_Handler,
+		},
 		{
 			MethodName: "GetSAMLConnection",
 			Handler:    _SSOReadyService_GetSAMLConnection_Handler,
@@ -3228,6 +3714,14 @@ var SSOReadyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSAMLConnection",
 			Handler:    _SSOReadyService_UpdateSAMLConnection_Handler,
 		},
+		{
+			MethodName: "RotateSAMLConnectionSPCertificate",
+			Handler:    _SSOReadyService_RotateSAMLConnectionSPCertificate_Handler,
+		},
+		{
+			MethodName: "GetSAMLConnectionSPSigningCertificate",
+			Handler:    _SSOReadyService_GetSAMLConnectionSPSigningCertificate_Handler,
+		},
 		{
 			MethodName: "ListSCIMDirectories",
 			Handler:    _SSOReadyService_ListSCIMDirectories_Handler,
@@ -3400,10 +3894,38 @@ var SSOReadyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AppUpdateSAMLConnection",
 			Handler:    _SSOReadyService_AppUpdateSAMLConnection_Handler,
 		},
+		{
+			MethodName: "AppRotateSAMLConnectionSPCertificate",
+			Handler:    _SSOReadyService_AppRotateSAMLConnectionSPCertificate_Handler,
+		},
+		{
+			MethodName: "AppGetSAMLConnectionSPSigningCertificate",
+			Handler:    _SSOReadyService_AppGetSAMLConnectionSPSigningCertificate_Handler,
+		},
 		{
 			MethodName: "AppDeleteSAMLConnection",
 			Handler:    _SSOReadyService_AppDeleteSAMLConnection_Handler,
 		},
+		{
+			MethodName: "AppListSAMLConnectionIDPCertificates",
+			Handler:    _SSOReadyService_AppListSAMLConnectionIDPCertificates_Handler,
+		},
+		{
+			MethodName: "AppCreateSAMLConnectionIDPCertificate",
+			Handler:    _SSOReadyService_AppCreateSAMLConnectionIDPCertificate_Handler,
+		},
+		{
+			MethodName: "AppDeleteSAMLConnectionIDPCertificate",
+			Handler:    _SSOReadyService_AppDeleteSAMLConnectionIDPCertificate_Handler,
+		},
+		{
+			MethodName: "AppListSAMLConnectionIDPMetadataUpdates",
+			Handler:    _SSOReadyService_AppListSAMLConnectionIDPMetadataUpdates_Handler,
+		},
+		{
+			MethodName: "AppAcceptSAMLConnectionIDPMetadataUpdate",
+			Handler:    _SSOReadyService_AppAcceptSAMLConnectionIDPMetadataUpdate_Handler,
+		},
 		{
 			MethodName: "AppListSAMLFlows",
 			Handler:    _SSOReadyService_AppListSAMLFlows_Handler,
@@ -3492,6 +4014,10 @@ var SSOReadyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminUpdateSAMLConnection",
 			Handler:    _SSOReadyService_AdminUpdateSAMLConnection_Handler,
 		},
+		{
+			MethodName: "AdminGetSAMLConnectionSPSigningCertificate",
+			Handler:    _SSOReadyService_AdminGetSAMLConnectionSPSigningCertificate_Handler,
+		},
 		{
 			MethodName: "AdminParseSAMLMetadata",
 			Handler:    _SSOReadyService_AdminParseSAMLMetadata_Handler,
@@ -40,6 +40,12 @@ const (
 	// SSOReadyServiceRedeemSAMLAccessCodeProcedure is the fully-qualified name of the SSOReadyService's
 	// RedeemSAMLAccessCode RPC.
 	SSOReadyServiceRedeemSAMLAccessCodeProcedure = "/ssoready.v1.SSOReadyService/RedeemSAMLAccessCode"
+	// SSOReadyServiceGetSAMLLogoutRedirectURLProcedure is the fully-qualified name of the
+	// SSOReadyService's GetSAMLLogoutRedirectURL RPC.
+	SSOReadyServiceGetSAMLLogoutRedirectURLProcedure = "/ssoready.v1.SSOReadyService/GetSAMLLogoutRedirectURL"
+	// SSOReadyServiceListSAMLLogoutsProcedure is the fully-qualified name of the SSOReadyService's
+	// ListSAMLLogouts RPC.
+	SSOReadyServiceListSAMLLogoutsProcedure = "/ssoready.v1.SSOReadyService/ListSAMLLogouts"
 	// SSOReadyServiceListSCIMUsersProcedure is the fully-qualified name of the SSOReadyService's
 	// ListSCIMUsers RPC.
 	SSOReadyServiceListSCIMUsersProcedure = "/ssoready.v1.SSOReadyService/ListSCIMUsers"
@@ -70,6 +76,9 @@ const (
 	// SSOReadyServiceListSAMLConnectionsProcedure is the fully-qualified name of the SSOReadyService's
 	// ListSAMLConnections RPC.
 	SSOReadyServiceListSAMLConnectionsProcedure = "/ssoready.v1.SSOReadyService/ListSAMLConnections"
+	// SSOReadyServiceListExpiringSAMLConnectionsProcedure is the fully-qualified name of the
+	// SSOReadyService's ListExpiringSAMLConnections RPC.
+	SSOReadyServiceListExpiringSAMLConnectionsProcedure = "/ssoready.v1.SSOReadyService/ListExpiringSAMLConnections"
 	// SSOReadyServiceGetSAMLConnectionProcedure is the fully-qualified name of the SSOReadyService's
 	// GetSAMLConnection RPC.
 	SSOReadyServiceGetSAMLConnectionProcedure = "/ssoready.v1.SSOReadyService/GetSAMLConnection"
@@ -79,6 +88,12 @@ const (
 	// SSOReadyServiceUpdateSAMLConnectionProcedure is the fully-qualified name of the SSOReadyService's
 	// UpdateSAMLConnection RPC.
 	SSOReadyServiceUpdateSAMLConnectionProcedure = "/ssoready.v1.SSOReadyService/UpdateSAMLConnection"
+	// SSOReadyServiceRotateSAMLConnectionSPCertificateProcedure is the fully-qualified name of the
+	// SSOReadyService's RotateSAMLConnectionSPCertificate RPC.
+	SSOReadyServiceRotateSAMLConnectionSPCertificateProcedure = "/ssoready.v1.SSOReadyService/RotateSAMLConnectionSPCertificate"
+	// SSOReadyServiceGetSAMLConnectionSPSigningCertificateProcedure is the fully-qualified name of the
+	// SSOReadyService's GetSAMLConnectionSPSigningCertificate RPC.
+	SSOReadyServiceGetSAMLConnectionSPSigningCertificateProcedure = "/ssoready.v1.SSOReadyService/GetSAMLConnectionSPSigningCertificate"
 	// SSOReadyServiceListSCIMDirectoriesProcedure is the fully-qualified name of the SSOReadyService's
 	// ListSCIMDirectories RPC.
 	SSOReadyServiceListSCIMDirectoriesProcedure = "/ssoready.v1.SSOReadyService/ListSCIMDirectories"
@@ -205,9 +220,30 @@ const (
 	// SSOReadyServiceAppUpdateSAMLConnectionProcedure is the fully-qualified name of the
 	// SSOReadyService's AppUpdateSAMLConnection RPC.
 	SSOReadyServiceAppUpdateSAMLConnectionProcedure = "/ssoready.v1.SSOReadyService/AppUpdateSAMLConnection"
+	// SSOReadyServiceAppRotateSAMLConnectionSPCertificateProcedure is the fully-qualified name of the
+	// SSOReadyService's AppRotateSAMLConnectionSPCertificate RPC.
+	SSOReadyServiceAppRotateSAMLConnectionSPCertificateProcedure = "/ssoready.v1.SSOReadyService/AppRotateSAMLConnectionSPCertificate"
+	// SSOReadyServiceAppGetSAMLConnectionSPSigningCertificateProcedure is the fully-qualified name of
+	// the SSOReadyService's AppGetSAMLConnectionSPSigningCertificate RPC.
+	SSOReadyServiceAppGetSAMLConnectionSPSigningCertificateProcedure = "/ssoready.v1.SSOReadyService/AppGetSAMLConnectionSPSigningCertificate"
 	// SSOReadyServiceAppDeleteSAMLConnectionProcedure is the fully-qualified name of the
 	// SSOReadyService's AppDeleteSAMLConnection RPC.
 	SSOReadyServiceAppDeleteSAMLConnectionProcedure = "/ssoready.v1.SSOReadyService/AppDeleteSAMLConnection"
+	// SSOReadyServiceAppListSAMLConnectionIDPCertificatesProcedure is the fully-qualified name of the
+	// SSOReadyService's AppListSAMLConnectionIDPCertificates RPC.
+	SSOReadyServiceAppListSAMLConnectionIDPCertificatesProcedure = "/ssoready.v1.SSOReadyService/AppListSAMLConnectionIDPCertificates"
+	// SSOReadyServiceAppCreateSAMLConnectionIDPCertificateProcedure is the fully-qualified name of the
+	// SSOReadyService's AppCreateSAMLConnectionIDPCertificate RPC.
+	SSOReadyServiceAppCreateSAMLConnectionIDPCertificateProcedure = "/ssoready.v1.SSOReadyService/AppCreateSAMLConnectionIDPCertificate"
+	// SSOReadyServiceAppDeleteSAMLConnectionIDPCertificateProcedure is the fully-qualified name of the
+	// SSOReadyService's AppDeleteSAMLConnectionIDPCertificate RPC.
+	SSOReadyServiceAppDeleteSAMLConnectionIDPCertificateProcedure = "/ssoready.v1.SSOReadyService/AppDeleteSAMLConnectionIDPCertificate"
+	// SSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesProcedure is the fully-qualified name of
+	// the SSOReadyService's AppListSAMLConnectionIDPMetadataUpdates RPC.
+	SSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesProcedure = "/ssoready.v1.SSOReadyService/AppListSAMLConnectionIDPMetadataUpdates"
+	// SSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateProcedure is the fully-qualified name of
+	// the SSOReadyService's AppAcceptSAMLConnectionIDPMetadataUpdate RPC.
+	SSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateProcedure = "/ssoready.v1.SSOReadyService/AppAcceptSAMLConnectionIDPMetadataUpdate"
 	// SSOReadyServiceAppListSAMLFlowsProcedure is the fully-qualified name of the SSOReadyService's
 	// AppListSAMLFlows RPC.
 	SSOReadyServiceAppListSAMLFlowsProcedure = "/ssoready.v1.SSOReadyService/AppListSAMLFlows"
@@ -274,6 +310,9 @@ const (
 	// SSOReadyServiceAdminUpdateSAMLConnectionProcedure is the fully-qualified name of the
 	// SSOReadyService's AdminUpdateSAMLConnection RPC.
 	SSOReadyServiceAdminUpdateSAMLConnectionProcedure = "/ssoready.v1.SSOReadyService/AdminUpdateSAMLConnection"
+	// SSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateProcedure is the fully-qualified name of
+	// the SSOReadyService's AdminGetSAMLConnectionSPSigningCertificate RPC.
+	SSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateProcedure = "/ssoready.v1.SSOReadyService/AdminGetSAMLConnectionSPSigningCertificate"
 	// SSOReadyServiceAdminParseSAMLMetadataProcedure is the fully-qualified name of the
 	// SSOReadyService's AdminParseSAMLMetadata RPC.
 	SSOReadyServiceAdminParseSAMLMetadataProcedure = "/ssoready.v1.SSOReadyService/AdminParseSAMLMetadata"
@@ -306,6 +345,12 @@ type SSOReadyServiceClient interface {
 	GetSAMLRedirectURL(context.Context, *connect.Request[v1.GetSAMLRedirectURLRequest]) (*connect.Response[v1.GetSAMLRedirectURLResponse], error)
 	// Exchanges a SAML access code for details about your user's SAML login details.
 	RedeemSAMLAccessCode(context.Context, *connect.Request[v1.RedeemSAMLAccessCodeRequest]) (*connect.Response[v1.RedeemSAMLAccessCodeResponse], error)
+	// Gets a URL to redirect your users to in order to log them out of their Identity Provider.
+	GetSAMLLogoutRedirectURL(context.Context, *connect.Request[v1.GetSAMLLogoutRedirectURLRequest]) (*connect.Response[v1.GetSAMLLogoutRedirectURLResponse], error)
+	// Gets a list of SAML logouts in a SAML connection.
+	//
+	// Poll this endpoint to find out which of your users' sessions their Identity Provider has asked you to terminate.
+	ListSAMLLogouts(context.Context, *connect.Request[v1.ListSAMLLogoutsRequest]) (*connect.Response[v1.ListSAMLLogoutsResponse], error)
 	// Gets a list of SCIM users in a SCIM directory.
 	ListSCIMUsers(context.Context, *connect.Request[v1.ListSCIMUsersRequest]) (*connect.Response[v1.ListSCIMUsersResponse], error)
 	// Gets a SCIM user.
@@ -328,12 +373,27 @@ type SSOReadyServiceClient interface {
 	CreateSetupURL(context.Context, *connect.Request[v1.CreateSetupURLRequest]) (*connect.Response[v1.CreateSetupURLResponse], error)
 	// Lists SAML connections in an organization.
 	ListSAMLConnections(context.Context, *connect.Request[v1.ListSAMLConnectionsRequest]) (*connect.Response[v1.ListSAMLConnectionsResponse], error)
+	// Lists SAML connections whose IDP certificate expires soon, across all organizations.
+	//
+	// Once a SAML connection's IDP certificate expires, your customer's users can no longer log in until your customer
+	// gives you a new one. Use this endpoint to find those customers ahead of time.
+	ListExpiringSAMLConnections(context.Context, *connect.Request[v1.ListExpiringSAMLConnectionsRequest]) (*connect.Response[v1.ListExpiringSAMLConnectionsResponse], error)
 	// Gets a SAML connection.
 	GetSAMLConnection(context.Context, *connect.Request[v1.GetSAMLConnectionRequest]) (*connect.Response[v1.GetSAMLConnectionResponse], error)
 	// Creates a SAML connection.
 	CreateSAMLConnection(context.Context, *connect.Request[v1.CreateSAMLConnectionRequest]) (*connect.Response[v1.CreateSAMLConnectionResponse], error)
 	// Updates a SAML connection.
 	UpdateSAMLConnection(context.Context, *connect.Request[v1.UpdateSAMLConnectionRequest]) (*connect.Response[v1.UpdateSAMLConnectionResponse], error)
+	// Rotates a SAML connection's SP certificate.
+	//
+	// SSOReady continues to accept assertions encrypted using the previous SP certificate until the next rotation, so
+	// that your customer has time to update their Identity Provider with the new value.
+	RotateSAMLConnectionSPCertificate(context.Context, *connect.Request[v1.RotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.RotateSAMLConnectionSPCertificateResponse], error)
+	// Gets a SAML connection's SP signing certificate.
+	//
+	// If the SAML connection has sign_authn_requests enabled, your customer needs to input this certificate into their
+	// Identity Provider, so that it can verify the SAML requests SSOReady sends it.
+	GetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.GetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.GetSAMLConnectionSPSigningCertificateResponse], error)
 	// Gets a list of SCIM directories in an organization.
 	ListSCIMDirectories(context.Context, *connect.Request[v1.ListSCIMDirectoriesRequest]) (*connect.Response[v1.ListSCIMDirectoriesResponse], error)
 	// Gets a SCIM directory.
@@ -392,7 +452,14 @@ type SSOReadyServiceClient interface {
 	AppGetSAMLConnection(context.Context, *connect.Request[v1.AppGetSAMLConnectionRequest]) (*connect.Response[v1.SAMLConnection], error)
 	AppCreateSAMLConnection(context.Context, *connect.Request[v1.AppCreateSAMLConnectionRequest]) (*connect.Response[v1.SAMLConnection], error)
 	AppUpdateSAMLConnection(context.Context, *connect.Request[v1.AppUpdateSAMLConnectionRequest]) (*connect.Response[v1.SAMLConnection], error)
+	AppRotateSAMLConnectionSPCertificate(context.Context, *connect.Request[v1.AppRotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.SAMLConnection], error)
+	AppGetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.AppGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AppGetSAMLConnectionSPSigningCertificateResponse], error)
 	AppDeleteSAMLConnection(context.Context, *connect.Request[v1.AppDeleteSAMLConnectionRequest]) (*connect.Response[emptypb.Empty], error)
+	AppListSAMLConnectionIDPCertificates(context.Context, *connect.Request[v1.AppListSAMLConnectionIDPCertificatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPCertificatesResponse], error)
+	AppCreateSAMLConnectionIDPCertificate(context.Context, *connect.Request[v1.AppCreateSAMLConnectionIDPCertificateRequest]) (*connect.Response[v1.SAMLConnectionIDPCertificate], error)
+	AppDeleteSAMLConnectionIDPCertificate(context.Context, *connect.Request[v1.AppDeleteSAMLConnectionIDPCertificateRequest]) (*connect.Response[emptypb.Empty], error)
+	AppListSAMLConnectionIDPMetadataUpdates(context.Context, *connect.Request[v1.AppListSAMLConnectionIDPMetadataUpdatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPMetadataUpdatesResponse], error)
+	AppAcceptSAMLConnectionIDPMetadataUpdate(context.Context, *connect.Request[v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest]) (*connect.Response[v1.SAMLConnectionIDPMetadataUpdate], error)
 	AppListSAMLFlows(context.Context, *connect.Request[v1.AppListSAMLFlowsRequest]) (*connect.Response[v1.AppListSAMLFlowsResponse], error)
 	AppGetSAMLFlow(context.Context, *connect.Request[v1.AppGetSAMLFlowRequest]) (*connect.Response[v1.SAMLFlow], error)
 	ParseSAMLMetadata(context.Context, *connect.Request[v1.ParseSAMLMetadataRequest]) (*connect.Response[v1.ParseSAMLMetadataResponse], error)
@@ -415,6 +482,7 @@ type SSOReadyServiceClient interface {
 	AdminGetSAMLConnection(context.Context, *connect.Request[v1.AdminGetSAMLConnectionRequest]) (*connect.Response[v1.AdminGetSAMLConnectionResponse], error)
 	AdminCreateSAMLConnection(context.Context, *connect.Request[v1.AdminCreateSAMLConnectionRequest]) (*connect.Response[v1.AdminCreateSAMLConnectionResponse], error)
 	AdminUpdateSAMLConnection(context.Context, *connect.Request[v1.AdminUpdateSAMLConnectionRequest]) (*connect.Response[v1.AdminUpdateSAMLConnectionResponse], error)
+	AdminGetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.AdminGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AdminGetSAMLConnectionSPSigningCertificateResponse], error)
 	AdminParseSAMLMetadata(context.Context, *connect.Request[v1.AdminParseSAMLMetadataRequest]) (*connect.Response[v1.AdminParseSAMLMetadataResponse], error)
 	AdminListSAMLFlows(context.Context, *connect.Request[v1.AdminListSAMLFlowsRequest]) (*connect.Response[v1.AdminListSAMLFlowsResponse], error)
 	AdminGetSAMLFlow(context.Context, *connect.Request[v1.AdminGetSAMLFlowRequest]) (*connect.Response[v1.AdminGetSAMLFlowResponse], error)
@@ -445,6 +513,16 @@ func NewSSOReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SSOReadyServiceRedeemSAMLAccessCodeProcedure,
 			opts...,
 		),
+		getSAMLLogoutRedirectURL: connect.NewClient[v1.GetSAMLLogoutRedirectURLRequest, v1.GetSAMLLogoutRedirectURLResponse](
+			httpClient,
+			baseURL+SSOReadyServiceGetSAMLLogoutRedirectURLProcedure,
+			opts...,
+		),
+		listSAMLLogouts: connect.NewClient[v1.ListSAMLLogoutsRequest, v1.ListSAMLLogoutsResponse](
+			httpClient,
+			baseURL+SSOReadyServiceListSAMLLogoutsProcedure,
+			opts...,
+		),
 		listSCIMUsers: connect.NewClient[v1.ListSCIMUsersRequest, v1.ListSCIMUsersResponse](
 			httpClient,
 			baseURL+SSOReadyServiceListSCIMUsersProcedure,
@@ -495,6 +573,11 @@ func NewSSOReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SSOReadyServiceListSAMLConnectionsProcedure,
 			opts...,
 		),
+		listExpiringSAMLConnections: connect.NewClient[v1.ListExpiringSAMLConnectionsRequest, v1.ListExpiringSAMLConnectionsResponse](
+			httpClient,
+			baseURL+SSOReadyServiceListExpiringSAMLConnectionsProcedure,
+			opts...,
+		),
 		getSAMLConnection: connect.NewClient[v1.GetSAMLConnectionRequest, v1.GetSAMLConnectionResponse](
 			httpClient,
 			baseURL+SSOReadyServiceGetSAMLConnectionProcedure,
@@ -510,6 +593,16 @@ func NewSSOReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SSOReadyServiceUpdateSAMLConnectionProcedure,
 			opts...,
 		),
+		rotateSAMLConnectionSPCertificate: connect.NewClient[v1.RotateSAMLConnectionSPCertificateRequest, v1.RotateSAMLConnectionSPCertificateResponse](
+			httpClient,
+			baseURL+SSOReadyServiceRotateSAMLConnectionSPCertificateProcedure,
+			opts...,
+		),
+		getSAMLConnectionSPSigningCertificate: connect.NewClient[v1.GetSAMLConnectionSPSigningCertificateRequest, v1.GetSAMLConnectionSPSigningCertificateResponse](
+			httpClient,
+			baseURL+SSOReadyServiceGetSAMLConnectionSPSigningCertificateProcedure,
+			opts...,
+		),
 		listSCIMDirectories: connect.NewClient[v1.ListSCIMDirectoriesRequest, v1.ListSCIMDirectoriesResponse](
 			httpClient,
 			baseURL+SSOReadyServiceListSCIMDirectoriesProcedure,
@@ -725,11 +818,46 @@ func NewSSOReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SSOReadyServiceAppUpdateSAMLConnectionProcedure,
 			opts...,
 		),
+		appRotateSAMLConnectionSPCertificate: connect.NewClient[v1.AppRotateSAMLConnectionSPCertificateRequest, v1.SAMLConnection](
+			httpClient,
+			baseURL+SSOReadyServiceAppRotateSAMLConnectionSPCertificateProcedure,
+			opts...,
+		),
+		appGetSAMLConnectionSPSigningCertificate: connect.NewClient[v1.AppGetSAMLConnectionSPSigningCertificateRequest, v1.AppGetSAMLConnectionSPSigningCertificateResponse](
+			httpClient,
+			baseURL+SSOReadyServiceAppGetSAMLConnectionSPSigningCertificateProcedure,
+			opts...,
+		),
 		appDeleteSAMLConnection: connect.NewClient[v1.AppDeleteSAMLConnectionRequest, emptypb.Empty](
 			httpClient,
 			baseURL+SSOReadyServiceAppDeleteSAMLConnectionProcedure,
 			opts...,
 		),
+		appListSAMLConnectionIDPCertificates: connect.NewClient[v1.AppListSAMLConnectionIDPCertificatesRequest, v1.AppListSAMLConnectionIDPCertificatesResponse](
+			httpClient,
+			baseURL+SSOReadyServiceAppListSAMLConnectionIDPCertificatesProcedure,
+			opts...,
+		),
+		appCreateSAMLConnectionIDPCertificate: connect.NewClient[v1.AppCreateSAMLConnectionIDPCertificateRequest, v1.SAMLConnectionIDPCertificate](
+			httpClient,
+			baseURL+SSOReadyServiceAppCreateSAMLConnectionIDPCertificateProcedure,
+			opts...,
+		),
+		appDeleteSAMLConnectionIDPCertificate: connect.NewClient[v1.AppDeleteSAMLConnectionIDPCertificateRequest, emptypb.Empty](
+			httpClient,
+			baseURL+SSOReadyServiceAppDeleteSAMLConnectionIDPCertificateProcedure,
+			opts...,
+		),
+		appListSAMLConnectionIDPMetadataUpdates: connect.NewClient[v1.AppListSAMLConnectionIDPMetadataUpdatesRequest, v1.AppListSAMLConnectionIDPMetadataUpdatesResponse](
+			httpClient,
+			baseURL+SSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesProcedure,
+			opts...,
+		),
+		appAcceptSAMLConnectionIDPMetadataUpdate: connect.NewClient[v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest, v1.SAMLConnectionIDPMetadataUpdate](
+			httpClient,
+			baseURL+SSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateProcedure,
+			opts...,
+		),
 		appListSAMLFlows: connect.NewClient[v1.AppListSAMLFlowsRequest, v1.AppListSAMLFlowsResponse](
 			httpClient,
 			baseURL+SSOReadyServiceAppListSAMLFlowsProcedure,
@@ -840,6 +968,11 @@ func NewSSOReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 			baseURL+SSOReadyServiceAdminUpdateSAMLConnectionProcedure,
 			opts...,
 		),
+		adminGetSAMLConnectionSPSigningCertificate: connect.NewClient[v1.AdminGetSAMLConnectionSPSigningCertificateRequest, v1.AdminGetSAMLConnectionSPSigningCertificateResponse](
+			httpClient,
+			baseURL+SSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateProcedure,
+			opts...,
+		),
 		adminParseSAMLMetadata: connect.NewClient[v1.AdminParseSAMLMetadataRequest, v1.AdminParseSAMLMetadataResponse](
 			httpClient,
 			baseURL+SSOReadyServiceAdminParseSAMLMetadataProcedure,
@@ -887,6 +1020,8 @@ func NewSSOReadyServiceClient(httpClient connect.HTTPClient, baseURL string, opt
 type sSOReadyServiceClient struct {
 	getSAMLRedirectURL                               *connect.Client[v1.GetSAMLRedirectURLRequest, v1.GetSAMLRedirectURLResponse]
 	redeemSAMLAccessCode                             *connect.Client[v1.RedeemSAMLAccessCodeRequest, v1.RedeemSAMLAccessCodeResponse]
+	getSAMLLogoutRedirectURL                         *connect.Client[v1.GetSAMLLogoutRedirectURLRequest, v1.GetSAMLLogoutRedirectURLResponse]
+	listSAMLLogouts                                  *connect.Client[v1.ListSAMLLogoutsRequest, v1.ListSAMLLogoutsResponse]
 	listSCIMUsers                                    *connect.Client[v1.ListSCIMUsersRequest, v1.ListSCIMUsersResponse]
 	getSCIMUser                                      *connect.Client[v1.GetSCIMUserRequest, v1.GetSCIMUserResponse]
 	listSCIMGroups                                   *connect.Client[v1.ListSCIMGroupsRequest, v1.ListSCIMGroupsResponse]
@@ -897,9 +1032,12 @@ type sSOReadyServiceClient struct {
 	updateOrganization                               *connect.Client[v1.UpdateOrganizationRequest, v1.UpdateOrganizationResponse]
 	createSetupURL                                   *connect.Client[v1.CreateSetupURLRequest, v1.CreateSetupURLResponse]
 	listSAMLConnections                              *connect.Client[v1.ListSAMLConnectionsRequest, v1.ListSAMLConnectionsResponse]
+	listExpiringSAMLConnections                      *connect.Client[v1.ListExpiringSAMLConnectionsRequest, v1.ListExpiringSAMLConnectionsResponse]
 	getSAMLConnection                                *connect.Client[v1.GetSAMLConnectionRequest, v1.GetSAMLConnectionResponse]
 	createSAMLConnection                             *connect.Client[v1.CreateSAMLConnectionRequest, v1.CreateSAMLConnectionResponse]
 	updateSAMLConnection                             *connect.Client[v1.UpdateSAMLConnectionRequest, v1.UpdateSAMLConnectionResponse]
+	rotateSAMLConnectionSPCertificate                *connect.Client[v1.RotateSAMLConnectionSPCertificateRequest, v1.RotateSAMLConnectionSPCertificateResponse]
+	getSAMLConnectionSPSigningCertificate            *connect.Client[v1.GetSAMLConnectionSPSigningCertificateRequest, v1.GetSAMLConnectionSPSigningCertificateResponse]
 	listSCIMDirectories                              *connect.Client[v1.ListSCIMDirectoriesRequest, v1.ListSCIMDirectoriesResponse]
 	getSCIMDirectory                                 *connect.Client[v1.GetSCIMDirectoryRequest, v1.GetSCIMDirectoryResponse]
 	createSCIMDirectory                              *connect.Client[v1.CreateSCIMDirectoryRequest, v1.CreateSCIMDirectoryResponse]
@@ -943,7 +1081,14 @@ type sSOReadyServiceClient struct {
 	appGetSAMLConnection                             *connect.Client[v1.AppGetSAMLConnectionRequest, v1.SAMLConnection]
 	appCreateSAMLConnection                          *connect.Client[v1.AppCreateSAMLConnectionRequest, v1.SAMLConnection]
 	appUpdateSAMLConnection                          *connect.Client[v1.AppUpdateSAMLConnectionRequest, v1.SAMLConnection]
+	appRotateSAMLConnectionSPCertificate             *connect.Client[v1.AppRotateSAMLConnectionSPCertificateRequest, v1.SAMLConnection]
+	appGetSAMLConnectionSPSigningCertificate         *connect.Client[v1.AppGetSAMLConnectionSPSigningCertificateRequest, v1.AppGetSAMLConnectionSPSigningCertificateResponse]
 	appDeleteSAMLConnection                          *connect.Client[v1.AppDeleteSAMLConnectionRequest, emptypb.Empty]
+	appListSAMLConnectionIDPCertificates             *connect.Client[v1.AppListSAMLConnectionIDPCertificatesRequest, v1.AppListSAMLConnectionIDPCertificatesResponse]
+	appCreateSAMLConnectionIDPCertificate            *connect.Client[v1.AppCreateSAMLConnectionIDPCertificateRequest, v1.SAMLConnectionIDPCertificate]
+	appDeleteSAMLConnectionIDPCertificate            *connect.Client[v1.AppDeleteSAMLConnectionIDPCertificateRequest, emptypb.Empty]
+	appListSAMLConnectionIDPMetadataUpdates          *connect.Client[v1.AppListSAMLConnectionIDPMetadataUpdatesRequest, v1.AppListSAMLConnectionIDPMetadataUpdatesResponse]
+	appAcceptSAMLConnectionIDPMetadataUpdate         *connect.Client[v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest, v1.SAMLConnectionIDPMetadataUpdate]
 	appListSAMLFlows                                 *connect.Client[v1.AppListSAMLFlowsRequest, v1.AppListSAMLFlowsResponse]
 	appGetSAMLFlow                                   *connect.Client[v1.AppGetSAMLFlowRequest, v1.SAMLFlow]
 	parseSAMLMetadata                                *connect.Client[v1.ParseSAMLMetadataRequest, v1.ParseSAMLMetadataResponse]
@@ -966,6 +1111,7 @@ type sSOReadyServiceClient struct {
 	adminGetSAMLConnection                           *connect.Client[v1.AdminGetSAMLConnectionRequest, v1.AdminGetSAMLConnectionResponse]
 	adminCreateSAMLConnection                        *connect.Client[v1.AdminCreateSAMLConnectionRequest, v1.AdminCreateSAMLConnectionResponse]
 	adminUpdateSAMLConnection                        *connect.Client[v1.AdminUpdateSAMLConnectionRequest, v1.AdminUpdateSAMLConnectionResponse]
+	adminGetSAMLConnectionSPSigningCertificate       *connect.Client[v1.AdminGetSAMLConnectionSPSigningCertificateRequest, v1.AdminGetSAMLConnectionSPSigningCertificateResponse]
 	adminParseSAMLMetadata                           *connect.Client[v1.AdminParseSAMLMetadataRequest, v1.AdminParseSAMLMetadataResponse]
 	adminListSAMLFlows                               *connect.Client[v1.AdminListSAMLFlowsRequest, v1.AdminListSAMLFlowsResponse]
 	adminGetSAMLFlow                                 *connect.Client[v1.AdminGetSAMLFlowRequest, v1.AdminGetSAMLFlowResponse]
@@ -986,6 +1132,16 @@ func (c *sSOReadyServiceClient) RedeemSAMLAccessCode(ctx context.Context, req *c
 	return c.redeemSAMLAccessCode.CallUnary(ctx, req)
 }
 
+// GetSAMLLogoutRedirectURL calls ssoready.v1.SSOReadyService.GetSAMLLogoutRedirectURL.
+func (c *sSOReadyServiceClient) GetSAMLLogoutRedirectURL(ctx context.Context, req *connect.Request[v1.GetSAMLLogoutRedirectURLRequest]) (*connect.Response[v1.GetSAMLLogoutRedirectURLResponse], error) {
+	return c.getSAMLLogoutRedirectURL.CallUnary(ctx, req)
+}
+
+// ListSAMLLogouts calls ssoready.v1.SSOReadyService.ListSAMLLogouts.
+func (c *sSOReadyServiceClient) ListSAMLLogouts(ctx context.Context, req *connect.Request[v1.ListSAMLLogoutsRequest]) (*connect.Response[v1.ListSAMLLogoutsResponse], error) {
+	return c.listSAMLLogouts.CallUnary(ctx, req)
+}
+
 // ListSCIMUsers calls ssoready.v1.SSOReadyService.ListSCIMUsers.
 func (c *sSOReadyServiceClient) ListSCIMUsers(ctx context.Context, req *connect.Request[v1.ListSCIMUsersRequest]) (*connect.Response[v1.ListSCIMUsersResponse], error) {
 	return c.listSCIMUsers.CallUnary(ctx, req)
@@ -1036,6 +1192,11 @@ func (c *sSOReadyServiceClient) ListSAMLConnections(ctx context.Context, req *co
 	return c.listSAMLConnections.CallUnary(ctx, req)
 }
 
+// ListExpiringSAMLConnections calls ssoready.v1.SSOReadyService.ListExpiringSAMLConnections.
+func (c *sSOReadyServiceClient) ListExpiringSAMLConnections(ctx context.Context, req *connect.Request[v1.ListExpiringSAMLConnectionsRequest]) (*connect.Response[v1.ListExpiringSAMLConnectionsResponse], error) {
+	return c.listExpiringSAMLConnections.CallUnary(ctx, req)
+}
+
 // GetSAMLConnection calls ssoready.v1.SSOReadyService.GetSAMLConnection.
 func (c *sSOReadyServiceClient) GetSAMLConnection(ctx context.Context, req *connect.Request[v1.GetSAMLConnectionRequest]) (*connect.Response[v1.GetSAMLConnectionResponse], error) {
 	return c.getSAMLConnection.CallUnary(ctx, req)
@@ -1051,6 +1212,18 @@ func (c *sSOReadyServiceClient) UpdateSAMLConnection(ctx context.Context, req *c
 	return c.updateSAMLConnection.CallUnary(ctx, req)
 }
 
+// RotateSAMLConnectionSPCertificate calls
+// ssoready.v1.SSOReadyService.RotateSAMLConnectionSPCertificate.
+func (c *sSOReadyServiceClient) RotateSAMLConnectionSPCertificate(ctx context.Context, req *connect.Request[v1.RotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.RotateSAMLConnectionSPCertificateResponse], error) {
+	return c.rotateSAMLConnectionSPCertificate.CallUnary(ctx, req)
+}
+
+// GetSAMLConnectionSPSigningCertificate calls
+// ssoready.v1.SSOReadyService.GetSAMLConnectionSPSigningCertificate.
+func (c *sSOReadyServiceClient) GetSAMLConnectionSPSigningCertificate(ctx context.Context, req *connect.Request[v1.GetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.GetSAMLConnectionSPSigningCertificateResponse], error) {
+	return c.getSAMLConnectionSPSigningCertificate.CallUnary(ctx, req)
+}
+
 // ListSCIMDirectories calls ssoready.v1.SSOReadyService.ListSCIMDirectories.
 func (c *sSOReadyServiceClient) ListSCIMDirectories(ctx context.Context, req *connect.Request[v1.ListSCIMDirectoriesRequest]) (*connect.Response[v1.ListSCIMDirectoriesResponse], error) {
 	return c.listSCIMDirectories.CallUnary(ctx, req)
@@ -1269,11 +1442,53 @@ func (c *sSOReadyServiceClient) AppUpdateSAMLConnection(ctx context.Context, req
 	return c.appUpdateSAMLConnection.CallUnary(ctx, req)
 }
 
+// AppRotateSAMLConnectionSPCertificate calls
+// ssoready.v1.SSOReadyService.AppRotateSAMLConnectionSPCertificate.
+func (c *sSOReadyServiceClient) AppRotateSAMLConnectionSPCertificate(ctx context.Context, req *connect.Request[v1.AppRotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.SAMLConnection], error) {
+	return c.appRotateSAMLConnectionSPCertificate.CallUnary(ctx, req)
+}
+
+// AppGetSAMLConnectionSPSigningCertificate calls
+// ssoready.v1.SSOReadyService.AppGetSAMLConnectionSPSigningCertificate.
+func (c *sSOReadyServiceClient) AppGetSAMLConnectionSPSigningCertificate(ctx context.Context, req *connect.Request[v1.AppGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AppGetSAMLConnectionSPSigningCertificateResponse], error) {
+	return c.appGetSAMLConnectionSPSigningCertificate.CallUnary(ctx, req)
+}
+
 // AppDeleteSAMLConnection calls ssoready.v1.SSOReadyService.AppDeleteSAMLConnection.
 func (c *sSOReadyServiceClient) AppDeleteSAMLConnection(ctx context.Context, req *connect.Request[v1.AppDeleteSAMLConnectionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return c.appDeleteSAMLConnection.CallUnary(ctx, req)
 }
 
+// AppListSAMLConnectionIDPCertificates calls
+// ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPCertificates.
+func (c *sSOReadyServiceClient) AppListSAMLConnectionIDPCertificates(ctx context.Context, req *connect.Request[v1.AppListSAMLConnectionIDPCertificatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPCertificatesResponse], error) {
+	return c.appListSAMLConnectionIDPCertificates.CallUnary(ctx, req)
+}
+
+// AppCreateSAMLConnectionIDPCertificate calls
+// ssoready.v1.SSOReadyService.AppCreateSAMLConnectionIDPCertificate.
+func (c *sSOReadyServiceClient) AppCreateSAMLConnectionIDPCertificate(ctx context.Context, req *connect.Request[v1.AppCreateSAMLConnectionIDPCertificateRequest]) (*connect.Response[v1.SAMLConnectionIDPCertificate], error) {
+	return c.appCreateSAMLConnectionIDPCertificate.CallUnary(ctx, req)
+}
+
+// AppDeleteSAMLConnectionIDPCertificate calls
+// ssoready.v1.SSOReadyService.AppDeleteSAMLConnectionIDPCertificate.
+func (c *sSOReadyServiceClient) AppDeleteSAMLConnectionIDPCertificate(ctx context.Context, req *connect.Request[v1.AppDeleteSAMLConnectionIDPCertificateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return c.appDeleteSAMLConnectionIDPCertificate.CallUnary(ctx, req)
+}
+
+// AppListSAMLConnectionIDPMetadataUpdates calls
+// ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPMetadataUpdates.
+func (c *sSOReadyServiceClient) AppListSAMLConnectionIDPMetadataUpdates(ctx context.Context, req *connect.Request[v1.AppListSAMLConnectionIDPMetadataUpdatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPMetadataUpdatesResponse], error) {
+	return c.appListSAMLConnectionIDPMetadataUpdates.CallUnary(ctx, req)
+}
+
+// AppAcceptSAMLConnectionIDPMetadataUpdate calls
+// ssoready.v1.SSOReadyService.AppAcceptSAMLConnectionIDPMetadataUpdate.
+func (c *sSOReadyServiceClient) AppAcceptSAMLConnectionIDPMetadataUpdate(ctx context.Context, req *connect.Request[v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest]) (*connect.Response[v1.SAMLConnectionIDPMetadataUpdate], error) {
+	return c.appAcceptSAMLConnectionIDPMetadataUpdate.CallUnary(ctx, req)
+}
+
 // AppListSAMLFlows calls ssoready.v1.SSOReadyService.AppListSAMLFlows.
 func (c *sSOReadyServiceClient) AppListSAMLFlows(ctx context.Context, req *connect.Request[v1.AppListSAMLFlowsRequest]) (*connect.Response[v1.AppListSAMLFlowsResponse], error) {
 	return c.appListSAMLFlows.CallUnary(ctx, req)
@@ -1385,6 +1600,12 @@ func (c *sSOReadyServiceClient) AdminUpdateSAMLConnection(ctx context.Context, r
 	return c.adminUpdateSAMLConnection.CallUnary(ctx, req)
 }
 
+// AdminGetSAMLConnectionSPSigningCertificate calls
+// ssoready.v1.SSOReadyService.AdminGetSAMLConnectionSPSigningCertificate.
+func (c *sSOReadyServiceClient) AdminGetSAMLConnectionSPSigningCertificate(ctx context.Context, req *connect.Request[v1.AdminGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AdminGetSAMLConnectionSPSigningCertificateResponse], error) {
+	return c.adminGetSAMLConnectionSPSigningCertificate.CallUnary(ctx, req)
+}
+
 // AdminParseSAMLMetadata calls ssoready.v1.SSOReadyService.AdminParseSAMLMetadata.
 func (c *sSOReadyServiceClient) AdminParseSAMLMetadata(ctx context.Context, req *connect.Request[v1.AdminParseSAMLMetadataRequest]) (*connect.Response[v1.AdminParseSAMLMetadataResponse], error) {
 	return c.adminParseSAMLMetadata.CallUnary(ctx, req)
@@ -1432,6 +1653,12 @@ type SSOReadyServiceHandler interface {
 	GetSAMLRedirectURL(context.Context, *connect.Request[v1.GetSAMLRedirectURLRequest]) (*connect.Response[v1.GetSAMLRedirectURLResponse], error)
 	// Exchanges a SAML access code for details about your user's SAML login details.
 	RedeemSAMLAccessCode(context.Context, *connect.Request[v1.RedeemSAMLAccessCodeRequest]) (*connect.Response[v1.RedeemSAMLAccessCodeResponse], error)
+	// Gets a URL to redirect your users to in order to log them out of their Identity Provider.
+	GetSAMLLogoutRedirectURL(context.Context, *connect.Request[v1.GetSAMLLogoutRedirectURLRequest]) (*connect.Response[v1.GetSAMLLogoutRedirectURLResponse], error)
+	// Gets a list of SAML logouts in a SAML connection.
+	//
+	// Poll this endpoint to find out which of your users' sessions their Identity Provider has asked you to terminate.
+	ListSAMLLogouts(context.Context, *connect.Request[v1.ListSAMLLogoutsRequest]) (*connect.Response[v1.ListSAMLLogoutsResponse], error)
 	// Gets a list of SCIM users in a SCIM directory.
 	ListSCIMUsers(context.Context, *connect.Request[v1.ListSCIMUsersRequest]) (*connect.Response[v1.ListSCIMUsersResponse], error)
 	// Gets a SCIM user.
@@ -1454,12 +1681,27 @@ type SSOReadyServiceHandler interface {
 	CreateSetupURL(context.Context, *connect.Request[v1.CreateSetupURLRequest]) (*connect.Response[v1.CreateSetupURLResponse], error)
 	// Lists SAML connections in an organization.
 	ListSAMLConnections(context.Context, *connect.Request[v1.ListSAMLConnectionsRequest]) (*connect.Response[v1.ListSAMLConnectionsResponse], error)
+	// Lists SAML connections whose IDP certificate expires soon, across all organizations.
+	//
+	// Once a SAML connection's IDP certificate expires, your customer's users can no longer log in until your customer
+	// gives you a new one. Use this endpoint to find those customers ahead of time.
+	ListExpiringSAMLConnections(context.Context, *connect.Request[v1.ListExpiringSAMLConnectionsRequest]) (*connect.Response[v1.ListExpiringSAMLConnectionsResponse], error)
 	// Gets a SAML connection.
 	GetSAMLConnection(context.Context, *connect.Request[v1.GetSAMLConnectionRequest]) (*connect.Response[v1.GetSAMLConnectionResponse], error)
 	// Creates a SAML connection.
 	CreateSAMLConnection(context.Context, *connect.Request[v1.CreateSAMLConnectionRequest]) (*connect.Response[v1.CreateSAMLConnectionResponse], error)
 	// Updates a SAML connection.
 	UpdateSAMLConnection(context.Context, *connect.Request[v1.UpdateSAMLConnectionRequest]) (*connect.Response[v1.UpdateSAMLConnectionResponse], error)
+	// Rotates a SAML connection's SP certificate.
+	//
+	// SSOReady continues to accept assertions encrypted using the previous SP certificate until the next rotation, so
+	// that your customer has time to update their Identity Provider with the new value.
+	RotateSAMLConnectionSPCertificate(context.Context, *connect.Request[v1.RotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.RotateSAMLConnectionSPCertificateResponse], error)
+	// Gets a SAML connection's SP signing certificate.
+	//
+	// If the SAML connection has sign_authn_requests enabled, your customer needs to input this certificate into their
+	// Identity Provider, so that it can verify the SAML requests SSOReady sends it.
+	GetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.GetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.GetSAMLConnectionSPSigningCertificateResponse], error)
 	// Gets a list of SCIM directories in an organization.
 	ListSCIMDirectories(context.Context, *connect.Request[v1.ListSCIMDirectoriesRequest]) (*connect.Response[v1.ListSCIMDirectoriesResponse], error)
 	// Gets a SCIM directory.
@@ -1518,7 +1760,14 @@ type SSOReadyServiceHandler interface {
 	AppGetSAMLConnection(context.Context, *connect.Request[v1.AppGetSAMLConnectionRequest]) (*connect.Response[v1.SAMLConnection], error)
 	AppCreateSAMLConnection(context.Context, *connect.Request[v1.AppCreateSAMLConnectionRequest]) (*connect.Response[v1.SAMLConnection], error)
 	AppUpdateSAMLConnection(context.Context, *connect.Request[v1.AppUpdateSAMLConnectionRequest]) (*connect.Response[v1.SAMLConnection], error)
+	AppRotateSAMLConnectionSPCertificate(context.Context, *connect.Request[v1.AppRotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.SAMLConnection], error)
+	AppGetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.AppGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AppGetSAMLConnectionSPSigningCertificateResponse], error)
 	AppDeleteSAMLConnection(context.Context, *connect.Request[v1.AppDeleteSAMLConnectionRequest]) (*connect.Response[emptypb.Empty], error)
+	AppListSAMLConnectionIDPCertificates(context.Context, *connect.Request[v1.AppListSAMLConnectionIDPCertificatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPCertificatesResponse], error)
+	AppCreateSAMLConnectionIDPCertificate(context.Context, *connect.Request[v1.AppCreateSAMLConnectionIDPCertificateRequest]) (*connect.Response[v1.SAMLConnectionIDPCertificate], error)
+	AppDeleteSAMLConnectionIDPCertificate(context.Context, *connect.Request[v1.AppDeleteSAMLConnectionIDPCertificateRequest]) (*connect.Response[emptypb.Empty], error)
+	AppListSAMLConnectionIDPMetadataUpdates(context.Context, *connect.Request[v1.AppListSAMLConnectionIDPMetadataUpdatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPMetadataUpdatesResponse], error)
+	AppAcceptSAMLConnectionIDPMetadataUpdate(context.Context, *connect.Request[v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest]) (*connect.Response[v1.SAMLConnectionIDPMetadataUpdate], error)
 	AppListSAMLFlows(context.Context, *connect.Request[v1.AppListSAMLFlowsRequest]) (*connect.Response[v1.AppListSAMLFlowsResponse], error)
 	AppGetSAMLFlow(context.Context, *connect.Request[v1.AppGetSAMLFlowRequest]) (*connect.Response[v1.SAMLFlow], error)
 	ParseSAMLMetadata(context.Context, *connect.Request[v1.ParseSAMLMetadataRequest]) (*connect.Response[v1.ParseSAMLMetadataResponse], error)
@@ -1541,6 +1790,7 @@ type SSOReadyServiceHandler interface {
 	AdminGetSAMLConnection(context.Context, *connect.Request[v1.AdminGetSAMLConnectionRequest]) (*connect.Response[v1.AdminGetSAMLConnectionResponse], error)
 	AdminCreateSAMLConnection(context.Context, *connect.Request[v1.AdminCreateSAMLConnectionRequest]) (*connect.Response[v1.AdminCreateSAMLConnectionResponse], error)
 	AdminUpdateSAMLConnection(context.Context, *connect.Request[v1.AdminUpdateSAMLConnectionRequest]) (*connect.Response[v1.AdminUpdateSAMLConnectionResponse], error)
+	AdminGetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.AdminGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AdminGetSAMLConnectionSPSigningCertificateResponse], error)
 	AdminParseSAMLMetadata(context.Context, *connect.Request[v1.AdminParseSAMLMetadataRequest]) (*connect.Response[v1.AdminParseSAMLMetadataResponse], error)
 	AdminListSAMLFlows(context.Context, *connect.Request[v1.AdminListSAMLFlowsRequest]) (*connect.Response[v1.AdminListSAMLFlowsResponse], error)
 	AdminGetSAMLFlow(context.Context, *connect.Request[v1.AdminGetSAMLFlowRequest]) (*connect.Response[v1.AdminGetSAMLFlowResponse], error)
@@ -1567,6 +1817,16 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 		svc.RedeemSAMLAccessCode,
 		opts...,
 	)
+	sSOReadyServiceGetSAMLLogoutRedirectURLHandler := connect.NewUnaryHandler(
+		SSOReadyServiceGetSAMLLogoutRedirectURLProcedure,
+		svc.GetSAMLLogoutRedirectURL,
+		opts...,
+	)
+	sSOReadyServiceListSAMLLogoutsHandler := connect.NewUnaryHandler(
+		SSOReadyServiceListSAMLLogoutsProcedure,
+		svc.ListSAMLLogouts,
+		opts...,
+	)
 	sSOReadyServiceListSCIMUsersHandler := connect.NewUnaryHandler(
 		SSOReadyServiceListSCIMUsersProcedure,
 		svc.ListSCIMUsers,
@@ -1617,6 +1877,11 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 		svc.ListSAMLConnections,
 		opts...,
 	)
+	sSOReadyServiceListExpiringSAMLConnectionsHandler := connect.NewUnaryHandler(
+		SSOReadyServiceListExpiringSAMLConnectionsProcedure,
+		svc.ListExpiringSAMLConnections,
+		opts...,
+	)
 	sSOReadyServiceGetSAMLConnectionHandler := connect.NewUnaryHandler(
 		SSOReadyServiceGetSAMLConnectionProcedure,
 		svc.GetSAMLConnection,
@@ -1632,6 +1897,16 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 		svc.UpdateSAMLConnection,
 		opts...,
 	)
+	sSOReadyServiceRotateSAMLConnectionSPCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceRotateSAMLConnectionSPCertificateProcedure,
+		svc.RotateSAMLConnectionSPCertificate,
+		opts...,
+	)
+	sSOReadyServiceGetSAMLConnectionSPSigningCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceGetSAMLConnectionSPSigningCertificateProcedure,
+		svc.GetSAMLConnectionSPSigningCertificate,
+		opts...,
+	)
 	sSOReadyServiceListSCIMDirectoriesHandler := connect.NewUnaryHandler(
 		SSOReadyServiceListSCIMDirectoriesProcedure,
 		svc.ListSCIMDirectories,
@@ -1847,11 +2122,46 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 		svc.AppUpdateSAMLConnection,
 		opts...,
 	)
+	sSOReadyServiceAppRotateSAMLConnectionSPCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppRotateSAMLConnectionSPCertificateProcedure,
+		svc.AppRotateSAMLConnectionSPCertificate,
+		opts...,
+	)
+	sSOReadyServiceAppGetSAMLConnectionSPSigningCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppGetSAMLConnectionSPSigningCertificateProcedure,
+		svc.AppGetSAMLConnectionSPSigningCertificate,
+		opts...,
+	)
 	sSOReadyServiceAppDeleteSAMLConnectionHandler := connect.NewUnaryHandler(
 		SSOReadyServiceAppDeleteSAMLConnectionProcedure,
 		svc.AppDeleteSAMLConnection,
 		opts...,
 	)
+	sSOReadyServiceAppListSAMLConnectionIDPCertificatesHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppListSAMLConnectionIDPCertificatesProcedure,
+		svc.AppListSAMLConnectionIDPCertificates,
+		opts...,
+	)
+	sSOReadyServiceAppCreateSAMLConnectionIDPCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppCreateSAMLConnectionIDPCertificateProcedure,
+		svc.AppCreateSAMLConnectionIDPCertificate,
+		opts...,
+	)
+	sSOReadyServiceAppDeleteSAMLConnectionIDPCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppDeleteSAMLConnectionIDPCertificateProcedure,
+		svc.AppDeleteSAMLConnectionIDPCertificate,
+		opts...,
+	)
+	sSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesProcedure,
+		svc.AppListSAMLConnectionIDPMetadataUpdates,
+		opts...,
+	)
+	sSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateProcedure,
+		svc.AppAcceptSAMLConnectionIDPMetadataUpdate,
+		opts...,
+	)
 	sSOReadyServiceAppListSAMLFlowsHandler := connect.NewUnaryHandler(
 		SSOReadyServiceAppListSAMLFlowsProcedure,
 		svc.AppListSAMLFlows,
@@ -1962,6 +2272,11 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 		svc.AdminUpdateSAMLConnection,
 		opts...,
 	)
+	sSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateHandler := connect.NewUnaryHandler(
+		SSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateProcedure,
+		svc.AdminGetSAMLConnectionSPSigningCertificate,
+		opts...,
+	)
 	sSOReadyServiceAdminParseSAMLMetadataHandler := connect.NewUnaryHandler(
 		SSOReadyServiceAdminParseSAMLMetadataProcedure,
 		svc.AdminParseSAMLMetadata,
@@ -2008,6 +2323,10 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 			sSOReadyServiceGetSAMLRedirectURLHandler.ServeHTTP(w, r)
 		case SSOReadyServiceRedeemSAMLAccessCodeProcedure:
 			sSOReadyServiceRedeemSAMLAccessCodeHandler.ServeHTTP(w, r)
+		case SSOReadyServiceGetSAMLLogoutRedirectURLProcedure:
+			sSOReadyServiceGetSAMLLogoutRedirectURLHandler.ServeHTTP(w, r)
+		case SSOReadyServiceListSAMLLogoutsProcedure:
+			sSOReadyServiceListSAMLLogoutsHandler.ServeHTTP(w, r)
 		case SSOReadyServiceListSCIMUsersProcedure:
 			sSOReadyServiceListSCIMUsersHandler.ServeHTTP(w, r)
 		case SSOReadyServiceGetSCIMUserProcedure:
@@ -2028,12 +2347,18 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 			sSOReadyServiceCreateSetupURLHandler.ServeHTTP(w, r)
 		case SSOReadyServiceListSAMLConnectionsProcedure:
 			sSOReadyServiceListSAMLConnectionsHandler.ServeHTTP(w, r)
+		case SSOReadyServiceListExpiringSAMLConnectionsProcedure:
+			sSOReadyServiceListExpiringSAMLConnectionsHandler.ServeHTTP(w, r)
 		case SSOReadyServiceGetSAMLConnectionProcedure:
 			sSOReadyServiceGetSAMLConnectionHandler.ServeHTTP(w, r)
 		case SSOReadyServiceCreateSAMLConnectionProcedure:
 			sSOReadyServiceCreateSAMLConnectionHandler.ServeHTTP(w, r)
 		case SSOReadyServiceUpdateSAMLConnectionProcedure:
 			sSOReadyServiceUpdateSAMLConnectionHandler.ServeHTTP(w, r)
+		case SSOReadyServiceRotateSAMLConnectionSPCertificateProcedure:
+			sSOReadyServiceRotateSAMLConnectionSPCertificateHandler.ServeHTTP(w, r)
+		case SSOReadyServiceGetSAMLConnectionSPSigningCertificateProcedure:
+			sSOReadyServiceGetSAMLConnectionSPSigningCertificateHandler.ServeHTTP(w, r)
 		case SSOReadyServiceListSCIMDirectoriesProcedure:
 			sSOReadyServiceListSCIMDirectoriesHandler.ServeHTTP(w, r)
 		case SSOReadyServiceGetSCIMDirectoryProcedure:
@@ -2120,8 +2445,22 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 			sSOReadyServiceAppCreateSAMLConnectionHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAppUpdateSAMLConnectionProcedure:
 			sSOReadyServiceAppUpdateSAMLConnectionHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppRotateSAMLConnectionSPCertificateProcedure:
+			sSOReadyServiceAppRotateSAMLConnectionSPCertificateHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppGetSAMLConnectionSPSigningCertificateProcedure:
+			sSOReadyServiceAppGetSAMLConnectionSPSigningCertificateHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAppDeleteSAMLConnectionProcedure:
 			sSOReadyServiceAppDeleteSAMLConnectionHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppListSAMLConnectionIDPCertificatesProcedure:
+			sSOReadyServiceAppListSAMLConnectionIDPCertificatesHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppCreateSAMLConnectionIDPCertificateProcedure:
+			sSOReadyServiceAppCreateSAMLConnectionIDPCertificateHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppDeleteSAMLConnectionIDPCertificateProcedure:
+			sSOReadyServiceAppDeleteSAMLConnectionIDPCertificateHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesProcedure:
+			sSOReadyServiceAppListSAMLConnectionIDPMetadataUpdatesHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateProcedure:
+			sSOReadyServiceAppAcceptSAMLConnectionIDPMetadataUpdateHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAppListSAMLFlowsProcedure:
 			sSOReadyServiceAppListSAMLFlowsHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAppGetSAMLFlowProcedure:
@@ -2166,6 +2505,8 @@ func NewSSOReadyServiceHandler(svc SSOReadyServiceHandler, opts ...connect.Handl
 			sSOReadyServiceAdminCreateSAMLConnectionHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAdminUpdateSAMLConnectionProcedure:
 			sSOReadyServiceAdminUpdateSAMLConnectionHandler.ServeHTTP(w, r)
+		case SSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateProcedure:
+			sSOReadyServiceAdminGetSAMLConnectionSPSigningCertificateHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAdminParseSAMLMetadataProcedure:
 			sSOReadyServiceAdminParseSAMLMetadataHandler.ServeHTTP(w, r)
 		case SSOReadyServiceAdminListSAMLFlowsProcedure:
@@ -2199,6 +2540,14 @@ func (UnimplementedSSOReadyServiceHandler) RedeemSAMLAccessCode(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.RedeemSAMLAccessCode is not implemented"))
 }
 
+func (UnimplementedSSOReadyServiceHandler) GetSAMLLogoutRedirectURL(context.Context, *connect.Request[v1.GetSAMLLogoutRedirectURLRequest]) (*connect.Response[v1.GetSAMLLogoutRedirectURLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.GetSAMLLogoutRedirectURL is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) ListSAMLLogouts(context.Context, *connect.Request[v1.ListSAMLLogoutsRequest]) (*connect.Response[v1.ListSAMLLogoutsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.ListSAMLLogouts is not implemented"))
+}
+
 func (UnimplementedSSOReadyServiceHandler) ListSCIMUsers(context.Context, *connect.Request[v1.ListSCIMUsersRequest]) (*connect.Response[v1.ListSCIMUsersResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.ListSCIMUsers is not implemented"))
 }
@@ -2239,6 +2588,10 @@ func (UnimplementedSSOReadyServiceHandler) ListSAMLConnections(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.ListSAMLConnections is not implemented"))
 }
 
+func (UnimplementedSSOReadyServiceHandler) ListExpiringSAMLConnections(context.Context, *connect.Request[v1.ListExpiringSAMLConnectionsRequest]) (*connect.Response[v1.ListExpiringSAMLConnectionsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.ListExpiringSAMLConnections is not implemented"))
+}
+
 func (UnimplementedSSOReadyServiceHandler) GetSAMLConnection(context.Context, *connect.Request[v1.GetSAMLConnectionRequest]) (*connect.Response[v1.GetSAMLConnectionResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.GetSAMLConnection is not implemented"))
 }
@@ -2251,6 +2604,14 @@ func (UnimplementedSSOReadyServiceHandler) UpdateSAMLConnection(context.Context,
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.UpdateSAMLConnection is not implemented"))
 }
 
+func (UnimplementedSSOReadyServiceHandler) RotateSAMLConnectionSPCertificate(context.Context, *connect.Request[v1.RotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.RotateSAMLConnectionSPCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.RotateSAMLConnectionSPCertificate is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) GetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.GetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.GetSAMLConnectionSPSigningCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.GetSAMLConnectionSPSigningCertificate is not implemented"))
+}
+
 func (UnimplementedSSOReadyServiceHandler) ListSCIMDirectories(context.Context, *connect.Request[v1.ListSCIMDirectoriesRequest]) (*connect.Response[v1.ListSCIMDirectoriesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.ListSCIMDirectories is not implemented"))
 }
@@ -2423,10 +2784,38 @@ func (UnimplementedSSOReadyServiceHandler) AppUpdateSAMLConnection(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppUpdateSAMLConnection is not implemented"))
 }
 
+func (UnimplementedSSOReadyServiceHandler) AppRotateSAMLConnectionSPCertificate(context.Context, *connect.Request[v1.AppRotateSAMLConnectionSPCertificateRequest]) (*connect.Response[v1.SAMLConnection], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppRotateSAMLConnectionSPCertificate is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) AppGetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.AppGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AppGetSAMLConnectionSPSigningCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppGetSAMLConnectionSPSigningCertificate is not implemented"))
+}
+
 func (UnimplementedSSOReadyServiceHandler) AppDeleteSAMLConnection(context.Context, *connect.Request[v1.AppDeleteSAMLConnectionRequest]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppDeleteSAMLConnection is not implemented"))
 }
 
+func (UnimplementedSSOReadyServiceHandler) AppListSAMLConnectionIDPCertificates(context.Context, *connect.Request[v1.AppListSAMLConnectionIDPCertificatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPCertificatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPCertificates is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) AppCreateSAMLConnectionIDPCertificate(context.Context, *connect.Request[v1.AppCreateSAMLConnectionIDPCertificateRequest]) (*connect.Response[v1.SAMLConnectionIDPCertificate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppCreateSAMLConnectionIDPCertificate is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) AppDeleteSAMLConnectionIDPCertificate(context.Context, *connect.Request[v1.AppDeleteSAMLConnectionIDPCertificateRequest]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppDeleteSAMLConnectionIDPCertificate is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) AppListSAMLConnectionIDPMetadataUpdates(context.Context, *connect.Request[v1.AppListSAMLConnectionIDPMetadataUpdatesRequest]) (*connect.Response[v1.AppListSAMLConnectionIDPMetadataUpdatesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppListSAMLConnectionIDPMetadataUpdates is not implemented"))
+}
+
+func (UnimplementedSSOReadyServiceHandler) AppAcceptSAMLConnectionIDPMetadataUpdate(context.Context, *connect.Request[v1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest]) (*connect.Response[v1.SAMLConnectionIDPMetadataUpdate], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppAcceptSAMLConnectionIDPMetadataUpdate is not implemented"))
+}
+
 func (UnimplementedSSOReadyServiceHandler) AppListSAMLFlows(context.Context, *connect.Request[v1.AppListSAMLFlowsRequest]) (*connect.Response[v1.AppListSAMLFlowsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AppListSAMLFlows is not implemented"))
 }
@@ -2515,6 +2904,10 @@ func (UnimplementedSSOReadyServiceHandler) AdminUpdateSAMLConnection(context.Con
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AdminUpdateSAMLConnection is not implemented"))
 }
 
+func (UnimplementedSSOReadyServiceHandler) AdminGetSAMLConnectionSPSigningCertificate(context.Context, *connect.Request[v1.AdminGetSAMLConnectionSPSigningCertificateRequest]) (*connect.Response[v1.AdminGetSAMLConnectionSPSigningCertificateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AdminGetSAMLConnectionSPSigningCertificate is not implemented"))
+}
+
 func (UnimplementedSSOReadyServiceHandler) AdminParseSAMLMetadata(context.Context, *connect.Request[v1.AdminParseSAMLMetadataRequest]) (*connect.Response[v1.AdminParseSAMLMetadataResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("ssoready.v1.SSOReadyService.AdminParseSAMLMetadata is not implemented"))
 }
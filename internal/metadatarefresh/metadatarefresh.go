@@ -0,0 +1,191 @@
+// Package metadatarefresh keeps SAML connections in sync with their IDP
+// metadata URL.
+package metadatarefresh
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/store"
+)
+
+const (
+	// pollInterval is how often the worker checks for SAML connections that
+	// are due to be refreshed.
+	pollInterval = time.Minute
+
+	batchSize       = 100
+	fetchTimeout    = 30 * time.Second
+	maxMetadataSize = 10 << 20
+)
+
+// Worker periodically fetches the IDP metadata URL of every SAML connection
+// that has one, and updates the SAML connection's IDP redirect URL and
+// certificates to match. A new IDP entity ID is recorded for someone to accept
+// instead; see store.CompleteIDPMetadataRefresh.
+type Worker struct {
+	Store      *store.Store
+	HTTPClient *http.Client
+
+	// Interval is how long to wait between fetches of a SAML connection's IDP
+	// metadata URL.
+	Interval time.Duration
+}
+
+// Run refreshes SAML connections until ctx is canceled.
+func (w *Worker) Run(ctx context.Context) {
+	for {
+		if err := w.refreshDue(ctx); err != nil {
+			slog.ErrorContext(ctx, "idp_metadata_refresh_error", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+func (w *Worker) refreshDue(ctx context.Context) error {
+	refreshes, err := w.Store.ListIDPMetadataRefreshes(ctx, time.Now().Add(-w.Interval), batchSize)
+	if err != nil {
+		return fmt.Errorf("list idp metadata refreshes: %w", err)
+	}
+
+	for _, refresh := range refreshes {
+		req := &store.CompleteIDPMetadataRefreshRequest{
+			Refresh: refresh,
+		}
+
+		idpMetadata, err := fetchIDPMetadata(ctx, w.HTTPClient, refresh)
+		if err != nil {
+			slog.InfoContext(ctx, "idp_metadata_refresh_fetch_error", "saml_connection_id", refresh.SAMLConnectionID, "error", err)
+			req.RefreshError = err.Error()
+		} else {
+			req.IDPMetadata = idpMetadata
+		}
+
+		req.RefreshTime = time.Now()
+		if err := w.Store.CompleteIDPMetadataRefresh(ctx, req); err != nil {
+			return fmt.Errorf("complete idp metadata refresh: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// fetchIDPMetadata fetches refresh's IDP metadata URL, and returns the
+// configuration the SAML connection should have according to it.
+func fetchIDPMetadata(ctx context.Context, client *http.Client, refresh store.IDPMetadataRefresh) (*store.IDPMetadata, error) {
+	// SAML connections configured before https was required may still have an
+	// http metadata URL; certificates fetched over it can't be trusted
+	if u, err := url.Parse(refresh.IDPMetadataURL); err != nil || u.Scheme != "https" {
+		return nil, fmt.Errorf("idp metadata url must be an https url")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, refresh.IDPMetadataURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRes, err := client.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch idp metadata: unexpected status %d", httpRes.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(httpRes.Body, maxMetadataSize))
+	if err != nil {
+		return nil, fmt.Errorf("fetch idp metadata: %w", err)
+	}
+
+	metadataRes, err := parseIDPMetadata(body, refresh.IDPMetadata.EntityID)
+	if err != nil {
+		return nil, err
+	}
+
+	redirectURL, ok := ssoLocation(metadataRes.SingleSignOnServices, refresh.IDPBinding)
+	if !ok {
+		return nil, fmt.Errorf("idp metadata has no single sign-on service for binding %s", refresh.IDPBinding)
+	}
+
+	// while an IDP is rotating certificates, its metadata lists both the old
+	// and the new one, and it may sign with either. Keep the current
+	// certificate as long as the IDP publishes it, and trust the others as
+	// additional certificates.
+	cert := metadataRes.IDPCertificate.Raw
+	for _, c := range metadataRes.IDPCertificates {
+		if bytes.Equal(c.Raw, refresh.IDPMetadata.X509Certificate) {
+			cert = c.Raw
+		}
+	}
+
+	var additionalCerts [][]byte
+	for _, c := range metadataRes.IDPCertificates {
+		if !bytes.Equal(c.Raw, cert) {
+			additionalCerts = append(additionalCerts, c.Raw)
+		}
+	}
+
+	return &store.IDPMetadata{
+		EntityID:                   metadataRes.IDPEntityID,
+		RedirectURL:                redirectURL,
+		X509Certificate:            cert,
+		AdditionalX509Certificates: additionalCerts,
+	}, nil
+}
+
+// parseIDPMetadata parses metadata, preferring the IDP with entityID. If the
+// metadata does not describe entityID, but does describe exactly one IDP, then
+// the IDP has changed its entity ID, and that IDP is used.
+func parseIDPMetadata(metadata []byte, entityID string) (*saml.ParseMetadataResponse, error) {
+	metadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata,
+		EntityID: entityID,
+		Now:      time.Now(),
+	})
+	if err == nil || entityID == "" || !errors.Is(err, saml.ErrNoIDPEntity) {
+		return metadataRes, err
+	}
+
+	metadataRes, err = saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata,
+		Now:      time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, w := range metadataRes.Warnings {
+		if w.Code == saml.MetadataWarningMultipleEntities {
+			return nil, fmt.Errorf("idp metadata describes several identity providers, none of which are %s", entityID)
+		}
+	}
+
+	return metadataRes, nil
+}
+
+func ssoLocation(endpoints []saml.Endpoint, binding string) (string, bool) {
+	for _, e := range endpoints {
+		if e.Binding == binding {
+			return e.Location, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,235 @@
+package metadatarefresh
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/samltest"
+	"github.com/ssoready/ssoready/internal/store"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchIDPMetadata(t *testing.T) {
+	oldCert := testCertificate(t, "okta")
+	newCert := testCertificate(t, "google")
+
+	oldMetadata := testMetadata("https://idp.example.com", "https://idp.example.com/sso", oldCert)
+	current := store.IDPMetadata{
+		EntityID:        "https://idp.example.com",
+		RedirectURL:     "https://idp.example.com/sso",
+		X509Certificate: oldCert,
+	}
+
+	testCases := []struct {
+		name     string
+		metadata string
+		binding  string
+		current  *store.IDPMetadata
+		out      *store.IDPMetadata
+		err      string
+	}{
+		{
+			name:     "unchanged",
+			metadata: oldMetadata,
+			binding:  saml.BindingHTTPPost,
+			out:      &current,
+		},
+		{
+			name:     "new redirect url and certificate",
+			metadata: testMetadata("https://idp.example.com", "https://idp.example.com/sso2", newCert),
+			binding:  saml.BindingHTTPPost,
+			out: &store.IDPMetadata{
+				EntityID:        "https://idp.example.com",
+				RedirectURL:     "https://idp.example.com/sso2",
+				X509Certificate: newCert,
+			},
+		},
+		{
+			name:     "certificate rotation in progress",
+			metadata: testMetadata("https://idp.example.com", "https://idp.example.com/sso", newCert, oldCert),
+			binding:  saml.BindingHTTPPost,
+			out: &store.IDPMetadata{
+				EntityID:                   "https://idp.example.com",
+				RedirectURL:                "https://idp.example.com/sso",
+				X509Certificate:            oldCert,
+				AdditionalX509Certificates: [][]byte{newCert},
+			},
+		},
+		{
+			name:     "certificate rotation of a new connection",
+			metadata: testMetadata("https://idp.example.com", "https://idp.example.com/sso", newCert, oldCert),
+			binding:  saml.BindingHTTPPost,
+			current:  &store.IDPMetadata{},
+			out: &store.IDPMetadata{
+				EntityID:                   "https://idp.example.com",
+				RedirectURL:                "https://idp.example.com/sso",
+				X509Certificate:            newCert,
+				AdditionalX509Certificates: [][]byte{oldCert},
+			},
+		},
+		{
+			name:     "new entity id",
+			metadata: testMetadata("https://idp2.example.com", "https://idp.example.com/sso", oldCert),
+			binding:  saml.BindingHTTPPost,
+			out: &store.IDPMetadata{
+				EntityID:        "https://idp2.example.com",
+				RedirectURL:     "https://idp.example.com/sso",
+				X509Certificate: oldCert,
+			},
+		},
+		{
+			name:     "http-redirect binding",
+			metadata: oldMetadata,
+			binding:  saml.BindingHTTPRedirect,
+			out: &store.IDPMetadata{
+				EntityID:        "https://idp.example.com",
+				RedirectURL:     "https://idp.example.com/sso/redirect",
+				X509Certificate: oldCert,
+			},
+		},
+		{
+			name:     "unsupported binding",
+			metadata: oldMetadata,
+			binding:  "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact",
+			err:      "idp metadata has no single sign-on service for binding urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact",
+		},
+		{
+			name:     "malformed metadata",
+			metadata: "not xml",
+			binding:  saml.BindingHTTPPost,
+			err:      saml.ErrMalformedMetadata.Error(),
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(tt.metadata))
+			}))
+			defer server.Close()
+
+			refresh := store.IDPMetadataRefresh{
+				SAMLConnectionID: "saml_conn_test",
+				IDPMetadataURL:   server.URL,
+				IDPBinding:       tt.binding,
+				IDPMetadata:      current,
+			}
+			if tt.current != nil {
+				refresh.IDPMetadata = *tt.current
+			}
+
+			out, err := fetchIDPMetadata(context.Background(), server.Client(), refresh)
+
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.out, out)
+		})
+	}
+}
+
+func TestFetchIDPMetadata_CertificateRotation(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	// the IDP publishes both certificates, and has already started signing
+	// with the new one
+	oldIDP := samltest.NewIDP(t)
+	newIDP := samltest.NewIDP(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(testMetadata(newIDP.EntityID, newIDP.SSOURL, newIDP.Certificate.Raw, oldIDP.Certificate.Raw)))
+	}))
+	defer server.Close()
+
+	out, err := fetchIDPMetadata(context.Background(), server.Client(), store.IDPMetadataRefresh{
+		SAMLConnectionID: "saml_conn_test",
+		IDPMetadataURL:   server.URL,
+		IDPBinding:       saml.BindingHTTPPost,
+		IDPMetadata: store.IDPMetadata{
+			EntityID:        oldIDP.EntityID,
+			RedirectURL:     oldIDP.SSOURL,
+			X509Certificate: oldIDP.Certificate.Raw,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, oldIDP.Certificate.Raw, out.X509Certificate)
+
+	var idpCertificates []saml.IDPCertificate
+	for _, raw := range append([][]byte{out.X509Certificate}, out.AdditionalX509Certificates...) {
+		cert, err := x509.ParseCertificate(raw)
+		require.NoError(t, err)
+		idpCertificates = append(idpCertificates, saml.IDPCertificate{Certificate: cert})
+	}
+
+	res := newIDP.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+	validateRes, err := saml.Validate(&saml.ValidateRequest{
+		SAMLResponse:    newIDP.SAMLResponse(t, res),
+		IDPCertificates: idpCertificates,
+		IDPEntityID:     out.EntityID,
+		SPEntityID:      "http://sp.example.com",
+		SPACSURL:        "http://sp.example.com/acs",
+		Now:             now,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, newIDP.Certificate, validateRes.IDPCertificate)
+}
+
+func TestFetchIDPMetadata_BadStatus(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	_, err := fetchIDPMetadata(context.Background(), server.Client(), store.IDPMetadataRefresh{
+		IDPMetadataURL: server.URL,
+		IDPBinding:     saml.BindingHTTPPost,
+	})
+	assert.EqualError(t, err, "fetch idp metadata: unexpected status 404")
+}
+
+func TestFetchIDPMetadata_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("idp metadata fetched over http")
+	}))
+	defer server.Close()
+
+	_, err := fetchIDPMetadata(context.Background(), server.Client(), store.IDPMetadataRefresh{
+		IDPMetadataURL: server.URL,
+		IDPBinding:     saml.BindingHTTPPost,
+	})
+	assert.EqualError(t, err, "idp metadata url must be an https url")
+}
+
+// testCertificate borrows the DER-encoded certificate of one of the saml
+// package's test IDPs.
+func testCertificate(t *testing.T, name string) []byte {
+	metadata, err := os.ReadFile("../saml/testdata/assertions/" + name + "/metadata.xml")
+	require.NoError(t, err)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata})
+	require.NoError(t, err)
+
+	return res.IDPCertificate.Raw
+}
+
+func testMetadata(entityID, redirectURL string, certs ...[]byte) string {
+	var keyDescriptors strings.Builder
+	for _, cert := range certs {
+		fmt.Fprintf(&keyDescriptors, `<KeyDescriptor use="signing"><KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#"><X509Data><X509Certificate>%s</X509Certificate></X509Data></KeyInfo></KeyDescriptor>`, base64.StdEncoding.EncodeToString(cert))
+	}
+
+	return fmt.Sprintf(`<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="%s"><IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">%s<SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="%s"/><SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="%s/redirect"/></IDPSSODescriptor></EntityDescriptor>`, entityID, keyDescriptors.String(), redirectURL, redirectURL)
+}
@@ -0,0 +1,403 @@
+package saml
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/saml/samltypes"
+)
+
+// Bindings involved in receiving assertions by artifact: the IDP sends the
+// artifact to the ACS URL with BindingHTTPArtifact, and SSOReady resolves it
+// into a Response at the IDP's artifact resolution service with BindingSOAP.
+const (
+	BindingHTTPArtifact = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact"
+	BindingSOAP         = "urn:oasis:names:tc:SAML:2.0:bindings:SOAP"
+)
+
+var (
+	ErrMalformedArtifact  = errors.New("saml: malformed artifact")
+	ErrArtifactResolution = errors.New("saml: artifact resolution failed")
+)
+
+// artifactTypeCode is the type of artifact SAML 2.0 defines, and the only one
+// IDPs issue.
+const artifactTypeCode = 0x0004
+
+// maxArtifactResponseSize is the largest ArtifactResponse ResolveArtifact
+// reads. It is generous, because users with many groups make for large
+// assertions.
+const maxArtifactResponseSize = 10 << 20
+
+// ArtifactResolutionTimeout is how long ResolveArtifact's default HTTP client
+// waits for the IDP. Anyone can send an artifact to an ACS URL, so resolving
+// one must not tie up the server for long.
+const ArtifactResolutionTimeout = 10 * time.Second
+
+// NewArtifactResolutionHTTPClient returns an HTTP client suitable for
+// resolving artifacts. It times out after ArtifactResolutionTimeout, and does
+// not follow redirects; the IDP's artifact resolution URL is configured
+// explicitly, and requests to it should not be sent elsewhere.
+func NewArtifactResolutionHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: ArtifactResolutionTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+const soapEnvelopeNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+
+// Artifact is a parsed type 0x0004 SAML artifact.
+type Artifact struct {
+	// EndpointIndex is the index of the IDP's artifact resolution service
+	// that can resolve the artifact.
+	EndpointIndex uint16
+
+	// SourceID identifies the IDP. IDPs conventionally use the SHA-1 hash of
+	// their entity ID.
+	SourceID [20]byte
+
+	// MessageHandle identifies the Response the artifact stands for.
+	MessageHandle [20]byte
+}
+
+// ParseArtifact parses the base64-encoded SAMLart parameter of an
+// HTTP-Artifact binding message.
+func ParseArtifact(s string) (*Artifact, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMalformedArtifact, err)
+	}
+
+	if len(data) != 44 {
+		return nil, fmt.Errorf("%w: artifact is %d bytes, not 44", ErrMalformedArtifact, len(data))
+	}
+
+	if typeCode := binary.BigEndian.Uint16(data[0:2]); typeCode != artifactTypeCode {
+		return nil, fmt.Errorf("%w: unsupported artifact type code %#04x", ErrMalformedArtifact, typeCode)
+	}
+
+	var artifact Artifact
+	artifact.EndpointIndex = binary.BigEndian.Uint16(data[2:4])
+	copy(artifact.SourceID[:], data[4:24])
+	copy(artifact.MessageHandle[:], data[24:44])
+	return &artifact, nil
+}
+
+type ResolveArtifactRequest struct {
+	// HTTPClient is used to call the IDP. If nil, a client from
+	// NewArtifactResolutionHTTPClient is used.
+	HTTPClient *http.Client
+
+	// ArtifactResolutionURL is the IDP's artifact resolution service for
+	// BindingSOAP.
+	ArtifactResolutionURL string
+
+	// Artifact is the SAMLart parameter the IDP sent.
+	Artifact string
+
+	RequestID   string
+	SPEntityID  string
+	IDPEntityID string
+	Now         time.Time
+
+	// SigningKey, if set, is used to sign the ArtifactResolve, which some IDPs
+	// require to authenticate the SP. SigningCertificate, if set, is included
+	// in the signature's KeyInfo.
+	SigningKey         *rsa.PrivateKey
+	SigningCertificate *x509.Certificate
+}
+
+type ResolveArtifactResponse struct {
+	// SAMLResponse is the Response the artifact stands for, base64-encoded as
+	// it would be with BindingHTTPPost. It is suitable for
+	// ValidateRequest.SAMLResponse, and is no more trusted than a Response
+	// received any other way.
+	SAMLResponse string
+
+	// ArtifactResolve is the message sent to the IDP, for logging.
+	ArtifactResolve string
+}
+
+// ResolveArtifact exchanges an artifact for the Response it stands for, using
+// the SOAP binding.
+func ResolveArtifact(ctx context.Context, req *ResolveArtifactRequest) (*ResolveArtifactResponse, error) {
+	if _, err := ParseArtifact(req.Artifact); err != nil {
+		return nil, err
+	}
+
+	var artifactResolve samlArtifactResolve
+	artifactResolve.ID = req.RequestID
+	artifactResolve.Version = "2.0"
+	artifactResolve.IssueInstant = req.Now.UTC().Truncate(time.Millisecond)
+	artifactResolve.Destination = req.ArtifactResolutionURL
+	artifactResolve.Issuer.Name = req.SPEntityID
+	artifactResolve.Artifact = req.Artifact
+
+	artifactResolveData, err := xml.Marshal(artifactResolve)
+	if err != nil {
+		panic(fmt.Errorf("marshal ArtifactResolve: %w", err))
+	}
+
+	if req.SigningKey != nil {
+		signature, err := dsig.Sign(&dsig.SignRequest{
+			Key:         req.SigningKey,
+			Certificate: req.SigningCertificate,
+			Data:        artifactResolveData,
+		})
+		if err != nil {
+			panic(fmt.Errorf("sign ArtifactResolve: %w", err))
+		}
+
+		// as with AuthnRequest, the schema requires the enveloped signature
+		// to immediately follow Issuer
+		artifactResolve.Signature = signature
+		artifactResolveData, err = xml.Marshal(artifactResolve)
+		if err != nil {
+			panic(fmt.Errorf("marshal ArtifactResolve: %w", err))
+		}
+	}
+
+	var envelope bytes.Buffer
+	envelope.WriteString(`<soap:Envelope xmlns:soap="` + soapEnvelopeNamespace + `"><soap:Body>`)
+	envelope.Write(artifactResolveData)
+	envelope.WriteString(`</soap:Body></soap:Envelope>`)
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.ArtifactResolutionURL, &envelope)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrArtifactResolution, err)
+	}
+	httpReq.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	httpReq.Header.Set("SOAPAction", "http://www.oasis-open.org/committees/security")
+
+	client := req.HTTPClient
+	if client == nil {
+		client = NewArtifactResolutionHTTPClient()
+	}
+
+	httpRes, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrArtifactResolution, err)
+	}
+	defer httpRes.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(httpRes.Body, maxArtifactResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrArtifactResolution, err)
+	}
+
+	var soapRes samltypes.ArtifactResponseEnvelope
+	if err := xml.Unmarshal(body, &soapRes); err != nil {
+		return nil, fmt.Errorf("%w: idp responded with http status %d and a malformed soap message: %s", ErrArtifactResolution, httpRes.StatusCode, err)
+	}
+
+	// SOAP faults are sent with a non-200 status, so check for them first to
+	// give a more useful error
+	if fault := soapRes.Body.Fault; fault != nil {
+		return nil, fmt.Errorf("%w: idp responded with soap fault: %s: %s", ErrArtifactResolution, fault.FaultCode, fault.FaultString)
+	}
+
+	if httpRes.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w: idp responded with http status %d", ErrArtifactResolution, httpRes.StatusCode)
+	}
+
+	artifactResponse := soapRes.Body.ArtifactResponse
+	if artifactResponse == nil {
+		return nil, fmt.Errorf("%w: idp response has no ArtifactResponse", ErrArtifactResolution)
+	}
+
+	if artifactResponse.InResponseTo != req.RequestID {
+		return nil, fmt.Errorf("%w: ArtifactResponse is in response to %q, not %q", ErrArtifactResolution, artifactResponse.InResponseTo, req.RequestID)
+	}
+
+	if artifactResponse.Issuer != "" && artifactResponse.Issuer != req.IDPEntityID {
+		return nil, fmt.Errorf("%w: ArtifactResponse issued by %q, not %q", ErrArtifactResolution, artifactResponse.Issuer, req.IDPEntityID)
+	}
+
+	if statusCode := artifactResponse.Status.StatusCode.Value; statusCode != statusCodeSuccess {
+		return nil, fmt.Errorf("%w: ArtifactResponse has status %s", ErrArtifactResolution, statusCode)
+	}
+
+	response, err := extractArtifactResponseResponse(body)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrArtifactResolution, err)
+	}
+
+	// the IDP does not recognize the artifact, e.g. because it was already
+	// resolved or has expired
+	if response == nil {
+		return nil, fmt.Errorf("%w: idp did not recognize the artifact", ErrArtifactResolution)
+	}
+
+	return &ResolveArtifactResponse{
+		SAMLResponse:    base64.StdEncoding.EncodeToString(response),
+		ArtifactResolve: envelope.String(),
+	}, nil
+}
+
+// artifactResponseResponsePath is where the Response sits in a SOAP
+// ArtifactResponse.
+var artifactResponseResponsePath = []xml.Name{
+	{Space: soapEnvelopeNamespace, Local: "Envelope"},
+	{Space: soapEnvelopeNamespace, Local: "Body"},
+	{Space: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "ArtifactResponse"},
+	{Space: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
+}
+
+// extractArtifactResponseResponse returns the Response in a SOAP
+// ArtifactResponse as a standalone document. It returns nil if there is no
+// Response.
+//
+// The Response is copied byte-for-byte, so that its signatures still verify.
+// The namespaces it inherits from its ancestors are declared on it, which
+// leaves its canonical form, and so its digests, unchanged.
+func extractArtifactResponseResponse(data []byte) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		names  []xml.Name
+		scopes []map[string]string // namespace declarations on each ancestor
+		out    []byte
+	)
+
+	for {
+		offset := decoder.InputOffset()
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			names = append(names, tok.Name)
+			if !equalNames(names, artifactResponseResponsePath) {
+				scopes = append(scopes, namespaceDeclarations(tok.Attr))
+				continue
+			}
+
+			if out != nil {
+				return nil, fmt.Errorf("ArtifactResponse contains multiple responses")
+			}
+
+			if err := decoder.Skip(); err != nil {
+				return nil, err
+			}
+			names = names[:len(names)-1]
+
+			out = declareNamespaces(data[offset:decoder.InputOffset()], inheritedNamespaces(scopes, namespaceDeclarations(tok.Attr)))
+		case xml.EndElement:
+			names = names[:len(names)-1]
+			scopes = scopes[:len(scopes)-1]
+		}
+	}
+
+	return out, nil
+}
+
+func equalNames(a, b []xml.Name) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// namespaceDeclarations returns the namespaces attrs declare, keyed by prefix.
+// The default namespace has an empty prefix.
+func namespaceDeclarations(attrs []xml.Attr) map[string]string {
+	decls := map[string]string{}
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" {
+			decls[attr.Name.Local] = attr.Value
+		}
+		if attr.Name.Space == "" && attr.Name.Local == "xmlns" {
+			decls[""] = attr.Value
+		}
+	}
+	return decls
+}
+
+// inheritedNamespaces returns the namespaces declared by scopes, outermost
+// first, that an element's own declarations do not override.
+func inheritedNamespaces(scopes []map[string]string, own map[string]string) map[string]string {
+	inherited := map[string]string{}
+	for _, scope := range scopes {
+		for prefix, uri := range scope {
+			inherited[prefix] = uri
+		}
+	}
+	for prefix := range own {
+		delete(inherited, prefix)
+	}
+	return inherited
+}
+
+// declareNamespaces adds namespace declarations to the start tag of element.
+func declareNamespaces(element []byte, namespaces map[string]string) []byte {
+	var prefixes []string
+	for prefix, uri := range namespaces {
+		// an empty default namespace is already in effect in a standalone
+		// document
+		if prefix == "" && uri == "" {
+			continue
+		}
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+
+	var decls bytes.Buffer
+	for _, prefix := range prefixes {
+		if prefix == "" {
+			decls.WriteString(` xmlns="`)
+		} else {
+			decls.WriteString(` xmlns:` + prefix + `="`)
+		}
+		_ = xml.EscapeText(&decls, []byte(namespaces[prefix]))
+		decls.WriteString(`"`)
+	}
+
+	// the element's name runs from after its "<" until whitespace, "/", or
+	// ">"
+	nameEnd := bytes.IndexAny(element, " \t\r\n/>")
+
+	var out []byte
+	out = append(out, element[:nameEnd]...)
+	out = append(out, decls.Bytes()...)
+	out = append(out, element[nameEnd:]...)
+	return out
+}
+
+type samlArtifactResolve struct {
+	XMLName      xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:protocol ArtifactResolve"`
+	ID           string    `xml:"ID,attr"`
+	Version      string    `xml:"Version,attr"`
+	IssueInstant time.Time `xml:"IssueInstant,attr"`
+	Destination  string    `xml:"Destination,attr"`
+	Issuer       struct {
+		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		Name    string   `xml:",chardata"`
+	} `xml:"Issuer"`
+	Signature []byte `xml:",innerxml"`
+	Artifact  string `xml:"urn:oasis:names:tc:SAML:2.0:protocol Artifact"`
+}
@@ -0,0 +1,253 @@
+package saml_test
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/samltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseArtifact(t *testing.T) {
+	artifact := newArtifact("http://idp.example.com", 3)
+
+	res, err := saml.ParseArtifact(artifact)
+	require.NoError(t, err)
+	assert.Equal(t, uint16(3), res.EndpointIndex)
+	assert.Equal(t, sha1.Sum([]byte("http://idp.example.com")), res.SourceID)
+
+	_, err = saml.ParseArtifact("not base64!")
+	assert.ErrorIs(t, err, saml.ErrMalformedArtifact)
+
+	_, err = saml.ParseArtifact(base64.StdEncoding.EncodeToString([]byte("too short")))
+	assert.ErrorIs(t, err, saml.ErrMalformedArtifact)
+
+	data, err := base64.StdEncoding.DecodeString(artifact)
+	require.NoError(t, err)
+	binary.BigEndian.PutUint16(data[0:2], 0x0001)
+
+	_, err = saml.ParseArtifact(base64.StdEncoding.EncodeToString(data))
+	assert.ErrorIs(t, err, saml.ErrMalformedArtifact)
+}
+
+func TestResolveArtifact(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	idp := samltest.NewIDP(t)
+
+	res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+	res.Signature = &samltest.Signature{}
+	artifact := newArtifact(idp.EntityID, 0)
+
+	var gotArtifactResolve artifactResolve
+	server := httptest.NewServer(artifactResolutionHandler(t, &gotArtifactResolve, func(inResponseTo string) string {
+		// declare the Response's namespaces on the SOAP envelope instead, as
+		// IDPs commonly do; the Response must still verify once extracted
+		response := string(idp.ResponseXML(t, res))
+		response = strings.Replace(response, ` xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion"`, "", 1)
+
+		return `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion"><soap:Body>` +
+			`<samlp:ArtifactResponse ID="_artifact-response" InResponseTo="` + inResponseTo + `" Version="2.0"><saml:Issuer>` + idp.EntityID + `</saml:Issuer>` +
+			`<samlp:Status><samlp:StatusCode Value="urn:oasis:names:tc:SAML:2.0:status:Success"/></samlp:Status>` +
+			response +
+			`</samlp:ArtifactResponse></soap:Body></soap:Envelope>`
+	}))
+	defer server.Close()
+
+	resolveRes, err := saml.ResolveArtifact(context.Background(), &saml.ResolveArtifactRequest{
+		ArtifactResolutionURL: server.URL,
+		Artifact:              artifact,
+		RequestID:             "_artifact-resolve",
+		SPEntityID:            "http://sp.example.com",
+		IDPEntityID:           idp.EntityID,
+		Now:                   now,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "_artifact-resolve", gotArtifactResolve.ID)
+	assert.Equal(t, server.URL, gotArtifactResolve.Destination)
+	assert.Equal(t, "http://sp.example.com", gotArtifactResolve.Issuer)
+	assert.Equal(t, artifact, gotArtifactResolve.Artifact)
+
+	validateRes, err := saml.Validate(&saml.ValidateRequest{
+		SAMLResponse:    resolveRes.SAMLResponse,
+		IDPCertificates: idp.IDPCertificates(),
+		IDPEntityID:     idp.EntityID,
+		SPEntityID:      "http://sp.example.com",
+		SPACSURL:        "http://sp.example.com/acs",
+		Now:             now,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "jane.doe@example.com", validateRes.SubjectID)
+	assert.True(t, validateRes.ResponseSigned)
+	assert.True(t, validateRes.AssertionSigned)
+}
+
+func TestResolveArtifact_Errors(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name             string
+		artifactResponse func(inResponseTo string) string
+		err              string
+	}{
+		{
+			name: "soap fault",
+			artifactResponse: func(inResponseTo string) string {
+				return `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><soap:Fault><faultcode>soap:Client</faultcode><faultstring>untrusted requester</faultstring></soap:Fault></soap:Body></soap:Envelope>`
+			},
+			err: "saml: artifact resolution failed: idp responded with soap fault: soap:Client: untrusted requester",
+		},
+		{
+			name: "not soap",
+			artifactResponse: func(inResponseTo string) string {
+				return `<html></html>`
+			},
+			err: "saml: artifact resolution failed: idp responded with http status 200 and a malformed soap message: expected element type <Envelope> but have <html>",
+		},
+		{
+			name: "wrong in response to",
+			artifactResponse: func(inResponseTo string) string {
+				return artifactResponseEnvelope("_other", "http://idp.example.com", "urn:oasis:names:tc:SAML:2.0:status:Success", "")
+			},
+			err: `saml: artifact resolution failed: ArtifactResponse is in response to "_other", not "_artifact-resolve"`,
+		},
+		{
+			name: "wrong issuer",
+			artifactResponse: func(inResponseTo string) string {
+				return artifactResponseEnvelope(inResponseTo, "http://evil.example.com", "urn:oasis:names:tc:SAML:2.0:status:Success", "")
+			},
+			err: `saml: artifact resolution failed: ArtifactResponse issued by "http://evil.example.com", not "http://idp.example.com"`,
+		},
+		{
+			name: "failed status",
+			artifactResponse: func(inResponseTo string) string {
+				return artifactResponseEnvelope(inResponseTo, "http://idp.example.com", "urn:oasis:names:tc:SAML:2.0:status:Requester", "")
+			},
+			err: "saml: artifact resolution failed: ArtifactResponse has status urn:oasis:names:tc:SAML:2.0:status:Requester",
+		},
+		{
+			name: "unrecognized artifact",
+			artifactResponse: func(inResponseTo string) string {
+				return artifactResponseEnvelope(inResponseTo, "http://idp.example.com", "urn:oasis:names:tc:SAML:2.0:status:Success", "")
+			},
+			err: "saml: artifact resolution failed: idp did not recognize the artifact",
+		},
+		{
+			name: "multiple responses",
+			artifactResponse: func(inResponseTo string) string {
+				response := `<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol"></samlp:Response>`
+				return artifactResponseEnvelope(inResponseTo, "http://idp.example.com", "urn:oasis:names:tc:SAML:2.0:status:Success", response+response)
+			},
+			err: "saml: artifact resolution failed: ArtifactResponse contains multiple responses",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotArtifactResolve artifactResolve
+			server := httptest.NewServer(artifactResolutionHandler(t, &gotArtifactResolve, tt.artifactResponse))
+			defer server.Close()
+
+			_, err := saml.ResolveArtifact(context.Background(), &saml.ResolveArtifactRequest{
+				ArtifactResolutionURL: server.URL,
+				Artifact:              newArtifact("http://idp.example.com", 0),
+				RequestID:             "_artifact-resolve",
+				SPEntityID:            "http://sp.example.com",
+				IDPEntityID:           "http://idp.example.com",
+				Now:                   now,
+			})
+			assert.ErrorIs(t, err, saml.ErrArtifactResolution)
+			assert.EqualError(t, err, tt.err)
+		})
+	}
+}
+
+func TestResolveArtifact_DoesNotFollowRedirects(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	var gotArtifactResolve artifactResolve
+	target := httptest.NewServer(artifactResolutionHandler(t, &gotArtifactResolve, func(inResponseTo string) string {
+		return artifactResponseEnvelope(inResponseTo, "http://idp.example.com", "urn:oasis:names:tc:SAML:2.0:status:Success", "")
+	}))
+	defer target.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, target.URL, http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	_, err := saml.ResolveArtifact(context.Background(), &saml.ResolveArtifactRequest{
+		ArtifactResolutionURL: server.URL,
+		Artifact:              newArtifact("http://idp.example.com", 0),
+		RequestID:             "_artifact-resolve",
+		SPEntityID:            "http://sp.example.com",
+		IDPEntityID:           "http://idp.example.com",
+		Now:                   now,
+	})
+	assert.ErrorIs(t, err, saml.ErrArtifactResolution)
+	assert.ErrorContains(t, err, "idp responded with http status 307")
+	assert.Empty(t, gotArtifactResolve.ID)
+}
+
+// newArtifact returns a type 0x0004 artifact issued by entityID.
+func newArtifact(entityID string, endpointIndex uint16) string {
+	sourceID := sha1.Sum([]byte(entityID))
+
+	data := make([]byte, 44)
+	binary.BigEndian.PutUint16(data[0:2], 0x0004)
+	binary.BigEndian.PutUint16(data[2:4], endpointIndex)
+	copy(data[4:24], sourceID[:])
+	copy(data[24:44], "a message handle....")
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+type artifactResolve struct {
+	ID          string `xml:"ID,attr"`
+	Destination string `xml:"Destination,attr"`
+	Issuer      string `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+	Artifact    string `xml:"urn:oasis:names:tc:SAML:2.0:protocol Artifact"`
+}
+
+// artifactResolutionHandler is a stand-in for an IDP's artifact resolution
+// service. It records the ArtifactResolve it receives in got, and replies with
+// the result of artifactResponse.
+func artifactResolutionHandler(t *testing.T, got *artifactResolve, artifactResponse func(inResponseTo string) string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "http://www.oasis-open.org/committees/security", r.Header.Get("SOAPAction"))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		var envelope struct {
+			Body struct {
+				ArtifactResolve artifactResolve `xml:"urn:oasis:names:tc:SAML:2.0:protocol ArtifactResolve"`
+			} `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+		}
+		require.NoError(t, xml.Unmarshal(body, &envelope))
+		*got = envelope.Body.ArtifactResolve
+
+		w.Header().Set("Content-Type", "text/xml")
+		_, _ = w.Write([]byte(artifactResponse(got.ID)))
+	})
+}
+
+func artifactResponseEnvelope(inResponseTo, issuer, statusCode, response string) string {
+	return `<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body>` +
+		`<samlp:ArtifactResponse xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_artifact-response" InResponseTo="` + inResponseTo + `" Version="2.0">` +
+		`<saml:Issuer>` + issuer + `</saml:Issuer>` +
+		`<samlp:Status><samlp:StatusCode Value="` + statusCode + `"/></samlp:Status>` +
+		response +
+		`</samlp:ArtifactResponse></soap:Body></soap:Envelope>`
+}
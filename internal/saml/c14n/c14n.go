@@ -10,14 +10,72 @@ import (
 	"github.com/ssoready/ssoready/internal/saml/uxml/stack"
 )
 
+// Identifiers for canonicalization algorithms, as they appear in XML DSig
+// CanonicalizationMethod and Transform elements.
+const (
+	AlgorithmInclusive               = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315"
+	AlgorithmInclusiveWithComments   = "http://www.w3.org/TR/2001/REC-xml-c14n-20010315#WithComments"
+	AlgorithmInclusive11             = "http://www.w3.org/2006/12/xml-c14n11"
+	AlgorithmInclusive11WithComments = "http://www.w3.org/2006/12/xml-c14n11#WithComments"
+	AlgorithmExclusive               = "http://www.w3.org/2001/10/xml-exc-c14n#"
+	AlgorithmExclusiveWithComments   = "http://www.w3.org/2001/10/xml-exc-c14n#WithComments"
+)
+
+// Algorithm is a variant of XML canonicalization.
+//
+// Inclusive canonicalization 1.0 and 1.1 differ only in how xml:* attributes
+// are inherited from outside the canonicalized element. uxml.Node does not
+// carry its ancestors' xml:* attributes, and SAML does not use them, so the two
+// are implemented identically.
+type Algorithm struct {
+	// Exclusive is whether only visibly used namespaces are rendered, as
+	// opposed to every namespace in scope.
+	Exclusive bool
+
+	// WithComments is whether comments are rendered, as opposed to omitted.
+	WithComments bool
+}
+
+var algorithms = map[string]Algorithm{
+	AlgorithmInclusive:               {},
+	AlgorithmInclusiveWithComments:   {WithComments: true},
+	AlgorithmInclusive11:             {},
+	AlgorithmInclusive11WithComments: {WithComments: true},
+	AlgorithmExclusive:               {Exclusive: true},
+	AlgorithmExclusiveWithComments:   {Exclusive: true, WithComments: true},
+}
+
+// ParseAlgorithm returns the Algorithm identified by uri, and whether uri is a
+// supported algorithm.
+func ParseAlgorithm(uri string) (Algorithm, bool) {
+	a, ok := algorithms[uri]
+	return a, ok
+}
+
+// Canonicalize canonicalizes n using exclusive canonicalization, without
+// comments.
 func Canonicalize(n uxml.Node, inclusiveNamespaces []string) ([]byte, error) {
+	return Algorithm{Exclusive: true}.Canonicalize(n, inclusiveNamespaces)
+}
+
+// Canonicalize canonicalizes n. The namespaces in scope for n must be declared
+// on n itself. inclusiveNamespaces is the InclusiveNamespaces PrefixList of
+// exclusive canonicalization, and is ignored by inclusive canonicalization.
+func (a Algorithm) Canonicalize(n uxml.Node, inclusiveNamespaces []string) ([]byte, error) {
 	var buf bytes.Buffer
 	var knownNames, renderedNames stack.Stack
-	canonicalize(&buf, knownNames, renderedNames, n, inclusiveNamespaces)
+	a.canonicalize(&buf, knownNames, renderedNames, n, inclusiveNamespaces)
 	return buf.Bytes(), nil
 }
 
-func canonicalize(buf *bytes.Buffer, knownNames, renderedNames stack.Stack, n uxml.Node, inclusiveNamespaces []string) {
+func (a Algorithm) canonicalize(buf *bytes.Buffer, knownNames, renderedNames stack.Stack, n uxml.Node, inclusiveNamespaces []string) {
+	if n.Comment != nil {
+		if a.WithComments {
+			_, _ = fmt.Fprintf(buf, "<!--%s-->", *n.Comment)
+		}
+		return
+	}
+
 	if n.Text != nil {
 		t := []byte(*n.Text)
 		t = bytes.ReplaceAll(t, amp, escAmp)
@@ -60,7 +118,18 @@ func canonicalize(buf *bytes.Buffer, knownNames, renderedNames stack.Stack, n ux
 	namesToRender := map[string]struct{}{}
 	for name, uri := range knownNames.GetAll() {
 		var shouldRender bool
-		if name == "" && uri == "" {
+		if !a.Exclusive {
+			// Inclusive canonicalization renders every namespace in scope,
+			// unless an output ancestor already rendered it with the same
+			// value. xmlns="" is only rendered to undo an ancestor's default
+			// namespace.
+			renderedValue, rendered := renderedNames.Get(name)
+			if name == "" && uri == "" {
+				shouldRender = rendered && renderedValue != ""
+			} else {
+				shouldRender = !rendered || renderedValue != uri
+			}
+		} else if name == "" && uri == "" {
 			_, visiblyUsed := visiblyUsedNames[""]
 			declaredValue, declared := names[""]
 			_, rendered := renderedNames.Get("")
@@ -144,7 +213,7 @@ func canonicalize(buf *bytes.Buffer, knownNames, renderedNames stack.Stack, n ux
 	_, _ = fmt.Fprint(buf, ">")
 
 	for _, c := range n.Element.Children {
-		canonicalize(buf, knownNames, renderedNames, c, inclusiveNamespaces)
+		a.canonicalize(buf, knownNames, renderedNames, c, inclusiveNamespaces)
 	}
 
 	if n.Element.Name.Qual == "" {
@@ -1,13 +1,17 @@
 package c14n_test
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
+	"strings"
 	"testing"
 
 	"github.com/ssoready/ssoready/internal/saml/c14n"
 	"github.com/ssoready/ssoready/internal/saml/uxml"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func ExampleCanonicalize() {
@@ -29,24 +33,197 @@ func TestCanonicalize(t *testing.T) {
 	// Note:
 	// tests/charmods is modified from the c14n test suite. CDATA is removed, because uxml intentionally does not
 	// support CDATA. Single-quoted attributes are changed to double-quoted ones, for the same reason.
+	//
+	// tests/comments is modified from the c14n test suite. The processing instructions and DOCTYPE are removed,
+	// because uxml does not support them.
+	//
+	// out.xml is the output of exclusive canonicalization without comments. Each test case may additionally have
+	// outputs for the other algorithms; if absent, that algorithm isn't checked.
+
+	outputs := []struct {
+		name       string
+		algorithms []string
+	}{
+		{"out.xml", []string{c14n.AlgorithmExclusive}},
+		{"out_with_comments.xml", []string{c14n.AlgorithmExclusiveWithComments}},
+		{"out_inclusive.xml", []string{c14n.AlgorithmInclusive, c14n.AlgorithmInclusive11}},
+		{"out_inclusive_with_comments.xml", []string{c14n.AlgorithmInclusiveWithComments, c14n.AlgorithmInclusive11WithComments}},
+	}
 
 	entries, err := os.ReadDir("testdata")
 	assert.NoError(t, err)
 
 	for _, file := range entries {
+		// see TestCanonicalize_W3C
+		if file.Name() == "w3c" {
+			continue
+		}
+
 		t.Run(file.Name(), func(t *testing.T) {
 			in, err := os.ReadFile(fmt.Sprintf("testdata/%s/in.xml", file.Name()))
 			assert.NoError(t, err)
 
-			out, err := os.ReadFile(fmt.Sprintf("testdata/%s/out.xml", file.Name()))
-			assert.NoError(t, err)
-
 			doc, err := uxml.Parse(in)
 			assert.NoError(t, err)
 
-			actual, err := c14n.Canonicalize(doc.Root, nil)
-			assert.NoError(t, err)
-			assert.Equal(t, out, actual)
+			for _, output := range outputs {
+				out, err := os.ReadFile(fmt.Sprintf("testdata/%s/%s", file.Name(), output.name))
+				if errors.Is(err, fs.ErrNotExist) && output.name != "out.xml" {
+					continue
+				}
+				assert.NoError(t, err)
+
+				for _, uri := range output.algorithms {
+					algorithm, ok := c14n.ParseAlgorithm(uri)
+					assert.True(t, ok)
+
+					actual, err := algorithm.Canonicalize(doc.Root, nil)
+					assert.NoError(t, err)
+					assert.Equal(t, string(out), string(actual), uri)
+				}
+			}
 		})
 	}
 }
+
+func TestCanonicalize_W3C(t *testing.T) {
+	// testdata/w3c holds the examples from section 3 of the Canonical XML 1.0
+	// recommendation (https://www.w3.org/TR/2001/REC-xml-c14n-20010315#Examples),
+	// as distributed with the C14N 2.0 test cases
+	// (https://www.w3.org/TR/xml-c14n2-testcases/). in.xml and out.xml are the
+	// published input and canonical form, byte for byte; out.xml for 3.3 is
+	// taken from the 1.0 recommendation, because C14N 2.0 drops the redundant
+	// namespace declarations that C14N 1.0 keeps.
+	//
+	// uxml does not support DTDs, processing instructions, CDATA or
+	// single-quoted attributes, and Canonicalize canonicalizes an element
+	// rather than a whole document. Where an example relies on those, the
+	// changes below are made to the input and expected output before
+	// comparing; they only remove or requote markup. 3.5 is made entirely of
+	// DTD-declared entities, so uxml must reject it instead.
+	//
+	// 3.7 selects a document subset with an XPath expression, which this
+	// package does not implement; it only canonicalizes whole subtrees, as
+	// enveloped signatures require. There is no 3.8 in C14N 1.0; the one in
+	// C14N 1.1 is likewise about XPath document subsets.
+	testCases := []struct {
+		section         string
+		inReplacements  []string
+		outReplacements []string
+		documentElement bool
+		parseErr        bool
+	}{
+		{
+			section: "3.1",
+			inReplacements: []string{
+				"<?xml-stylesheet   href=\"doc.xsl\"\n   type=\"text/xsl\"   ?>", "",
+				`<!DOCTYPE doc SYSTEM "doc.dtd">`, "",
+				"<?pi-without-data     ?>", "",
+			},
+			// the PIs and comments outside the document element are part of
+			// the document's canonical form, but not the element's
+			documentElement: true,
+		},
+		{
+			section: "3.2",
+		},
+		{
+			section: "3.3",
+			inReplacements: []string{
+				"<!DOCTYPE doc [<!ATTLIST e9 attr CDATA \"default\">]>\n", "",
+			},
+			// attr="default" comes from the DTD
+			outReplacements: []string{
+				` attr="default"`, "",
+			},
+		},
+		{
+			section: "3.4",
+			inReplacements: []string{
+				"<!DOCTYPE doc [\n<!ATTLIST normId id ID #IMPLIED>\n<!ATTLIST normNames attr NMTOKENS #IMPLIED>\n]>\n", "",
+				"   <compute><![CDATA[value>\"0\" && value<\"10\" ?\"valid\":\"error\"]]></compute>\n", "",
+				`expr='value>"0" &amp;&amp; value&lt;"10" ?"valid":"error"'`, `expr="value>&quot;0&quot; &amp;&amp; value&lt;&quot;10&quot; ?&quot;valid&quot;:&quot;error&quot;"`,
+				`attr=' &apos;   &#x20;&#13;&#xa;&#9;   &apos; '`, `attr=" &apos;   &#x20;&#13;&#xa;&#9;   &apos; "`,
+				// normNames and normId are normalized according to their
+				// DTD-declared types
+				"   <normNames attr='   A   &#x20;&#13;&#xa;&#9;   B   '/>\n", "",
+				"   <normId id=' &apos;&#x20;&#13;&#xa;&#9; &apos; '/>\n", "",
+			},
+			outReplacements: []string{
+				"   <compute>value&gt;\"0\" &amp;&amp; value&lt;\"10\" ?\"valid\":\"error\"</compute>\n", "",
+				"   <normNames attr=\"A &#xD;&#xA;&#x9; B\"></normNames>\n", "",
+				"   <normId id=\"' &#xD;&#xA;&#x9; '\"></normId>\n", "",
+			},
+		},
+		{
+			section:  "3.5",
+			parseErr: true,
+		},
+		{
+			section: "3.6",
+		},
+	}
+
+	outputs := []struct {
+		name       string
+		algorithms []string
+	}{
+		{"out.xml", []string{c14n.AlgorithmInclusive, c14n.AlgorithmInclusive11}},
+		{"out_with_comments.xml", []string{c14n.AlgorithmInclusiveWithComments, c14n.AlgorithmInclusive11WithComments}},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.section, func(t *testing.T) {
+			in, err := os.ReadFile(fmt.Sprintf("testdata/w3c/%s/in.xml", tt.section))
+			require.NoError(t, err)
+
+			doc, err := uxml.Parse([]byte(replaceAll(t, string(in), tt.inReplacements)))
+			if tt.parseErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, output := range outputs {
+				out, err := os.ReadFile(fmt.Sprintf("testdata/w3c/%s/%s", tt.section, output.name))
+				if errors.Is(err, fs.ErrNotExist) && output.name != "out.xml" {
+					continue
+				}
+				require.NoError(t, err)
+
+				expected := replaceAll(t, string(out), tt.outReplacements)
+				if tt.documentElement {
+					expected = expected[strings.Index(expected, "<doc>") : strings.Index(expected, "</doc>")+len("</doc>")]
+				}
+
+				for _, uri := range output.algorithms {
+					algorithm, ok := c14n.ParseAlgorithm(uri)
+					require.True(t, ok)
+
+					actual, err := algorithm.Canonicalize(doc.Root, nil)
+					require.NoError(t, err)
+					assert.Equal(t, expected, string(actual), uri)
+				}
+			}
+		})
+	}
+}
+
+// replaceAll applies each old, new pair in replacements to s, failing the test
+// if an old string doesn't occur in s.
+func replaceAll(t *testing.T, s string, replacements []string) string {
+	for i := 0; i < len(replacements); i += 2 {
+		require.Contains(t, s, replacements[i])
+		s = strings.ReplaceAll(s, replacements[i], replacements[i+1])
+	}
+	return s
+}
+
+func TestParseAlgorithm(t *testing.T) {
+	_, ok := c14n.ParseAlgorithm("http://www.w3.org/2000/09/xmldsig#enveloped-signature")
+	assert.False(t, ok)
+
+	algorithm, ok := c14n.ParseAlgorithm(c14n.AlgorithmInclusive11WithComments)
+	assert.True(t, ok)
+	assert.Equal(t, c14n.Algorithm{Exclusive: false, WithComments: true}, algorithm)
+}
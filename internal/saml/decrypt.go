@@ -0,0 +1,79 @@
+package saml
+
+import (
+	"bytes"
+	"crypto/rsa"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ssoready/ssoready/internal/saml/samltypes"
+	"github.com/ssoready/ssoready/internal/saml/xmlenc"
+)
+
+var encryptedAssertionName = xml.Name{Space: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "EncryptedAssertion"}
+
+// decryptAssertion returns data with the EncryptedAssertion in its root
+// element replaced by the decrypted Assertion. It returns nil if data does not
+// contain an EncryptedAssertion.
+func decryptAssertion(data []byte, keys []*rsa.PrivateKey) ([]byte, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	var (
+		depth     int
+		found     bool
+		start     int64
+		end       int64
+		plainText []byte
+	)
+
+	for {
+		offset := decoder.InputOffset()
+		tok, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			if depth != 1 || tok.Name != encryptedAssertionName {
+				depth++
+				continue
+			}
+
+			if found {
+				return nil, fmt.Errorf("saml response contains multiple encrypted assertions")
+			}
+
+			var encryptedAssertion samltypes.EncryptedAssertion
+			if err := decoder.DecodeElement(&encryptedAssertion, &tok); err != nil {
+				return nil, err
+			}
+
+			plainText, err = xmlenc.Decrypt(keys, &encryptedAssertion.EncryptedData, encryptedAssertion.EncryptedKeys)
+			if err != nil {
+				return nil, err
+			}
+
+			found = true
+			start = offset
+			end = decoder.InputOffset()
+		case xml.EndElement:
+			depth--
+		}
+	}
+
+	if !found {
+		return nil, nil
+	}
+
+	var out []byte
+	out = append(out, data[:start]...)
+	out = append(out, plainText...)
+	out = append(out, data[end:]...)
+	return out, nil
+}
@@ -0,0 +1,164 @@
+package dsig
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"slices"
+
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// Signature algorithm identifiers, as they appear in SignatureMethod.
+const (
+	SignatureAlgorithmRSASHA1     = "http://www.w3.org/2000/09/xmldsig#rsa-sha1"
+	SignatureAlgorithmRSASHA256   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256"
+	SignatureAlgorithmRSASHA384   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha384"
+	SignatureAlgorithmRSASHA512   = "http://www.w3.org/2001/04/xmldsig-more#rsa-sha512"
+	SignatureAlgorithmECDSASHA256 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha256"
+	SignatureAlgorithmECDSASHA384 = "http://www.w3.org/2001/04/xmldsig-more#ecdsa-sha384"
+)
+
+// Digest algorithm identifiers, as they appear in DigestMethod.
+const (
+	DigestAlgorithmSHA1   = "http://www.w3.org/2000/09/xmldsig#sha1"
+	DigestAlgorithmSHA256 = "http://www.w3.org/2001/04/xmlenc#sha256"
+	DigestAlgorithmSHA384 = "http://www.w3.org/2001/04/xmldsig-more#sha384"
+	DigestAlgorithmSHA512 = "http://www.w3.org/2001/04/xmlenc#sha512"
+)
+
+// DefaultSignatureAlgorithms are the signature algorithms allowed when a
+// VerifyRequest does not specify any. SHA-1 is deliberately excluded.
+var DefaultSignatureAlgorithms = []string{
+	SignatureAlgorithmRSASHA256,
+	SignatureAlgorithmRSASHA384,
+	SignatureAlgorithmRSASHA512,
+	SignatureAlgorithmECDSASHA256,
+	SignatureAlgorithmECDSASHA384,
+}
+
+// DefaultDigestAlgorithms are the digest algorithms allowed when a
+// VerifyRequest does not specify any. SHA-1 is deliberately excluded.
+var DefaultDigestAlgorithms = []string{
+	DigestAlgorithmSHA256,
+	DigestAlgorithmSHA384,
+	DigestAlgorithmSHA512,
+}
+
+type signatureAlgorithm struct {
+	hash  crypto.Hash
+	ecdsa bool
+	curve elliptic.Curve // only for ecdsa
+}
+
+var signatureAlgorithms = map[string]signatureAlgorithm{
+	SignatureAlgorithmRSASHA1:     {hash: crypto.SHA1},
+	SignatureAlgorithmRSASHA256:   {hash: crypto.SHA256},
+	SignatureAlgorithmRSASHA384:   {hash: crypto.SHA384},
+	SignatureAlgorithmRSASHA512:   {hash: crypto.SHA512},
+	SignatureAlgorithmECDSASHA256: {hash: crypto.SHA256, ecdsa: true, curve: elliptic.P256()},
+	SignatureAlgorithmECDSASHA384: {hash: crypto.SHA384, ecdsa: true, curve: elliptic.P384()},
+}
+
+var digestAlgorithms = map[string]crypto.Hash{
+	DigestAlgorithmSHA1:   crypto.SHA1,
+	DigestAlgorithmSHA256: crypto.SHA256,
+	DigestAlgorithmSHA384: crypto.SHA384,
+	DigestAlgorithmSHA512: crypto.SHA512,
+}
+
+func allowedSignatureAlgorithm(allowed []string, algorithm string) (signatureAlgorithm, bool) {
+	if len(allowed) == 0 {
+		allowed = DefaultSignatureAlgorithms
+	}
+	if !slices.Contains(allowed, algorithm) {
+		return signatureAlgorithm{}, false
+	}
+	alg, ok := signatureAlgorithms[algorithm]
+	return alg, ok
+}
+
+func allowedDigestAlgorithm(allowed []string, algorithm string) (crypto.Hash, bool) {
+	if len(allowed) == 0 {
+		allowed = DefaultDigestAlgorithms
+	}
+	if !slices.Contains(allowed, algorithm) {
+		return 0, false
+	}
+	hash, ok := digestAlgorithms[algorithm]
+	return hash, ok
+}
+
+func (a signatureAlgorithm) verify(cert *x509.Certificate, data, signature []byte) error {
+	h := a.hash.New()
+	h.Write(data)
+	hashed := h.Sum(nil)
+
+	if !a.ecdsa {
+		publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+		if !ok {
+			return ErrNoRSAPublicKey
+		}
+
+		return rsa.VerifyPKCS1v15(publicKey, a.hash, hashed, signature)
+	}
+
+	publicKey, ok := cert.PublicKey.(*ecdsa.PublicKey)
+	if !ok || publicKey.Curve != a.curve {
+		return ErrNoECDSAPublicKey
+	}
+
+	// XML DSig encodes ECDSA signatures as the concatenation of r and s, each
+	// padded to the size of the curve, rather than as ASN.1
+	size := (a.curve.Params().BitSize + 7) / 8
+	if len(signature) != 2*size {
+		return fmt.Errorf("dsig: bad ecdsa signature length: %d", len(signature))
+	}
+
+	r := new(big.Int).SetBytes(signature[:size])
+	s := new(big.Int).SetBytes(signature[size:])
+	if !ecdsa.Verify(publicKey, hashed, r, s) {
+		return fmt.Errorf("dsig: ecdsa signature verification failed")
+	}
+
+	return nil
+}
+
+func (a signatureAlgorithm) sign(key crypto.Signer, data []byte) ([]byte, error) {
+	h := a.hash.New()
+	h.Write(data)
+	hashed := h.Sum(nil)
+
+	if !a.ecdsa {
+		privateKey, ok := key.(*rsa.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("dsig: key is not an *rsa.PrivateKey")
+		}
+
+		return rsa.SignPKCS1v15(rand.Reader, privateKey, a.hash, hashed)
+	}
+
+	privateKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok || privateKey.Curve != a.curve {
+		return nil, fmt.Errorf("dsig: key is not an *ecdsa.PrivateKey on the curve the signature algorithm requires")
+	}
+
+	r, s, err := ecdsa.Sign(rand.Reader, privateKey, hashed)
+	if err != nil {
+		return nil, err
+	}
+
+	// the inverse of the r and s concatenation undone in verify
+	size := (a.curve.Params().BitSize + 7) / 8
+	signature := make([]byte, 2*size)
+	r.FillBytes(signature[:size])
+	s.FillBytes(signature[size:])
+	return signature, nil
+}
@@ -2,11 +2,9 @@ package dsig
 
 import (
 	"bytes"
-	"crypto"
-	"crypto/rsa"
-	"crypto/sha256"
 	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -15,9 +13,13 @@ import (
 )
 
 var (
-	ErrUnsigned       = fmt.Errorf("dsig: unsigned saml assertion")
-	ErrNoRSAPublicKey = fmt.Errorf("dsig: cert does not contain *rsa.PublicKey")
-	ErrBadDigest      = fmt.Errorf("dsig: digest mismatch in saml assertion")
+	ErrUnsigned         = fmt.Errorf("dsig: unsigned saml assertion")
+	ErrNoRSAPublicKey   = fmt.Errorf("dsig: cert does not contain *rsa.PublicKey")
+	ErrNoECDSAPublicKey = fmt.Errorf("dsig: cert does not contain *ecdsa.PublicKey on the curve the signature algorithm requires")
+	ErrBadDigest        = fmt.Errorf("dsig: digest mismatch in saml assertion")
+	ErrBadReference     = fmt.Errorf("dsig: signature reference does not match signed element")
+	ErrNoAssertion      = fmt.Errorf("dsig: saml response does not contain exactly one assertion")
+	ErrDuplicateID      = fmt.Errorf("dsig: saml response contains duplicate IDs")
 )
 
 type BadSignatureAlgorithmError struct {
@@ -44,188 +46,553 @@ func (e BadCertificateError) Error() string {
 	return fmt.Sprintf("dsig: bad certificate on response")
 }
 
-func Verify(cert *x509.Certificate, data []byte) ([]byte, error) {
-	unverifiedDoc, err := uxml.Parse(data)
-	if err != nil {
-		return nil, err
+type UnsupportedTransformError struct {
+	Algorithm string
+}
+
+func (e UnsupportedTransformError) Error() string {
+	return fmt.Sprintf("dsig: unsupported transform or canonicalization algorithm: %s", e.Algorithm)
+}
+
+type VerifyRequest struct {
+	// Certificates are the certificates the IDP may sign with. A signature is
+	// accepted if its KeyInfo carries any of them.
+	Certificates []*x509.Certificate
+	Data         []byte
+
+	// DecryptedData, if set, is Data with its EncryptedAssertion replaced by
+	// the decrypted Assertion. The Response signature is verified against Data,
+	// because it covers the assertion as transmitted; the Assertion signature
+	// is verified against DecryptedData.
+	DecryptedData []byte
+
+	// AllowedSignatureAlgorithms and AllowedDigestAlgorithms restrict the
+	// algorithms the signatures may use. If empty, DefaultSignatureAlgorithms
+	// and DefaultDigestAlgorithms are used respectively.
+	AllowedSignatureAlgorithms []string
+	AllowedDigestAlgorithms    []string
+}
+
+// VerifyResponse describes which parts of a SAML response carried a valid
+// signature.
+type VerifyResponse struct {
+	// Assertion is the canonicalized Assertion, as covered by at least one of
+	// the verified signatures.
+	Assertion []byte
+
+	ResponseSigned  bool
+	AssertionSigned bool
+
+	// Certificate is the certificate, from the request's Certificates, that
+	// verified the Assertion signature, or the Response signature if the
+	// Assertion is unsigned.
+	Certificate *x509.Certificate
+}
+
+var (
+	pathResponse = path{
+		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
 	}
 
-	signatureValue, _ := onlyPathHoistNames(path{
+	pathAssertion = path{
 		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
 		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignatureValue"},
-	}, unverifiedDoc.Root)
+	}
+)
 
-	if signatureValue.Element == nil || signatureValue.Element.Children[0].Text == nil {
-		return nil, ErrUnsigned
+// errNoSignature indicates that an element does not have a Signature child at
+// all. It is distinct from ErrUnsigned, which is returned if neither the
+// Response nor the Assertion are signed, or if a Signature is missing its key
+// info.
+var errNoSignature = fmt.Errorf("dsig: no signature on element")
+
+func Verify(req *VerifyRequest) (*VerifyResponse, error) {
+	unverifiedDoc, err := uxml.Parse(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	unverifiedAssertionDoc := unverifiedDoc
+	if req.DecryptedData != nil {
+		unverifiedAssertionDoc, err = uxml.Parse(req.DecryptedData)
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	signatureBase64 := *signatureValue.Element.Children[0].Text
+	// Signature wrapping attacks move the signed assertion elsewhere in the
+	// document and put an unsigned one in its place, or give an unsigned
+	// element the signed one's ID. Refuse any document where an ID or the
+	// assertion is ambiguous, rather than trying to pick the right one.
+	ids, err := elementsByID(unverifiedDoc.Root)
+	if err != nil {
+		return nil, err
+	}
 
-	signatureMethod, _ := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignatureMethod"},
-	}, unverifiedDoc.Root)
+	assertionIDs := ids
+	if req.DecryptedData != nil {
+		assertionIDs, err = elementsByID(unverifiedAssertionDoc.Root)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	signatureMethodAlgorithm, _ := attrValueIgnoreNamespace(signatureMethod, "Algorithm")
-	if signatureMethodAlgorithm != "http://www.w3.org/2001/04/xmldsig-more#rsa-sha256" {
-		return nil, BadSignatureAlgorithmError{signatureMethodAlgorithm}
+	if countAssertions(unverifiedDoc.Root) > 1 || countAssertions(unverifiedAssertionDoc.Root) > 1 {
+		return nil, ErrNoAssertion
 	}
 
-	digestMethod, _ := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "DigestMethod"},
-	}, unverifiedDoc.Root)
+	var res VerifyResponse
 
-	digestMethodAlgorithm, _ := attrValueIgnoreNamespace(digestMethod, "Algorithm")
-	if digestMethodAlgorithm != "http://www.w3.org/2001/04/xmlenc#sha256" {
-		return nil, BadDigestAlgorithmError{digestMethodAlgorithm}
+	// The assertion signature is checked first, so that errors on the
+	// assertion's signature take precedence over the digest mismatch they
+	// would also cause in an enclosing signed response.
+	assertionData, assertionCert, err := verifyElement(req, unverifiedAssertionDoc, assertionIDs, pathAssertion, nil)
+	if err != nil && !errors.Is(err, errNoSignature) {
+		return nil, err
+	}
+	if err == nil {
+		res.Assertion = assertionData
+		res.AssertionSigned = true
+		res.Certificate = assertionCert
 	}
 
-	x509Certificate, ok := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "KeyInfo"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "X509Data"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "X509Certificate"},
-	}, unverifiedDoc.Root)
+	_, responseCert, err := verifyElement(req, unverifiedDoc, ids, pathResponse, nil)
+	if err != nil && !errors.Is(err, errNoSignature) {
+		return nil, err
+	}
+	if err == nil {
+		res.ResponseSigned = true
+		if res.Certificate == nil {
+			res.Certificate = responseCert
+		}
+	}
 
-	if !ok {
+	if !res.AssertionSigned && !res.ResponseSigned {
 		return nil, ErrUnsigned
 	}
 
-	resCertBase64 := *x509Certificate.Element.Children[0].Text
-	resCertBase64 = strings.ReplaceAll(resCertBase64, " ", "")
-	resCertBase64 = strings.ReplaceAll(resCertBase64, "\n", "")
-	resCertRaw, err := base64.StdEncoding.DecodeString(resCertBase64)
-	if err != nil {
-		return nil, fmt.Errorf("parse saml response certificate: %w", err)
-	}
+	if !res.AssertionSigned {
+		// Only the response is signed. Take the assertion from the same
+		// document the response digest was computed over, so that the
+		// assertion consumed is exactly the one covered by the signature. If
+		// the assertion was encrypted, the signature covers its cipher text,
+		// and so the plaintext derived from it.
+		assertion, ok := onlyPathHoistNames(pathAssertion, unverifiedAssertionDoc.Root)
+		if !ok {
+			return nil, ErrNoAssertion
+		}
 
-	if !bytes.Equal(resCertRaw, cert.Raw) {
-		badCert, err := x509.ParseCertificate(resCertRaw)
+		assertionData, err := c14n.Canonicalize(assertion, nil)
 		if err != nil {
-			return nil, fmt.Errorf("parse saml response certificate: %w", err)
+			return nil, err
 		}
 
-		return nil, BadCertificateError{BadCertificate: badCert}
+		res.Assertion = assertionData
 	}
 
-	digestData, err := responseDigestData(unverifiedDoc)
+	return &res, nil
+}
+
+// VerifyMessage verifies the enveloped signature on a SAML protocol message
+// other than a Response, such as a LogoutRequest. local is the expected name of
+// the message's root element. It returns the canonicalized message, as covered
+// by the signature, and the certificate that verified it.
+func VerifyMessage(req *VerifyRequest, local string) ([]byte, *x509.Certificate, error) {
+	unverifiedDoc, err := uxml.Parse(req.Data)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
+	}
+
+	ids, err := elementsByID(unverifiedDoc.Root)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	digestHash := sha256.Sum256(digestData)
-	digestHashBase64 := base64.StdEncoding.EncodeToString(digestHash[:])
+	data, cert, err := verifyElement(req, unverifiedDoc, ids, path{
+		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: local},
+	}, nil)
+	if err != nil {
+		if errors.Is(err, errNoSignature) {
+			return nil, nil, ErrUnsigned
+		}
+		return nil, nil, err
+	}
 
-	digestValue, _ := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "DigestValue"},
-	}, unverifiedDoc.Root)
+	return data, cert, nil
+}
 
-	if *digestValue.Element.Children[0].Text != digestHashBase64 {
-		return nil, ErrBadDigest
+// verifyElement verifies the enveloped signature on the element at elementPath,
+// returning the canonicalized element (without its signature) and the
+// certificate that verified it on success. ids must be the result of
+// elementsByID on unverifiedDoc.
+//
+// If report is nil, verifyElement stops at the first problem with the
+// signature. Otherwise, it carries on wherever it can, and records in report
+// every problem along with the values it computed on the way; the first
+// problem is still returned as the error.
+func verifyElement(req *VerifyRequest, unverifiedDoc *uxml.Document, ids map[string]*uxml.Element, elementPath path, report *SignatureReport) ([]byte, *x509.Certificate, error) {
+	collect := report != nil
+	if !collect {
+		report = &SignatureReport{}
 	}
 
-	publicKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	// fail records a problem with the signature, and returns whether to stop
+	fail := func(err error) bool {
+		report.Problems = append(report.Problems, err)
+		return !collect
+	}
+
+	element, ok := onlyPath(elementPath, unverifiedDoc.Root)
 	if !ok {
-		return nil, ErrNoRSAPublicKey
+		return nil, nil, errNoSignature
 	}
 
-	signatureData, err := responseSignatureData(data)
-	if err != nil {
-		return nil, err
+	if _, ok := onlyPathHoistNames(signaturePath(elementPath), unverifiedDoc.Root); !ok {
+		return nil, nil, errNoSignature
+	}
+
+	report.Present = true
+	report.ElementID, _ = attrValueIgnoreNamespace(element, "ID")
+
+	signedInfoPath := func(rest ...segment) path {
+		return signaturePath(elementPath, append([]segment{{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"}}, rest...)...)
+	}
+
+	signatureValue, _ := onlyPathHoistNames(signaturePath(elementPath,
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignatureValue"},
+	), unverifiedDoc.Root)
+
+	signatureBase64 := textContent(signatureValue)
+	if signatureBase64 == "" && fail(ErrUnsigned) {
+		return nil, nil, ErrUnsigned
+	}
+
+	canonicalizationMethod, _ := onlyPathHoistNames(signedInfoPath(
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "CanonicalizationMethod"},
+	), unverifiedDoc.Root)
+	report.CanonicalizationMethod, _ = attrValueIgnoreNamespace(canonicalizationMethod, "Algorithm")
+
+	signatureMethod, _ := onlyPathHoistNames(signedInfoPath(
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignatureMethod"},
+	), unverifiedDoc.Root)
+	report.SignatureMethod, _ = attrValueIgnoreNamespace(signatureMethod, "Algorithm")
+
+	signatureAlgorithm, ok := allowedSignatureAlgorithm(req.AllowedSignatureAlgorithms, report.SignatureMethod)
+	if !ok {
+		if err := (BadSignatureAlgorithmError{report.SignatureMethod}); fail(err) {
+			return nil, nil, err
+		}
+
+		// still try to verify the signature, if the algorithm is one we know
+		signatureAlgorithm, ok = signatureAlgorithms[report.SignatureMethod]
+	}
+	knownSignatureAlgorithm := ok
+
+	reference, _ := onlyPathHoistNames(signedInfoPath(
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
+	), unverifiedDoc.Root)
+	report.ReferenceURI, _ = attrValueIgnoreNamespace(reference, "URI")
+
+	transforms, _ := onlyPathHoistNames(signedInfoPath(
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Transforms"},
+	), unverifiedDoc.Root)
+	if transforms.Element != nil {
+		for _, t := range transforms.Element.Children {
+			if algorithm, ok := attrValueIgnoreNamespace(t, "Algorithm"); t.Element != nil && ok {
+				report.Transforms = append(report.Transforms, algorithm)
+			}
+		}
+	}
+
+	digestMethod, _ := onlyPathHoistNames(signedInfoPath(
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "DigestMethod"},
+	), unverifiedDoc.Root)
+	report.DigestMethod, _ = attrValueIgnoreNamespace(digestMethod, "Algorithm")
+
+	digestAlgorithm, ok := allowedDigestAlgorithm(req.AllowedDigestAlgorithms, report.DigestMethod)
+	if !ok {
+		if err := (BadDigestAlgorithmError{report.DigestMethod}); fail(err) {
+			return nil, nil, err
+		}
+
+		// still compute the digest, if the algorithm is one we know
+		digestAlgorithm, ok = digestAlgorithms[report.DigestMethod]
+	}
+	knownDigestAlgorithm := ok
+
+	x509Certificate, _ := onlyPathHoistNames(signaturePath(elementPath,
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "KeyInfo"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "X509Data"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "X509Certificate"},
+	), unverifiedDoc.Root)
+
+	if certBase64 := textContent(x509Certificate); certBase64 == "" {
+		if fail(ErrUnsigned) {
+			return nil, nil, ErrUnsigned
+		}
+	} else if certRaw, err := base64.StdEncoding.DecodeString(certBase64); err != nil {
+		if err := fmt.Errorf("parse saml response certificate: %w", err); fail(err) {
+			return nil, nil, err
+		}
+	} else {
+		for _, c := range req.Certificates {
+			if bytes.Equal(certRaw, c.Raw) {
+				report.KeyInfoCertificate = c
+				report.KeyInfoCertificateTrusted = true
+				break
+			}
+		}
+
+		if !report.KeyInfoCertificateTrusted {
+			badCert, err := x509.ParseCertificate(certRaw)
+			if err != nil {
+				err = fmt.Errorf("parse saml response certificate: %w", err)
+			} else {
+				report.KeyInfoCertificate = badCert
+				err = BadCertificateError{BadCertificate: badCert}
+			}
+
+			if fail(err) {
+				return nil, nil, err
+			}
+		}
+	}
+
+	// the signature must refer, by ID, to the element it is enveloped in;
+	// otherwise, the signature may cover some other element than the one we
+	// consume
+	referenced, ok := ids[strings.TrimPrefix(report.ReferenceURI, "#")]
+	if (!strings.HasPrefix(report.ReferenceURI, "#") || !ok || referenced != element.Element) && fail(ErrBadReference) {
+		return nil, nil, ErrBadReference
+	}
+
+	digestData, err := digestDataForElement(unverifiedDoc, elementPath)
+	if err != nil && fail(err) {
+		return nil, nil, err
+	}
+	report.DigestInput = digestData
+
+	// SignedInfo is canonicalized with the algorithm it declares
+	signedInfoAlgorithm, signedInfoInclusiveNamespaces, err := canonicalizationAlgorithm(canonicalizationMethod)
+	if err != nil && fail(err) {
+		return nil, nil, err
+	}
+	knownCanonicalizationAlgorithm := err == nil
+
+	digestValue, _ := onlyPathHoistNames(signedInfoPath(
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "DigestValue"},
+	), unverifiedDoc.Root)
+	report.ExpectedDigest = textContent(digestValue)
+
+	if digestData != nil && knownDigestAlgorithm {
+		digestHash := digestAlgorithm.New()
+		digestHash.Write(digestData)
+		report.ComputedDigest = base64.StdEncoding.EncodeToString(digestHash.Sum(nil))
+
+		if report.ComputedDigest != report.ExpectedDigest && fail(ErrBadDigest) {
+			return nil, nil, ErrBadDigest
+		}
 	}
 
-	signatureHash := sha256.Sum256(signatureData)
+	if !knownCanonicalizationAlgorithm || !knownSignatureAlgorithm || signatureBase64 == "" {
+		return nil, nil, report.Problems[0]
+	}
+
+	signedInfo, _ := onlyPathHoistNames(signedInfoPath(), unverifiedDoc.Root)
+	signatureData, err := signedInfoAlgorithm.Canonicalize(signedInfo, signedInfoInclusiveNamespaces)
+	if err != nil {
+		fail(err)
+		return nil, nil, report.Problems[0]
+	}
 
-	//signatureBase64 := signatureBase64
-	signatureBase64 = strings.ReplaceAll(signatureBase64, " ", "")
-	signatureBase64 = strings.ReplaceAll(signatureBase64, "\n", "")
 	expectedSignature, err := base64.StdEncoding.DecodeString(signatureBase64)
 	if err != nil {
+		fail(err)
+		return nil, nil, report.Problems[0]
+	}
+
+	if report.KeyInfoCertificate != nil {
+		if err := signatureAlgorithm.verify(report.KeyInfoCertificate, signatureData, expectedSignature); err == nil {
+			report.SignedBy = report.KeyInfoCertificate
+		} else if report.KeyInfoCertificateTrusted {
+			if err := fmt.Errorf("verify signature: %w", err); fail(err) {
+				return nil, nil, err
+			}
+		}
+	}
+
+	if report.SignedBy == nil {
+		// find whichever trusted certificate signed SignedInfo, so that a
+		// certificate rollover can be told apart from a corrupted signature
+		for _, c := range req.Certificates {
+			if err := signatureAlgorithm.verify(c, signatureData, expectedSignature); err == nil {
+				report.SignedBy = c
+				break
+			}
+		}
+
+		if report.SignedBy == nil {
+			fail(ErrNoVerifyingCertificate)
+		}
+	}
+
+	if len(report.Problems) > 0 {
+		return nil, nil, report.Problems[0]
+	}
+
+	return digestData, report.SignedBy, nil
+}
+
+// elementsByID indexes every element under n by its ID attribute. It returns
+// ErrDuplicateID if more than one element carries the same ID, or an element
+// carries more than one ID attribute.
+func elementsByID(n uxml.Node) (map[string]*uxml.Element, error) {
+	ids := map[string]*uxml.Element{}
+	if err := elementsByIDInternal(ids, n); err != nil {
 		return nil, err
 	}
+	return ids, nil
+}
 
-	if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, signatureHash[:], expectedSignature); err != nil {
-		return nil, fmt.Errorf("verify signature: %w", err)
+func elementsByIDInternal(ids map[string]*uxml.Element, n uxml.Node) error {
+	if n.Element == nil {
+		return nil
 	}
 
-	return digestData, nil
+	id, ok := attrValueIgnoreNamespace(n, "ID")
+	if !ok {
+		return ErrDuplicateID
+	}
+	if id != "" {
+		if _, ok := ids[id]; ok {
+			return ErrDuplicateID
+		}
+		ids[id] = n.Element
+	}
+
+	for _, c := range n.Element.Children {
+		if err := elementsByIDInternal(ids, c); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func responseDigestData(unverifiedDoc *uxml.Document) ([]byte, error) {
-	assertion, _ := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-	}, unverifiedDoc.Root)
+// countAssertions returns the number of Assertion and EncryptedAssertion
+// elements anywhere under n, including ones nested in other assertions or in
+// signatures.
+func countAssertions(n uxml.Node) int {
+	if n.Element == nil {
+		return 0
+	}
+
+	var count int
+	if n.Element.Name.URI == "urn:oasis:names:tc:SAML:2.0:assertion" && (n.Element.Name.Local == "Assertion" || n.Element.Name.Local == "EncryptedAssertion") {
+		count++
+	}
+	for _, c := range n.Element.Children {
+		count += countAssertions(c)
+	}
+	return count
+}
+
+// signaturePath returns the path to the Signature enveloped in the element at
+// elementPath, followed by rest.
+func signaturePath(elementPath path, rest ...segment) path {
+	var p path
+	p = append(p, elementPath...)
+	p = append(p, segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"})
+	p = append(p, rest...)
+	return p
+}
+
+func digestDataForElement(unverifiedDoc *uxml.Document, elementPath path) ([]byte, error) {
+	element, _ := onlyPathHoistNames(elementPath, unverifiedDoc.Root)
 
 	nosig := exceptPath(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
+		elementPath[len(elementPath)-1],
 		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-	}, assertion)
+	}, element)
 
-	transforms, _ := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Transforms"},
-	}, unverifiedDoc.Root)
+	transforms, _ := onlyPathHoistNames(signaturePath(elementPath,
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Reference"},
+		segment{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Transforms"},
+	), unverifiedDoc.Root)
 
+	// absent a canonicalization transform, XML DSig converts the referenced
+	// element to octets with inclusive canonicalization
+	algorithm, _ := c14n.ParseAlgorithm(c14n.AlgorithmInclusive)
 	var inclusiveNamespaces []string
-	for _, t := range transforms.Element.Children {
-		algorithm, _ := attrValueIgnoreNamespace(t, "Algorithm")
-		if algorithm != "http://www.w3.org/2001/10/xml-exc-c14n#" {
-			continue
-		}
+	if transforms.Element != nil {
+		for _, t := range transforms.Element.Children {
+			if t.Element == nil {
+				continue
+			}
 
-		var inclusiveNamespacesElement uxml.Node
-		for _, c := range t.Element.Children {
-			if c.Element.Name.Local == "InclusiveNamespaces" {
-				inclusiveNamespacesElement = c
+			transformAlgorithm, _ := attrValueIgnoreNamespace(t, "Algorithm")
+			if transformAlgorithm == transformEnvelopedSignature {
+				// applied above, by removing the Signature from nosig
+				continue
 			}
-		}
-		prefixList, _ := attrValueIgnoreNamespace(inclusiveNamespacesElement, "PrefixList")
 
-		// ensure inclusiveNamespaces remains empty if PrefixList is empty
-		// ("empty" here likely just means the assertion XML lacks InclusiveNamespaces at all)
-		if prefixList != "" {
-			inclusiveNamespaces = strings.Split(prefixList, " ")
+			var err error
+			algorithm, inclusiveNamespaces, err = canonicalizationAlgorithm(t)
+			if err != nil {
+				return nil, err
+			}
 		}
 	}
 
-	return c14n.Canonicalize(nosig, inclusiveNamespaces)
+	// The reference is a bare ID, which per XML DSig selects the element
+	// without its comments, even if a #WithComments algorithm follows.
+	// Comments therefore never contribute to the digest; they must not be
+	// able to change what a signed assertion is taken to say.
+	algorithm.WithComments = false
+
+	return algorithm.Canonicalize(nosig, inclusiveNamespaces)
 }
 
-func responseSignatureData(data []byte) ([]byte, error) {
-	doc, err := uxml.Parse(data)
-	if err != nil {
-		return nil, err
+const transformEnvelopedSignature = "http://www.w3.org/2000/09/xmldsig#enveloped-signature"
+
+// canonicalizationAlgorithm parses the canonicalization algorithm of a
+// CanonicalizationMethod or Transform element, along with the PrefixList of
+// its InclusiveNamespaces if it is exclusive canonicalization.
+func canonicalizationAlgorithm(n uxml.Node) (c14n.Algorithm, []string, error) {
+	// tolerate a missing CanonicalizationMethod, as was accepted before
+	// canonicalization algorithms other than exclusive were supported
+	if n.Element == nil {
+		algorithm, _ := c14n.ParseAlgorithm(c14n.AlgorithmExclusive)
+		return algorithm, nil, nil
 	}
 
-	// todo remove ok?
-	n, _ := onlyPathHoistNames(path{
-		{URI: "urn:oasis:names:tc:SAML:2.0:protocol", Local: "Response"},
-		{URI: "urn:oasis:names:tc:SAML:2.0:assertion", Local: "Assertion"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "Signature"},
-		{URI: "http://www.w3.org/2000/09/xmldsig#", Local: "SignedInfo"},
-	}, doc.Root)
+	uri, _ := attrValueIgnoreNamespace(n, "Algorithm")
+	algorithm, ok := c14n.ParseAlgorithm(uri)
+	if !ok {
+		return c14n.Algorithm{}, nil, UnsupportedTransformError{Algorithm: uri}
+	}
+
+	if !algorithm.Exclusive {
+		return algorithm, nil, nil
+	}
+
+	var inclusiveNamespacesElement uxml.Node
+	for _, c := range n.Element.Children {
+		if c.Element != nil && c.Element.Name.Local == "InclusiveNamespaces" {
+			inclusiveNamespacesElement = c
+		}
+	}
+	prefixList, _ := attrValueIgnoreNamespace(inclusiveNamespacesElement, "PrefixList")
+
+	// ensure inclusiveNamespaces remains empty if PrefixList is empty
+	// ("empty" here likely just means the assertion XML lacks InclusiveNamespaces at all)
+	var inclusiveNamespaces []string
+	if prefixList != "" {
+		inclusiveNamespaces = strings.Split(prefixList, " ")
+	}
 
-	return c14n.Canonicalize(n, nil)
+	return algorithm, inclusiveNamespaces, nil
 }
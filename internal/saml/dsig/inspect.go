@@ -0,0 +1,119 @@
+package dsig
+
+import (
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/ssoready/ssoready/internal/saml/uxml"
+)
+
+// ErrNoVerifyingCertificate is reported by Inspect if no certificate, trusted
+// or not, verifies a signature.
+var ErrNoVerifyingCertificate = fmt.Errorf("dsig: signature not verified by any certificate")
+
+// InspectResponse describes the signatures on a SAML response, for debugging
+// why it does or does not verify.
+type InspectResponse struct {
+	Response  SignatureReport
+	Assertion SignatureReport
+
+	// Problems are the failures that apply to the document as a whole, such as
+	// ErrDuplicateID.
+	Problems []error
+
+	// VerifyError is what Verify returns for the same request.
+	VerifyError error
+}
+
+// SignatureReport describes the enveloped signature on an element. Fields are
+// populated on a best-effort basis, and are left empty if the signature lacks
+// the corresponding elements.
+type SignatureReport struct {
+	// Present is whether the element exists and has a Signature child.
+	Present bool
+
+	ElementID              string
+	ReferenceURI           string
+	CanonicalizationMethod string
+	SignatureMethod        string
+	DigestMethod           string
+	Transforms             []string
+
+	// DigestInput is the canonicalized element, without its signature, that
+	// the digest is computed over.
+	DigestInput    []byte
+	ComputedDigest string
+	ExpectedDigest string
+
+	// KeyInfoCertificate is the certificate carried in the signature's
+	// KeyInfo, and KeyInfoCertificateTrusted is whether it is among the
+	// request's Certificates.
+	KeyInfoCertificate        *x509.Certificate
+	KeyInfoCertificateTrusted bool
+
+	// SignedBy is the certificate, from the request's Certificates or else the
+	// KeyInfo, whose key verifies the signature over SignedInfo.
+	SignedBy *x509.Certificate
+
+	// Problems is every reason the signature does not verify. It is empty if
+	// and only if the signature verifies.
+	Problems []error
+}
+
+// Inspect reports on the Response and Assertion signatures in req.Data,
+// collecting every problem with them rather than stopping at the first. It
+// only returns an error if the document cannot be parsed.
+func Inspect(req *VerifyRequest) (*InspectResponse, error) {
+	unverifiedDoc, err := uxml.Parse(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	unverifiedAssertionDoc := unverifiedDoc
+	if req.DecryptedData != nil {
+		unverifiedAssertionDoc, err = uxml.Parse(req.DecryptedData)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var res InspectResponse
+
+	ids, err := elementsByID(unverifiedDoc.Root)
+	if err != nil {
+		res.Problems = append(res.Problems, err)
+	}
+
+	assertionIDs := ids
+	if req.DecryptedData != nil {
+		assertionIDs, err = elementsByID(unverifiedAssertionDoc.Root)
+		if err != nil {
+			res.Problems = append(res.Problems, err)
+		}
+	}
+
+	if countAssertions(unverifiedDoc.Root) > 1 || countAssertions(unverifiedAssertionDoc.Root) > 1 {
+		res.Problems = append(res.Problems, ErrNoAssertion)
+	}
+
+	// the problems are in the reports, and VerifyError below
+	_, _, _ = verifyElement(req, unverifiedDoc, ids, pathResponse, &res.Response)
+	_, _, _ = verifyElement(req, unverifiedAssertionDoc, assertionIDs, pathAssertion, &res.Assertion)
+
+	_, res.VerifyError = Verify(req)
+	return &res, nil
+}
+
+// textContent returns the text of n's first child, without the whitespace
+// that is commonly inserted into base64 values.
+func textContent(n uxml.Node) string {
+	if n.Element == nil || len(n.Element.Children) == 0 || n.Element.Children[0].Text == nil {
+		return ""
+	}
+
+	s := *n.Element.Children[0].Text
+	s = strings.ReplaceAll(s, " ", "")
+	s = strings.ReplaceAll(s, "\n", "")
+	return s
+}
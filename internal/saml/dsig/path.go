@@ -118,10 +118,20 @@ func exceptPathInternal(p, cur path, n uxml.Node) uxml.Node {
 
 	var children []uxml.Node
 	for _, c := range n.Element.Children {
-		if c.Element != nil && pathsEqual(p, append(cur, segment{URI: c.Element.Name.URI, Local: c.Element.Name.Local})) {
+		if c.Element == nil {
+			children = append(children, c)
 			continue
 		}
-		children = append(children, exceptPathInternal(p, cur, c))
+
+		// copy cur, so that siblings do not share a backing array
+		var next path
+		next = append(next, cur...)
+		next = append(next, segment{URI: c.Element.Name.URI, Local: c.Element.Name.Local})
+
+		if pathsEqual(p, next) {
+			continue
+		}
+		children = append(children, exceptPathInternal(p, next, c))
 	}
 
 	return uxml.Node{
@@ -0,0 +1,47 @@
+package dsig
+
+import (
+	"crypto/x509"
+	"fmt"
+)
+
+var ErrBadRedirectSignature = fmt.Errorf("dsig: redirect signature not verified by any certificate")
+
+type VerifyRedirectRequest struct {
+	// Certificates are the certificates the IDP may sign with. The
+	// HTTP-Redirect binding does not carry a KeyInfo, so each is tried in turn.
+	Certificates []*x509.Certificate
+
+	// SignatureAlgorithm is the SigAlg query parameter.
+	SignatureAlgorithm string
+
+	// Data is the signed octet string: the SAMLRequest or SAMLResponse,
+	// RelayState, and SigAlg parameters, exactly as they were URL-encoded on
+	// the wire.
+	Data []byte
+
+	// Signature is the base64-decoded Signature query parameter.
+	Signature []byte
+
+	// AllowedSignatureAlgorithms restricts the algorithms the signature may
+	// use. If empty, DefaultSignatureAlgorithms are used.
+	AllowedSignatureAlgorithms []string
+}
+
+// VerifyRedirect verifies a signature made per the SAML HTTP-Redirect binding,
+// which signs the query string rather than the message it carries. It returns
+// the certificate that verified the signature.
+func VerifyRedirect(req *VerifyRedirectRequest) (*x509.Certificate, error) {
+	signatureAlgorithm, ok := allowedSignatureAlgorithm(req.AllowedSignatureAlgorithms, req.SignatureAlgorithm)
+	if !ok {
+		return nil, BadSignatureAlgorithmError{req.SignatureAlgorithm}
+	}
+
+	for _, cert := range req.Certificates {
+		if err := signatureAlgorithm.verify(cert, req.Data, req.Signature); err == nil {
+			return cert, nil
+		}
+	}
+
+	return nil, ErrBadRedirectSignature
+}
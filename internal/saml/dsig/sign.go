@@ -0,0 +1,122 @@
+package dsig
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/ssoready/ssoready/internal/saml/c14n"
+	"github.com/ssoready/ssoready/internal/saml/uxml"
+)
+
+type SignRequest struct {
+	// Key is an *rsa.PrivateKey, or an *ecdsa.PrivateKey if SignatureAlgorithm
+	// is an ECDSA algorithm.
+	Key crypto.Signer
+
+	// Certificate, if set, is included in the signature's KeyInfo.
+	Certificate *x509.Certificate
+
+	// Data is the serialized element to sign. Its root must carry an ID
+	// attribute, which the signature references.
+	Data []byte
+
+	// SignatureAlgorithm and DigestAlgorithm default to RSA-SHA256 and SHA-256
+	// respectively.
+	SignatureAlgorithm string
+	DigestAlgorithm    string
+}
+
+// Sign returns an enveloped signature over req.Data, using exclusive
+// canonicalization. The caller is responsible for placing the
+// returned Signature element inside the signed element; because of the
+// enveloped-signature transform, doing so does not invalidate the digest.
+func Sign(req *SignRequest) ([]byte, error) {
+	doc, err := uxml.Parse(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureMethod := req.SignatureAlgorithm
+	if signatureMethod == "" {
+		signatureMethod = SignatureAlgorithmRSASHA256
+	}
+	signatureAlgorithm, ok := signatureAlgorithms[signatureMethod]
+	if !ok {
+		return nil, BadSignatureAlgorithmError{BadAlgorithm: signatureMethod}
+	}
+
+	digestMethod := req.DigestAlgorithm
+	if digestMethod == "" {
+		digestMethod = DigestAlgorithmSHA256
+	}
+	digestAlgorithm, ok := digestAlgorithms[digestMethod]
+	if !ok {
+		return nil, BadDigestAlgorithmError{BadAlgorithm: digestMethod}
+	}
+
+	id, _ := attrValueIgnoreNamespace(doc.Root, "ID")
+	if id == "" {
+		return nil, fmt.Errorf("dsig: element to sign has no ID")
+	}
+
+	digestData, err := c14n.Canonicalize(doc.Root, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var escapedID bytes.Buffer
+	if err := xml.EscapeText(&escapedID, []byte(id)); err != nil {
+		return nil, err
+	}
+
+	digest := digestAlgorithm.New()
+	digest.Write(digestData)
+
+	var signedInfo bytes.Buffer
+	signedInfo.WriteString(`<ds:SignedInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#">`)
+	signedInfo.WriteString(`<ds:CanonicalizationMethod Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:CanonicalizationMethod>`)
+	signedInfo.WriteString(`<ds:SignatureMethod Algorithm="` + signatureMethod + `"></ds:SignatureMethod>`)
+	signedInfo.WriteString(`<ds:Reference URI="#` + escapedID.String() + `">`)
+	signedInfo.WriteString(`<ds:Transforms>`)
+	signedInfo.WriteString(`<ds:Transform Algorithm="http://www.w3.org/2000/09/xmldsig#enveloped-signature"></ds:Transform>`)
+	signedInfo.WriteString(`<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#"></ds:Transform>`)
+	signedInfo.WriteString(`</ds:Transforms>`)
+	signedInfo.WriteString(`<ds:DigestMethod Algorithm="` + digestMethod + `"></ds:DigestMethod>`)
+	signedInfo.WriteString(`<ds:DigestValue>` + base64.StdEncoding.EncodeToString(digest.Sum(nil)) + `</ds:DigestValue>`)
+	signedInfo.WriteString(`</ds:Reference>`)
+	signedInfo.WriteString(`</ds:SignedInfo>`)
+
+	// canonicalize SignedInfo as a standalone document; under exclusive
+	// canonicalization, this is identical to canonicalizing it in the context
+	// of the Signature it is placed in
+	signedInfoDoc, err := uxml.Parse(signedInfo.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	signedInfoData, err := c14n.Canonicalize(signedInfoDoc.Root, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := signatureAlgorithm.sign(req.Key, signedInfoData)
+	if err != nil {
+		return nil, fmt.Errorf("dsig: sign: %w", err)
+	}
+
+	var out bytes.Buffer
+	out.WriteString(`<ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">`)
+	out.Write(signedInfoData)
+	out.WriteString(`<ds:SignatureValue>` + base64.StdEncoding.EncodeToString(signature) + `</ds:SignatureValue>`)
+	if req.Certificate != nil {
+		out.WriteString(`<ds:KeyInfo><ds:X509Data><ds:X509Certificate>`)
+		out.WriteString(base64.StdEncoding.EncodeToString(req.Certificate.Raw))
+		out.WriteString(`</ds:X509Certificate></ds:X509Data></ds:KeyInfo>`)
+	}
+	out.WriteString(`</ds:Signature>`)
+	return out.Bytes(), nil
+}
@@ -0,0 +1,104 @@
+package dsig
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	authnRequestOpen  = `<AuthnRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol" ID="saml_flow_1" Version="2.0" IssueInstant="2024-01-01T00:00:00Z"><Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">http://sp.example.com</Issuer>`
+	authnRequestClose = `</AuthnRequest>`
+)
+
+func TestSign(t *testing.T) {
+	key, cert := signingKeyPair(t)
+
+	signature, err := Sign(&SignRequest{
+		Key:         key,
+		Certificate: cert,
+		Data:        []byte(authnRequestOpen + authnRequestClose),
+	})
+	require.NoError(t, err)
+
+	signed := authnRequestOpen + string(signature) + authnRequestClose
+	_, verifiedCert, err := VerifyMessage(&VerifyRequest{Certificates: []*x509.Certificate{cert}, Data: []byte(signed)}, "AuthnRequest")
+	require.NoError(t, err)
+	assert.Equal(t, cert, verifiedCert)
+
+	tampered := strings.Replace(signed, "http://sp.example.com", "http://evil.example.com", 1)
+	_, _, err = VerifyMessage(&VerifyRequest{Certificates: []*x509.Certificate{cert}, Data: []byte(tampered)}, "AuthnRequest")
+	assert.ErrorIs(t, err, ErrBadDigest)
+}
+
+func TestVerify_Comments(t *testing.T) {
+	key, cert := signingKeyPair(t)
+
+	signature, err := Sign(&SignRequest{
+		Key:         key,
+		Certificate: cert,
+		Data:        []byte(authnRequestOpen + authnRequestClose),
+	})
+	require.NoError(t, err)
+
+	// comments are not part of the digest, so adding them does not break the
+	// signature
+	signed := authnRequestOpen + string(signature) + authnRequestClose
+	commented := strings.Replace(signed, "http://sp.example.com", "http://sp.example.com<!-- comment -->", 1)
+	_, _, err = VerifyMessage(&VerifyRequest{Certificates: []*x509.Certificate{cert}, Data: []byte(commented)}, "AuthnRequest")
+	assert.NoError(t, err)
+}
+
+func TestVerify_UnsupportedTransform(t *testing.T) {
+	key, cert := signingKeyPair(t)
+
+	signature, err := Sign(&SignRequest{
+		Key:         key,
+		Certificate: cert,
+		Data:        []byte(authnRequestOpen + authnRequestClose),
+	})
+	require.NoError(t, err)
+
+	signed := authnRequestOpen + string(signature) + authnRequestClose
+	xslt := strings.Replace(signed, `<ds:Transform Algorithm="http://www.w3.org/2001/10/xml-exc-c14n#">`, `<ds:Transform Algorithm="http://www.w3.org/TR/1999/REC-xslt-19991116">`, 1)
+	_, _, err = VerifyMessage(&VerifyRequest{Certificates: []*x509.Certificate{cert}, Data: []byte(xslt)}, "AuthnRequest")
+	assert.ErrorAs(t, err, &UnsupportedTransformError{})
+}
+
+func TestSign_NoID(t *testing.T) {
+	key, _ := signingKeyPair(t)
+
+	_, err := Sign(&SignRequest{
+		Key:  key,
+		Data: []byte(`<AuthnRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol"></AuthnRequest>`),
+	})
+	assert.Error(t, err)
+}
+
+func signingKeyPair(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp.example.com"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	return key, cert
+}
@@ -1,21 +1,76 @@
 package saml
 
 import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/xml"
 	"fmt"
+	"net/url"
 	"time"
+
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+)
+
+// Bindings SSOReady can send AuthnRequests with, as they appear in IDP
+// metadata.
+const (
+	BindingHTTPPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+	BindingHTTPRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
 )
 
 type InitRequest struct {
 	RequestID  string
 	SPEntityID string
 	Now        time.Time
+
+	// Binding is the binding the AuthnRequest is sent with. If empty,
+	// BindingHTTPPost is used.
+	Binding string
+
+	// RelayState, if set, is included alongside the AuthnRequest when using
+	// BindingHTTPRedirect.
+	RelayState string
+
+	// SigningKey, if set, is used to sign the AuthnRequest. SigningCertificate,
+	// if set, is included in the signature's KeyInfo.
+	SigningKey         *rsa.PrivateKey
+	SigningCertificate *x509.Certificate
+
+	// ForceAuthn, if true, asks the IDP to re-authenticate the user even if
+	// they already have a session with it.
+	ForceAuthn bool
+
+	// IsPassive, if true, asks the IDP not to interact with the user. The IDP
+	// fails the login if the user does not already have a session with it.
+	IsPassive bool
+
+	// NameIDPolicyFormat, if set, is the NameID format requested from the IDP,
+	// e.g. "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress".
+	NameIDPolicyFormat string
+
+	// RequestedAuthnContextClassRefs, if set, are the authentication context
+	// classes requested from the IDP, e.g.
+	// "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport".
+	// RequestedAuthnContextComparison is how the IDP compares them against the
+	// user's authentication; if empty, the IDP treats it as "exact".
+	RequestedAuthnContextClassRefs  []string
+	RequestedAuthnContextComparison string
 }
 
 type InitResponse struct {
+	// SAMLRequest is the encoded AuthnRequest: base64 for BindingHTTPPost, and
+	// DEFLATE and then base64 for BindingHTTPRedirect.
 	SAMLRequest     string
 	InitiateRequest string
+
+	// RedirectQuery is the query string to append to the IDP's redirect URL.
+	// It is only set when using BindingHTTPRedirect.
+	RedirectQuery string
 }
 
 func Init(req *InitRequest) *InitResponse {
@@ -24,25 +79,132 @@ func Init(req *InitRequest) *InitResponse {
 	samlReq.Version = "2.0"
 	samlReq.IssueInstant = req.Now.UTC().Truncate(time.Millisecond)
 	samlReq.Issuer.Name = req.SPEntityID
+	samlReq.ForceAuthn = req.ForceAuthn
+	samlReq.IsPassive = req.IsPassive
+
+	if req.NameIDPolicyFormat != "" {
+		samlReq.NameIDPolicy = &samlNameIDPolicy{Format: req.NameIDPolicyFormat}
+	}
+
+	if len(req.RequestedAuthnContextClassRefs) > 0 {
+		samlReq.RequestedAuthnContext = &samlRequestedAuthnContext{
+			Comparison:           req.RequestedAuthnContextComparison,
+			AuthnContextClassRef: req.RequestedAuthnContextClassRefs,
+		}
+	}
+
 	samlReqData, err := xml.Marshal(samlReq)
 
 	if err != nil {
 		panic(fmt.Errorf("marshal AuthnRequest: %w", err))
 	}
 
+	if req.Binding == BindingHTTPRedirect {
+		// the Redirect binding carries its signature in the query string,
+		// rather than in the AuthnRequest
+		samlRequest := deflateBase64(samlReqData)
+		return &InitResponse{
+			SAMLRequest:     samlRequest,
+			InitiateRequest: string(samlReqData),
+			RedirectQuery:   redirectQuery(req.SigningKey, "SAMLRequest", samlRequest, req.RelayState),
+		}
+	}
+
+	if req.SigningKey != nil {
+		signature, err := dsig.Sign(&dsig.SignRequest{
+			Key:         req.SigningKey,
+			Certificate: req.SigningCertificate,
+			Data:        samlReqData,
+		})
+		if err != nil {
+			panic(fmt.Errorf("sign AuthnRequest: %w", err))
+		}
+
+		// the schema requires the enveloped signature to immediately follow
+		// Issuer, which is where samlRequest places it
+		samlReq.Signature = signature
+		samlReqData, err = xml.Marshal(samlReq)
+		if err != nil {
+			panic(fmt.Errorf("marshal AuthnRequest: %w", err))
+		}
+	}
+
 	return &InitResponse{
 		SAMLRequest:     base64.StdEncoding.EncodeToString(samlReqData),
 		InitiateRequest: string(samlReqData),
 	}
 }
 
+// deflateBase64 encodes a message for the HTTP-Redirect binding: DEFLATE, and
+// then base64.
+func deflateBase64(data []byte) string {
+	var deflated bytes.Buffer
+	w, err := flate.NewWriter(&deflated, flate.DefaultCompression)
+	if err != nil {
+		panic(fmt.Errorf("deflate: %w", err))
+	}
+	if _, err := w.Write(data); err != nil {
+		panic(fmt.Errorf("deflate: %w", err))
+	}
+	if err := w.Close(); err != nil {
+		panic(fmt.Errorf("deflate: %w", err))
+	}
+
+	return base64.StdEncoding.EncodeToString(deflated.Bytes())
+}
+
+// redirectQuery returns the query string carrying an HTTP-Redirect binding
+// message in the param parameter, which is either SAMLRequest or SAMLResponse.
+// If key is non-nil, the query string is signed, as the binding requires, by
+// adding SigAlg and Signature parameters.
+func redirectQuery(key *rsa.PrivateKey, param, message, relayState string) string {
+	// the signature covers the query parameters in this exact order, as they
+	// appear URL-encoded on the wire
+	query := param + "=" + url.QueryEscape(message)
+	if relayState != "" {
+		query += "&RelayState=" + url.QueryEscape(relayState)
+	}
+
+	if key == nil {
+		return query
+	}
+
+	query += "&SigAlg=" + url.QueryEscape(dsig.SignatureAlgorithmRSASHA256)
+
+	hashed := crypto.SHA256.New()
+	hashed.Write([]byte(query))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hashed.Sum(nil))
+	if err != nil {
+		panic(fmt.Errorf("sign redirect query: %w", err))
+	}
+
+	return query + "&Signature=" + url.QueryEscape(base64.StdEncoding.EncodeToString(signature))
+}
+
 type samlRequest struct {
 	XMLName      xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:protocol AuthnRequest"`
 	ID           string    `xml:"ID,attr"`
 	Version      string    `xml:"Version,attr"`
 	IssueInstant time.Time `xml:"IssueInstant,attr"`
+	ForceAuthn   bool      `xml:"ForceAuthn,attr,omitempty"`
+	IsPassive    bool      `xml:"IsPassive,attr,omitempty"`
 	Issuer       struct {
 		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
 		Name    string   `xml:",chardata"`
 	} `xml:"Issuer"`
+	Signature []byte `xml:",innerxml"`
+
+	// the schema requires NameIDPolicy and RequestedAuthnContext, in that
+	// order, after Signature
+	NameIDPolicy          *samlNameIDPolicy          `xml:"urn:oasis:names:tc:SAML:2.0:protocol NameIDPolicy"`
+	RequestedAuthnContext *samlRequestedAuthnContext `xml:"urn:oasis:names:tc:SAML:2.0:protocol RequestedAuthnContext"`
+}
+
+type samlNameIDPolicy struct {
+	Format string `xml:"Format,attr"`
+}
+
+type samlRequestedAuthnContext struct {
+	Comparison           string   `xml:"Comparison,attr,omitempty"`
+	AuthnContextClassRef []string `xml:"urn:oasis:names:tc:SAML:2.0:assertion AuthnContextClassRef"`
 }
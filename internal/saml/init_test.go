@@ -0,0 +1,196 @@
+package saml_test
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"io"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInit(t *testing.T) {
+	res := saml.Init(&saml.InitRequest{
+		RequestID:  "saml_flow_1",
+		SPEntityID: "http://sp.example.com",
+		Now:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.Equal(t, `<AuthnRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol" ID="saml_flow_1" Version="2.0" IssueInstant="2024-01-01T00:00:00Z"><Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">http://sp.example.com</Issuer></AuthnRequest>`, res.InitiateRequest)
+	assert.Equal(t, base64.StdEncoding.EncodeToString([]byte(res.InitiateRequest)), res.SAMLRequest)
+}
+
+func TestInit_Signed(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	res := saml.Init(&saml.InitRequest{
+		RequestID:  "saml_flow_1",
+		SPEntityID: "http://sp.example.com",
+		Now:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		SigningKey: key,
+	})
+
+	// the signature must immediately follow Issuer
+	assert.Contains(t, res.InitiateRequest, `</Issuer><ds:Signature xmlns:ds="http://www.w3.org/2000/09/xmldsig#">`)
+	assert.Contains(t, res.InitiateRequest, `<ds:Reference URI="#saml_flow_1">`)
+	assert.True(t, strings.HasSuffix(res.InitiateRequest, `</ds:Signature></AuthnRequest>`))
+	assert.NotContains(t, res.InitiateRequest, "KeyInfo")
+
+	// with the SP signing certificate in KeyInfo, the signature verifies
+	// against that certificate, as an IDP would check it
+	cert := selfSignedCertificate(t, key)
+	res = saml.Init(&saml.InitRequest{
+		RequestID:          "saml_flow_1",
+		SPEntityID:         "http://sp.example.com",
+		Now:                time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		SigningKey:         key,
+		SigningCertificate: cert,
+	})
+
+	_, verifiedCert, err := dsig.VerifyMessage(&dsig.VerifyRequest{
+		Certificates: []*x509.Certificate{cert},
+		Data:         []byte(res.InitiateRequest),
+	}, "AuthnRequest")
+	require.NoError(t, err)
+	assert.Equal(t, cert, verifiedCert)
+
+	// and not against any other certificate
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, _, err = dsig.VerifyMessage(&dsig.VerifyRequest{
+		Certificates: []*x509.Certificate{selfSignedCertificate(t, otherKey)},
+		Data:         []byte(res.InitiateRequest),
+	}, "AuthnRequest")
+	assert.Error(t, err)
+
+	// nor once the AuthnRequest is tampered with
+	tampered := strings.Replace(res.InitiateRequest, "http://sp.example.com", "http://evil.example.com", 1)
+	_, _, err = dsig.VerifyMessage(&dsig.VerifyRequest{
+		Certificates: []*x509.Certificate{cert},
+		Data:         []byte(tampered),
+	}, "AuthnRequest")
+	assert.Error(t, err)
+}
+
+func selfSignedCertificate(t *testing.T, key *rsa.PrivateKey) *x509.Certificate {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sp.example.com"},
+		NotBefore:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2034, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
+func TestInit_Redirect(t *testing.T) {
+	res := saml.Init(&saml.InitRequest{
+		RequestID:  "saml_flow_1",
+		SPEntityID: "http://sp.example.com",
+		Now:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Binding:    saml.BindingHTTPRedirect,
+		RelayState: "saml_flow_1",
+	})
+
+	values, err := url.ParseQuery(res.RedirectQuery)
+	require.NoError(t, err)
+	assert.Equal(t, res.SAMLRequest, values.Get("SAMLRequest"))
+	assert.Equal(t, "saml_flow_1", values.Get("RelayState"))
+	assert.False(t, values.Has("SigAlg"))
+	assert.False(t, values.Has("Signature"))
+
+	assert.Equal(t, res.InitiateRequest, inflateSAMLRequest(t, values.Get("SAMLRequest")))
+	assert.NotContains(t, res.InitiateRequest, "Signature")
+}
+
+func TestInit_RedirectSigned(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	res := saml.Init(&saml.InitRequest{
+		RequestID:  "saml_flow_1",
+		SPEntityID: "http://sp.example.com",
+		Now:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		Binding:    saml.BindingHTTPRedirect,
+		RelayState: "saml_flow_1",
+		SigningKey: key,
+	})
+
+	// the Redirect binding signs the query string, not the AuthnRequest
+	assert.NotContains(t, res.InitiateRequest, "Signature")
+
+	values, err := url.ParseQuery(res.RedirectQuery)
+	require.NoError(t, err)
+	assert.Equal(t, dsig.SignatureAlgorithmRSASHA256, values.Get("SigAlg"))
+
+	signature, err := base64.StdEncoding.DecodeString(values.Get("Signature"))
+	require.NoError(t, err)
+
+	signed := res.RedirectQuery[:strings.Index(res.RedirectQuery, "&Signature=")]
+	assert.True(t, strings.HasPrefix(signed, "SAMLRequest="))
+	hashed := sha256.Sum256([]byte(signed))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, hashed[:], signature))
+}
+
+func TestInit_Options(t *testing.T) {
+	res := saml.Init(&saml.InitRequest{
+		RequestID:                       "saml_flow_1",
+		SPEntityID:                      "http://sp.example.com",
+		Now:                             time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		ForceAuthn:                      true,
+		IsPassive:                       true,
+		NameIDPolicyFormat:              "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		RequestedAuthnContextClassRefs:  []string{"urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract", "urn:oasis:names:tc:SAML:2.0:ac:classes:X509"},
+		RequestedAuthnContextComparison: "minimum",
+	})
+
+	assert.Equal(t, `<AuthnRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol" ID="saml_flow_1" Version="2.0" IssueInstant="2024-01-01T00:00:00Z" ForceAuthn="true" IsPassive="true"><Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">http://sp.example.com</Issuer><NameIDPolicy xmlns="urn:oasis:names:tc:SAML:2.0:protocol" Format="urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"></NameIDPolicy><RequestedAuthnContext xmlns="urn:oasis:names:tc:SAML:2.0:protocol" Comparison="minimum"><AuthnContextClassRef xmlns="urn:oasis:names:tc:SAML:2.0:assertion">urn:oasis:names:tc:SAML:2.0:ac:classes:MobileTwoFactorContract</AuthnContextClassRef><AuthnContextClassRef xmlns="urn:oasis:names:tc:SAML:2.0:assertion">urn:oasis:names:tc:SAML:2.0:ac:classes:X509</AuthnContextClassRef></RequestedAuthnContext></AuthnRequest>`, res.InitiateRequest)
+}
+
+func TestInit_OptionsSigned(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	res := saml.Init(&saml.InitRequest{
+		RequestID:                      "saml_flow_1",
+		SPEntityID:                     "http://sp.example.com",
+		Now:                            time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		SigningKey:                     key,
+		NameIDPolicyFormat:             "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+		RequestedAuthnContextClassRefs: []string{"urn:oasis:names:tc:SAML:2.0:ac:classes:X509"},
+	})
+
+	// NameIDPolicy and RequestedAuthnContext must follow the signature
+	assert.Contains(t, res.InitiateRequest, `</ds:Signature><NameIDPolicy`)
+	assert.True(t, strings.HasSuffix(res.InitiateRequest, `</RequestedAuthnContext></AuthnRequest>`))
+}
+
+func inflateSAMLRequest(t *testing.T, samlRequest string) string {
+	deflated, err := base64.StdEncoding.DecodeString(samlRequest)
+	require.NoError(t, err)
+
+	inflated, err := io.ReadAll(flate.NewReader(bytes.NewReader(deflated)))
+	require.NoError(t, err)
+
+	return string(inflated)
+}
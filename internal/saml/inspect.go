@@ -0,0 +1,89 @@
+package saml
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/saml/samltypes"
+)
+
+// InspectResponse is a step-by-step account of validating a SAML response,
+// for debugging logins that fail.
+type InspectResponse struct {
+	// Data is the SAML response XML, decoded from base64.
+	Data []byte
+
+	// Response is the parsed SAML response. If the assertion was encrypted and
+	// could be decrypted, Response contains the decrypted assertion.
+	Response samltypes.Response
+
+	// Encrypted is whether the assertion was encrypted. DecryptError is
+	// populated if it could not be decrypted.
+	Encrypted    bool
+	DecryptError error
+
+	// Signatures describes the signatures on the response and its assertion.
+	Signatures *dsig.InspectResponse
+
+	// AssertionErrors are all of the checks on the contents of the assertion
+	// that fail. They are evaluated even if the assertion's signature does
+	// not verify, which Validate would never do.
+	AssertionErrors []*ValidateError
+
+	// ValidateResponse and ValidateError are what Validate returns for the
+	// same request.
+	ValidateResponse *ValidateResponse
+	ValidateError    error
+}
+
+// Inspect runs each of the steps of Validate on req, and reports on all of
+// them rather than stopping at the first failure. It only returns an error if
+// req.SAMLResponse cannot be parsed at all.
+func Inspect(req *ValidateRequest) (*InspectResponse, error) {
+	var res InspectResponse
+
+	data, err := base64.StdEncoding.DecodeString(req.SAMLResponse)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml response: %w", err)
+	}
+	res.Data = data
+
+	if err := xml.Unmarshal(data, &res.Response); err != nil {
+		return nil, fmt.Errorf("parse saml response: %w", err)
+	}
+
+	decryptedData, err := decryptAssertion(data, req.SPDecryptionKeys)
+	if err != nil {
+		res.Encrypted = true
+		res.DecryptError = err
+	}
+
+	if decryptedData != nil {
+		res.Encrypted = true
+		res.Response = samltypes.Response{}
+		if err := xml.Unmarshal(decryptedData, &res.Response); err != nil {
+			return nil, fmt.Errorf("parse decrypted saml response: %w", err)
+		}
+	}
+
+	res.Signatures, err = dsig.Inspect(&dsig.VerifyRequest{
+		Certificates:               activeCertificates(req.IDPCertificates, req.Now),
+		Data:                       data,
+		DecryptedData:              decryptedData,
+		AllowedSignatureAlgorithms: req.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    req.AllowedDigestAlgorithms,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parse saml response: %w", err)
+	}
+
+	// a response with a non-success status has no assertion to check
+	if statusCode := res.Response.Status.StatusCode.Value; statusCode == "" || statusCode == statusCodeSuccess {
+		res.AssertionErrors = assertionErrors(req, res.Response, res.Response.Assertion, &ValidateError{})
+	}
+
+	res.ValidateResponse, res.ValidateError = Validate(req)
+	return &res, nil
+}
@@ -0,0 +1,96 @@
+package saml_test
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/saml/samltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInspect(t *testing.T) {
+	res, err := inspectOkta(t, func(s string) string { return s }, "http://localhost:8080", time.Date(2024, 4, 25, 20, 31, 55, 494000000, time.UTC))
+	require.NoError(t, err)
+
+	assert.NoError(t, res.ValidateError)
+	assert.Empty(t, res.AssertionErrors)
+	assert.Empty(t, res.Signatures.Problems)
+	assert.Empty(t, res.Signatures.Response.Problems)
+	assert.Empty(t, res.Signatures.Assertion.Problems)
+
+	assert.Equal(t, "#id35528194006743571812188338", res.Signatures.Assertion.ReferenceURI)
+	assert.Equal(t, "gYuidj1kP4bdhylZxf86HtfmknIINpURdJGSpKXoM3I=", res.Signatures.Assertion.ComputedDigest)
+	assert.Equal(t, res.Signatures.Assertion.ExpectedDigest, res.Signatures.Assertion.ComputedDigest)
+	assert.True(t, res.Signatures.Assertion.KeyInfoCertificateTrusted)
+	assert.Equal(t, idpCertificateFromDir(t, "testdata/assertions/okta"), res.Signatures.Assertion.SignedBy)
+}
+
+func TestInspect_AllProblems(t *testing.T) {
+	// tampered, for the wrong SP, and expired: Validate stops at the first of
+	// these, but Inspect reports them all
+	tamper := func(s string) string {
+		return strings.Replace(s, "ulysse.carion@", "evil@", 1)
+	}
+
+	res, err := inspectOkta(t, tamper, "http://sp.example.com", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+
+	assert.ErrorIs(t, res.ValidateError, dsig.ErrBadDigest)
+	assert.Equal(t, []error{dsig.ErrBadDigest}, res.Signatures.Response.Problems)
+	assert.Equal(t, []error{dsig.ErrBadDigest}, res.Signatures.Assertion.Problems)
+
+	var assertionErrors []string
+	for _, err := range res.AssertionErrors {
+		assertionErrors = append(assertionErrors, err.Error())
+	}
+	assert.Equal(t, []string{
+		"bad sp entity id: http://localhost:8080",
+		"saml assertion is expired",
+		"saml subject confirmation is expired",
+	}, assertionErrors)
+}
+
+func TestInspect_UntrustedCertificate(t *testing.T) {
+	// as if the IDP rolled over to a certificate not yet trusted: the report
+	// still says which certificate signed it
+	assertion, err := os.ReadFile("testdata/assertions/okta/assertion.xml")
+	require.NoError(t, err)
+
+	res, err := saml.Inspect(&saml.ValidateRequest{
+		SAMLResponse:    base64.StdEncoding.EncodeToString(assertion),
+		IDPCertificates: samltest.NewIDP(t).IDPCertificates(),
+		IDPEntityID:     "http://www.okta.com/exkdoocxa1VmjpXmX697",
+		SPEntityID:      "http://localhost:8080",
+		Now:             time.Date(2024, 4, 25, 20, 31, 55, 494000000, time.UTC),
+	})
+	require.NoError(t, err)
+
+	oktaCert := idpCertificateFromDir(t, "testdata/assertions/okta")
+	assert.Equal(t, []error{dsig.BadCertificateError{BadCertificate: oktaCert}}, res.Signatures.Assertion.Problems)
+	assert.False(t, res.Signatures.Assertion.KeyInfoCertificateTrusted)
+	assert.Equal(t, oktaCert, res.Signatures.Assertion.SignedBy)
+	assert.Equal(t, res.Signatures.Assertion.ExpectedDigest, res.Signatures.Assertion.ComputedDigest)
+
+	var validateError *saml.ValidateError
+	require.ErrorAs(t, res.ValidateError, &validateError)
+	assert.Equal(t, oktaCert, validateError.BadCertificate)
+}
+
+func inspectOkta(t *testing.T, modify func(string) string, spEntityID string, now time.Time) (*saml.InspectResponse, error) {
+	assertion, err := os.ReadFile("testdata/assertions/okta/assertion.xml")
+	require.NoError(t, err)
+
+	return saml.Inspect(&saml.ValidateRequest{
+		SAMLResponse:    base64.StdEncoding.EncodeToString([]byte(modify(string(assertion)))),
+		IDPCertificates: []saml.IDPCertificate{{Certificate: idpCertificateFromDir(t, "testdata/assertions/okta")}},
+		IDPEntityID:     "http://www.okta.com/exkdoocxa1VmjpXmX697",
+		SPEntityID:      spEntityID,
+		Now:             now,
+	})
+}
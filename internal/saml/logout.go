@@ -0,0 +1,443 @@
+package saml
+
+import (
+	"bytes"
+	"compress/flate"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+)
+
+// StatusSuccess is the StatusCode of a successful LogoutResponse.
+const StatusSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+
+// maxInflatedMessageSize bounds how large an HTTP-Redirect binding message may
+// inflate to.
+const maxInflatedMessageSize = 1 << 20
+
+// InitLogoutRequest describes an SP-initiated LogoutRequest. Logout messages
+// are always sent with BindingHTTPRedirect.
+type InitLogoutRequest struct {
+	RequestID  string
+	SPEntityID string
+
+	// Destination is the IDP's single logout URL.
+	Destination string
+
+	// NameID, NameIDFormat and SessionIndex identify the IDP session to
+	// terminate, as they appeared in the assertion that established it.
+	// NameIDFormat and SessionIndex are optional.
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+
+	Now        time.Time
+	RelayState string
+
+	// SigningKey, if set, is used to sign the redirect query string.
+	SigningKey *rsa.PrivateKey
+}
+
+type InitLogoutResponse struct {
+	LogoutRequest string
+
+	// RedirectQuery is the query string to append to the IDP's single logout
+	// URL.
+	RedirectQuery string
+}
+
+func InitLogout(req *InitLogoutRequest) *InitLogoutResponse {
+	var logoutReq logoutRequest
+	logoutReq.ID = req.RequestID
+	logoutReq.Version = "2.0"
+	logoutReq.IssueInstant = req.Now.UTC().Truncate(time.Millisecond)
+	logoutReq.Destination = req.Destination
+	logoutReq.Issuer.Name = req.SPEntityID
+	logoutReq.NameID.Format = req.NameIDFormat
+	logoutReq.NameID.Value = req.NameID
+	if req.SessionIndex != "" {
+		logoutReq.SessionIndexes = []string{req.SessionIndex}
+	}
+
+	logoutReqData, err := xml.Marshal(logoutReq)
+	if err != nil {
+		panic(fmt.Errorf("marshal LogoutRequest: %w", err))
+	}
+
+	return &InitLogoutResponse{
+		LogoutRequest: string(logoutReqData),
+		RedirectQuery: redirectQuery(req.SigningKey, "SAMLRequest", deflateBase64(logoutReqData), req.RelayState),
+	}
+}
+
+// RespondLogoutRequest describes the LogoutResponse the SP sends after handling
+// an IDP-initiated LogoutRequest.
+type RespondLogoutRequest struct {
+	ResponseID   string
+	InResponseTo string
+	SPEntityID   string
+
+	// Destination is the IDP's single logout URL.
+	Destination string
+
+	// StatusCode is the top-level status of the response. If empty,
+	// StatusSuccess is used.
+	StatusCode string
+
+	Now        time.Time
+	RelayState string
+
+	// SigningKey, if set, is used to sign the redirect query string.
+	SigningKey *rsa.PrivateKey
+}
+
+type RespondLogoutResponse struct {
+	LogoutResponse string
+
+	// RedirectQuery is the query string to append to the IDP's single logout
+	// URL.
+	RedirectQuery string
+}
+
+func RespondLogout(req *RespondLogoutRequest) *RespondLogoutResponse {
+	statusCode := req.StatusCode
+	if statusCode == "" {
+		statusCode = StatusSuccess
+	}
+
+	var logoutRes logoutResponse
+	logoutRes.ID = req.ResponseID
+	logoutRes.Version = "2.0"
+	logoutRes.IssueInstant = req.Now.UTC().Truncate(time.Millisecond)
+	logoutRes.Destination = req.Destination
+	logoutRes.InResponseTo = req.InResponseTo
+	logoutRes.Issuer.Name = req.SPEntityID
+	logoutRes.Status.StatusCode.Value = statusCode
+
+	logoutResData, err := xml.Marshal(logoutRes)
+	if err != nil {
+		panic(fmt.Errorf("marshal LogoutResponse: %w", err))
+	}
+
+	return &RespondLogoutResponse{
+		LogoutResponse: string(logoutResData),
+		RedirectQuery:  redirectQuery(req.SigningKey, "SAMLResponse", deflateBase64(logoutResData), req.RelayState),
+	}
+}
+
+type ReceiveLogoutRequest struct {
+	// Binding is the binding the message was received with.
+	Binding string
+
+	// RawQuery is the query string, as it appeared on the wire, that a
+	// BindingHTTPRedirect message was received in.
+	RawQuery string
+
+	// Form is the form a BindingHTTPPost message was received in.
+	Form url.Values
+
+	IDPCertificates []IDPCertificate
+	IDPEntityID     string
+	Now             time.Time
+
+	// AllowedSignatureAlgorithms and AllowedDigestAlgorithms are the XML DSig
+	// algorithm identifiers the IDP may sign with. If empty, the dsig package's
+	// defaults are used.
+	AllowedSignatureAlgorithms []string
+	AllowedDigestAlgorithms    []string
+}
+
+// ReceiveLogoutResponse is a validated logout message from the IDP. Exactly
+// one of LogoutRequest and LogoutResponse is set.
+type ReceiveLogoutResponse struct {
+	LogoutRequest  *LogoutRequest
+	LogoutResponse *LogoutResponse
+	RelayState     string
+
+	// IDPCertificate is the certificate that verified the signature.
+	IDPCertificate *x509.Certificate
+}
+
+// LogoutRequest is an IDP-initiated request to terminate the sessions of
+// NameID. If SessionIndexes is empty, all of NameID's sessions are to be
+// terminated.
+type LogoutRequest struct {
+	ID             string
+	NameID         string
+	SessionIndexes []string
+}
+
+// LogoutResponse is the IDP's response to an SP-initiated LogoutRequest.
+type LogoutResponse struct {
+	ID           string
+	InResponseTo string
+	StatusCode   string
+}
+
+var (
+	ErrMalformedLogout = errors.New("saml: malformed logout message")
+	ErrUnsignedLogout  = errors.New("saml: unsigned logout message")
+	ErrExpiredLogout   = errors.New("saml: expired logout message")
+)
+
+// ReceiveLogout parses and verifies a LogoutRequest or LogoutResponse sent by
+// the IDP. The message must be signed by one of the IDP's certificates.
+func ReceiveLogout(req *ReceiveLogoutRequest) (*ReceiveLogoutResponse, error) {
+	var (
+		param     string
+		data      []byte
+		res       ReceiveLogoutResponse
+		certs     = activeCertificates(req.IDPCertificates, req.Now)
+		verifyErr error
+	)
+
+	switch req.Binding {
+	case BindingHTTPRedirect:
+		query, err := parseRawQuery(req.RawQuery)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedLogout, err.Error())
+		}
+
+		param, data, err = redirectMessage(query)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedLogout, err.Error())
+		}
+
+		if res.RelayState, err = url.QueryUnescape(query["RelayState"]); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedLogout, err.Error())
+		}
+
+		res.IDPCertificate, verifyErr = verifyRedirectQuery(query, param, certs, req.AllowedSignatureAlgorithms)
+	case BindingHTTPPost:
+		for _, p := range []string{"SAMLRequest", "SAMLResponse"} {
+			if req.Form.Has(p) {
+				param = p
+			}
+		}
+		if param == "" {
+			return nil, fmt.Errorf("%w: no SAMLRequest or SAMLResponse", ErrMalformedLogout)
+		}
+
+		unverifiedData, err := base64.StdEncoding.DecodeString(req.Form.Get(param))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedLogout, err.Error())
+		}
+
+		local := "LogoutRequest"
+		if param == "SAMLResponse" {
+			local = "LogoutResponse"
+		}
+
+		// consume only the canonicalized message the signature covers
+		data, res.IDPCertificate, verifyErr = dsig.VerifyMessage(&dsig.VerifyRequest{
+			Certificates:               certs,
+			Data:                       unverifiedData,
+			AllowedSignatureAlgorithms: req.AllowedSignatureAlgorithms,
+			AllowedDigestAlgorithms:    req.AllowedDigestAlgorithms,
+		}, local)
+		res.RelayState = req.Form.Get("RelayState")
+	default:
+		return nil, fmt.Errorf("unsupported logout binding: %q", req.Binding)
+	}
+
+	if verifyErr != nil {
+		if errors.Is(verifyErr, dsig.ErrUnsigned) {
+			return nil, ErrUnsignedLogout
+		}
+		return nil, fmt.Errorf("verify logout signature: %w", verifyErr)
+	}
+
+	var issuer string
+	if param == "SAMLRequest" {
+		var logoutReq logoutRequest
+		if err := xml.Unmarshal(data, &logoutReq); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedLogout, err.Error())
+		}
+
+		if logoutReq.NameID.Value == "" {
+			return nil, fmt.Errorf("%w: LogoutRequest has no NameID", ErrMalformedLogout)
+		}
+
+		if logoutReq.NotOnOrAfter != nil && !req.Now.Before(*logoutReq.NotOnOrAfter) {
+			return nil, ErrExpiredLogout
+		}
+
+		issuer = logoutReq.Issuer.Name
+		res.LogoutRequest = &LogoutRequest{
+			ID:             logoutReq.ID,
+			NameID:         logoutReq.NameID.Value,
+			SessionIndexes: logoutReq.SessionIndexes,
+		}
+	} else {
+		var logoutRes logoutResponse
+		if err := xml.Unmarshal(data, &logoutRes); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedLogout, err.Error())
+		}
+
+		issuer = logoutRes.Issuer.Name
+		res.LogoutResponse = &LogoutResponse{
+			ID:           logoutRes.ID,
+			InResponseTo: logoutRes.InResponseTo,
+			StatusCode:   logoutRes.Status.StatusCode.Value,
+		}
+	}
+
+	if issuer != req.IDPEntityID {
+		return nil, fmt.Errorf("bad idp entity id: %s", issuer)
+	}
+
+	return &res, nil
+}
+
+// parseRawQuery splits a query string into its parameters, leaving their
+// values URL-encoded as they appeared on the wire. The HTTP-Redirect binding
+// signs those encoded values, which url.ParseQuery does not preserve.
+func parseRawQuery(rawQuery string) (map[string]string, error) {
+	query := map[string]string{}
+	for _, kv := range strings.Split(rawQuery, "&") {
+		if kv == "" {
+			continue
+		}
+
+		k, v, _ := strings.Cut(kv, "=")
+		k, err := url.QueryUnescape(k)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := query[k]; ok {
+			return nil, fmt.Errorf("duplicate query parameter: %s", k)
+		}
+		query[k] = v
+	}
+	return query, nil
+}
+
+// redirectMessage returns the name of the parameter carrying an HTTP-Redirect
+// binding message, and the inflated message.
+func redirectMessage(query map[string]string) (string, []byte, error) {
+	var param string
+	for _, p := range []string{"SAMLRequest", "SAMLResponse"} {
+		if _, ok := query[p]; ok {
+			if param != "" {
+				return "", nil, fmt.Errorf("both SAMLRequest and SAMLResponse present")
+			}
+			param = p
+		}
+	}
+	if param == "" {
+		return "", nil, fmt.Errorf("no SAMLRequest or SAMLResponse")
+	}
+
+	message, err := url.QueryUnescape(query[param])
+	if err != nil {
+		return "", nil, err
+	}
+
+	deflated, err := base64.StdEncoding.DecodeString(message)
+	if err != nil {
+		return "", nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(flate.NewReader(bytes.NewReader(deflated)), maxInflatedMessageSize+1))
+	if err != nil {
+		return "", nil, fmt.Errorf("inflate: %w", err)
+	}
+	if len(data) > maxInflatedMessageSize {
+		return "", nil, fmt.Errorf("message too large")
+	}
+
+	return param, data, nil
+}
+
+// verifyRedirectQuery verifies the signature on an HTTP-Redirect binding
+// message carried in param.
+func verifyRedirectQuery(query map[string]string, param string, certs []*x509.Certificate, allowedSignatureAlgorithms []string) (*x509.Certificate, error) {
+	rawSigAlg, ok := query["SigAlg"]
+	if !ok {
+		return nil, dsig.ErrUnsigned
+	}
+	rawSignature, ok := query["Signature"]
+	if !ok {
+		return nil, dsig.ErrUnsigned
+	}
+
+	sigAlg, err := url.QueryUnescape(rawSigAlg)
+	if err != nil {
+		return nil, err
+	}
+
+	signatureBase64, err := url.QueryUnescape(rawSignature)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return nil, err
+	}
+
+	// the signature covers these parameters in this exact order, as they
+	// appeared URL-encoded on the wire
+	signed := param + "=" + query[param]
+	if rawRelayState, ok := query["RelayState"]; ok {
+		signed += "&RelayState=" + rawRelayState
+	}
+	signed += "&SigAlg=" + rawSigAlg
+
+	return dsig.VerifyRedirect(&dsig.VerifyRedirectRequest{
+		Certificates:               certs,
+		SignatureAlgorithm:         sigAlg,
+		Data:                       []byte(signed),
+		Signature:                  signature,
+		AllowedSignatureAlgorithms: allowedSignatureAlgorithms,
+	})
+}
+
+type logoutRequest struct {
+	XMLName      xml.Name   `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutRequest"`
+	ID           string     `xml:"ID,attr"`
+	Version      string     `xml:"Version,attr"`
+	IssueInstant time.Time  `xml:"IssueInstant,attr"`
+	Destination  string     `xml:"Destination,attr,omitempty"`
+	NotOnOrAfter *time.Time `xml:"NotOnOrAfter,attr,omitempty"`
+	Issuer       struct {
+		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		Name    string   `xml:",chardata"`
+	} `xml:"Issuer"`
+	NameID struct {
+		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+		Format  string   `xml:"Format,attr,omitempty"`
+		Value   string   `xml:",chardata"`
+	} `xml:"NameID"`
+	SessionIndexes []string `xml:"urn:oasis:names:tc:SAML:2.0:protocol SessionIndex"`
+}
+
+type logoutResponse struct {
+	XMLName      xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:protocol LogoutResponse"`
+	ID           string    `xml:"ID,attr"`
+	Version      string    `xml:"Version,attr"`
+	IssueInstant time.Time `xml:"IssueInstant,attr"`
+	Destination  string    `xml:"Destination,attr,omitempty"`
+	InResponseTo string    `xml:"InResponseTo,attr,omitempty"`
+	Issuer       struct {
+		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		Name    string   `xml:",chardata"`
+	} `xml:"Issuer"`
+	Status struct {
+		XMLName    xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol Status"`
+		StatusCode struct {
+			XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+			Value   string   `xml:"Value,attr"`
+		} `xml:"StatusCode"`
+	} `xml:"Status"`
+}
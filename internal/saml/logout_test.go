@@ -0,0 +1,229 @@
+package saml_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitLogout(t *testing.T) {
+	res := saml.InitLogout(&saml.InitLogoutRequest{
+		RequestID:    "saml_logout_1",
+		SPEntityID:   "http://sp.example.com",
+		Destination:  "http://idp.example.com/slo",
+		NameID:       "john.doe@example.com",
+		SessionIndex: "session_1",
+		Now:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		RelayState:   "saml_logout_1",
+	})
+
+	assert.Equal(t, `<LogoutRequest xmlns="urn:oasis:names:tc:SAML:2.0:protocol" ID="saml_logout_1" Version="2.0" IssueInstant="2024-01-01T00:00:00Z" Destination="http://idp.example.com/slo"><Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">http://sp.example.com</Issuer><NameID xmlns="urn:oasis:names:tc:SAML:2.0:assertion">john.doe@example.com</NameID><SessionIndex xmlns="urn:oasis:names:tc:SAML:2.0:protocol">session_1</SessionIndex></LogoutRequest>`, res.LogoutRequest)
+
+	values, err := url.ParseQuery(res.RedirectQuery)
+	require.NoError(t, err)
+	assert.Equal(t, res.LogoutRequest, inflateSAMLRequest(t, values.Get("SAMLRequest")))
+	assert.Equal(t, "saml_logout_1", values.Get("RelayState"))
+	assert.False(t, values.Has("Signature"))
+}
+
+func TestInitLogout_NameIDFormat(t *testing.T) {
+	res := saml.InitLogout(&saml.InitLogoutRequest{
+		RequestID:    "saml_logout_1",
+		SPEntityID:   "http://sp.example.com",
+		Destination:  "http://idp.example.com/slo",
+		NameID:       "00u1a2b3c4d5e6f7g8h9",
+		NameIDFormat: "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent",
+		Now:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.Contains(t, res.LogoutRequest, `<NameID xmlns="urn:oasis:names:tc:SAML:2.0:assertion" Format="urn:oasis:names:tc:SAML:2.0:nameid-format:persistent">00u1a2b3c4d5e6f7g8h9</NameID>`)
+}
+
+func TestRespondLogout(t *testing.T) {
+	res := saml.RespondLogout(&saml.RespondLogoutRequest{
+		ResponseID:   "saml_logout_1",
+		InResponseTo: "idp_request_1",
+		SPEntityID:   "http://sp.example.com",
+		Destination:  "http://idp.example.com/slo",
+		Now:          time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.Equal(t, `<LogoutResponse xmlns="urn:oasis:names:tc:SAML:2.0:protocol" ID="saml_logout_1" Version="2.0" IssueInstant="2024-01-01T00:00:00Z" Destination="http://idp.example.com/slo" InResponseTo="idp_request_1"><Issuer xmlns="urn:oasis:names:tc:SAML:2.0:assertion">http://sp.example.com</Issuer><Status xmlns="urn:oasis:names:tc:SAML:2.0:protocol"><StatusCode xmlns="urn:oasis:names:tc:SAML:2.0:protocol" Value="urn:oasis:names:tc:SAML:2.0:status:Success"></StatusCode></Status></LogoutResponse>`, res.LogoutResponse)
+
+	values, err := url.ParseQuery(res.RedirectQuery)
+	require.NoError(t, err)
+	assert.Equal(t, res.LogoutResponse, inflateSAMLRequest(t, values.Get("SAMLResponse")))
+}
+
+func TestReceiveLogout_Redirect(t *testing.T) {
+	key, cert := logoutKeyPair(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the IDP's LogoutRequest is built the same way as the SP's
+	logoutReq := saml.InitLogout(&saml.InitLogoutRequest{
+		RequestID:    "idp_request_1",
+		SPEntityID:   "http://idp.example.com",
+		NameID:       "john.doe@example.com",
+		SessionIndex: "session_1",
+		Now:          now,
+		RelayState:   "relay state",
+		SigningKey:   key,
+	})
+
+	receiveReq := saml.ReceiveLogoutRequest{
+		Binding:         saml.BindingHTTPRedirect,
+		RawQuery:        logoutReq.RedirectQuery,
+		IDPCertificates: []saml.IDPCertificate{{Certificate: cert}},
+		IDPEntityID:     "http://idp.example.com",
+		Now:             now,
+	}
+
+	res, err := saml.ReceiveLogout(&receiveReq)
+	require.NoError(t, err)
+	assert.Equal(t, &saml.LogoutRequest{
+		ID:             "idp_request_1",
+		NameID:         "john.doe@example.com",
+		SessionIndexes: []string{"session_1"},
+	}, res.LogoutRequest)
+	assert.Nil(t, res.LogoutResponse)
+	assert.Equal(t, "relay state", res.RelayState)
+	assert.Equal(t, cert, res.IDPCertificate)
+
+	tampered := receiveReq
+	tampered.RawQuery = strings.Replace(logoutReq.RedirectQuery, "RelayState=relay+state", "RelayState=other", 1)
+	_, err = saml.ReceiveLogout(&tampered)
+	assert.ErrorIs(t, err, dsig.ErrBadRedirectSignature)
+
+	unsigned := receiveReq
+	unsigned.RawQuery = logoutReq.RedirectQuery[:strings.Index(logoutReq.RedirectQuery, "&SigAlg=")]
+	_, err = saml.ReceiveLogout(&unsigned)
+	assert.ErrorIs(t, err, saml.ErrUnsignedLogout)
+
+	badIssuer := receiveReq
+	badIssuer.IDPEntityID = "http://other.example.com"
+	_, err = saml.ReceiveLogout(&badIssuer)
+	assert.EqualError(t, err, "bad idp entity id: http://idp.example.com")
+
+	inactiveCert := receiveReq
+	inactiveCert.IDPCertificates = []saml.IDPCertificate{{Certificate: cert, NotAfter: now}}
+	_, err = saml.ReceiveLogout(&inactiveCert)
+	assert.ErrorIs(t, err, dsig.ErrBadRedirectSignature)
+}
+
+func TestReceiveLogout_RedirectResponse(t *testing.T) {
+	key, cert := logoutKeyPair(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	logoutRes := saml.RespondLogout(&saml.RespondLogoutRequest{
+		ResponseID:   "idp_response_1",
+		InResponseTo: "saml_logout_1",
+		SPEntityID:   "http://idp.example.com",
+		Now:          now,
+		RelayState:   "saml_logout_1",
+		SigningKey:   key,
+	})
+
+	res, err := saml.ReceiveLogout(&saml.ReceiveLogoutRequest{
+		Binding:         saml.BindingHTTPRedirect,
+		RawQuery:        logoutRes.RedirectQuery,
+		IDPCertificates: []saml.IDPCertificate{{Certificate: cert}},
+		IDPEntityID:     "http://idp.example.com",
+		Now:             now,
+	})
+	require.NoError(t, err)
+	assert.Nil(t, res.LogoutRequest)
+	assert.Equal(t, &saml.LogoutResponse{
+		ID:           "idp_response_1",
+		InResponseTo: "saml_logout_1",
+		StatusCode:   saml.StatusSuccess,
+	}, res.LogoutResponse)
+	assert.Equal(t, "saml_logout_1", res.RelayState)
+}
+
+func TestReceiveLogout_Post(t *testing.T) {
+	key, cert := logoutKeyPair(t)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	open := `<samlp:LogoutRequest xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="idp_request_1" Version="2.0" IssueInstant="2024-01-01T00:00:00Z" NotOnOrAfter="2024-01-01T00:05:00Z"><saml:Issuer>http://idp.example.com</saml:Issuer>`
+	rest := `<saml:NameID>john.doe@example.com</saml:NameID><samlp:SessionIndex>session_1</samlp:SessionIndex><samlp:SessionIndex>session_2</samlp:SessionIndex></samlp:LogoutRequest>`
+
+	signature, err := dsig.Sign(&dsig.SignRequest{
+		Key:         key,
+		Certificate: cert,
+		Data:        []byte(open + rest),
+	})
+	require.NoError(t, err)
+
+	form := url.Values{}
+	form.Set("SAMLRequest", base64.StdEncoding.EncodeToString([]byte(open+string(signature)+rest)))
+	form.Set("RelayState", "relay state")
+
+	receiveReq := saml.ReceiveLogoutRequest{
+		Binding:         saml.BindingHTTPPost,
+		Form:            form,
+		IDPCertificates: []saml.IDPCertificate{{Certificate: cert}},
+		IDPEntityID:     "http://idp.example.com",
+		Now:             now,
+	}
+
+	res, err := saml.ReceiveLogout(&receiveReq)
+	require.NoError(t, err)
+	assert.Equal(t, &saml.LogoutRequest{
+		ID:             "idp_request_1",
+		NameID:         "john.doe@example.com",
+		SessionIndexes: []string{"session_1", "session_2"},
+	}, res.LogoutRequest)
+	assert.Equal(t, "relay state", res.RelayState)
+
+	expired := receiveReq
+	expired.Now = now.Add(5 * time.Minute)
+	_, err = saml.ReceiveLogout(&expired)
+	assert.ErrorIs(t, err, saml.ErrExpiredLogout)
+
+	unsignedForm := url.Values{}
+	unsignedForm.Set("SAMLRequest", base64.StdEncoding.EncodeToString([]byte(open+rest)))
+	unsigned := receiveReq
+	unsigned.Form = unsignedForm
+	_, err = saml.ReceiveLogout(&unsigned)
+	assert.ErrorIs(t, err, saml.ErrUnsignedLogout)
+
+	tamperedForm := url.Values{}
+	tamperedForm.Set("SAMLRequest", base64.StdEncoding.EncodeToString([]byte(open+string(signature)+strings.Replace(rest, "john.doe", "jane.doe", 1))))
+	tampered := receiveReq
+	tampered.Form = tamperedForm
+	_, err = saml.ReceiveLogout(&tampered)
+	assert.ErrorIs(t, err, dsig.ErrBadDigest)
+}
+
+func logoutKeyPair(t *testing.T) (*rsa.PrivateKey, *x509.Certificate) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2034, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+	}, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+
+	return key, cert
+}
@@ -1,49 +1,429 @@
 package saml
 
 import (
+	"bytes"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ssoready/ssoready/internal/saml/samltypes"
 )
 
+type ParseMetadataRequest struct {
+	Metadata []byte
+
+	// EntityID selects which IDP to use, if Metadata describes several. If
+	// empty, the first IDP is used.
+	EntityID string
+
+	// Now is used to warn about expired certificates. If zero, certificates
+	// are not checked for expiry.
+	Now time.Time
+}
+
 type ParseMetadataResponse struct {
-	IDPEntityID    string
+	IDPEntityID string
+
+	// IDPCertificate is the first of IDPCertificates.
 	IDPCertificate *x509.Certificate
-	RedirectURL    string
+
+	// IDPCertificates are all of the certificates the IDP may sign with, in
+	// the order the metadata lists them.
+	IDPCertificates []*x509.Certificate
+
+	RedirectURL string
+
+	// Binding is the binding RedirectURL accepts AuthnRequests with. If the
+	// IDP supports both, BindingHTTPPost is preferred.
+	Binding string
+
+	// SLOURL is the IDP's single logout URL for BindingHTTPRedirect, which
+	// SSOReady sends logout messages with. It is empty if the IDP does not
+	// support single logout with that binding.
+	SLOURL string
+
+	// ArtifactResolutionURL is the IDP's artifact resolution service for
+	// BindingSOAP, which SSOReady resolves artifacts with when the IDP sends
+	// assertions using BindingHTTPArtifact. It is empty if the IDP does not
+	// support the artifact binding.
+	ArtifactResolutionURL string
+
+	// SingleSignOnServices and SingleLogoutServices are all of the IDP's
+	// endpoints, including those with bindings SSOReady does not use.
+	SingleSignOnServices []Endpoint
+	SingleLogoutServices []Endpoint
+
+	// Vendor is the IDP product the metadata appears to describe, if it is
+	// recognized. See DetectVendor.
+	Vendor Vendor
+
+	// Warnings are problems with the metadata that did not prevent it from
+	// being parsed, but may need attention.
+	Warnings []MetadataWarning
+}
+
+type MetadataWarningCode string
+
+const (
+	MetadataWarningMultipleEntities            MetadataWarningCode = "multiple_entities"
+	MetadataWarningMultipleSigningCertificates MetadataWarningCode = "multiple_signing_certificates"
+	MetadataWarningBadCertificate              MetadataWarningCode = "bad_certificate"
+	MetadataWarningExpiredCertificate          MetadataWarningCode = "expired_certificate"
+	MetadataWarningNoHTTPPostBinding           MetadataWarningCode = "no_http_post_binding"
+	MetadataWarningNoHTTPRedirectSingleLogout  MetadataWarningCode = "no_http_redirect_single_logout"
+)
+
+type MetadataWarning struct {
+	Code    MetadataWarningCode
+	Message string
 }
 
-func ParseMetadata(b []byte) (*ParseMetadataResponse, error) {
-	var metadata samltypes.Metadata
-	if err := xml.Unmarshal(b, &metadata); err != nil {
+var (
+	ErrMalformedMetadata    = errors.New("saml: malformed metadata")
+	ErrNoIDPEntity          = errors.New("saml: metadata does not describe an identity provider")
+	ErrNoSigningCertificate = errors.New("saml: metadata has no signing certificate")
+	ErrNoSupportedBinding   = errors.New("saml: metadata has no HTTP-POST or HTTP-Redirect binding")
+)
+
+func ParseMetadata(req *ParseMetadataRequest) (*ParseMetadataResponse, error) {
+	entities, err := parseMetadataEntities(req.Metadata)
+	if err != nil {
 		return nil, err
 	}
 
-	asn1Base64 := metadata.IDPSSODescriptor.KeyDescriptor.KeyInfo.X509Data.X509Certificate.Value
-	asn1Base64 = strings.ReplaceAll(asn1Base64, " ", "")
-	asn1Base64 = strings.ReplaceAll(asn1Base64, "\n", "")
-	asn1Data, err := base64.StdEncoding.DecodeString(asn1Base64)
+	var res ParseMetadataResponse
+
+	var idps []samltypes.Metadata
+	for _, entity := range entities {
+		if entity.IDPSSODescriptor != nil {
+			idps = append(idps, entity)
+		}
+	}
+
+	if len(idps) == 0 {
+		return nil, ErrNoIDPEntity
+	}
+
+	idp := idps[0]
+	if req.EntityID != "" {
+		var ok bool
+		for _, entity := range idps {
+			if entity.EntityID == req.EntityID {
+				idp, ok = entity, true
+				break
+			}
+		}
+
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrNoIDPEntity, req.EntityID)
+		}
+	} else if len(idps) > 1 {
+		res.Warnings = append(res.Warnings, MetadataWarning{
+			Code:    MetadataWarningMultipleEntities,
+			Message: fmt.Sprintf("Metadata describes %d identity providers. Using the first, %s.", len(idps), idp.EntityID),
+		})
+	}
+
+	res.IDPEntityID = idp.EntityID
+
+	for _, keyDescriptor := range idp.IDPSSODescriptor.KeyDescriptors {
+		// a KeyDescriptor without a use is for both signing and encryption
+		if keyDescriptor.Use != "" && keyDescriptor.Use != "signing" {
+			continue
+		}
+
+		for _, x509Data := range keyDescriptor.KeyInfo.X509Data {
+			for _, x509Cert := range x509Data.X509Certificates {
+				cert, err := parseMetadataCertificate(x509Cert.Value)
+				if err != nil {
+					res.Warnings = append(res.Warnings, MetadataWarning{
+						Code:    MetadataWarningBadCertificate,
+						Message: fmt.Sprintf("Skipped a signing certificate that could not be parsed: %s.", err),
+					})
+					continue
+				}
+
+				if containsCertificate(res.IDPCertificates, cert) {
+					continue
+				}
+
+				if !req.Now.IsZero() && req.Now.After(cert.NotAfter) {
+					res.Warnings = append(res.Warnings, MetadataWarning{
+						Code:    MetadataWarningExpiredCertificate,
+						Message: fmt.Sprintf("Signing certificate %q expired at %s.", cert.Subject.CommonName, cert.NotAfter.UTC().Format(time.RFC3339)),
+					})
+				}
+
+				res.IDPCertificates = append(res.IDPCertificates, cert)
+			}
+		}
+	}
+
+	if len(res.IDPCertificates) == 0 {
+		return nil, ErrNoSigningCertificate
+	}
+
+	res.IDPCertificate = res.IDPCertificates[0]
+	if len(res.IDPCertificates) > 1 {
+		res.Warnings = append(res.Warnings, MetadataWarning{
+			Code:    MetadataWarningMultipleSigningCertificates,
+			Message: fmt.Sprintf("Metadata lists %d signing certificates. Only the first is used as the IDP certificate; if the IDP is rotating certificates, add the others as additional IDP certificates.", len(res.IDPCertificates)),
+		})
+	}
+
+	for _, s := range idp.IDPSSODescriptor.SingleSignOnServices {
+		res.SingleSignOnServices = append(res.SingleSignOnServices, Endpoint{Binding: s.Binding, Location: s.Location})
+	}
+
+	for _, s := range idp.IDPSSODescriptor.SingleLogoutServices {
+		res.SingleLogoutServices = append(res.SingleLogoutServices, Endpoint{Binding: s.Binding, Location: s.Location})
+	}
+
+	for _, binding := range []string{BindingHTTPPost, BindingHTTPRedirect} {
+		if location, ok := endpointLocation(res.SingleSignOnServices, binding); ok {
+			res.RedirectURL = location
+			res.Binding = binding
+			break
+		}
+	}
+
+	if res.RedirectURL == "" {
+		return nil, ErrNoSupportedBinding
+	}
+
+	if res.Binding != BindingHTTPPost {
+		res.Warnings = append(res.Warnings, MetadataWarning{
+			Code:    MetadataWarningNoHTTPPostBinding,
+			Message: "Identity provider does not accept HTTP-POST AuthnRequests. HTTP-Redirect will be used instead.",
+		})
+	}
+
+	var artifactResolutionServices []Endpoint
+	for _, s := range idp.IDPSSODescriptor.ArtifactResolutionServices {
+		artifactResolutionServices = append(artifactResolutionServices, Endpoint{Binding: s.Binding, Location: s.Location})
+	}
+	res.ArtifactResolutionURL, _ = endpointLocation(artifactResolutionServices, BindingSOAP)
+
+	res.SLOURL, _ = endpointLocation(res.SingleLogoutServices, BindingHTTPRedirect)
+	if res.SLOURL == "" && len(res.SingleLogoutServices) > 0 {
+		res.Warnings = append(res.Warnings, MetadataWarning{
+			Code:    MetadataWarningNoHTTPRedirectSingleLogout,
+			Message: "Identity provider supports single logout, but not with HTTP-Redirect, which SSOReady sends logout messages with. Single logout will not be available.",
+		})
+	}
+
+	var vendorURLs []string
+	for _, endpoints := range [][]Endpoint{res.SingleSignOnServices, res.SingleLogoutServices} {
+		for _, e := range endpoints {
+			vendorURLs = append(vendorURLs, e.Location)
+		}
+	}
+	res.Vendor = DetectVendor(&DetectVendorRequest{
+		EntityID:    res.IDPEntityID,
+		URLs:        vendorURLs,
+		Certificate: res.IDPCertificate,
+	})
+
+	return &res, nil
+}
+
+// parseMetadataEntities returns the entities in b, which may be an
+// EntityDescriptor or an EntitiesDescriptor.
+func parseMetadataEntities(b []byte) ([]samltypes.Metadata, error) {
+	root, err := metadataRootElement(b)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: %s", ErrMalformedMetadata, err)
 	}
 
-	cert, err := x509.ParseCertificate(asn1Data)
+	switch root {
+	case xml.Name{Space: "urn:oasis:names:tc:SAML:2.0:metadata", Local: "EntityDescriptor"}:
+		var entity samltypes.Metadata
+		if err := xml.Unmarshal(b, &entity); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedMetadata, err)
+		}
+		return []samltypes.Metadata{entity}, nil
+	case xml.Name{Space: "urn:oasis:names:tc:SAML:2.0:metadata", Local: "EntitiesDescriptor"}:
+		var entities samltypes.EntitiesDescriptor
+		if err := xml.Unmarshal(b, &entities); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrMalformedMetadata, err)
+		}
+		return flattenEntities(entities), nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected root element: %s", ErrMalformedMetadata, root.Local)
+	}
+}
+
+func metadataRootElement(b []byte) (xml.Name, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return xml.Name{}, err
+		}
+
+		if start, ok := token.(xml.StartElement); ok {
+			return start.Name, nil
+		}
+	}
+}
+
+func flattenEntities(entities samltypes.EntitiesDescriptor) []samltypes.Metadata {
+	out := entities.EntityDescriptors
+	for _, nested := range entities.EntitiesDescriptors {
+		out = append(out, flattenEntities(nested)...)
+	}
+	return out
+}
+
+func parseMetadataCertificate(value string) (*x509.Certificate, error) {
+	// certificates are often wrapped across lines
+	asn1Data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(value), ""))
 	if err != nil {
 		return nil, err
 	}
 
-	for _, s := range metadata.IDPSSODescriptor.SingleSignOnServices {
-		if s.Binding == "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" {
-			return &ParseMetadataResponse{
-				IDPEntityID:    metadata.EntityID,
-				IDPCertificate: cert,
-				RedirectURL:    s.Location,
-			}, nil
+	return x509.ParseCertificate(asn1Data)
+}
+
+func containsCertificate(certs []*x509.Certificate, cert *x509.Certificate) bool {
+	for _, c := range certs {
+		if c.Equal(cert) {
+			return true
+		}
+	}
+	return false
+}
+
+func endpointLocation(endpoints []Endpoint, binding string) (string, bool) {
+	for _, e := range endpoints {
+		if e.Binding == binding {
+			return e.Location, true
+		}
+	}
+	return "", false
+}
+
+// NameID formats advertised in SP metadata. SSOReady accepts any NameID
+// format, but these are the ones IDPs should prefer.
+var spNameIDFormats = []string{
+	"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+	"urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified",
+}
+
+type SPMetadataRequest struct {
+	SPEntityID string
+	SPACSURL   string
+
+	// SPSLOURL, if set, is advertised as the SP's single logout URL, for both
+	// BindingHTTPRedirect and BindingHTTPPost.
+	SPSLOURL string
+
+	// SignAuthnRequests is whether AuthnRequests are signed with
+	// SigningCertificate.
+	SignAuthnRequests bool
+
+	// ArtifactBinding is whether SPACSURL is also advertised for
+	// BindingHTTPArtifact, in addition to BindingHTTPPost.
+	ArtifactBinding bool
+
+	// SigningCertificate and EncryptionCertificate, if set, are included as
+	// KeyDescriptors.
+	SigningCertificate    *x509.Certificate
+	EncryptionCertificate *x509.Certificate
+}
+
+// SPMetadata returns an EntityDescriptor describing the SP side of a SAML
+// connection, suitable for uploading to an IDP.
+func SPMetadata(req *SPMetadataRequest) []byte {
+	var metadata spMetadata
+	metadata.EntityID = req.SPEntityID
+	metadata.SPSSODescriptor.AuthnRequestsSigned = req.SignAuthnRequests
+	metadata.SPSSODescriptor.WantAssertionsSigned = true
+	metadata.SPSSODescriptor.ProtocolSupportEnumeration = "urn:oasis:names:tc:SAML:2.0:protocol"
+
+	if req.SigningCertificate != nil {
+		metadata.SPSSODescriptor.KeyDescriptors = append(metadata.SPSSODescriptor.KeyDescriptors, spKeyDescriptor("signing", req.SigningCertificate))
+	}
+	if req.EncryptionCertificate != nil {
+		metadata.SPSSODescriptor.KeyDescriptors = append(metadata.SPSSODescriptor.KeyDescriptors, spKeyDescriptor("encryption", req.EncryptionCertificate))
+	}
+
+	if req.SPSLOURL != "" {
+		for _, binding := range []string{BindingHTTPRedirect, BindingHTTPPost} {
+			metadata.SPSSODescriptor.SingleLogoutServices = append(metadata.SPSSODescriptor.SingleLogoutServices, Endpoint{
+				Binding:  binding,
+				Location: req.SPSLOURL,
+			})
 		}
 	}
 
-	return nil, fmt.Errorf("metadata has no HTTP-POST binding")
+	metadata.SPSSODescriptor.NameIDFormats = spNameIDFormats
+	metadata.SPSSODescriptor.AssertionConsumerServices = append(metadata.SPSSODescriptor.AssertionConsumerServices, spAssertionConsumerService{
+		Binding:   BindingHTTPPost,
+		Location:  req.SPACSURL,
+		Index:     0,
+		IsDefault: true,
+	})
+
+	if req.ArtifactBinding {
+		metadata.SPSSODescriptor.AssertionConsumerServices = append(metadata.SPSSODescriptor.AssertionConsumerServices, spAssertionConsumerService{
+			Binding:  BindingHTTPArtifact,
+			Location: req.SPACSURL,
+			Index:    1,
+		})
+	}
+
+	b, err := xml.Marshal(metadata)
+	if err != nil {
+		panic(fmt.Errorf("marshal EntityDescriptor: %w", err))
+	}
+	return append([]byte(xml.Header), b...)
+}
+
+func spKeyDescriptor(use string, cert *x509.Certificate) keyDescriptor {
+	var k keyDescriptor
+	k.Use = use
+	k.KeyInfo.X509Data.X509Certificate = base64.StdEncoding.EncodeToString(cert.Raw)
+	return k
+}
+
+type spMetadata struct {
+	XMLName         xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID        string   `xml:"entityID,attr"`
+	SPSSODescriptor struct {
+		AuthnRequestsSigned        bool                         `xml:"AuthnRequestsSigned,attr"`
+		WantAssertionsSigned       bool                         `xml:"WantAssertionsSigned,attr"`
+		ProtocolSupportEnumeration string                       `xml:"protocolSupportEnumeration,attr"`
+		KeyDescriptors             []keyDescriptor              `xml:"KeyDescriptor"`
+		SingleLogoutServices       []Endpoint                   `xml:"SingleLogoutService"`
+		NameIDFormats              []string                     `xml:"NameIDFormat"`
+		AssertionConsumerServices  []spAssertionConsumerService `xml:"AssertionConsumerService"`
+	} `xml:"SPSSODescriptor"`
+}
+
+type spAssertionConsumerService struct {
+	Binding   string `xml:"Binding,attr"`
+	Location  string `xml:"Location,attr"`
+	Index     int    `xml:"index,attr"`
+	IsDefault bool   `xml:"isDefault,attr"`
+}
+
+// Endpoint is a SAML service endpoint.
+type Endpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+type keyDescriptor struct {
+	Use     string `xml:"use,attr"`
+	KeyInfo struct {
+		XMLName  xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+		X509Data struct {
+			X509Certificate string `xml:"X509Certificate"`
+		} `xml:"X509Data"`
+	} `xml:"KeyInfo"`
 }
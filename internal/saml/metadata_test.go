@@ -0,0 +1,285 @@
+package saml_test
+
+import (
+	"encoding/base64"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetadata_Binding(t *testing.T) {
+	postOnly, err := os.ReadFile("testdata/assertions/ecdsa-p256/metadata.xml")
+	require.NoError(t, err)
+
+	// lists HTTP-Redirect before HTTP-POST
+	redirectAndPost, err := os.ReadFile("testdata/assertions/adfs/metadata.xml")
+	require.NoError(t, err)
+
+	redirectOnly := strings.ReplaceAll(string(postOnly), saml.BindingHTTPPost, saml.BindingHTTPRedirect)
+	soapOnly := strings.ReplaceAll(string(postOnly), saml.BindingHTTPPost, "urn:oasis:names:tc:SAML:2.0:bindings:SOAP")
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: postOnly})
+	require.NoError(t, err)
+	assert.Equal(t, saml.BindingHTTPPost, res.Binding)
+	assert.Equal(t, "https://idp.example.com/saml/sso", res.RedirectURL)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: redirectAndPost})
+	require.NoError(t, err)
+	assert.Equal(t, saml.BindingHTTPPost, res.Binding)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: []byte(redirectOnly)})
+	require.NoError(t, err)
+	assert.Equal(t, saml.BindingHTTPRedirect, res.Binding)
+	assert.Equal(t, "https://idp.example.com/saml/sso", res.RedirectURL)
+
+	_, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: []byte(soapOnly)})
+	assert.Error(t, err)
+}
+
+func TestParseMetadata_SLOURL(t *testing.T) {
+	adfs, err := os.ReadFile("testdata/assertions/adfs/metadata.xml")
+	require.NoError(t, err)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: adfs})
+	require.NoError(t, err)
+	assert.Equal(t, "https://login.microsoftonline.com/a9054a0f-2011-4e31-b3ac-fd8c354146ec/saml2", res.SLOURL)
+
+	// lists HTTP-POST before HTTP-Redirect
+	ping, err := os.ReadFile("testdata/assertions/ping/metadata.xml")
+	require.NoError(t, err)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: ping})
+	require.NoError(t, err)
+	assert.Equal(t, "https://auth.pingone.com/3030059e-440b-4ad0-9217-44326f1757f6/saml20/idp/slo", res.SLOURL)
+
+	okta, err := os.ReadFile("testdata/assertions/okta/metadata.xml")
+	require.NoError(t, err)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: okta})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.SLOURL)
+}
+
+func TestParseMetadata_ArtifactResolutionURL(t *testing.T) {
+	keycloak, err := os.ReadFile("testdata/assertions/keycloak/metadata.xml")
+	require.NoError(t, err)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: keycloak})
+	require.NoError(t, err)
+	assert.Equal(t, "http://localhost:8085/realms/master/protocol/saml/resolve", res.ArtifactResolutionURL)
+
+	okta, err := os.ReadFile("testdata/assertions/okta/metadata.xml")
+	require.NoError(t, err)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: okta})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.ArtifactResolutionURL)
+}
+
+func TestParseMetadata_Warnings(t *testing.T) {
+	postOnly, err := os.ReadFile("testdata/assertions/ecdsa-p256/metadata.xml")
+	require.NoError(t, err)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: postOnly,
+		Now:      time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, res.Warnings)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: []byte(strings.ReplaceAll(string(postOnly), saml.BindingHTTPPost, saml.BindingHTTPRedirect)),
+		Now:      time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []saml.MetadataWarningCode{
+		saml.MetadataWarningExpiredCertificate,
+		saml.MetadataWarningNoHTTPPostBinding,
+	}, metadataWarningCodes(res.Warnings))
+
+	// with its HTTP-Redirect endpoint changed to SOAP, ping no longer supports
+	// single logout with HTTP-Redirect
+	ping, err := os.ReadFile("testdata/assertions/ping/metadata.xml")
+	require.NoError(t, err)
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: []byte(strings.Replace(string(ping), `/idp/slo" Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"`, `/idp/slo" Binding="urn:oasis:names:tc:SAML:2.0:bindings:SOAP"`, 1)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "", res.SLOURL)
+	assert.Equal(t, []saml.MetadataWarningCode{saml.MetadataWarningNoHTTPRedirectSingleLogout}, metadataWarningCodes(res.Warnings))
+}
+
+func TestParseMetadata_SigningCertificates(t *testing.T) {
+	certA := metadataCertificate(t, "ecdsa-p256")
+	certB := metadataCertificate(t, "ecdsa-p384")
+	certC := metadataCertificate(t, "rsa-sha512")
+
+	metadata := func(keyDescriptors string) []byte {
+		return []byte(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" entityID="https://idp.example.com"><md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">` + keyDescriptors + `<md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://idp.example.com/sso"/></md:IDPSSODescriptor></md:EntityDescriptor>`)
+	}
+	keyDescriptor := func(use, cert string) string {
+		var useAttr string
+		if use != "" {
+			useAttr = ` use="` + use + `"`
+		}
+		return `<md:KeyDescriptor` + useAttr + `><ds:KeyInfo><ds:X509Data><ds:X509Certificate>` + cert + `</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`
+	}
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata(keyDescriptor("encryption", certA) + keyDescriptor("signing", certB) + keyDescriptor("", certC) + keyDescriptor("signing", certB)),
+	})
+	require.NoError(t, err)
+	require.Len(t, res.IDPCertificates, 2)
+	assert.Equal(t, certB, base64.StdEncoding.EncodeToString(res.IDPCertificates[0].Raw))
+	assert.Equal(t, certC, base64.StdEncoding.EncodeToString(res.IDPCertificates[1].Raw))
+	assert.Equal(t, res.IDPCertificates[0], res.IDPCertificate)
+	assert.Equal(t, []saml.MetadataWarningCode{saml.MetadataWarningMultipleSigningCertificates}, metadataWarningCodes(res.Warnings))
+
+	// certificates wrapped across lines are accepted
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata(keyDescriptor("signing", "\n\t"+certB[:40]+"\r\n"+certB[40:]+"\n")),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, certB, base64.StdEncoding.EncodeToString(res.IDPCertificate.Raw))
+
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata(keyDescriptor("signing", "not a certificate") + keyDescriptor("signing", certA)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, certA, base64.StdEncoding.EncodeToString(res.IDPCertificate.Raw))
+	assert.Equal(t, []saml.MetadataWarningCode{saml.MetadataWarningBadCertificate}, metadataWarningCodes(res.Warnings))
+
+	_, err = saml.ParseMetadata(&saml.ParseMetadataRequest{
+		Metadata: metadata(keyDescriptor("encryption", certA)),
+	})
+	assert.ErrorIs(t, err, saml.ErrNoSigningCertificate)
+}
+
+func TestParseMetadata_EntitiesDescriptor(t *testing.T) {
+	entity := func(entityID, cert string) string {
+		return `<md:EntityDescriptor entityID="` + entityID + `"><md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"><md:KeyDescriptor use="signing"><ds:KeyInfo><ds:X509Data><ds:X509Certificate>` + cert + `</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor><md:SingleSignOnService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="` + entityID + `/sso"/></md:IDPSSODescriptor></md:EntityDescriptor>`
+	}
+
+	sp := `<md:EntityDescriptor entityID="https://sp.example.com"><md:SPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"><md:AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="https://sp.example.com/acs" index="0"/></md:SPSSODescriptor></md:EntityDescriptor>`
+
+	metadata := []byte(`<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" xmlns:ds="http://www.w3.org/2000/09/xmldsig#">` +
+		sp +
+		entity("https://idp1.example.com", metadataCertificate(t, "ecdsa-p256")) +
+		`<md:EntitiesDescriptor>` + entity("https://idp2.example.com", metadataCertificate(t, "ecdsa-p384")) + `</md:EntitiesDescriptor>` +
+		`</md:EntitiesDescriptor>`)
+
+	// the first IDP is used by default; the SP is skipped
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata})
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp1.example.com", res.IDPEntityID)
+	assert.Equal(t, "https://idp1.example.com/sso", res.RedirectURL)
+	assert.Equal(t, []saml.MetadataWarningCode{saml.MetadataWarningMultipleEntities}, metadataWarningCodes(res.Warnings))
+
+	// IDPs in nested EntitiesDescriptors can be selected
+	res, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata, EntityID: "https://idp2.example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://idp2.example.com", res.IDPEntityID)
+	assert.Equal(t, "https://idp2.example.com/sso", res.RedirectURL)
+	assert.Empty(t, res.Warnings)
+
+	_, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata, EntityID: "https://sp.example.com"})
+	assert.ErrorIs(t, err, saml.ErrNoIDPEntity)
+
+	_, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: []byte(`<md:EntitiesDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata">` + sp + `</md:EntitiesDescriptor>`)})
+	assert.ErrorIs(t, err, saml.ErrNoIDPEntity)
+
+	_, err = saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: []byte(`<foo/>`)})
+	assert.ErrorIs(t, err, saml.ErrMalformedMetadata)
+}
+
+func TestParseMetadata_Endpoints(t *testing.T) {
+	adfs, err := os.ReadFile("testdata/assertions/adfs/metadata.xml")
+	require.NoError(t, err)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: adfs})
+	require.NoError(t, err)
+	assert.Equal(t, []saml.Endpoint{
+		{Binding: saml.BindingHTTPRedirect, Location: "https://login.microsoftonline.com/a9054a0f-2011-4e31-b3ac-fd8c354146ec/saml2"},
+		{Binding: saml.BindingHTTPPost, Location: "https://login.microsoftonline.com/a9054a0f-2011-4e31-b3ac-fd8c354146ec/saml2"},
+	}, res.SingleSignOnServices)
+	assert.Equal(t, []saml.Endpoint{
+		{Binding: saml.BindingHTTPRedirect, Location: "https://login.microsoftonline.com/a9054a0f-2011-4e31-b3ac-fd8c354146ec/saml2"},
+	}, res.SingleLogoutServices)
+}
+
+func metadataCertificate(t *testing.T, name string) string {
+	metadata, err := os.ReadFile("testdata/assertions/" + name + "/metadata.xml")
+	require.NoError(t, err)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata})
+	require.NoError(t, err)
+
+	return base64.StdEncoding.EncodeToString(res.IDPCertificate.Raw)
+}
+
+func metadataWarningCodes(warnings []saml.MetadataWarning) []saml.MetadataWarningCode {
+	var codes []saml.MetadataWarningCode
+	for _, w := range warnings {
+		codes = append(codes, w.Code)
+	}
+	return codes
+}
+
+func TestSPMetadata(t *testing.T) {
+	assert.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<EntityDescriptor xmlns="urn:oasis:names:tc:SAML:2.0:metadata" entityID="http://sp.example.com"><SPSSODescriptor AuthnRequestsSigned="false" WantAssertionsSigned="true" protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol"><NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress</NameIDFormat><NameIDFormat>urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified</NameIDFormat><AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="http://sp.example.com/acs" index="0" isDefault="true"></AssertionConsumerService></SPSSODescriptor></EntityDescriptor>`, string(saml.SPMetadata(&saml.SPMetadataRequest{
+		SPEntityID: "http://sp.example.com",
+		SPACSURL:   "http://sp.example.com/acs",
+	})))
+}
+
+func TestSPMetadata_Certificates(t *testing.T) {
+	// borrow an IDP's certificate; any certificate will do
+	idpMetadata, err := os.ReadFile("testdata/assertions/ecdsa-p256/metadata.xml")
+	require.NoError(t, err)
+
+	idpMetadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: idpMetadata})
+	require.NoError(t, err)
+
+	cert := base64.StdEncoding.EncodeToString(idpMetadataRes.IDPCertificate.Raw)
+	metadata := string(saml.SPMetadata(&saml.SPMetadataRequest{
+		SPEntityID:            "http://sp.example.com",
+		SPACSURL:              "http://sp.example.com/acs",
+		SignAuthnRequests:     true,
+		SigningCertificate:    idpMetadataRes.IDPCertificate,
+		EncryptionCertificate: idpMetadataRes.IDPCertificate,
+	}))
+
+	assert.Contains(t, metadata, `AuthnRequestsSigned="true"`)
+	assert.Contains(t, metadata, `<KeyDescriptor use="signing"><KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#"><X509Data><X509Certificate>`+cert+`</X509Certificate></X509Data></KeyInfo></KeyDescriptor>`)
+	assert.Contains(t, metadata, `<KeyDescriptor use="encryption"><KeyInfo xmlns="http://www.w3.org/2000/09/xmldsig#"><X509Data><X509Certificate>`+cert+`</X509Certificate></X509Data></KeyInfo></KeyDescriptor>`)
+
+	// KeyDescriptors must precede NameIDFormats
+	assert.Less(t, strings.Index(metadata, "KeyDescriptor"), strings.Index(metadata, "NameIDFormat"))
+}
+
+func TestSPMetadata_SLOURL(t *testing.T) {
+	metadata := string(saml.SPMetadata(&saml.SPMetadataRequest{
+		SPEntityID: "http://sp.example.com",
+		SPACSURL:   "http://sp.example.com/acs",
+		SPSLOURL:   "http://sp.example.com/slo",
+	}))
+
+	assert.Contains(t, metadata, `<SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect" Location="http://sp.example.com/slo"></SingleLogoutService><SingleLogoutService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="http://sp.example.com/slo"></SingleLogoutService><NameIDFormat>`)
+}
+
+func TestSPMetadata_ArtifactBinding(t *testing.T) {
+	metadata := string(saml.SPMetadata(&saml.SPMetadataRequest{
+		SPEntityID:      "http://sp.example.com",
+		SPACSURL:        "http://sp.example.com/acs",
+		ArtifactBinding: true,
+	}))
+
+	assert.Contains(t, metadata, `<AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST" Location="http://sp.example.com/acs" index="0" isDefault="true"></AssertionConsumerService><AssertionConsumerService Binding="urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Artifact" Location="http://sp.example.com/acs" index="1" isDefault="false"></AssertionConsumerService>`)
+}
@@ -0,0 +1,478 @@
+// Package samltest is a fake SAML identity provider, for tests that need SAML
+// responses other than the captured ones under testdata.
+//
+// A typical test creates an IDP, takes a valid response from NewResponse,
+// modifies whatever it is testing, and passes the result of SAMLResponse to
+// saml.Validate or an ACS endpoint:
+//
+//	idp := samltest.NewIDP(t)
+//	res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+//	res.Assertion.Audience = "http://evil.example.com"
+//	samlResponse := idp.SAMLResponse(t, res)
+package samltest
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/saml/xmlenc"
+)
+
+const (
+	statusCodeSuccess             = "urn:oasis:names:tc:SAML:2.0:status:Success"
+	subjectConfirmationBearer     = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+	nameIDFormatEmailAddress      = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+	authnContextClassRefPassword  = "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport"
+	defaultEntityID               = "http://idp.example.com"
+	defaultSSOURL                 = "http://idp.example.com/sso"
+	defaultAssertionValidDuration = 5 * time.Minute
+)
+
+// IDP is a fake identity provider. It signs with a key generated by NewIDP or
+// NewECDSAIDP, and a self-signed certificate for that key.
+type IDP struct {
+	EntityID    string
+	SSOURL      string
+	Key         crypto.Signer
+	Certificate *x509.Certificate
+
+	// SignatureAlgorithm is the default algorithm of the IDP's signatures. It
+	// is chosen to match Key.
+	SignatureAlgorithm string
+}
+
+// NewIDP returns an IDP that signs with a new RSA key.
+func NewIDP(t testing.TB) *IDP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("samltest: generate key: %v", err)
+	}
+
+	return newIDP(t, key, dsig.SignatureAlgorithmRSASHA256)
+}
+
+// NewECDSAIDP returns an IDP that signs with a new ECDSA key on curve, which
+// must be P-256 or P-384.
+func NewECDSAIDP(t testing.TB, curve elliptic.Curve) *IDP {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		t.Fatalf("samltest: generate key: %v", err)
+	}
+
+	signatureAlgorithm := dsig.SignatureAlgorithmECDSASHA256
+	if curve == elliptic.P384() {
+		signatureAlgorithm = dsig.SignatureAlgorithmECDSASHA384
+	}
+
+	return newIDP(t, key, signatureAlgorithm)
+}
+
+func newIDP(t testing.TB, key crypto.Signer, signatureAlgorithm string) *IDP {
+	t.Helper()
+
+	// the certificate's validity is deliberately wide, so that tests can
+	// validate responses at any time; saml.Validate does not check it anyway
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "idp.example.com"},
+		NotBefore:    time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2100, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("samltest: create certificate: %v", err)
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatalf("samltest: parse certificate: %v", err)
+	}
+
+	return &IDP{
+		EntityID:           defaultEntityID,
+		SSOURL:             defaultSSOURL,
+		Key:                key,
+		Certificate:        cert,
+		SignatureAlgorithm: signatureAlgorithm,
+	}
+}
+
+// IDPCertificates returns the IDP's certificate, in the form
+// saml.ValidateRequest takes it.
+func (idp *IDP) IDPCertificates() []saml.IDPCertificate {
+	return []saml.IDPCertificate{{Certificate: idp.Certificate}}
+}
+
+// Metadata returns SAML metadata describing the IDP.
+func (idp *IDP) Metadata() []byte {
+	var b bytes.Buffer
+	b.WriteString(`<md:EntityDescriptor xmlns:md="urn:oasis:names:tc:SAML:2.0:metadata" entityID="` + escape(idp.EntityID) + `">`)
+	b.WriteString(`<md:IDPSSODescriptor protocolSupportEnumeration="urn:oasis:names:tc:SAML:2.0:protocol">`)
+	b.WriteString(`<md:KeyDescriptor use="signing"><ds:KeyInfo xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><ds:X509Data><ds:X509Certificate>`)
+	b.WriteString(base64.StdEncoding.EncodeToString(idp.Certificate.Raw))
+	b.WriteString(`</ds:X509Certificate></ds:X509Data></ds:KeyInfo></md:KeyDescriptor>`)
+	b.WriteString(`<md:SingleSignOnService Binding="` + saml.BindingHTTPPost + `" Location="` + escape(idp.SSOURL) + `"/>`)
+	b.WriteString(`</md:IDPSSODescriptor>`)
+	b.WriteString(`</md:EntityDescriptor>`)
+	return b.Bytes()
+}
+
+// Response is a SAML Response for an IDP to build and sign. Zero-valued fields
+// are omitted from the response, except where noted otherwise.
+type Response struct {
+	ID           string
+	InResponseTo string
+	Destination  string
+	IssueInstant time.Time
+
+	// StatusCode defaults to urn:oasis:names:tc:SAML:2.0:status:Success.
+	StatusCode    string
+	SubStatusCode string
+	StatusMessage string
+
+	// Signature, if not nil, is how to sign the Response.
+	Signature *Signature
+
+	// Assertion, if not nil, is the response's assertion.
+	Assertion *Assertion
+
+	// EncryptAssertionFor, if not nil, is the SP's encryption key. The signed
+	// assertion is then encrypted for it, using AES-256-GCM and RSA-OAEP.
+	EncryptAssertionFor *rsa.PublicKey
+}
+
+// Assertion is a SAML Assertion within a Response.
+type Assertion struct {
+	ID           string
+	IssueInstant time.Time
+
+	// Issuer defaults to the IDP's EntityID.
+	Issuer string
+
+	NameID       string
+	NameIDFormat string
+
+	// SubjectConfirmationMethod defaults to
+	// urn:oasis:names:tc:SAML:2.0:cm:bearer.
+	SubjectConfirmationMethod       string
+	Recipient                       string
+	InResponseTo                    string
+	SubjectConfirmationNotOnOrAfter time.Time
+
+	NotBefore    time.Time
+	NotOnOrAfter time.Time
+	Audience     string
+
+	// AuthnStatement is omitted if AuthnInstant is zero.
+	AuthnInstant         time.Time
+	SessionIndex         string
+	SessionNotOnOrAfter  time.Time
+	AuthnContextClassRef string
+
+	// Attributes are included in an AttributeStatement, in order. It is
+	// omitted if there are no attributes.
+	Attributes []Attribute
+
+	// Signature, if not nil, is how to sign the Assertion.
+	Signature *Signature
+}
+
+type Attribute struct {
+	Name   string
+	Values []string
+}
+
+// Signature configures an enveloped signature on a Response or Assertion.
+type Signature struct {
+	// SignatureAlgorithm defaults to the IDP's SignatureAlgorithm.
+	SignatureAlgorithm string
+
+	// DigestAlgorithm defaults to SHA-256.
+	DigestAlgorithm string
+
+	// OmitCertificate omits the certificate from the signature's KeyInfo.
+	OmitCertificate bool
+}
+
+// NewResponse returns a response that saml.Validate accepts, given the IDP's
+// EntityID and certificates, and the SP's entity ID and ACS URL. The assertion
+// is valid for five minutes either side of now, and is signed; the Response is
+// not.
+func (idp *IDP) NewResponse(spEntityID, spACSURL string, now time.Time, nameID string) *Response {
+	return &Response{
+		ID:           newID(),
+		Destination:  spACSURL,
+		IssueInstant: now,
+		Assertion: &Assertion{
+			ID:                              newID(),
+			IssueInstant:                    now,
+			NameID:                          nameID,
+			NameIDFormat:                    nameIDFormatEmailAddress,
+			Recipient:                       spACSURL,
+			SubjectConfirmationNotOnOrAfter: now.Add(defaultAssertionValidDuration),
+			NotBefore:                       now.Add(-defaultAssertionValidDuration),
+			NotOnOrAfter:                    now.Add(defaultAssertionValidDuration),
+			Audience:                        spEntityID,
+			AuthnInstant:                    now,
+			SessionIndex:                    newID(),
+			AuthnContextClassRef:            authnContextClassRefPassword,
+			Signature:                       &Signature{},
+		},
+	}
+}
+
+// ResponseXML builds and signs res.
+func (idp *IDP) ResponseXML(t testing.TB, res *Response) []byte {
+	t.Helper()
+
+	var head bytes.Buffer
+	head.WriteString(`<samlp:Response xmlns:samlp="urn:oasis:names:tc:SAML:2.0:protocol" xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" Version="2.0"`)
+	attr(&head, "ID", res.ID)
+	attr(&head, "InResponseTo", res.InResponseTo)
+	attr(&head, "Destination", res.Destination)
+	timeAttr(&head, "IssueInstant", res.IssueInstant)
+	head.WriteString(`>`)
+	head.WriteString(`<saml:Issuer>` + escape(idp.EntityID) + `</saml:Issuer>`)
+
+	statusCode := res.StatusCode
+	if statusCode == "" {
+		statusCode = statusCodeSuccess
+	}
+
+	var tail bytes.Buffer
+	tail.WriteString(`<samlp:Status>`)
+	tail.WriteString(`<samlp:StatusCode Value="` + escape(statusCode) + `">`)
+	if res.SubStatusCode != "" {
+		tail.WriteString(`<samlp:StatusCode Value="` + escape(res.SubStatusCode) + `"></samlp:StatusCode>`)
+	}
+	tail.WriteString(`</samlp:StatusCode>`)
+	if res.StatusMessage != "" {
+		tail.WriteString(`<samlp:StatusMessage>` + escape(res.StatusMessage) + `</samlp:StatusMessage>`)
+	}
+	tail.WriteString(`</samlp:Status>`)
+	if res.Assertion != nil {
+		assertion := idp.assertionXML(t, res.Assertion)
+		if res.EncryptAssertionFor != nil {
+			assertion = encryptAssertion(t, res.EncryptAssertionFor, assertion)
+		}
+		tail.Write(assertion)
+	}
+	tail.WriteString(`</samlp:Response>`)
+
+	return idp.sign(t, res.Signature, head.Bytes(), tail.Bytes())
+}
+
+// SAMLResponse builds and signs res, and encodes it as the SAMLResponse
+// parameter of the HTTP-POST binding.
+func (idp *IDP) SAMLResponse(t testing.TB, res *Response) string {
+	t.Helper()
+	return base64.StdEncoding.EncodeToString(idp.ResponseXML(t, res))
+}
+
+func (idp *IDP) assertionXML(t testing.TB, a *Assertion) []byte {
+	t.Helper()
+
+	issuer := a.Issuer
+	if issuer == "" {
+		issuer = idp.EntityID
+	}
+
+	// the assertion declares its own namespaces, so that it canonicalizes the
+	// same way inside the Response as it does standalone when signed
+	var head bytes.Buffer
+	head.WriteString(`<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" Version="2.0"`)
+	attr(&head, "ID", a.ID)
+	timeAttr(&head, "IssueInstant", a.IssueInstant)
+	head.WriteString(`>`)
+	head.WriteString(`<saml:Issuer>` + escape(issuer) + `</saml:Issuer>`)
+
+	subjectConfirmationMethod := a.SubjectConfirmationMethod
+	if subjectConfirmationMethod == "" {
+		subjectConfirmationMethod = subjectConfirmationBearer
+	}
+
+	var tail bytes.Buffer
+	tail.WriteString(`<saml:Subject>`)
+	tail.WriteString(`<saml:NameID`)
+	attr(&tail, "Format", a.NameIDFormat)
+	tail.WriteString(`>` + escape(a.NameID) + `</saml:NameID>`)
+	tail.WriteString(`<saml:SubjectConfirmation Method="` + escape(subjectConfirmationMethod) + `">`)
+	tail.WriteString(`<saml:SubjectConfirmationData`)
+	attr(&tail, "InResponseTo", a.InResponseTo)
+	timeAttr(&tail, "NotOnOrAfter", a.SubjectConfirmationNotOnOrAfter)
+	attr(&tail, "Recipient", a.Recipient)
+	tail.WriteString(`></saml:SubjectConfirmationData>`)
+	tail.WriteString(`</saml:SubjectConfirmation>`)
+	tail.WriteString(`</saml:Subject>`)
+
+	tail.WriteString(`<saml:Conditions`)
+	timeAttr(&tail, "NotBefore", a.NotBefore)
+	timeAttr(&tail, "NotOnOrAfter", a.NotOnOrAfter)
+	tail.WriteString(`>`)
+	tail.WriteString(`<saml:AudienceRestriction><saml:Audience>` + escape(a.Audience) + `</saml:Audience></saml:AudienceRestriction>`)
+	tail.WriteString(`</saml:Conditions>`)
+
+	if !a.AuthnInstant.IsZero() {
+		tail.WriteString(`<saml:AuthnStatement`)
+		timeAttr(&tail, "AuthnInstant", a.AuthnInstant)
+		attr(&tail, "SessionIndex", a.SessionIndex)
+		timeAttr(&tail, "SessionNotOnOrAfter", a.SessionNotOnOrAfter)
+		tail.WriteString(`>`)
+		if a.AuthnContextClassRef != "" {
+			tail.WriteString(`<saml:AuthnContext><saml:AuthnContextClassRef>` + escape(a.AuthnContextClassRef) + `</saml:AuthnContextClassRef></saml:AuthnContext>`)
+		}
+		tail.WriteString(`</saml:AuthnStatement>`)
+	}
+
+	if len(a.Attributes) > 0 {
+		tail.WriteString(`<saml:AttributeStatement>`)
+		for _, attribute := range a.Attributes {
+			tail.WriteString(`<saml:Attribute Name="` + escape(attribute.Name) + `">`)
+			for _, v := range attribute.Values {
+				tail.WriteString(`<saml:AttributeValue>` + escape(v) + `</saml:AttributeValue>`)
+			}
+			tail.WriteString(`</saml:Attribute>`)
+		}
+		tail.WriteString(`</saml:AttributeStatement>`)
+	}
+
+	tail.WriteString(`</saml:Assertion>`)
+
+	return idp.sign(t, a.Signature, head.Bytes(), tail.Bytes())
+}
+
+// sign returns head followed by tail, with an enveloped signature between them
+// if sig is not nil. SAML requires that the signature immediately follow the
+// element's Issuer, which head should end with.
+func (idp *IDP) sign(t testing.TB, sig *Signature, head, tail []byte) []byte {
+	t.Helper()
+
+	data := append(append([]byte{}, head...), tail...)
+	if sig == nil {
+		return data
+	}
+
+	signatureAlgorithm := sig.SignatureAlgorithm
+	if signatureAlgorithm == "" {
+		signatureAlgorithm = idp.SignatureAlgorithm
+	}
+
+	var cert *x509.Certificate
+	if !sig.OmitCertificate {
+		cert = idp.Certificate
+	}
+
+	signature, err := dsig.Sign(&dsig.SignRequest{
+		Key:                idp.Key,
+		Certificate:        cert,
+		Data:               data,
+		SignatureAlgorithm: signatureAlgorithm,
+		DigestAlgorithm:    sig.DigestAlgorithm,
+	})
+	if err != nil {
+		t.Fatalf("samltest: sign: %v", err)
+	}
+
+	var out bytes.Buffer
+	out.Write(head)
+	out.Write(signature)
+	out.Write(tail)
+	return out.Bytes()
+}
+
+// encryptAssertion returns an EncryptedAssertion of assertion, with a new
+// content-encryption key encrypted for pub.
+func encryptAssertion(t testing.TB, pub *rsa.PublicKey, assertion []byte) []byte {
+	t.Helper()
+
+	cek := make([]byte, 32)
+	if _, err := rand.Read(cek); err != nil {
+		t.Fatalf("samltest: generate content-encryption key: %v", err)
+	}
+
+	encryptedKey, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, cek, nil)
+	if err != nil {
+		t.Fatalf("samltest: encrypt content-encryption key: %v", err)
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		t.Fatalf("samltest: new cipher: %v", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		t.Fatalf("samltest: new gcm: %v", err)
+	}
+
+	// the nonce is prepended to the cipher text
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		t.Fatalf("samltest: generate nonce: %v", err)
+	}
+	cipherText := gcm.Seal(nonce, nonce, assertion, nil)
+
+	var b bytes.Buffer
+	b.WriteString(`<saml:EncryptedAssertion>`)
+	b.WriteString(`<xenc:EncryptedData xmlns:xenc="http://www.w3.org/2001/04/xmlenc#" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" Type="http://www.w3.org/2001/04/xmlenc#Element">`)
+	b.WriteString(`<xenc:EncryptionMethod Algorithm="` + xmlenc.AlgorithmAES256GCM + `"/>`)
+	b.WriteString(`<ds:KeyInfo><xenc:EncryptedKey>`)
+	b.WriteString(`<xenc:EncryptionMethod Algorithm="` + xmlenc.AlgorithmRSAOAEPMGF1P + `"/>`)
+	b.WriteString(`<xenc:CipherData><xenc:CipherValue>` + base64.StdEncoding.EncodeToString(encryptedKey) + `</xenc:CipherValue></xenc:CipherData>`)
+	b.WriteString(`</xenc:EncryptedKey></ds:KeyInfo>`)
+	b.WriteString(`<xenc:CipherData><xenc:CipherValue>` + base64.StdEncoding.EncodeToString(cipherText) + `</xenc:CipherValue></xenc:CipherData>`)
+	b.WriteString(`</xenc:EncryptedData>`)
+	b.WriteString(`</saml:EncryptedAssertion>`)
+	return b.Bytes()
+}
+
+// attr writes an attribute, if its value is not empty.
+func attr(b *bytes.Buffer, name, value string) {
+	if value != "" {
+		b.WriteString(` ` + name + `="` + escape(value) + `"`)
+	}
+}
+
+// timeAttr writes a time attribute, if its value is not zero.
+func timeAttr(b *bytes.Buffer, name string, value time.Time) {
+	if !value.IsZero() {
+		attr(b, name, value.UTC().Format(time.RFC3339Nano))
+	}
+}
+
+func escape(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+// newID returns a random ID. IDs must be valid xsd:IDs, and so must not start
+// with a digit.
+func newID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	return "_" + hex.EncodeToString(b[:])
+}
@@ -0,0 +1,141 @@
+package samltest_test
+
+import (
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/samltest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResponse_Validate(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	spKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name            string
+		idp             *samltest.IDP
+		modify          func(res *samltest.Response)
+		responseSigned  bool
+		assertionSigned bool
+	}{
+		{
+			name:            "rsa",
+			idp:             samltest.NewIDP(t),
+			modify:          func(res *samltest.Response) {},
+			assertionSigned: true,
+		},
+		{
+			name: "rsa response signed",
+			idp:  samltest.NewIDP(t),
+			modify: func(res *samltest.Response) {
+				res.Signature = &samltest.Signature{}
+				res.Assertion.Signature = nil
+			},
+			responseSigned: true,
+		},
+		{
+			name:            "ecdsa p256",
+			idp:             samltest.NewECDSAIDP(t, elliptic.P256()),
+			modify:          func(res *samltest.Response) {},
+			assertionSigned: true,
+		},
+		{
+			name: "ecdsa p384 both signed",
+			idp:  samltest.NewECDSAIDP(t, elliptic.P384()),
+			modify: func(res *samltest.Response) {
+				res.Signature = &samltest.Signature{}
+			},
+			responseSigned:  true,
+			assertionSigned: true,
+		},
+		{
+			name: "encrypted",
+			idp:  samltest.NewIDP(t),
+			modify: func(res *samltest.Response) {
+				res.EncryptAssertionFor = &spKey.PublicKey
+			},
+			assertionSigned: true,
+		},
+		{
+			name: "ecdsa encrypted",
+			idp:  samltest.NewECDSAIDP(t, elliptic.P256()),
+			modify: func(res *samltest.Response) {
+				res.EncryptAssertionFor = &spKey.PublicKey
+			},
+			assertionSigned: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+			res.InResponseTo = "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd"
+			res.Assertion.InResponseTo = res.InResponseTo
+			res.Assertion.Attributes = []samltest.Attribute{{Name: "groups", Values: []string{"engineering"}}}
+			tt.modify(res)
+
+			validateRes, err := saml.Validate(&saml.ValidateRequest{
+				SAMLResponse:     tt.idp.SAMLResponse(t, res),
+				IDPCertificates:  tt.idp.IDPCertificates(),
+				IDPEntityID:      tt.idp.EntityID,
+				SPEntityID:       "http://sp.example.com",
+				SPACSURL:         "http://sp.example.com/acs",
+				Now:              now,
+				SPDecryptionKeys: []*rsa.PrivateKey{spKey},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd", validateRes.RequestID)
+			assert.Equal(t, res.Assertion.ID, validateRes.AssertionID)
+			assert.Equal(t, "jane.doe@example.com", validateRes.SubjectID)
+			assert.Equal(t, map[string][]string{"groups": {"engineering"}}, validateRes.SubjectAttributes)
+			assert.Equal(t, tt.responseSigned, validateRes.ResponseSigned)
+			assert.Equal(t, tt.assertionSigned, validateRes.AssertionSigned)
+			assert.Equal(t, tt.idp.Certificate, validateRes.IDPCertificate)
+		})
+	}
+}
+
+func TestResponse_EncryptedWrongKey(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	idp := samltest.NewIDP(t)
+
+	spKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+	res.EncryptAssertionFor = &otherKey.PublicKey
+
+	_, err = saml.Validate(&saml.ValidateRequest{
+		SAMLResponse:     idp.SAMLResponse(t, res),
+		IDPCertificates:  idp.IDPCertificates(),
+		IDPEntityID:      idp.EntityID,
+		SPEntityID:       "http://sp.example.com",
+		SPACSURL:         "http://sp.example.com/acs",
+		Now:              now,
+		SPDecryptionKeys: []*rsa.PrivateKey{spKey},
+	})
+
+	var validateError *saml.ValidateError
+	require.ErrorAs(t, err, &validateError)
+	assert.True(t, validateError.UndecryptableAssertion)
+}
+
+func TestIDP_Metadata(t *testing.T) {
+	idp := samltest.NewECDSAIDP(t, elliptic.P256())
+
+	parseMetadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: idp.Metadata()})
+	require.NoError(t, err)
+	assert.Equal(t, idp.EntityID, parseMetadataRes.IDPEntityID)
+	assert.Equal(t, idp.SSOURL, parseMetadataRes.RedirectURL)
+	assert.Equal(t, idp.Certificate, parseMetadataRes.IDPCertificate)
+}
@@ -0,0 +1,28 @@
+package samltypes
+
+import "encoding/xml"
+
+// ArtifactResponseEnvelope is an IDP's reply to an ArtifactResolve, using the
+// SOAP binding. The Response it carries is not parsed here, because it must be
+// verified as it appears on the wire.
+type ArtifactResponseEnvelope struct {
+	XMLName xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+	Body    struct {
+		XMLName xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+
+		// Fault is non-nil if the IDP could not process the ArtifactResolve.
+		Fault *struct {
+			XMLName     xml.Name `xml:"http://schemas.xmlsoap.org/soap/envelope/ Fault"`
+			FaultCode   string   `xml:"faultcode"`
+			FaultString string   `xml:"faultstring"`
+		} `xml:"Fault"`
+
+		ArtifactResponse *struct {
+			XMLName      xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol ArtifactResponse"`
+			ID           string   `xml:"ID,attr"`
+			InResponseTo string   `xml:"InResponseTo,attr"`
+			Issuer       string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+			Status       Status   `xml:"Status"`
+		} `xml:"ArtifactResponse"`
+	} `xml:"Body"`
+}
@@ -2,18 +2,29 @@ package samltypes
 
 import "encoding/xml"
 
+// EntitiesDescriptor is a metadata document describing several entities, as
+// published by federations and some IDPs. EntitiesDescriptors may nest.
+type EntitiesDescriptor struct {
+	XMLName             xml.Name             `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntitiesDescriptor"`
+	EntitiesDescriptors []EntitiesDescriptor `xml:"EntitiesDescriptor"`
+	EntityDescriptors   []Metadata           `xml:"EntityDescriptor"`
+}
+
 type Metadata struct {
-	XMLName          xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
-	EntityID         string   `xml:"entityID,attr"`
-	IDPSSODescriptor struct {
-		XMLName       xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
-		KeyDescriptor struct {
+	XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string   `xml:"entityID,attr"`
+
+	// IDPSSODescriptor is nil if the entity is not an IDP.
+	IDPSSODescriptor *struct {
+		XMLName        xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata IDPSSODescriptor"`
+		KeyDescriptors []struct {
 			XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata KeyDescriptor"`
+			Use     string   `xml:"use,attr"`
 			KeyInfo struct {
 				XMLName  xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
-				X509Data struct {
-					XMLName         xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# X509Data"`
-					X509Certificate struct {
+				X509Data []struct {
+					XMLName          xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# X509Data"`
+					X509Certificates []struct {
 						XMLName xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# X509Certificate"`
 						Value   string   `xml:",chardata"`
 					} `xml:"X509Certificate"`
@@ -25,5 +36,15 @@ type Metadata struct {
 			Binding  string   `xml:"Binding,attr"`
 			Location string   `xml:"Location,attr"`
 		} `xml:"SingleSignOnService"`
+		SingleLogoutServices []struct {
+			XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata SingleLogoutService"`
+			Binding  string   `xml:"Binding,attr"`
+			Location string   `xml:"Location,attr"`
+		} `xml:"SingleLogoutService"`
+		ArtifactResolutionServices []struct {
+			XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata ArtifactResolutionService"`
+			Binding  string   `xml:"Binding,attr"`
+			Location string   `xml:"Location,attr"`
+		} `xml:"ArtifactResolutionService"`
 	} `xml:"IDPSSODescriptor"`
 }
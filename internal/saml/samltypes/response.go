@@ -1,17 +1,48 @@
 package samltypes
 
-import "time"
-import "encoding/xml"
+import (
+	"encoding/xml"
+	"time"
+
+	"github.com/ssoready/ssoready/internal/saml/xmlenc"
+)
 
 type Response struct {
-	XMLName   xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:protocol Response"`
+	XMLName      xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol Response"`
+	InResponseTo string   `xml:"InResponseTo,attr"`
+	Destination  string   `xml:"Destination,attr"`
+	Issuer       struct {
+		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Issuer"`
+		Name    string   `xml:",chardata"`
+	} `xml:"Issuer"`
+	Status    Status    `xml:"Status"`
 	Assertion Assertion `xml:"Assertion"`
 }
 
+type Status struct {
+	XMLName    xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol Status"`
+	StatusCode struct {
+		XMLName    xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+		Value      string   `xml:"Value,attr"`
+		StatusCode struct {
+			XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusCode"`
+			Value   string   `xml:"Value,attr"`
+		} `xml:"StatusCode"`
+	} `xml:"StatusCode"`
+	StatusMessage string `xml:"urn:oasis:names:tc:SAML:2.0:protocol StatusMessage"`
+}
+
+type EncryptedAssertion struct {
+	XMLName       xml.Name              `xml:"urn:oasis:names:tc:SAML:2.0:assertion EncryptedAssertion"`
+	EncryptedData xmlenc.EncryptedData  `xml:"http://www.w3.org/2001/04/xmlenc# EncryptedData"`
+	EncryptedKeys []xmlenc.EncryptedKey `xml:"http://www.w3.org/2001/04/xmlenc# EncryptedKey"`
+}
+
 type Assertion struct {
-	XMLName   xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
-	ID        string   `xml:"ID,attr"`
-	Signature struct {
+	XMLName      xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:assertion Assertion"`
+	ID           string    `xml:"ID,attr"`
+	IssueInstant time.Time `xml:"IssueInstant,attr"`
+	Signature    struct {
 		XMLName    xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# Signature"`
 		SignedInfo struct {
 			XMLName                xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# SignedInfo"`
@@ -58,13 +89,17 @@ type Assertion struct {
 		XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Subject"`
 		NameID  struct {
 			XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion NameID"`
+			Format  string   `xml:"Format,attr"`
 			Value   string   `xml:",chardata"`
 		} `xml:"NameID"`
 		SubjectConfirmation struct {
 			XMLName                 xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmation"`
+			Method                  string   `xml:"Method,attr"`
 			SubjectConfirmationData struct {
-				XMLName      xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmationData"`
-				InResponseTo string   `xml:"InResponseTo,attr"`
+				XMLName      xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:assertion SubjectConfirmationData"`
+				InResponseTo string    `xml:"InResponseTo,attr"`
+				Recipient    string    `xml:"Recipient,attr"`
+				NotOnOrAfter time.Time `xml:"NotOnOrAfter,attr"`
 			} `xml:"SubjectConfirmationData"`
 		} `xml:"SubjectConfirmation"`
 	} `xml:"Subject"`
@@ -85,11 +120,17 @@ type Assertion struct {
 		Attributes []struct {
 			XMLName xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion Attribute"`
 			Name    string   `xml:"Name,attr"`
-			Value   string   `xml:"AttributeValue"`
+			Values  []string `xml:"AttributeValue"`
 		} `xml:"Attribute"`
 	} `xml:"AttributeStatement"`
 	AuthnStatement struct {
-		XMLName      xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion AuthnStatement"`
-		SessionIndex string   `xml:"SessionIndex,attr"`
+		XMLName             xml.Name  `xml:"urn:oasis:names:tc:SAML:2.0:assertion AuthnStatement"`
+		AuthnInstant        time.Time `xml:"AuthnInstant,attr"`
+		SessionIndex        string    `xml:"SessionIndex,attr"`
+		SessionNotOnOrAfter time.Time `xml:"SessionNotOnOrAfter,attr"`
+		AuthnContext        struct {
+			XMLName              xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:assertion AuthnContext"`
+			AuthnContextClassRef string   `xml:"urn:oasis:names:tc:SAML:2.0:assertion AuthnContextClassRef"`
+		} `xml:"AuthnContext"`
 	} `xml:"AuthnStatement"`
 }
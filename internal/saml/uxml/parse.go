@@ -14,6 +14,7 @@ var parser = participle.MustBuild[doc](
 	participle.Lexer(lexer.MustStateful(lexer.Rules{
 		"Root": {
 			{"<?", `<\?`, lexer.Push("Declaration")},
+			{"<!--", `<!--`, lexer.Push("Comment")},
 			{"<", `<`, lexer.Push("Element")},
 			{"BeginText", `[^<]`, lexer.Push("Text")},
 		},
@@ -33,13 +34,17 @@ var parser = participle.MustBuild[doc](
 			{"Name", `[a-zA-Z][a-zA-Z0-9:]*`, nil},
 			{"String", `"([^"\\]|\\.)*"`, nil},
 		},
+		"Comment": {
+			{"-->", `-->`, lexer.Pop()},
+			{"CommentText", `([^-]|-[^-])+`, nil},
+		},
 		"Text": {
 			{"Text", `[^<]+`, nil},
 			lexer.Return(),
 		},
 	})),
 	participle.Elide("S"),
-	participle.Union[node](elem{}, text{}),
+	participle.Union[node](elem{}, text{}, comment{}),
 	participle.Union[elemTail](elemTailEmpty{}, elemTailChildren{}),
 )
 
@@ -88,6 +93,12 @@ type text struct {
 
 func (text) node() {}
 
+type comment struct {
+	Text string `parser:"'<!--' @CommentText? '-->'"`
+}
+
+func (comment) node() {}
+
 func convertDocument(d doc) (*Document, error) {
 	var s stack.Stack
 	for _, n := range d.Nodes {
@@ -117,6 +128,9 @@ func convertNode(s stack.Stack, n node) (*Node, error) {
 			return nil, err
 		}
 		return &Node{Text: text}, nil
+	case comment:
+		text := n.Text
+		return &Node{Comment: &text}, nil
 	default:
 		panic("unreachable")
 	}
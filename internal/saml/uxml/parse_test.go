@@ -10,6 +10,10 @@ func textNode(s string) uxml.Node {
 	return uxml.Node{Text: &s}
 }
 
+func commentNode(s string) uxml.Node {
+	return uxml.Node{Comment: &s}
+}
+
 func TestParse(t *testing.T) {
 	testCases := []struct {
 		name string
@@ -118,6 +122,11 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "element with comments",
+			in:   `<!-- before --><a>text<!-- inside -->more<!----></a><!-- after -->`,
+			out:  &uxml.Document{Root: uxml.Node{Element: &uxml.Element{Name: uxml.Name{Local: "a"}, Children: []uxml.Node{textNode("text"), commentNode(" inside "), textNode("more"), commentNode("")}}}},
+		},
 		{
 			name: "declaration",
 			in:   `<?xml version="1.0" encoding="UTF-8"?><a />`,
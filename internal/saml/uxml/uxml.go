@@ -8,6 +8,7 @@ type Document struct {
 type Node struct {
 	Element *Element
 	Text    *string
+	Comment *string
 }
 
 type Element struct {
@@ -1,6 +1,7 @@
 package saml
 
 import (
+	"crypto/rsa"
 	"crypto/x509"
 	"encoding/base64"
 	"encoding/xml"
@@ -12,20 +13,129 @@ import (
 	"github.com/ssoready/ssoready/internal/saml/samltypes"
 )
 
+const subjectConfirmationMethodBearer = "urn:oasis:names:tc:SAML:2.0:cm:bearer"
+
+const statusCodeSuccess = "urn:oasis:names:tc:SAML:2.0:status:Success"
+
 type ValidateRequest struct {
-	SAMLResponse   string
-	IDPCertificate *x509.Certificate
-	IDPEntityID    string
-	SPEntityID     string
-	Now            time.Time
+	SAMLResponse    string
+	IDPCertificates []IDPCertificate
+	IDPEntityID     string
+	SPEntityID      string
+	Now             time.Time
+
+	// SPACSURL is the URL the IDP must address the SAML response to, in the
+	// Response's Destination and the SubjectConfirmationData's Recipient.
+	SPACSURL string
+
+	// ClockSkew is how far the IDP's clock may be from Now when checking the
+	// assertion's validity windows.
+	ClockSkew time.Duration
+
+	// AllowedSignatureAlgorithms and AllowedDigestAlgorithms are the XML DSig
+	// algorithm identifiers the IDP may sign with. If empty, the dsig package's
+	// defaults are used.
+	AllowedSignatureAlgorithms []string
+	AllowedDigestAlgorithms    []string
+
+	// SPDecryptionKeys are used to decrypt an EncryptedAssertion, if the IDP
+	// sent one. Each key is tried in turn.
+	SPDecryptionKeys []*rsa.PrivateKey
+}
+
+// IDPCertificate is a certificate the IDP may sign with. If non-zero, NotBefore
+// and NotAfter restrict when the certificate is trusted, so that an IDP's next
+// certificate can be configured ahead of a rollover, and its previous one
+// retired after.
+type IDPCertificate struct {
+	Certificate *x509.Certificate
+	NotBefore   time.Time
+	NotAfter    time.Time
+}
+
+func (c IDPCertificate) active(now time.Time) bool {
+	if !c.NotBefore.IsZero() && now.Before(c.NotBefore) {
+		return false
+	}
+	if !c.NotAfter.IsZero() && !now.Before(c.NotAfter) {
+		return false
+	}
+	return true
+}
+
+// activeCertificates returns the certificates in certs that are trusted at
+// now.
+func activeCertificates(certs []IDPCertificate, now time.Time) []*x509.Certificate {
+	var out []*x509.Certificate
+	for _, c := range certs {
+		if c.active(now) {
+			out = append(out, c.Certificate)
+		}
+	}
+	return out
 }
 
 type ValidateResponse struct {
-	RequestID         string
-	AssertionID       string
-	Assertion         string
-	SubjectID         string
-	SubjectAttributes map[string]string
+	RequestID   string
+	AssertionID string
+	Assertion   string
+	SubjectID   string
+
+	// SubjectIDFormat is the Format of the assertion's NameID, e.g.
+	// "urn:oasis:names:tc:SAML:2.0:nameid-format:persistent". It is empty if
+	// the IDP did not specify one.
+	SubjectIDFormat string
+
+	// SubjectAttributes are the values of each attribute in the assertion's
+	// AttributeStatement, in the order the IDP sent them.
+	SubjectAttributes map[string][]string
+
+	// IssueInstant is when the IDP issued the assertion.
+	IssueInstant time.Time
+
+	// SessionIndex identifies the IDP session the assertion was issued in, if
+	// the IDP provided one. It is needed to request single logout.
+	SessionIndex string
+
+	// AuthnInstant is when the user authenticated to the IDP, which may be
+	// well before IssueInstant if the user already had a session there.
+	AuthnInstant time.Time
+
+	// SessionNotOnOrAfter is when the IDP says the session should end. It is
+	// zero if the IDP did not specify one.
+	SessionNotOnOrAfter time.Time
+
+	// AuthnContextClassRef describes how the user authenticated, e.g.
+	// "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport". It
+	// is empty if the IDP did not specify one.
+	AuthnContextClassRef string
+
+	// ResponseSigned and AssertionSigned indicate which elements carried a
+	// valid signature. At least one of them is always true.
+	ResponseSigned  bool
+	AssertionSigned bool
+
+	// IDPCertificate is the certificate that verified the signature.
+	IDPCertificate *x509.Certificate
+
+	// IDPVendor is the IDP product the assertion appears to be from, if it is
+	// recognized. See DetectVendor.
+	IDPVendor Vendor
+}
+
+// IDPStatus is the samlp:Status of a SAML response that did not succeed.
+type IDPStatus struct {
+	// StatusCode is the top-level StatusCode, e.g.
+	// "urn:oasis:names:tc:SAML:2.0:status:Responder".
+	StatusCode string
+
+	// SubStatusCode is the second-level StatusCode, e.g.
+	// "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed". It is empty if the IDP
+	// did not specify one.
+	SubStatusCode string
+
+	// StatusMessage is empty if the IDP did not specify one.
+	StatusMessage string
 }
 
 type ValidateError struct {
@@ -33,14 +143,45 @@ type ValidateError struct {
 	AssertionID string
 	Assertion   string
 
-	MalformedAssertion    bool
-	UnsignedAssertion     bool
-	ExpiredAssertion      bool
-	BadIDPEntityID        *string
-	BadSPEntityID         *string
-	BadSignatureAlgorithm *string
-	BadDigestAlgorithm    *string
-	BadCertificate        *x509.Certificate
+	MalformedAssertion     bool
+	UndecryptableAssertion bool
+	UnsignedAssertion      bool
+	ExpiredAssertion       bool
+	BadIDPEntityID         *string
+	BadSPEntityID          *string
+	BadSignatureAlgorithm  *string
+	BadDigestAlgorithm     *string
+	BadCertificate         *x509.Certificate
+
+	// ExpiredSubjectConfirmation, BadDestination, BadRecipient, and
+	// BadSubjectConfirmationMethod are populated if the assertion was not
+	// issued for delivery to the SP's ACS URL, or is past the time the IDP said
+	// it may be delivered.
+	ExpiredSubjectConfirmation   bool
+	BadDestination               *string
+	BadRecipient                 *string
+	BadSubjectConfirmationMethod *string
+
+	// FailedStatus is populated if the IDP responded with a non-success status
+	// instead of an assertion. RequestID is taken from the response's
+	// InResponseTo.
+	FailedStatus *IDPStatus
+
+	// UnverifiedFailedStatus is set alongside FailedStatus if the response was
+	// unsigned, or its signature did not verify. Anyone can send such a
+	// response, so its status is only diagnostic; it must not be taken as the
+	// outcome of the SAML flow it claims to respond to.
+	UnverifiedFailedStatus bool
+
+	// ResponseSigned, AssertionSigned, and IDPCertificate are populated if the
+	// error happened after signatures were verified.
+	ResponseSigned  bool
+	AssertionSigned bool
+	IDPCertificate  *x509.Certificate
+
+	// IDPVendor is the IDP product the response appears to be from, if it is
+	// recognized. It is populated unless the response is malformed.
+	IDPVendor Vendor
 }
 
 func (e *ValidateError) Error() string {
@@ -48,6 +189,17 @@ func (e *ValidateError) Error() string {
 		return "saml assertion is malformed"
 	}
 
+	if e.UndecryptableAssertion {
+		return "saml assertion could not be decrypted"
+	}
+
+	if e.FailedStatus != nil {
+		if e.UnverifiedFailedStatus {
+			return "unverified saml response status: " + e.FailedStatus.StatusCode
+		}
+		return "saml response status: " + e.FailedStatus.StatusCode
+	}
+
 	if e.UnsignedAssertion {
 		return "saml assertion is unsigned"
 	}
@@ -56,6 +208,10 @@ func (e *ValidateError) Error() string {
 		return "saml assertion is expired"
 	}
 
+	if e.ExpiredSubjectConfirmation {
+		return "saml subject confirmation is expired"
+	}
+
 	if e.BadIDPEntityID != nil {
 		return "bad idp entity id: " + *e.BadIDPEntityID
 	}
@@ -76,6 +232,18 @@ func (e *ValidateError) Error() string {
 		return "bad assertion certificate"
 	}
 
+	if e.BadDestination != nil {
+		return "bad destination: " + *e.BadDestination
+	}
+
+	if e.BadRecipient != nil {
+		return "bad recipient: " + *e.BadRecipient
+	}
+
+	if e.BadSubjectConfirmationMethod != nil {
+		return "bad subject confirmation method: " + *e.BadSubjectConfirmationMethod
+	}
+
 	panic("unreachable")
 }
 
@@ -94,16 +262,79 @@ func Validate(req *ValidateRequest) (*ValidateResponse, error) {
 		})
 	}
 
+	// a response with a non-success status carries no assertion; report the
+	// status back so it can be tied to the flow it responds to
+	if statusCode := unverifiedResponse.Status.StatusCode.Value; statusCode != "" && statusCode != statusCodeSuccess {
+		validateError := &ValidateError{
+			RequestID: unverifiedResponse.InResponseTo,
+			Assertion: string(unverifiedData),
+			IDPVendor: DetectVendor(&DetectVendorRequest{EntityID: unverifiedResponse.Issuer.Name}),
+			FailedStatus: &IDPStatus{
+				StatusCode:    statusCode,
+				SubStatusCode: unverifiedResponse.Status.StatusCode.StatusCode.Value,
+				StatusMessage: unverifiedResponse.Status.StatusMessage,
+			},
+		}
+
+		// IDPs often don't sign failed responses, so their status is still
+		// reported back, but only a verified status may fail the flow
+		_, cert, err := dsig.VerifyMessage(&dsig.VerifyRequest{
+			Certificates:               activeCertificates(req.IDPCertificates, req.Now),
+			Data:                       unverifiedData,
+			AllowedSignatureAlgorithms: req.AllowedSignatureAlgorithms,
+			AllowedDigestAlgorithms:    req.AllowedDigestAlgorithms,
+		}, "Response")
+		if err != nil {
+			validateError.UnverifiedFailedStatus = true
+			return nil, validateError
+		}
+
+		validateError.ResponseSigned = true
+		validateError.IDPCertificate = cert
+		return nil, validateError
+	}
+
+	decryptedData, err := decryptAssertion(unverifiedData, req.SPDecryptionKeys)
+	if err != nil {
+		return nil, fmt.Errorf("decrypt saml assertion: %s: %w", err.Error(), &ValidateError{
+			Assertion:              string(unverifiedData),
+			UndecryptableAssertion: true,
+			IDPVendor:              DetectVendor(&DetectVendorRequest{EntityID: unverifiedResponse.Issuer.Name}),
+		})
+	}
+
+	// when the assertion is encrypted, report back and parse the decrypted
+	// response instead
+	assertionData := unverifiedData
+	if decryptedData != nil {
+		assertionData = decryptedData
+		unverifiedResponse = samltypes.Response{}
+		if err := xml.Unmarshal(assertionData, &unverifiedResponse); err != nil {
+			return nil, fmt.Errorf("parse decrypted saml response: %s: %w", err.Error(), &ValidateError{
+				MalformedAssertion: true,
+			})
+		}
+	}
+
 	validateError := &ValidateError{
-		Assertion: string(unverifiedData),
+		Assertion: string(assertionData),
 
 		// populate these fields on a preliminary basis, so we can report errors
 		// back to the user
 		RequestID:   unverifiedResponse.Assertion.Subject.SubjectConfirmation.SubjectConfirmationData.InResponseTo,
 		AssertionID: unverifiedResponse.Assertion.ID,
+		IDPVendor:   assertionVendor(unverifiedResponse.Assertion, nil),
 	}
 
-	verifiedData, err := dsig.Verify(req.IDPCertificate, unverifiedData)
+	certs := activeCertificates(req.IDPCertificates, req.Now)
+
+	verifyRes, err := dsig.Verify(&dsig.VerifyRequest{
+		Certificates:               certs,
+		Data:                       unverifiedData,
+		DecryptedData:              decryptedData,
+		AllowedSignatureAlgorithms: req.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    req.AllowedDigestAlgorithms,
+	})
 	if err != nil {
 		if errors.Is(err, dsig.ErrUnsigned) {
 			validateError.UnsignedAssertion = true
@@ -128,51 +359,131 @@ func Validate(req *ValidateRequest) (*ValidateResponse, error) {
 			return nil, validateError
 		}
 
+		// signature wrapping attempts, and documents ambiguous enough to be
+		// one, are rejected outright
+		if errors.Is(err, dsig.ErrBadReference) || errors.Is(err, dsig.ErrDuplicateID) || errors.Is(err, dsig.ErrNoAssertion) {
+			validateError.MalformedAssertion = true
+			return nil, fmt.Errorf("verify signature: %w: %w", err, validateError)
+		}
+
+		var unsupportedTransformError dsig.UnsupportedTransformError
+		if errors.As(err, &unsupportedTransformError) {
+			validateError.MalformedAssertion = true
+			return nil, fmt.Errorf("verify signature: %w: %w", err, validateError)
+		}
+
 		return nil, fmt.Errorf("verify signature: %w", err)
 	}
 
+	validateError.ResponseSigned = verifyRes.ResponseSigned
+	validateError.AssertionSigned = verifyRes.AssertionSigned
+	validateError.IDPCertificate = verifyRes.Certificate
+	validateError.IDPVendor = assertionVendor(unverifiedResponse.Assertion, verifyRes.Certificate)
+
 	var assertion samltypes.Assertion
-	if err := xml.Unmarshal(verifiedData, &assertion); err != nil {
+	if err := xml.Unmarshal(verifyRes.Assertion, &assertion); err != nil {
 		panic(err)
 	}
 
-	attrs := map[string]string{}
+	attrs := map[string][]string{}
 	for _, attr := range assertion.AttributeStatement.Attributes {
-		attrs[attr.Name] = attr.Value
+		if _, ok := attrs[attr.Name]; !ok {
+			attrs[attr.Name] = []string{}
+		}
+		attrs[attr.Name] = append(attrs[attr.Name], attr.Values...)
 	}
 
 	res := ValidateResponse{
-		Assertion: string(unverifiedData),
+		Assertion: string(assertionData),
 
 		// For purity's sake, when an assertion is considered legitimate, prefer
 		// the RequestID and AssertionID from the canonicalized assertion (in
 		// the variable assertion) over the initial input (in
 		// unverifiedResponse).
-		RequestID:         assertion.Subject.SubjectConfirmation.SubjectConfirmationData.InResponseTo,
-		AssertionID:       assertion.ID,
-		SubjectID:         assertion.Subject.NameID.Value,
-		SubjectAttributes: attrs,
+		RequestID:            assertion.Subject.SubjectConfirmation.SubjectConfirmationData.InResponseTo,
+		AssertionID:          assertion.ID,
+		SubjectID:            assertion.Subject.NameID.Value,
+		SubjectIDFormat:      assertion.Subject.NameID.Format,
+		SubjectAttributes:    attrs,
+		IssueInstant:         assertion.IssueInstant,
+		SessionIndex:         assertion.AuthnStatement.SessionIndex,
+		AuthnInstant:         assertion.AuthnStatement.AuthnInstant,
+		SessionNotOnOrAfter:  assertion.AuthnStatement.SessionNotOnOrAfter,
+		AuthnContextClassRef: assertion.AuthnStatement.AuthnContext.AuthnContextClassRef,
+		ResponseSigned:       verifyRes.ResponseSigned,
+		AssertionSigned:      verifyRes.AssertionSigned,
+		IDPCertificate:       verifyRes.Certificate,
+		IDPVendor:            assertionVendor(assertion, verifyRes.Certificate),
+	}
+
+	if errs := assertionErrors(req, unverifiedResponse, assertion, validateError); len(errs) > 0 {
+		return nil, errs[0]
+	}
+
+	return &res, nil
+}
+
+// assertionVendor detects the vendor of the IDP that issued assertion, which
+// was signed by cert if it is non-nil.
+func assertionVendor(assertion samltypes.Assertion, cert *x509.Certificate) Vendor {
+	var attributeNames []string
+	for _, attr := range assertion.AttributeStatement.Attributes {
+		attributeNames = append(attributeNames, attr.Name)
+	}
+
+	return DetectVendor(&DetectVendorRequest{
+		EntityID:       assertion.Issuer.Name,
+		Certificate:    cert,
+		AssertionID:    assertion.ID,
+		AttributeNames: attributeNames,
+	})
+}
+
+// assertionErrors returns every way that a signature-verified assertion fails
+// validation, in the order Validate checks them. Each error is a copy of base,
+// with the field describing the failure populated.
+func assertionErrors(req *ValidateRequest, response samltypes.Response, assertion samltypes.Assertion, base *ValidateError) []*ValidateError {
+	var errs []*ValidateError
+	fail := func(f func(e *ValidateError)) {
+		e := *base
+		f(&e)
+		errs = append(errs, &e)
 	}
 
 	if assertion.Issuer.Name != req.IDPEntityID {
-		validateError.BadIDPEntityID = &assertion.Issuer.Name
-		return nil, validateError
+		fail(func(e *ValidateError) { e.BadIDPEntityID = &assertion.Issuer.Name })
 	}
 
 	if assertion.Conditions.AudienceRestriction.Audience.Name != req.SPEntityID {
-		validateError.BadSPEntityID = &assertion.Conditions.AudienceRestriction.Audience.Name
-		return nil, validateError
+		fail(func(e *ValidateError) { e.BadSPEntityID = &assertion.Conditions.AudienceRestriction.Audience.Name })
 	}
 
-	if req.Now.Before(assertion.Conditions.NotBefore) {
-		validateError.ExpiredAssertion = true
-		return nil, validateError
+	// allow for the IDP's clock being ahead of or behind ours when checking
+	// validity windows
+	notBeforeNow := req.Now.Add(req.ClockSkew)
+	notAfterNow := req.Now.Add(-req.ClockSkew)
+
+	if notBeforeNow.Before(assertion.Conditions.NotBefore) || notAfterNow.After(assertion.Conditions.NotOnOrAfter) {
+		fail(func(e *ValidateError) { e.ExpiredAssertion = true })
 	}
 
-	if req.Now.After(assertion.Conditions.NotOnOrAfter) {
-		validateError.ExpiredAssertion = true
-		return nil, validateError
+	// the Destination is optional, but when present must be us
+	if req.SPACSURL != "" && response.Destination != "" && response.Destination != req.SPACSURL {
+		fail(func(e *ValidateError) { e.BadDestination = &response.Destination })
 	}
 
-	return &res, nil
+	subjectConfirmation := assertion.Subject.SubjectConfirmation
+	if subjectConfirmation.Method != subjectConfirmationMethodBearer {
+		fail(func(e *ValidateError) { e.BadSubjectConfirmationMethod = &subjectConfirmation.Method })
+	}
+
+	if req.SPACSURL != "" && subjectConfirmation.SubjectConfirmationData.Recipient != req.SPACSURL {
+		fail(func(e *ValidateError) { e.BadRecipient = &subjectConfirmation.SubjectConfirmationData.Recipient })
+	}
+
+	if notOnOrAfter := subjectConfirmation.SubjectConfirmationData.NotOnOrAfter; !notOnOrAfter.IsZero() && !notAfterNow.Before(notOnOrAfter) {
+		fail(func(e *ValidateError) { e.ExpiredSubjectConfirmation = true })
+	}
+
+	return errs
 }
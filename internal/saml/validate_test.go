@@ -1,15 +1,22 @@
 package saml_test
 
 import (
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/saml/samltest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,15 +33,200 @@ func TestValidate_KnownGoodAssertions(t *testing.T) {
 	}
 }
 
+func TestValidate_KnownBadAssertions(t *testing.T) {
+	entries, err := os.ReadDir("testdata/bad-assertions")
+	assert.NoError(t, err)
+
+	for _, entry := range entries {
+		t.Run(entry.Name(), func(t *testing.T) {
+			_, err := validateFromDir(fmt.Sprintf("testdata/bad-assertions/%s", entry.Name()))
+			assert.Error(t, err)
+		})
+	}
+}
+
 func TestValidate_GoodAssertionData(t *testing.T) {
 	res, err := validateFromDir("testdata/assertions/okta")
 	require.NoError(t, err)
 
-	assert.Equal(t, "<?xml version=\"1.0\" encoding=\"UTF-8\"?><saml2p:Response Destination=\"http://localhost:8080\" ID=\"id35528194005172931133953195\" IssueInstant=\"2024-04-25T20:31:55.494Z\" Version=\"2.0\" xmlns:saml2p=\"urn:oasis:names:tc:SAML:2.0:protocol\"><saml2:Issuer Format=\"urn:oasis:names:tc:SAML:2.0:nameid-format:entity\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\">http://www.okta.com/exkdoocxa1VmjpXmX697</saml2:Issuer><ds:Signature xmlns:ds=\"http://www.w3.org/2000/09/xmldsig#\"><ds:SignedInfo><ds:CanonicalizationMethod Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/><ds:SignatureMethod Algorithm=\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\"/><ds:Reference URI=\"#id35528194005172931133953195\"><ds:Transforms><ds:Transform Algorithm=\"http://www.w3.org/2000/09/xmldsig#enveloped-signature\"/><ds:Transform Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/></ds:Transforms><ds:DigestMethod Algorithm=\"http://www.w3.org/2001/04/xmlenc#sha256\"/><ds:DigestValue>tQ3cGy9Kax5v8DdRTNTVPboMtL5viRVZLNmBIgpx/rQ=</ds:DigestValue></ds:Reference></ds:SignedInfo><ds:SignatureValue>Jbtjo4MLglMSc6SopDHj2ZdRf8IA0bT5nlLeaysYgGlj0kd3gO6vYFzsybD6EqRiZvrUrOJU8JANuz17vpPxSGLmt8h1N1Uy0vVRpL3VQYU7KNgr6o2xtSU87IzBKCaGfFqPqN4CLaCs1wbKkAdkxKnwdEo6kHE//hAEckDofmKXdEJDihy8h6uUxO/EwKJgg9+G/8UYD3YiKpeFHfJTI0W+rDKLGmPXbRvHNF/JriltOTPSSZ8noQk2fz7WWYyO0F179MDMBDyxRHhA1uOf9JCYr28pCQ9iPQIIQnABVgAdaq++hixIHhvR4jNrwpGItwJb7aqCqd28TuXXzBUkxw==</ds:SignatureValue><ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIDqjCCApKgAwIBAgIGAY8W9FSqMA0GCSqGSIb3DQEBCwUAMIGVMQswCQYDVQQGEwJVUzETMBEG\n    A1UECAwKQ2FsaWZvcm5pYTEWMBQGA1UEBwwNU2FuIEZyYW5jaXNjbzENMAsGA1UECgwET2t0YTEU\n    MBIGA1UECwwLU1NPUHJvdmlkZXIxFjAUBgNVBAMMDXRyaWFsLTEwMjI4NjMxHDAaBgkqhkiG9w0B\n    CQEWDWluZm9Ab2t0YS5jb20wHhcNMjQwNDI1MjAzMDAyWhcNMzQwNDI1MjAzMTAyWjCBlTELMAkG\n    A1UEBhMCVVMxEzARBgNVBAgMCkNhbGlmb3JuaWExFjAUBgNVBAcMDVNhbiBGcmFuY2lzY28xDTAL\n    BgNVBAoMBE9rdGExFDASBgNVBAsMC1NTT1Byb3ZpZGVyMRYwFAYDVQQDDA10cmlhbC0xMDIyODYz\n    MRwwGgYJKoZIhvcNAQkBFg1pbmZvQG9rdGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\n    CgKCAQEAh8g24a5HDZpwtWuA/HP1JuecGMZ1Wh8R3QC/DQb4aNJtNwJlzMN746MQhkEtXI4TYTah\n    3bpbJc5jUFunjZdy8I4+pHCa4wS7lf9Z3c2Ptc9R1XzAX9zhC1Cuj01L69vAinNF8JR1tTx1A7im\n    pAWqjtKEQAZNsWrjo0TkQVZlU2wY/CLW+w/zRmHmxSzuCHIVtD9SkgPVXr/Wr2X2SFUc0miGc09x\n    FKSl1ARIRVf7jrI0hcSpB5lOd4jrZaM6pvYPTHZYsvtvE9IJUtRlD3OAenBeiHBvkzPwbnhIFUm0\n    2Rq9Q7Fvr2CMD8+w/vdgFECelHS0euNVx3uOGydnUh9WOQIDAQABMA0GCSqGSIb3DQEBCwUAA4IB\n    AQBqUvihKyejxTpV/mcm7KQu4g3NUx5blTa1jRj2jCDfbn3YckqGI9i0j8BAHNaZw56Nu7OIzDrL\n    nxsi8uMmdRAJqAQA7iILGAEJuMvHfv2SJkcu2goB9Xl69Kh34UgZd3tucDEgM3cwhUlltU8yV+P2\n    +uzhNaHJkDargKeEI1NQG0lvcFJHP5ESTR9idIipJDdBcSxais3wLkRlhvufp3Rr71Z6TylTVvc3\n    QwAjCyTmfR2YjhQkVVfWdOEwqOYhyIn2d+gUex0gEGOZqzmMgCD20mNkiL+YTEsz5XqDaUDQsLrS\n    whMgwbzHoz7vrWZiwq2K2AYIu8Uh//DZxsDM9g0B</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature><saml2p:Status xmlns:saml2p=\"urn:oasis:names:tc:SAML:2.0:protocol\"><saml2p:StatusCode Value=\"urn:oasis:names:tc:SAML:2.0:status:Success\"/></saml2p:Status><saml2:Assertion ID=\"id35528194006743571812188338\" IssueInstant=\"2024-04-25T20:31:55.494Z\" Version=\"2.0\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:Issuer Format=\"urn:oasis:names:tc:SAML:2.0:nameid-format:entity\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\">http://www.okta.com/exkdoocxa1VmjpXmX697</saml2:Issuer><ds:Signature xmlns:ds=\"http://www.w3.org/2000/09/xmldsig#\"><ds:SignedInfo><ds:CanonicalizationMethod Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/><ds:SignatureMethod Algorithm=\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\"/><ds:Reference URI=\"#id35528194006743571812188338\"><ds:Transforms><ds:Transform Algorithm=\"http://www.w3.org/2000/09/xmldsig#enveloped-signature\"/><ds:Transform Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/></ds:Transforms><ds:DigestMethod Algorithm=\"http://www.w3.org/2001/04/xmlenc#sha256\"/><ds:DigestValue>gYuidj1kP4bdhylZxf86HtfmknIINpURdJGSpKXoM3I=</ds:DigestValue></ds:Reference></ds:SignedInfo><ds:SignatureValue>IJ6EEwpVGEOCTJX6xOlO43/HtZ9JgOYODlZepGfUHWVYYkKee6LW/zyCgKPPtHObgzbUjjyRjl/0yMQQ1FhB7K8KJp0dYu/1iguHxKJdVSb2fdAerKzpGuJq2WXw1BtKp+UmCfDi1SFjCrXf8noJrwYnpRI+wVgHC+QnSE1S49y+E2FLt5WY18D8KvMj8X7SKDNOhgUTUCErnG3PVH6gR4WRjTv8Ea3C+jn4jKKLSbvNgVJ9WuI8ZMM90g+LyVEWvPaR2zs9SQgAJG5VHAKtS7c8VCINRwyNb+oh2hdpaSMjPrxdPEjdD73YuZttupZGjnPbI4PsS2kg4OL4py0Ozw==</ds:SignatureValue><ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIDqjCCApKgAwIBAgIGAY8W9FSqMA0GCSqGSIb3DQEBCwUAMIGVMQswCQYDVQQGEwJVUzETMBEG\n    A1UECAwKQ2FsaWZvcm5pYTEWMBQGA1UEBwwNU2FuIEZyYW5jaXNjbzENMAsGA1UECgwET2t0YTEU\n    MBIGA1UECwwLU1NPUHJvdmlkZXIxFjAUBgNVBAMMDXRyaWFsLTEwMjI4NjMxHDAaBgkqhkiG9w0B\n    CQEWDWluZm9Ab2t0YS5jb20wHhcNMjQwNDI1MjAzMDAyWhcNMzQwNDI1MjAzMTAyWjCBlTELMAkG\n    A1UEBhMCVVMxEzARBgNVBAgMCkNhbGlmb3JuaWExFjAUBgNVBAcMDVNhbiBGcmFuY2lzY28xDTAL\n    BgNVBAoMBE9rdGExFDASBgNVBAsMC1NTT1Byb3ZpZGVyMRYwFAYDVQQDDA10cmlhbC0xMDIyODYz\n    MRwwGgYJKoZIhvcNAQkBFg1pbmZvQG9rdGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\n    CgKCAQEAh8g24a5HDZpwtWuA/HP1JuecGMZ1Wh8R3QC/DQb4aNJtNwJlzMN746MQhkEtXI4TYTah\n    3bpbJc5jUFunjZdy8I4+pHCa4wS7lf9Z3c2Ptc9R1XzAX9zhC1Cuj01L69vAinNF8JR1tTx1A7im\n    pAWqjtKEQAZNsWrjo0TkQVZlU2wY/CLW+w/zRmHmxSzuCHIVtD9SkgPVXr/Wr2X2SFUc0miGc09x\n    FKSl1ARIRVf7jrI0hcSpB5lOd4jrZaM6pvYPTHZYsvtvE9IJUtRlD3OAenBeiHBvkzPwbnhIFUm0\n    2Rq9Q7Fvr2CMD8+w/vdgFECelHS0euNVx3uOGydnUh9WOQIDAQABMA0GCSqGSIb3DQEBCwUAA4IB\n    AQBqUvihKyejxTpV/mcm7KQu4g3NUx5blTa1jRj2jCDfbn3YckqGI9i0j8BAHNaZw56Nu7OIzDrL\n    nxsi8uMmdRAJqAQA7iILGAEJuMvHfv2SJkcu2goB9Xl69Kh34UgZd3tucDEgM3cwhUlltU8yV+P2\n    +uzhNaHJkDargKeEI1NQG0lvcFJHP5ESTR9idIipJDdBcSxais3wLkRlhvufp3Rr71Z6TylTVvc3\n    QwAjCyTmfR2YjhQkVVfWdOEwqOYhyIn2d+gUex0gEGOZqzmMgCD20mNkiL+YTEsz5XqDaUDQsLrS\n    whMgwbzHoz7vrWZiwq2K2AYIu8Uh//DZxsDM9g0B</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature><saml2:Subject xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:NameID Format=\"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress\">ulysse.carion@codomaindata.com</saml2:NameID><saml2:SubjectConfirmation Method=\"urn:oasis:names:tc:SAML:2.0:cm:bearer\"><saml2:SubjectConfirmationData NotOnOrAfter=\"2024-04-25T20:36:55.494Z\" Recipient=\"http://localhost:8080\"/></saml2:SubjectConfirmation></saml2:Subject><saml2:Conditions NotBefore=\"2024-04-25T20:26:55.494Z\" NotOnOrAfter=\"2024-04-25T20:36:55.494Z\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:AudienceRestriction><saml2:Audience>http://localhost:8080</saml2:Audience></saml2:AudienceRestriction></saml2:Conditions><saml2:AuthnStatement AuthnInstant=\"2024-04-25T20:26:25.134Z\" SessionIndex=\"id1714077115304.328863927\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:AuthnContext><saml2:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport</saml2:AuthnContextClassRef></saml2:AuthnContext></saml2:AuthnStatement></saml2:Assertion></saml2p:Response>\n", res.Assertion)
+	assert.Equal(t, "<?xml version=\"1.0\" encoding=\"UTF-8\"?><saml2p:Response Destination=\"http://localhost:8080\" ID=\"id35528194005172931133953195\" IssueInstant=\"2024-04-25T20:31:55.494Z\" Version=\"2.0\" xmlns:saml2p=\"urn:oasis:names:tc:SAML:2.0:protocol\"><saml2:Issuer Format=\"urn:oasis:names:tc:SAML:2.0:nameid-format:entity\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\">http://www.okta.com/exkdoocxa1VmjpXmX697</saml2:Issuer><ds:Signature xmlns:ds=\"http://www.w3.org/2000/09/xmldsig#\"><ds:SignedInfo><ds:CanonicalizationMethod Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/><ds:SignatureMethod Algorithm=\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\"/><ds:Reference URI=\"#id35528194005172931133953195\"><ds:Transforms><ds:Transform Algorithm=\"http://www.w3.org/2000/09/xmldsig#enveloped-signature\"/><ds:Transform Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/></ds:Transforms><ds:DigestMethod Algorithm=\"http://www.w3.org/2001/04/xmlenc#sha256\"/><ds:DigestValue>tQ3cGy9Kax5v8DdRTNTVPboMtL5viRVZLNmBIgpx/rQ=</ds:DigestValue></ds:Reference></ds:SignedInfo><ds:SignatureValue>Jbtjo4MLglMSc6SopDHj2ZdRf8IA0bT5nlLeaysYgGlj0kd3gO6vYFzsybD6EqRiZvrUrOJU8JANuz17vpPxSGLmt8h1N1Uy0vVRpL3VQYU7KNgr6o2xtSU87IzBKCaGfFqPqN4CLaCs1wbKkAdkxKnwdEo6kHE//hAEckDofmKXdEJDihy8h6uUxO/EwKJgg9+G/8UYD3YiKpeFHfJTI0W+rDKLGmPXbRvHNF/JriltOTPSSZ8noQk2fz7WWYyO0F179MDMBDyxRHhA1uOf9JCYr28pCQ9iPQIIQnABVgAdaq++hixIHhvR4jNrwpGItwJb7aqCqd28TuXXzBUkxw==</ds:SignatureValue><ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIDqjCCApKgAwIBAgIGAY8W9FSqMA0GCSqGSIb3DQEBCwUAMIGVMQswCQYDVQQGEwJVUzETMBEG\nA1UECAwKQ2FsaWZvcm5pYTEWMBQGA1UEBwwNU2FuIEZyYW5jaXNjbzENMAsGA1UECgwET2t0YTEU\nMBIGA1UECwwLU1NPUHJvdmlkZXIxFjAUBgNVBAMMDXRyaWFsLTEwMjI4NjMxHDAaBgkqhkiG9w0B\nCQEWDWluZm9Ab2t0YS5jb20wHhcNMjQwNDI1MjAzMDAyWhcNMzQwNDI1MjAzMTAyWjCBlTELMAkG\nA1UEBhMCVVMxEzARBgNVBAgMCkNhbGlmb3JuaWExFjAUBgNVBAcMDVNhbiBGcmFuY2lzY28xDTAL\nBgNVBAoMBE9rdGExFDASBgNVBAsMC1NTT1Byb3ZpZGVyMRYwFAYDVQQDDA10cmlhbC0xMDIyODYz\nMRwwGgYJKoZIhvcNAQkBFg1pbmZvQG9rdGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEAh8g24a5HDZpwtWuA/HP1JuecGMZ1Wh8R3QC/DQb4aNJtNwJlzMN746MQhkEtXI4TYTah\n3bpbJc5jUFunjZdy8I4+pHCa4wS7lf9Z3c2Ptc9R1XzAX9zhC1Cuj01L69vAinNF8JR1tTx1A7im\npAWqjtKEQAZNsWrjo0TkQVZlU2wY/CLW+w/zRmHmxSzuCHIVtD9SkgPVXr/Wr2X2SFUc0miGc09x\nFKSl1ARIRVf7jrI0hcSpB5lOd4jrZaM6pvYPTHZYsvtvE9IJUtRlD3OAenBeiHBvkzPwbnhIFUm0\n2Rq9Q7Fvr2CMD8+w/vdgFECelHS0euNVx3uOGydnUh9WOQIDAQABMA0GCSqGSIb3DQEBCwUAA4IB\nAQBqUvihKyejxTpV/mcm7KQu4g3NUx5blTa1jRj2jCDfbn3YckqGI9i0j8BAHNaZw56Nu7OIzDrL\nnxsi8uMmdRAJqAQA7iILGAEJuMvHfv2SJkcu2goB9Xl69Kh34UgZd3tucDEgM3cwhUlltU8yV+P2\n+uzhNaHJkDargKeEI1NQG0lvcFJHP5ESTR9idIipJDdBcSxais3wLkRlhvufp3Rr71Z6TylTVvc3\nQwAjCyTmfR2YjhQkVVfWdOEwqOYhyIn2d+gUex0gEGOZqzmMgCD20mNkiL+YTEsz5XqDaUDQsLrS\nwhMgwbzHoz7vrWZiwq2K2AYIu8Uh//DZxsDM9g0B</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature><saml2p:Status xmlns:saml2p=\"urn:oasis:names:tc:SAML:2.0:protocol\"><saml2p:StatusCode Value=\"urn:oasis:names:tc:SAML:2.0:status:Success\"/></saml2p:Status><saml2:Assertion ID=\"id35528194006743571812188338\" IssueInstant=\"2024-04-25T20:31:55.494Z\" Version=\"2.0\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:Issuer Format=\"urn:oasis:names:tc:SAML:2.0:nameid-format:entity\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\">http://www.okta.com/exkdoocxa1VmjpXmX697</saml2:Issuer><ds:Signature xmlns:ds=\"http://www.w3.org/2000/09/xmldsig#\"><ds:SignedInfo><ds:CanonicalizationMethod Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/><ds:SignatureMethod Algorithm=\"http://www.w3.org/2001/04/xmldsig-more#rsa-sha256\"/><ds:Reference URI=\"#id35528194006743571812188338\"><ds:Transforms><ds:Transform Algorithm=\"http://www.w3.org/2000/09/xmldsig#enveloped-signature\"/><ds:Transform Algorithm=\"http://www.w3.org/2001/10/xml-exc-c14n#\"/></ds:Transforms><ds:DigestMethod Algorithm=\"http://www.w3.org/2001/04/xmlenc#sha256\"/><ds:DigestValue>gYuidj1kP4bdhylZxf86HtfmknIINpURdJGSpKXoM3I=</ds:DigestValue></ds:Reference></ds:SignedInfo><ds:SignatureValue>IJ6EEwpVGEOCTJX6xOlO43/HtZ9JgOYODlZepGfUHWVYYkKee6LW/zyCgKPPtHObgzbUjjyRjl/0yMQQ1FhB7K8KJp0dYu/1iguHxKJdVSb2fdAerKzpGuJq2WXw1BtKp+UmCfDi1SFjCrXf8noJrwYnpRI+wVgHC+QnSE1S49y+E2FLt5WY18D8KvMj8X7SKDNOhgUTUCErnG3PVH6gR4WRjTv8Ea3C+jn4jKKLSbvNgVJ9WuI8ZMM90g+LyVEWvPaR2zs9SQgAJG5VHAKtS7c8VCINRwyNb+oh2hdpaSMjPrxdPEjdD73YuZttupZGjnPbI4PsS2kg4OL4py0Ozw==</ds:SignatureValue><ds:KeyInfo><ds:X509Data><ds:X509Certificate>MIIDqjCCApKgAwIBAgIGAY8W9FSqMA0GCSqGSIb3DQEBCwUAMIGVMQswCQYDVQQGEwJVUzETMBEG\nA1UECAwKQ2FsaWZvcm5pYTEWMBQGA1UEBwwNU2FuIEZyYW5jaXNjbzENMAsGA1UECgwET2t0YTEU\nMBIGA1UECwwLU1NPUHJvdmlkZXIxFjAUBgNVBAMMDXRyaWFsLTEwMjI4NjMxHDAaBgkqhkiG9w0B\nCQEWDWluZm9Ab2t0YS5jb20wHhcNMjQwNDI1MjAzMDAyWhcNMzQwNDI1MjAzMTAyWjCBlTELMAkG\nA1UEBhMCVVMxEzARBgNVBAgMCkNhbGlmb3JuaWExFjAUBgNVBAcMDVNhbiBGcmFuY2lzY28xDTAL\nBgNVBAoMBE9rdGExFDASBgNVBAsMC1NTT1Byb3ZpZGVyMRYwFAYDVQQDDA10cmlhbC0xMDIyODYz\nMRwwGgYJKoZIhvcNAQkBFg1pbmZvQG9rdGEuY29tMIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIB\nCgKCAQEAh8g24a5HDZpwtWuA/HP1JuecGMZ1Wh8R3QC/DQb4aNJtNwJlzMN746MQhkEtXI4TYTah\n3bpbJc5jUFunjZdy8I4+pHCa4wS7lf9Z3c2Ptc9R1XzAX9zhC1Cuj01L69vAinNF8JR1tTx1A7im\npAWqjtKEQAZNsWrjo0TkQVZlU2wY/CLW+w/zRmHmxSzuCHIVtD9SkgPVXr/Wr2X2SFUc0miGc09x\nFKSl1ARIRVf7jrI0hcSpB5lOd4jrZaM6pvYPTHZYsvtvE9IJUtRlD3OAenBeiHBvkzPwbnhIFUm0\n2Rq9Q7Fvr2CMD8+w/vdgFECelHS0euNVx3uOGydnUh9WOQIDAQABMA0GCSqGSIb3DQEBCwUAA4IB\nAQBqUvihKyejxTpV/mcm7KQu4g3NUx5blTa1jRj2jCDfbn3YckqGI9i0j8BAHNaZw56Nu7OIzDrL\nnxsi8uMmdRAJqAQA7iILGAEJuMvHfv2SJkcu2goB9Xl69Kh34UgZd3tucDEgM3cwhUlltU8yV+P2\n+uzhNaHJkDargKeEI1NQG0lvcFJHP5ESTR9idIipJDdBcSxais3wLkRlhvufp3Rr71Z6TylTVvc3\nQwAjCyTmfR2YjhQkVVfWdOEwqOYhyIn2d+gUex0gEGOZqzmMgCD20mNkiL+YTEsz5XqDaUDQsLrS\nwhMgwbzHoz7vrWZiwq2K2AYIu8Uh//DZxsDM9g0B</ds:X509Certificate></ds:X509Data></ds:KeyInfo></ds:Signature><saml2:Subject xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:NameID Format=\"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress\">ulysse.carion@codomaindata.com</saml2:NameID><saml2:SubjectConfirmation Method=\"urn:oasis:names:tc:SAML:2.0:cm:bearer\"><saml2:SubjectConfirmationData NotOnOrAfter=\"2024-04-25T20:36:55.494Z\" Recipient=\"http://localhost:8080\"/></saml2:SubjectConfirmation></saml2:Subject><saml2:Conditions NotBefore=\"2024-04-25T20:26:55.494Z\" NotOnOrAfter=\"2024-04-25T20:36:55.494Z\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:AudienceRestriction><saml2:Audience>http://localhost:8080</saml2:Audience></saml2:AudienceRestriction></saml2:Conditions><saml2:AuthnStatement AuthnInstant=\"2024-04-25T20:26:25.134Z\" SessionIndex=\"id1714077115304.328863927\" xmlns:saml2=\"urn:oasis:names:tc:SAML:2.0:assertion\"><saml2:AuthnContext><saml2:AuthnContextClassRef>urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport</saml2:AuthnContextClassRef></saml2:AuthnContext></saml2:AuthnStatement></saml2:Assertion></saml2p:Response>\n", res.Assertion)
 	assert.Equal(t, "id35528194006743571812188338", res.AssertionID)
 	assert.Equal(t, "", res.RequestID)
 	assert.Equal(t, "ulysse.carion@codomaindata.com", res.SubjectID)
-	assert.Equal(t, map[string]string{}, res.SubjectAttributes)
+	assert.Equal(t, "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress", res.SubjectIDFormat)
+	assert.Equal(t, map[string][]string{}, res.SubjectAttributes)
+	assert.Equal(t, time.Date(2024, 4, 25, 20, 31, 55, 494000000, time.UTC), res.IssueInstant)
+	assert.Equal(t, "id1714077115304.328863927", res.SessionIndex)
+	assert.Equal(t, time.Date(2024, 4, 25, 20, 26, 25, 134000000, time.UTC), res.AuthnInstant)
+	assert.True(t, res.SessionNotOnOrAfter.IsZero())
+	assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:ac:classes:PasswordProtectedTransport", res.AuthnContextClassRef)
+}
+
+func TestValidate_SignedElements(t *testing.T) {
+	testCases := []struct {
+		name            string
+		responseSigned  bool
+		assertionSigned bool
+	}{
+		{name: "okta", responseSigned: true, assertionSigned: true},
+		{name: "google", responseSigned: false, assertionSigned: true},
+		{name: "response-signed", responseSigned: true, assertionSigned: false},
+		{name: "encrypted-assertion", responseSigned: false, assertionSigned: true},
+		{name: "encrypted-response-signed", responseSigned: true, assertionSigned: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := validateFromDir(fmt.Sprintf("testdata/assertions/%s", tt.name))
+			require.NoError(t, err)
+			assert.Equal(t, tt.responseSigned, res.ResponseSigned)
+			assert.Equal(t, tt.assertionSigned, res.AssertionSigned)
+		})
+	}
+}
+
+func TestValidate_ResponseSignedAssertionData(t *testing.T) {
+	// only the outer Response is signed; the assertion is unsigned
+	res, err := validateFromDir("testdata/assertions/response-signed")
+	require.NoError(t, err)
+
+	assert.Equal(t, "_assertion_8d2e7b4c1a", res.AssertionID)
+	assert.Equal(t, "saml_flow_example", res.RequestID)
+	assert.Equal(t, "jane.doe@example.com", res.SubjectID)
+	assert.Equal(t, map[string][]string{"firstName": {"Jane"}}, res.SubjectAttributes)
+}
+
+func TestValidate_MultiValuedAttributes(t *testing.T) {
+	res, err := validateFromDir("testdata/assertions/multi-valued-attributes")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string][]string{
+		"firstName": {"Jane"},
+		"groups":    {"engineering", "admins", "on-call"},
+	}, res.SubjectAttributes)
+}
+
+func TestValidate_BadResponseReference(t *testing.T) {
+	// the Response's signature refers to the Assertion's ID, rather than the
+	// Response's
+	_, err := validateFromDir("testdata/bad-assertions/bad-response-reference")
+	require.ErrorIs(t, err, dsig.ErrBadReference)
+}
+
+func TestValidate_ReindentedCapture(t *testing.T) {
+	// okta-reindented-capture is the okta capture as it was originally checked
+	// in. Its certificates' base64 was reindented after capture; the Response
+	// signature covers the Assertion's KeyInfo, so only the capture with
+	// Okta's original whitespace (testdata/assertions/okta) verifies
+	original, err := os.ReadFile("testdata/bad-assertions/okta-reindented-capture/assertion.xml")
+	require.NoError(t, err)
+	good, err := os.ReadFile("testdata/assertions/okta/assertion.xml")
+	require.NoError(t, err)
+	assert.Equal(t, string(good), strings.ReplaceAll(string(original), "\n    ", "\n"))
+
+	_, err = validateFromDir("testdata/bad-assertions/okta-reindented-capture")
+	require.ErrorIs(t, err, dsig.ErrBadDigest)
+}
+
+func TestValidate_SignatureWrapping(t *testing.T) {
+	// each of these is a signed response from the same IDP, rearranged so that
+	// an unsigned assertion for attacker@example.com sits where a naive
+	// verifier would consume it
+	testCases := []struct {
+		name string
+		err  error
+	}{
+		// an unsigned assertion precedes the signed one
+		{name: "xsw-assertion-sibling", err: dsig.ErrNoAssertion},
+		// an unsigned assertion with the signed one's ID precedes it
+		{name: "xsw-assertion-duplicate-id", err: dsig.ErrDuplicateID},
+		// the signed assertion is a child of an unsigned one
+		{name: "xsw-assertion-wrapped", err: dsig.ErrNoAssertion},
+		// the signed assertion is in the Object of its signature, which was
+		// moved to an unsigned assertion
+		{name: "xsw-assertion-in-signature-object", err: dsig.ErrNoAssertion},
+		// the signed assertion is in the Response's Extensions
+		{name: "xsw-assertion-in-extensions", err: dsig.ErrNoAssertion},
+		// an unsigned assertion carries the signed one's signature
+		{name: "xsw-assertion-copied-signature", err: dsig.ErrBadReference},
+		// the signed Response is in the Object of its signature, which was
+		// moved to an unsigned Response
+		{name: "xsw-response-in-signature-object", err: dsig.ErrNoAssertion},
+		// the signed Response is a child of an unsigned one
+		{name: "xsw-response-wrapped", err: dsig.ErrNoAssertion},
+		// the signed Response's assertion carries the Response's ID
+		{name: "xsw-response-duplicate-id", err: dsig.ErrDuplicateID},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateFromDir(fmt.Sprintf("testdata/bad-assertions/%s", tt.name))
+			require.ErrorIs(t, err, tt.err)
+
+			var validateError *saml.ValidateError
+			require.ErrorAs(t, err, &validateError)
+			assert.True(t, validateError.MalformedAssertion)
+		})
+	}
+}
+
+func TestValidate_NameIDComment(t *testing.T) {
+	// the IDP signed a NameID of jane.doe@example.com.evil.example.com, and a
+	// comment was inserted to make it read as jane.doe@example.com to
+	// verifiers that only consider the first text node
+	//
+	// comments are not covered by the signature, so this response is valid,
+	// but the NameID consumed must be the one the IDP signed
+	res, err := validateFromDir("testdata/assertions/nameid-comment")
+	require.NoError(t, err)
+	assert.Equal(t, "jane.doe@example.com.evil.example.com", res.SubjectID)
+}
+
+func TestValidate_NoCanonicalizationMethod(t *testing.T) {
+	// modified from nameid-comment, but the SignedInfo's
+	// CanonicalizationMethod is removed
+	_, err := validateFromDir("testdata/bad-assertions/no-canonicalization-method")
+	require.Error(t, err)
+}
+
+func TestValidate_EncryptedAssertionData(t *testing.T) {
+	for _, name := range []string{"encrypted-assertion", "encrypted-response-signed"} {
+		t.Run(name, func(t *testing.T) {
+			res, err := validateFromDir(fmt.Sprintf("testdata/assertions/%s", name))
+			require.NoError(t, err)
+
+			// the assertion reported back is the decrypted one
+			assert.NotContains(t, res.Assertion, "EncryptedAssertion")
+			assert.Contains(t, res.Assertion, "<saml:NameID Format=\"urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress\">jane.doe@example.com</saml:NameID>")
+			assert.Equal(t, "jane.doe@example.com", res.SubjectID)
+		})
+	}
+}
+
+func TestValidate_EncryptedAssertionWrongKey(t *testing.T) {
+	// same as encrypted-assertion, but sp-key.pem is a different key
+	_, err := validateFromDir("testdata/bad-assertions/encrypted-assertion-wrong-key")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.True(t, validateError.UndecryptableAssertion)
+}
+
+func TestValidate_EncryptedAssertionNoKey(t *testing.T) {
+	assertion, err := os.ReadFile("testdata/assertions/encrypted-assertion/assertion.xml")
+	require.NoError(t, err)
+
+	_, err = saml.Validate(&saml.ValidateRequest{
+		SAMLResponse: base64.StdEncoding.EncodeToString(assertion),
+	})
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.True(t, validateError.UndecryptableAssertion)
 }
 
 func TestValidate_UnsignedAssertion(t *testing.T) {
@@ -70,6 +262,183 @@ func TestValidate_ExpiredAssertionLate(t *testing.T) {
 	require.True(t, validateError.ExpiredAssertion)
 }
 
+func TestValidate_ClockSkew(t *testing.T) {
+	// the okta assertion's conditions are valid from 2024-04-25T20:26:55.494Z
+	// until 2024-04-25T20:36:55.494Z
+	oktaCert := idpCertificateFromDir(t, "testdata/assertions/okta")
+	assertion, err := os.ReadFile("testdata/assertions/okta/assertion.xml")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		now       time.Time
+		clockSkew time.Duration
+		wantErr   bool
+	}{
+		{name: "early without skew", now: time.Date(2024, 4, 25, 20, 25, 55, 0, time.UTC), wantErr: true},
+		{name: "early within skew", now: time.Date(2024, 4, 25, 20, 25, 55, 0, time.UTC), clockSkew: 2 * time.Minute},
+		{name: "late without skew", now: time.Date(2024, 4, 25, 20, 37, 55, 0, time.UTC), wantErr: true},
+		{name: "late within skew", now: time.Date(2024, 4, 25, 20, 37, 55, 0, time.UTC), clockSkew: 2 * time.Minute},
+		{name: "late beyond skew", now: time.Date(2024, 4, 25, 20, 39, 55, 0, time.UTC), clockSkew: 2 * time.Minute, wantErr: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := saml.Validate(&saml.ValidateRequest{
+				SAMLResponse:    base64.StdEncoding.EncodeToString(assertion),
+				IDPCertificates: []saml.IDPCertificate{{Certificate: oktaCert}},
+				IDPEntityID:     "http://www.okta.com/exkdoocxa1VmjpXmX697",
+				SPEntityID:      "http://localhost:8080",
+				SPACSURL:        "http://localhost:8080",
+				Now:             tt.now,
+				ClockSkew:       tt.clockSkew,
+			})
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+
+			var validateError *saml.ValidateError
+			if !errors.As(err, &validateError) {
+				t.Fatalf("bad error: %v", err)
+			}
+
+			require.True(t, validateError.ExpiredAssertion || validateError.ExpiredSubjectConfirmation)
+		})
+	}
+}
+
+func TestValidate_ExpiredSubjectConfirmation(t *testing.T) {
+	// the SubjectConfirmationData's NotOnOrAfter has passed, but the
+	// assertion's Conditions have not
+	_, err := validateFromDir("testdata/bad-assertions/expired-subject-confirmation")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.True(t, validateError.ExpiredSubjectConfirmation)
+}
+
+func TestValidate_BadDestination(t *testing.T) {
+	// the Response is addressed to a different ACS URL
+	_, err := validateFromDir("testdata/bad-assertions/bad-destination")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.NotEmpty(t, validateError.BadDestination)
+	assert.Equal(t, "https://sp.example.com/acs", *validateError.BadDestination)
+}
+
+func TestValidate_BadRecipient(t *testing.T) {
+	// the SubjectConfirmationData's Recipient is a different ACS URL
+	_, err := validateFromDir("testdata/bad-assertions/bad-recipient")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.NotEmpty(t, validateError.BadRecipient)
+	assert.Equal(t, "https://sp.example.com/acs", *validateError.BadRecipient)
+}
+
+func TestValidate_BadSubjectConfirmationMethod(t *testing.T) {
+	// the SubjectConfirmation uses holder-of-key, rather than bearer
+	_, err := validateFromDir("testdata/bad-assertions/bad-subject-confirmation-method")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.NotEmpty(t, validateError.BadSubjectConfirmationMethod)
+	assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:cm:holder-of-key", *validateError.BadSubjectConfirmationMethod)
+}
+
+func TestValidate_FailedStatus(t *testing.T) {
+	// the IDP responded with AuthnFailed, and no assertion
+	_, err := validateFromDir("testdata/bad-assertions/failed-status")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	assert.Equal(t, "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd", validateError.RequestID)
+	assert.Equal(t, &saml.IDPStatus{
+		StatusCode:    "urn:oasis:names:tc:SAML:2.0:status:Responder",
+		SubStatusCode: "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed",
+		StatusMessage: "User account is disabled",
+	}, validateError.FailedStatus)
+
+	// the response is unsigned, so anyone could have sent it
+	assert.True(t, validateError.UnverifiedFailedStatus)
+	assert.False(t, validateError.ResponseSigned)
+}
+
+func TestValidate_FailedStatusSignature(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	idp := samltest.NewIDP(t)
+
+	testCases := []struct {
+		name       string
+		signer     *samltest.IDP
+		signed     bool
+		unverified bool
+	}{
+		{
+			name:       "forged unsigned",
+			signer:     idp,
+			unverified: true,
+		},
+		{
+			name:   "signed by idp",
+			signer: idp,
+			signed: true,
+		},
+		{
+			name:       "signed by another idp",
+			signer:     samltest.NewIDP(t),
+			signed:     true,
+			unverified: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			res := tt.signer.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+			res.InResponseTo = "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd"
+			res.StatusCode = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+			res.SubStatusCode = "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed"
+			res.Assertion = nil
+			if tt.signed {
+				res.Signature = &samltest.Signature{}
+			}
+
+			_, err := saml.Validate(&saml.ValidateRequest{
+				SAMLResponse:    tt.signer.SAMLResponse(t, res),
+				IDPCertificates: idp.IDPCertificates(),
+				IDPEntityID:     idp.EntityID,
+				SPEntityID:      "http://sp.example.com",
+				SPACSURL:        "http://sp.example.com/acs",
+				Now:             now,
+			})
+			var validateError *saml.ValidateError
+			if !errors.As(err, &validateError) {
+				t.Fatalf("bad error: %v", err)
+			}
+
+			assert.Equal(t, "saml_flow_8b2kq0x3j4tybm5rvkgmxnsnd", validateError.RequestID)
+			assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed", validateError.FailedStatus.SubStatusCode)
+			assert.Equal(t, tt.unverified, validateError.UnverifiedFailedStatus)
+			assert.Equal(t, !tt.unverified, validateError.ResponseSigned)
+			if !tt.unverified {
+				assert.Equal(t, idp.Certificate, validateError.IDPCertificate)
+			}
+		})
+	}
+}
+
 func TestValidate_BadIDPEntityID(t *testing.T) {
 	// modified from okta, but metadata.xml has a different idp entity id
 	_, err := validateFromDir("testdata/bad-assertions/bad-idp-entity-id")
@@ -118,6 +487,18 @@ func TestValidate_BadDigestAlgorithm(t *testing.T) {
 	assert.Equal(t, "BAD_DIGEST_ALGORITHM", *validateError.BadDigestAlgorithm)
 }
 
+func TestValidate_SignatureAlgorithmNotAllowed(t *testing.T) {
+	// same as rsa-sha1, but params.json uses the default algorithm allowlist
+	_, err := validateFromDir("testdata/bad-assertions/rsa-sha1-not-allowed")
+	var validateError *saml.ValidateError
+	if !errors.As(err, &validateError) {
+		t.Fatalf("bad error: %v", err)
+	}
+
+	require.NotEmpty(t, validateError.BadSignatureAlgorithm)
+	assert.Equal(t, dsig.SignatureAlgorithmRSASHA1, *validateError.BadSignatureAlgorithm)
+}
+
 func TestValidate_BadCertificate(t *testing.T) {
 	// modified from okta, but metadata.xml has a modified certificate
 	_, err := validateFromDir("testdata/bad-assertions/bad-certificate")
@@ -129,6 +510,55 @@ func TestValidate_BadCertificate(t *testing.T) {
 	require.NotEmpty(t, validateError.BadCertificate)
 }
 
+func TestValidate_MultipleIDPCertificates(t *testing.T) {
+	oktaCert := idpCertificateFromDir(t, "testdata/assertions/okta")
+	otherCert := idpCertificateFromDir(t, "testdata/bad-assertions/bad-certificate")
+
+	res, err := validateOkta(t, []saml.IDPCertificate{
+		{Certificate: otherCert},
+		{Certificate: oktaCert},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, oktaCert, res.IDPCertificate)
+}
+
+func TestValidate_IDPCertificateActivation(t *testing.T) {
+	oktaCert := idpCertificateFromDir(t, "testdata/assertions/okta")
+	otherCert := idpCertificateFromDir(t, "testdata/bad-assertions/bad-certificate")
+
+	// the okta assertion is from 2024-04-25T20:31:55.494Z
+	before := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	after := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name    string
+		cert    saml.IDPCertificate
+		wantErr bool
+	}{
+		{name: "within window", cert: saml.IDPCertificate{Certificate: oktaCert, NotBefore: before, NotAfter: after}},
+		{name: "open-ended window", cert: saml.IDPCertificate{Certificate: oktaCert, NotBefore: before}},
+		{name: "not yet active", cert: saml.IDPCertificate{Certificate: oktaCert, NotBefore: after}, wantErr: true},
+		{name: "no longer active", cert: saml.IDPCertificate{Certificate: oktaCert, NotAfter: before}, wantErr: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := validateOkta(t, []saml.IDPCertificate{{Certificate: otherCert}, tt.cert})
+			if !tt.wantErr {
+				require.NoError(t, err)
+				return
+			}
+
+			var validateError *saml.ValidateError
+			if !errors.As(err, &validateError) {
+				t.Fatalf("bad error: %v", err)
+			}
+
+			require.NotEmpty(t, validateError.BadCertificate)
+		})
+	}
+}
+
 func TestValidate_NoCertificate(t *testing.T) {
 	// modified from okta, but no KeyInfo
 	_, err := validateFromDir("testdata/bad-assertions/no-certificate")
@@ -167,25 +597,43 @@ func validateFromDir(path string) (*saml.ValidateResponse, error) {
 		return nil, fmt.Errorf("read params: %w", err)
 	}
 
-	parseMetadataRes, err := saml.ParseMetadata(metadata)
+	// sp-key.pem is only present for encrypted assertions
+	var spDecryptionKeys []*rsa.PrivateKey
+	if spKeyPEM, err := os.ReadFile(fmt.Sprintf("%s/sp-key.pem", path)); err == nil {
+		blk, _ := pem.Decode(spKeyPEM)
+		spKey, err := x509.ParsePKCS8PrivateKey(blk.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse sp key: %w", err)
+		}
+		spDecryptionKeys = append(spDecryptionKeys, spKey.(*rsa.PrivateKey))
+	}
+
+	parseMetadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata})
 	if err != nil {
 		return nil, fmt.Errorf("parse metadata: %w", err)
 	}
 
 	var paramData struct {
-		SPEntityID string    `json:"sp_entity_id"`
-		Now        time.Time `json:"now"`
+		SPEntityID                 string    `json:"sp_entity_id"`
+		SPACSURL                   string    `json:"sp_acs_url"`
+		Now                        time.Time `json:"now"`
+		AllowedSignatureAlgorithms []string  `json:"allowed_signature_algorithms"`
+		AllowedDigestAlgorithms    []string  `json:"allowed_digest_algorithms"`
 	}
 	if err := json.Unmarshal(params, &paramData); err != nil {
 		return nil, fmt.Errorf("unmarshal params: %w", err)
 	}
 
 	validateRes, err := saml.Validate(&saml.ValidateRequest{
-		SAMLResponse:   base64.StdEncoding.EncodeToString(assertion),
-		IDPCertificate: parseMetadataRes.IDPCertificate,
-		IDPEntityID:    parseMetadataRes.IDPEntityID,
-		SPEntityID:     paramData.SPEntityID,
-		Now:            paramData.Now,
+		SAMLResponse:               base64.StdEncoding.EncodeToString(assertion),
+		IDPCertificates:            []saml.IDPCertificate{{Certificate: parseMetadataRes.IDPCertificate}},
+		IDPEntityID:                parseMetadataRes.IDPEntityID,
+		SPEntityID:                 paramData.SPEntityID,
+		SPACSURL:                   paramData.SPACSURL,
+		Now:                        paramData.Now,
+		AllowedSignatureAlgorithms: paramData.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    paramData.AllowedDigestAlgorithms,
+		SPDecryptionKeys:           spDecryptionKeys,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("validate: %w", err)
@@ -193,3 +641,217 @@ func validateFromDir(path string) (*saml.ValidateResponse, error) {
 
 	return validateRes, nil
 }
+
+func idpCertificateFromDir(t *testing.T, path string) *x509.Certificate {
+	metadata, err := os.ReadFile(fmt.Sprintf("%s/metadata.xml", path))
+	require.NoError(t, err)
+
+	parseMetadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata})
+	require.NoError(t, err)
+
+	return parseMetadataRes.IDPCertificate
+}
+
+func validateOkta(t *testing.T, idpCertificates []saml.IDPCertificate) (*saml.ValidateResponse, error) {
+	assertion, err := os.ReadFile("testdata/assertions/okta/assertion.xml")
+	require.NoError(t, err)
+
+	return saml.Validate(&saml.ValidateRequest{
+		SAMLResponse:    base64.StdEncoding.EncodeToString(assertion),
+		IDPCertificates: idpCertificates,
+		IDPEntityID:     "http://www.okta.com/exkdoocxa1VmjpXmX697",
+		SPEntityID:      "http://localhost:8080",
+		Now:             time.Date(2024, 4, 25, 20, 31, 55, 494000000, time.UTC),
+	})
+}
+
+func TestValidate_Generated(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name   string
+		idp    *samltest.IDP
+		modify func(res *samltest.Response)
+
+		allowedSignatureAlgorithms []string
+		allowedDigestAlgorithms    []string
+
+		responseSigned  bool
+		assertionSigned bool
+		err             func(t *testing.T, validateError *saml.ValidateError)
+	}{
+		{
+			name:            "assertion signed",
+			modify:          func(res *samltest.Response) {},
+			assertionSigned: true,
+		},
+		{
+			name: "response signed",
+			modify: func(res *samltest.Response) {
+				res.Signature = &samltest.Signature{}
+				res.Assertion.Signature = nil
+			},
+			responseSigned: true,
+		},
+		{
+			name: "both signed",
+			modify: func(res *samltest.Response) {
+				res.Signature = &samltest.Signature{}
+			},
+			responseSigned:  true,
+			assertionSigned: true,
+		},
+		{
+			name:            "ecdsa",
+			idp:             samltest.NewECDSAIDP(t, elliptic.P384()),
+			modify:          func(res *samltest.Response) {},
+			assertionSigned: true,
+		},
+		{
+			name: "sha1 allowed",
+			modify: func(res *samltest.Response) {
+				res.Assertion.Signature = &samltest.Signature{
+					SignatureAlgorithm: dsig.SignatureAlgorithmRSASHA1,
+					DigestAlgorithm:    dsig.DigestAlgorithmSHA1,
+				}
+			},
+			allowedSignatureAlgorithms: []string{dsig.SignatureAlgorithmRSASHA1},
+			allowedDigestAlgorithms:    []string{dsig.DigestAlgorithmSHA1},
+			assertionSigned:            true,
+		},
+		{
+			name: "sha1 not allowed",
+			modify: func(res *samltest.Response) {
+				res.Assertion.Signature = &samltest.Signature{
+					SignatureAlgorithm: dsig.SignatureAlgorithmRSASHA1,
+				}
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.Equal(t, dsig.SignatureAlgorithmRSASHA1, *validateError.BadSignatureAlgorithm)
+			},
+		},
+		{
+			name: "unsigned",
+			modify: func(res *samltest.Response) {
+				res.Assertion.Signature = nil
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.True(t, validateError.UnsignedAssertion)
+			},
+		},
+		{
+			name: "expired",
+			modify: func(res *samltest.Response) {
+				res.Assertion.NotOnOrAfter = now.Add(-time.Second)
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.True(t, validateError.ExpiredAssertion)
+			},
+		},
+		{
+			name: "wrong audience",
+			modify: func(res *samltest.Response) {
+				res.Assertion.Audience = "http://evil.example.com"
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.Equal(t, "http://evil.example.com", *validateError.BadSPEntityID)
+			},
+		},
+		{
+			name: "wrong issuer",
+			modify: func(res *samltest.Response) {
+				res.Assertion.Issuer = "http://evil.example.com"
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.Equal(t, "http://evil.example.com", *validateError.BadIDPEntityID)
+			},
+		},
+		{
+			name: "failed status",
+			modify: func(res *samltest.Response) {
+				res.StatusCode = "urn:oasis:names:tc:SAML:2.0:status:Responder"
+				res.SubStatusCode = "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed"
+				res.Assertion = nil
+			},
+			err: func(t *testing.T, validateError *saml.ValidateError) {
+				assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:status:AuthnFailed", validateError.FailedStatus.SubStatusCode)
+				assert.True(t, validateError.UnverifiedFailedStatus)
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			idp := tt.idp
+			if idp == nil {
+				idp = samltest.NewIDP(t)
+			}
+
+			res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+			res.Assertion.Attributes = []samltest.Attribute{{Name: "groups", Values: []string{"engineering", "admins"}}}
+			tt.modify(res)
+
+			validateRes, err := saml.Validate(&saml.ValidateRequest{
+				SAMLResponse:               idp.SAMLResponse(t, res),
+				IDPCertificates:            idp.IDPCertificates(),
+				IDPEntityID:                idp.EntityID,
+				SPEntityID:                 "http://sp.example.com",
+				SPACSURL:                   "http://sp.example.com/acs",
+				Now:                        now,
+				AllowedSignatureAlgorithms: tt.allowedSignatureAlgorithms,
+				AllowedDigestAlgorithms:    tt.allowedDigestAlgorithms,
+			})
+
+			if tt.err != nil {
+				var validateError *saml.ValidateError
+				if !errors.As(err, &validateError) {
+					t.Fatalf("bad error: %v", err)
+				}
+				tt.err(t, validateError)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, "jane.doe@example.com", validateRes.SubjectID)
+			assert.Equal(t, map[string][]string{"groups": {"engineering", "admins"}}, validateRes.SubjectAttributes)
+			assert.Equal(t, tt.responseSigned, validateRes.ResponseSigned)
+			assert.Equal(t, tt.assertionSigned, validateRes.AssertionSigned)
+			assert.Equal(t, idp.Certificate, validateRes.IDPCertificate)
+		})
+	}
+}
+
+func TestValidate_GeneratedAuthnStatement(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	idp := samltest.NewIDP(t)
+
+	res := idp.NewResponse("http://sp.example.com", "http://sp.example.com/acs", now, "jane.doe@example.com")
+	res.Assertion.AuthnInstant = now.Add(-time.Hour)
+	res.Assertion.SessionIndex = "session-1"
+	res.Assertion.SessionNotOnOrAfter = now.Add(8 * time.Hour)
+	res.Assertion.AuthnContextClassRef = "urn:oasis:names:tc:SAML:2.0:ac:classes:X509"
+
+	validateRes, err := saml.Validate(&saml.ValidateRequest{
+		SAMLResponse:    idp.SAMLResponse(t, res),
+		IDPCertificates: idp.IDPCertificates(),
+		IDPEntityID:     idp.EntityID,
+		SPEntityID:      "http://sp.example.com",
+		SPACSURL:        "http://sp.example.com/acs",
+		Now:             now,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-time.Hour), validateRes.AuthnInstant)
+	assert.Equal(t, "session-1", validateRes.SessionIndex)
+	assert.Equal(t, now.Add(8*time.Hour), validateRes.SessionNotOnOrAfter)
+	assert.Equal(t, "urn:oasis:names:tc:SAML:2.0:ac:classes:X509", validateRes.AuthnContextClassRef)
+}
+
+func TestValidate_GeneratedMetadata(t *testing.T) {
+	idp := samltest.NewIDP(t)
+
+	res, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: idp.Metadata()})
+	require.NoError(t, err)
+	assert.Equal(t, idp.EntityID, res.IDPEntityID)
+	assert.Equal(t, idp.SSOURL, res.RedirectURL)
+	assert.Equal(t, idp.Certificate, res.IDPCertificate)
+}
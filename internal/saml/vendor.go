@@ -0,0 +1,169 @@
+package saml
+
+import (
+	"crypto/x509"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Vendor is an IDP product that SSOReady recognizes.
+type Vendor string
+
+const (
+	VendorOkta      Vendor = "okta"
+	VendorEntra     Vendor = "entra"
+	VendorGoogle    Vendor = "google"
+	VendorADFS      Vendor = "adfs"
+	VendorJumpCloud Vendor = "jumpcloud"
+	VendorPing      Vendor = "ping"
+	VendorKeycloak  Vendor = "keycloak"
+	VendorOneLogin  Vendor = "onelogin"
+)
+
+type DetectVendorRequest struct {
+	// EntityID is the IDP's entity ID, or the Issuer of an assertion from it.
+	EntityID string
+
+	// URLs are any of the IDP's endpoints, such as its redirect URL or
+	// single logout URL.
+	URLs []string
+
+	// Certificate is a certificate the IDP signs with.
+	Certificate *x509.Certificate
+
+	// AssertionID and AttributeNames describe an assertion from the IDP, if
+	// there is one.
+	AssertionID    string
+	AttributeNames []string
+}
+
+// DetectVendor guesses which product an IDP is, from the hosts it runs on and
+// the conventions it follows. It returns an empty Vendor if the IDP is not
+// recognized.
+//
+// The result is a hint for choosing defaults and for support. It is not
+// authenticated, and must not be used to make security decisions.
+func DetectVendor(req *DetectVendorRequest) Vendor {
+	// the IDP's own URLs are the most reliable signal; self-hosted IDPs are
+	// recognized from their paths
+	for _, u := range append([]string{req.EntityID}, req.URLs...) {
+		if v := vendorFromURL(u); v != "" {
+			return v
+		}
+	}
+
+	if req.Certificate != nil {
+		if v := vendorFromCertificate(req.Certificate); v != "" {
+			return v
+		}
+	}
+
+	for _, name := range req.AttributeNames {
+		if v, ok := vendorAttributeNames[name]; ok {
+			return v
+		}
+	}
+
+	for _, p := range vendorAssertionIDPatterns {
+		if p.pattern.MatchString(req.AssertionID) {
+			return p.vendor
+		}
+	}
+
+	return ""
+}
+
+// vendorHosts are the domains that hosted IDPs run on. Subdomains of each
+// domain are matched as well.
+var vendorHosts = map[string]Vendor{
+	"okta.com":                  VendorOkta,
+	"oktapreview.com":           VendorOkta,
+	"okta-emea.com":             VendorOkta,
+	"sts.windows.net":           VendorEntra,
+	"login.microsoftonline.com": VendorEntra,
+	"login.microsoftonline.us":  VendorEntra,
+	"login.windows.net":         VendorEntra,
+	"accounts.google.com":       VendorGoogle,
+	"jumpcloud.com":             VendorJumpCloud,
+	"pingone.com":               VendorPing,
+	"pingone.eu":                VendorPing,
+	"pingone.asia":              VendorPing,
+	"pingone.ca":                VendorPing,
+	"pingidentity.com":          VendorPing,
+	"onelogin.com":              VendorOneLogin,
+}
+
+// vendorPaths recognize self-hosted IDPs from their default endpoint paths.
+var vendorPaths = []struct {
+	pattern *regexp.Regexp
+	vendor  Vendor
+}{
+	{regexp.MustCompile(`(?i)^/adfs/`), VendorADFS},
+	{regexp.MustCompile(`^(/auth)?/realms/[^/]+(/|$)`), VendorKeycloak},
+	{regexp.MustCompile(`(?i)^/idp/sso\.saml2$`), VendorPing}, // PingFederate
+}
+
+func vendorFromURL(s string) Vendor {
+	u, err := url.Parse(s)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+
+	host := strings.ToLower(u.Hostname())
+
+	for h, v := range vendorHosts {
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return v
+		}
+	}
+
+	for _, p := range vendorPaths {
+		if p.pattern.MatchString(u.Path) {
+			return p.vendor
+		}
+	}
+
+	return ""
+}
+
+// vendorFromCertificate recognizes the self-signed certificates IDPs generate
+// by default. Admins can replace them, so this is only a fallback.
+func vendorFromCertificate(cert *x509.Certificate) Vendor {
+	subject := cert.Subject
+	switch {
+	case slices.Contains(subject.Organization, "Okta"):
+		return VendorOkta
+	case subject.CommonName == "Microsoft Azure Federated SSO Certificate":
+		return VendorEntra
+	case slices.Contains(subject.OrganizationalUnit, "Google For Work"):
+		return VendorGoogle
+	case strings.HasPrefix(subject.CommonName, "ADFS Signing - "):
+		return VendorADFS
+	case slices.Contains(subject.OrganizationalUnit, "JumpCloudSAMLIdP"):
+		return VendorJumpCloud
+	case slices.Contains(subject.Organization, "Ping Identity"):
+		return VendorPing
+	case slices.Contains(subject.Organization, "OneLogin") || slices.Contains(subject.OrganizationalUnit, "OneLogin_Account"):
+		return VendorOneLogin
+	}
+	return ""
+}
+
+// vendorAttributeNames are attributes that only one IDP sends.
+var vendorAttributeNames = map[string]Vendor{
+	"http://schemas.microsoft.com/identity/claims/tenantid":         VendorEntra,
+	"http://schemas.microsoft.com/identity/claims/identityprovider": VendorEntra,
+}
+
+// vendorAssertionIDPatterns recognize IDPs from how they generate assertion
+// IDs.
+var vendorAssertionIDPatterns = []struct {
+	pattern *regexp.Regexp
+	vendor  Vendor
+}{
+	{regexp.MustCompile(`^ID_[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`), VendorKeycloak},
+	{regexp.MustCompile(`^pfx[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`), VendorOneLogin},
+	{regexp.MustCompile(`^[0-9A-Z]{40}$`), VendorJumpCloud},
+}
@@ -0,0 +1,104 @@
+package saml_test
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectVendor_KnownGoodAssertions(t *testing.T) {
+	testCases := []struct {
+		name   string
+		vendor saml.Vendor
+	}{
+		{name: "okta", vendor: saml.VendorOkta},
+		// despite its name, this fixture is from Microsoft Entra ID
+		{name: "adfs", vendor: saml.VendorEntra},
+		{name: "google", vendor: saml.VendorGoogle},
+		{name: "jumpcloud", vendor: saml.VendorJumpCloud},
+		{name: "keycloak", vendor: saml.VendorKeycloak},
+		{name: "ping", vendor: saml.VendorPing},
+		{name: "response-signed", vendor: ""},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata, err := os.ReadFile(fmt.Sprintf("testdata/assertions/%s/metadata.xml", tt.name))
+			require.NoError(t, err)
+
+			metadataRes, err := saml.ParseMetadata(&saml.ParseMetadataRequest{Metadata: metadata})
+			require.NoError(t, err)
+			assert.Equal(t, tt.vendor, metadataRes.Vendor)
+
+			validateRes, err := validateFromDir(fmt.Sprintf("testdata/assertions/%s", tt.name))
+			require.NoError(t, err)
+			assert.Equal(t, tt.vendor, validateRes.IDPVendor)
+		})
+	}
+}
+
+func TestDetectVendor(t *testing.T) {
+	testCases := []struct {
+		name   string
+		req    saml.DetectVendorRequest
+		vendor saml.Vendor
+	}{
+		{
+			name:   "adfs entity id",
+			req:    saml.DetectVendorRequest{EntityID: "http://adfs.example.com/adfs/services/trust"},
+			vendor: saml.VendorADFS,
+		},
+		{
+			name:   "adfs certificate",
+			req:    saml.DetectVendorRequest{EntityID: "urn:example", Certificate: &x509.Certificate{Subject: pkix.Name{CommonName: "ADFS Signing - adfs.example.com"}}},
+			vendor: saml.VendorADFS,
+		},
+		{
+			name:   "onelogin entity id",
+			req:    saml.DetectVendorRequest{EntityID: "https://app.onelogin.com/saml/metadata/1234"},
+			vendor: saml.VendorOneLogin,
+		},
+		{
+			name:   "onelogin assertion id",
+			req:    saml.DetectVendorRequest{EntityID: "urn:example", AssertionID: "pfx3b7c2a1e-5d4f-4e6a-8b9c-0d1e2f3a4b5c"},
+			vendor: saml.VendorOneLogin,
+		},
+		{
+			name:   "pingfederate redirect url",
+			req:    saml.DetectVendorRequest{EntityID: "urn:example", URLs: []string{"https://sso.example.com/idp/SSO.saml2"}},
+			vendor: saml.VendorPing,
+		},
+		{
+			name:   "keycloak under /auth",
+			req:    saml.DetectVendorRequest{EntityID: "https://sso.example.com/auth/realms/acme"},
+			vendor: saml.VendorKeycloak,
+		},
+		{
+			name:   "entra attribute",
+			req:    saml.DetectVendorRequest{EntityID: "urn:example", AttributeNames: []string{"http://schemas.microsoft.com/identity/claims/tenantid"}},
+			vendor: saml.VendorEntra,
+		},
+		{
+			name:   "lookalike host",
+			req:    saml.DetectVendorRequest{EntityID: "https://notokta.com/sso"},
+			vendor: "",
+		},
+		{
+			name:   "unrecognized",
+			req:    saml.DetectVendorRequest{EntityID: "https://idp.example.com/idp/shibboleth"},
+			vendor: "",
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.vendor, saml.DetectVendor(&tt.req))
+		})
+	}
+}
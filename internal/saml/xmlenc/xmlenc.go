@@ -0,0 +1,259 @@
+// Package xmlenc implements decryption of XML Encryption (xmlenc) elements, as
+// used by SAML EncryptedAssertions.
+package xmlenc
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	_ "crypto/sha1"
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+)
+
+// Data encryption algorithm identifiers, as they appear in the
+// EncryptionMethod of an EncryptedData.
+const (
+	AlgorithmAES128CBC = "http://www.w3.org/2001/04/xmlenc#aes128-cbc"
+	AlgorithmAES192CBC = "http://www.w3.org/2001/04/xmlenc#aes192-cbc"
+	AlgorithmAES256CBC = "http://www.w3.org/2001/04/xmlenc#aes256-cbc"
+	AlgorithmAES128GCM = "http://www.w3.org/2009/xmlenc11#aes128-gcm"
+	AlgorithmAES192GCM = "http://www.w3.org/2009/xmlenc11#aes192-gcm"
+	AlgorithmAES256GCM = "http://www.w3.org/2009/xmlenc11#aes256-gcm"
+)
+
+// Key transport algorithm identifiers, as they appear in the EncryptionMethod
+// of an EncryptedKey.
+const (
+	AlgorithmRSAOAEPMGF1P = "http://www.w3.org/2001/04/xmlenc#rsa-oaep-mgf1p"
+	AlgorithmRSAOAEP      = "http://www.w3.org/2009/xmlenc11#rsa-oaep"
+)
+
+var (
+	ErrNoEncryptedKey = fmt.Errorf("xmlenc: no encrypted key")
+	ErrNoDecryptKey   = fmt.Errorf("xmlenc: no private key could decrypt the encrypted key")
+	ErrBadCipherText  = fmt.Errorf("xmlenc: malformed cipher text")
+)
+
+type UnsupportedAlgorithmError struct {
+	Algorithm string
+}
+
+func (e UnsupportedAlgorithmError) Error() string {
+	return fmt.Sprintf("xmlenc: unsupported algorithm: %q", e.Algorithm)
+}
+
+type EncryptedData struct {
+	XMLName          xml.Name         `xml:"http://www.w3.org/2001/04/xmlenc# EncryptedData"`
+	EncryptionMethod EncryptionMethod `xml:"http://www.w3.org/2001/04/xmlenc# EncryptionMethod"`
+	KeyInfo          struct {
+		EncryptedKeys []EncryptedKey `xml:"http://www.w3.org/2001/04/xmlenc# EncryptedKey"`
+	} `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+	CipherData CipherData `xml:"http://www.w3.org/2001/04/xmlenc# CipherData"`
+}
+
+type EncryptedKey struct {
+	XMLName          xml.Name         `xml:"http://www.w3.org/2001/04/xmlenc# EncryptedKey"`
+	EncryptionMethod EncryptionMethod `xml:"http://www.w3.org/2001/04/xmlenc# EncryptionMethod"`
+	CipherData       CipherData       `xml:"http://www.w3.org/2001/04/xmlenc# CipherData"`
+}
+
+type EncryptionMethod struct {
+	Algorithm    string `xml:"Algorithm,attr"`
+	DigestMethod struct {
+		Algorithm string `xml:"Algorithm,attr"`
+	} `xml:"http://www.w3.org/2000/09/xmldsig# DigestMethod"`
+	MGF struct {
+		Algorithm string `xml:"Algorithm,attr"`
+	} `xml:"http://www.w3.org/2009/xmlenc11# MGF"`
+}
+
+type CipherData struct {
+	CipherValue string `xml:"http://www.w3.org/2001/04/xmlenc# CipherValue"`
+}
+
+type dataAlgorithm struct {
+	keySize int
+	gcm     bool
+}
+
+var dataAlgorithms = map[string]dataAlgorithm{
+	AlgorithmAES128CBC: {keySize: 16},
+	AlgorithmAES192CBC: {keySize: 24},
+	AlgorithmAES256CBC: {keySize: 32},
+	AlgorithmAES128GCM: {keySize: 16, gcm: true},
+	AlgorithmAES192GCM: {keySize: 24, gcm: true},
+	AlgorithmAES256GCM: {keySize: 32, gcm: true},
+}
+
+var digestAlgorithms = map[string]crypto.Hash{
+	"http://www.w3.org/2000/09/xmldsig#sha1":        crypto.SHA1,
+	"http://www.w3.org/2001/04/xmlenc#sha256":       crypto.SHA256,
+	"http://www.w3.org/2001/04/xmldsig-more#sha384": crypto.SHA384,
+	"http://www.w3.org/2001/04/xmlenc#sha512":       crypto.SHA512,
+}
+
+var mgfAlgorithms = map[string]crypto.Hash{
+	"http://www.w3.org/2009/xmlenc11#mgf1sha1":   crypto.SHA1,
+	"http://www.w3.org/2009/xmlenc11#mgf1sha224": crypto.SHA224,
+	"http://www.w3.org/2009/xmlenc11#mgf1sha256": crypto.SHA256,
+	"http://www.w3.org/2009/xmlenc11#mgf1sha384": crypto.SHA384,
+	"http://www.w3.org/2009/xmlenc11#mgf1sha512": crypto.SHA512,
+}
+
+// Decrypt returns the plaintext of data.
+//
+// The content-encryption key is taken from the EncryptedKeys in data's KeyInfo,
+// or from extraKeys; some IDPs place EncryptedKey alongside EncryptedData
+// rather than inside it. Each of keys is tried against each EncryptedKey.
+func Decrypt(keys []*rsa.PrivateKey, data *EncryptedData, extraKeys []EncryptedKey) ([]byte, error) {
+	alg, ok := dataAlgorithms[data.EncryptionMethod.Algorithm]
+	if !ok {
+		return nil, UnsupportedAlgorithmError{data.EncryptionMethod.Algorithm}
+	}
+
+	var encryptedKeys []EncryptedKey
+	encryptedKeys = append(encryptedKeys, data.KeyInfo.EncryptedKeys...)
+	encryptedKeys = append(encryptedKeys, extraKeys...)
+	if len(encryptedKeys) == 0 {
+		return nil, ErrNoEncryptedKey
+	}
+
+	cek, err := decryptKey(keys, encryptedKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(cek) != alg.keySize {
+		return nil, fmt.Errorf("xmlenc: bad content-encryption key size: %d", len(cek))
+	}
+
+	cipherText, err := decodeCipherValue(data.CipherData.CipherValue)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	if alg.gcm {
+		return decryptGCM(block, cipherText)
+	}
+	return decryptCBC(block, cipherText)
+}
+
+func decryptKey(keys []*rsa.PrivateKey, encryptedKeys []EncryptedKey) ([]byte, error) {
+	for _, encryptedKey := range encryptedKeys {
+		opts, err := oaepOptions(encryptedKey.EncryptionMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		cipherText, err := decodeCipherValue(encryptedKey.CipherData.CipherValue)
+		if err != nil {
+			return nil, err
+		}
+
+		// OAEP decryption fails with overwhelming probability when using the
+		// wrong private key, so the first success identifies the right one.
+		for _, key := range keys {
+			if cek, err := key.Decrypt(nil, cipherText, opts); err == nil {
+				return cek, nil
+			}
+		}
+	}
+
+	return nil, ErrNoDecryptKey
+}
+
+func oaepOptions(method EncryptionMethod) (*rsa.OAEPOptions, error) {
+	hash := crypto.SHA1
+	if method.DigestMethod.Algorithm != "" {
+		h, ok := digestAlgorithms[method.DigestMethod.Algorithm]
+		if !ok {
+			return nil, UnsupportedAlgorithmError{method.DigestMethod.Algorithm}
+		}
+		hash = h
+	}
+
+	switch method.Algorithm {
+	case AlgorithmRSAOAEPMGF1P:
+		// rsa-oaep-mgf1p always uses MGF1 with SHA-1, regardless of the digest
+		return &rsa.OAEPOptions{Hash: hash, MGFHash: crypto.SHA1}, nil
+	case AlgorithmRSAOAEP:
+		mgfHash := crypto.SHA1
+		if method.MGF.Algorithm != "" {
+			h, ok := mgfAlgorithms[method.MGF.Algorithm]
+			if !ok {
+				return nil, UnsupportedAlgorithmError{method.MGF.Algorithm}
+			}
+			mgfHash = h
+		}
+		return &rsa.OAEPOptions{Hash: hash, MGFHash: mgfHash}, nil
+	default:
+		return nil, UnsupportedAlgorithmError{method.Algorithm}
+	}
+}
+
+func decryptCBC(block cipher.Block, cipherText []byte) ([]byte, error) {
+	// the IV is prepended to the cipher text
+	if len(cipherText) < 2*block.BlockSize() || len(cipherText)%block.BlockSize() != 0 {
+		return nil, ErrBadCipherText
+	}
+
+	iv, cipherText := cipherText[:block.BlockSize()], cipherText[block.BlockSize():]
+	plainText := make([]byte, len(cipherText))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plainText, cipherText)
+
+	// xmlenc padding only specifies the final byte, which is the padding
+	// length; unlike PKCS#7, the other padding bytes are arbitrary
+	padding := int(plainText[len(plainText)-1])
+	if padding == 0 || padding > block.BlockSize() {
+		return nil, ErrBadCipherText
+	}
+
+	return plainText[:len(plainText)-padding], nil
+}
+
+func decryptGCM(block cipher.Block, cipherText []byte) ([]byte, error) {
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// the IV is prepended to the cipher text, and the tag appended to it
+	if len(cipherText) < gcm.NonceSize()+gcm.Overhead() {
+		return nil, ErrBadCipherText
+	}
+
+	plainText, err := gcm.Open(nil, cipherText[:gcm.NonceSize()], cipherText[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, errors.Join(ErrBadCipherText, err)
+	}
+
+	return plainText, nil
+}
+
+func decodeCipherValue(s string) ([]byte, error) {
+	s = strings.Map(func(r rune) rune {
+		if r == ' ' || r == '\t' || r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, s)
+
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("xmlenc: decode cipher value: %w", err)
+	}
+	return b, nil
+}
@@ -0,0 +1,237 @@
+package xmlenc_test
+
+import (
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"testing"
+
+	"github.com/ssoready/ssoready/internal/saml/xmlenc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const plainText = `<saml:Assertion xmlns:saml="urn:oasis:names:tc:SAML:2.0:assertion" ID="_a"><saml:Issuer>https://idp.example.com</saml:Issuer></saml:Assertion>`
+
+func TestDecrypt(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	dataAlgorithms := []string{
+		xmlenc.AlgorithmAES128CBC,
+		xmlenc.AlgorithmAES192CBC,
+		xmlenc.AlgorithmAES256CBC,
+		xmlenc.AlgorithmAES128GCM,
+		xmlenc.AlgorithmAES192GCM,
+		xmlenc.AlgorithmAES256GCM,
+	}
+
+	keyAlgorithms := []struct {
+		name      string
+		algorithm string
+		extra     string
+		hash      crypto.Hash
+	}{
+		{
+			name:      "rsa-oaep-mgf1p",
+			algorithm: xmlenc.AlgorithmRSAOAEPMGF1P,
+			extra:     `<ds:DigestMethod Algorithm="http://www.w3.org/2000/09/xmldsig#sha1"/>`,
+			hash:      crypto.SHA1,
+		},
+		{
+			name:      "rsa-oaep-defaults",
+			algorithm: xmlenc.AlgorithmRSAOAEP,
+			hash:      crypto.SHA1,
+		},
+		{
+			name:      "rsa-oaep-sha256",
+			algorithm: xmlenc.AlgorithmRSAOAEP,
+			extra:     `<ds:DigestMethod Algorithm="http://www.w3.org/2001/04/xmlenc#sha256"/><xenc11:MGF Algorithm="http://www.w3.org/2009/xmlenc11#mgf1sha256"/>`,
+			hash:      crypto.SHA256,
+		},
+	}
+
+	for _, dataAlgorithm := range dataAlgorithms {
+		for _, keyAlgorithm := range keyAlgorithms {
+			t.Run(fmt.Sprintf("%s/%s", dataAlgorithm, keyAlgorithm.name), func(t *testing.T) {
+				encryptedKey := encryptKey(t, &key.PublicKey, keyAlgorithm.algorithm, keyAlgorithm.extra, keyAlgorithm.hash, keySize(dataAlgorithm))
+				data := encryptData(t, dataAlgorithm, encryptedKey, []byte(plainText))
+
+				out, err := xmlenc.Decrypt([]*rsa.PrivateKey{key}, data, nil)
+				require.NoError(t, err)
+				assert.Equal(t, plainText, string(out))
+			})
+		}
+	}
+}
+
+func TestDecrypt_ExtraKeys(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	cek := make([]byte, 32)
+	_, err = rand.Read(cek)
+	require.NoError(t, err)
+
+	encryptedKeyXML := encryptKeyWithCEK(t, &key.PublicKey, xmlenc.AlgorithmRSAOAEPMGF1P, "", crypto.SHA1, cek)
+	data := encryptDataWithCEK(t, xmlenc.AlgorithmAES256GCM, "", cek, []byte(plainText))
+
+	var encryptedKey xmlenc.EncryptedKey
+	require.NoError(t, xml.Unmarshal([]byte(encryptedKeyXML), &encryptedKey))
+
+	out, err := xmlenc.Decrypt([]*rsa.PrivateKey{key}, data, []xmlenc.EncryptedKey{encryptedKey})
+	require.NoError(t, err)
+	assert.Equal(t, plainText, string(out))
+}
+
+func TestDecrypt_MultiplePrivateKeys(t *testing.T) {
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// encrypted to the old key, as an IDP would after an SP key rotation it
+	// hasn't caught up with yet
+	encryptedKey := encryptKey(t, &oldKey.PublicKey, xmlenc.AlgorithmRSAOAEPMGF1P, "", crypto.SHA1, 16)
+	data := encryptData(t, xmlenc.AlgorithmAES128CBC, encryptedKey, []byte(plainText))
+
+	out, err := xmlenc.Decrypt([]*rsa.PrivateKey{newKey, oldKey}, data, nil)
+	require.NoError(t, err)
+	assert.Equal(t, plainText, string(out))
+}
+
+func TestDecrypt_WrongKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	encryptedKey := encryptKey(t, &key.PublicKey, xmlenc.AlgorithmRSAOAEPMGF1P, "", crypto.SHA1, 32)
+	data := encryptData(t, xmlenc.AlgorithmAES256CBC, encryptedKey, []byte(plainText))
+
+	_, err = xmlenc.Decrypt([]*rsa.PrivateKey{otherKey}, data, nil)
+	assert.ErrorIs(t, err, xmlenc.ErrNoDecryptKey)
+}
+
+func TestDecrypt_UnsupportedAlgorithm(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	// rsa-1_5 is deliberately unsupported, because it is vulnerable to padding
+	// oracle attacks
+	encryptedKey := `<xenc:EncryptedKey xmlns:xenc="http://www.w3.org/2001/04/xmlenc#"><xenc:EncryptionMethod Algorithm="http://www.w3.org/2001/04/xmlenc#rsa-1_5"/><xenc:CipherData><xenc:CipherValue>AAAA</xenc:CipherValue></xenc:CipherData></xenc:EncryptedKey>`
+	data := encryptDataWithCEK(t, xmlenc.AlgorithmAES128CBC, encryptedKey, make([]byte, 16), []byte(plainText))
+
+	_, err = xmlenc.Decrypt([]*rsa.PrivateKey{key}, data, nil)
+	assert.Equal(t, xmlenc.UnsupportedAlgorithmError{Algorithm: "http://www.w3.org/2001/04/xmlenc#rsa-1_5"}, err)
+}
+
+func TestDecrypt_TamperedGCM(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	encryptedKey := encryptKey(t, &key.PublicKey, xmlenc.AlgorithmRSAOAEPMGF1P, "", crypto.SHA1, 16)
+	data := encryptData(t, xmlenc.AlgorithmAES128GCM, encryptedKey, []byte(plainText))
+
+	cipherText, err := base64.StdEncoding.DecodeString(data.CipherData.CipherValue)
+	require.NoError(t, err)
+	cipherText[len(cipherText)-1] ^= 1
+	data.CipherData.CipherValue = base64.StdEncoding.EncodeToString(cipherText)
+
+	_, err = xmlenc.Decrypt([]*rsa.PrivateKey{key}, data, nil)
+	assert.ErrorIs(t, err, xmlenc.ErrBadCipherText)
+}
+
+// encryptKey generates a random content-encryption key of size bytes and
+// returns it alongside its EncryptedKey element.
+func encryptKey(t *testing.T, pub *rsa.PublicKey, algorithm, extra string, hash crypto.Hash, size int) encryptedKey {
+	cek := make([]byte, size)
+	_, err := rand.Read(cek)
+	require.NoError(t, err)
+
+	return encryptedKey{
+		cek: cek,
+		xml: encryptKeyWithCEK(t, pub, algorithm, extra, hash, cek),
+	}
+}
+
+type encryptedKey struct {
+	cek []byte
+	xml string
+}
+
+func encryptKeyWithCEK(t *testing.T, pub *rsa.PublicKey, algorithm, extra string, hash crypto.Hash, cek []byte) string {
+	h := sha1.New()
+	if hash == crypto.SHA256 {
+		h = sha256.New()
+	}
+
+	cipherText, err := rsa.EncryptOAEP(h, rand.Reader, pub, cek, nil)
+	require.NoError(t, err)
+
+	return fmt.Sprintf(`<xenc:EncryptedKey xmlns:xenc="http://www.w3.org/2001/04/xmlenc#" xmlns:xenc11="http://www.w3.org/2009/xmlenc11#" xmlns:ds="http://www.w3.org/2000/09/xmldsig#"><xenc:EncryptionMethod Algorithm="%s">%s</xenc:EncryptionMethod><xenc:CipherData><xenc:CipherValue>%s</xenc:CipherValue></xenc:CipherData></xenc:EncryptedKey>`, algorithm, extra, base64.StdEncoding.EncodeToString(cipherText))
+}
+
+func encryptData(t *testing.T, algorithm string, key encryptedKey, plainText []byte) *xmlenc.EncryptedData {
+	return encryptDataWithCEK(t, algorithm, key.xml, key.cek, plainText)
+}
+
+func encryptDataWithCEK(t *testing.T, algorithm, encryptedKeyXML string, cek, plainText []byte) *xmlenc.EncryptedData {
+	block, err := aes.NewCipher(cek)
+	require.NoError(t, err)
+
+	var cipherText []byte
+	switch algorithm {
+	case xmlenc.AlgorithmAES128GCM, xmlenc.AlgorithmAES192GCM, xmlenc.AlgorithmAES256GCM:
+		gcm, err := cipher.NewGCM(block)
+		require.NoError(t, err)
+
+		iv := make([]byte, gcm.NonceSize())
+		_, err = rand.Read(iv)
+		require.NoError(t, err)
+
+		cipherText = gcm.Seal(iv, iv, plainText, nil)
+	default:
+		// pad with arbitrary bytes, ending in the padding length
+		padding := aes.BlockSize - len(plainText)%aes.BlockSize
+		padded := append([]byte{}, plainText...)
+		for i := 0; i < padding-1; i++ {
+			padded = append(padded, 0xAB)
+		}
+		padded = append(padded, byte(padding))
+
+		iv := make([]byte, aes.BlockSize)
+		_, err = rand.Read(iv)
+		require.NoError(t, err)
+
+		cipherText = make([]byte, len(padded))
+		cipher.NewCBCEncrypter(block, iv).CryptBlocks(cipherText, padded)
+		cipherText = append(iv, cipherText...)
+	}
+
+	doc := fmt.Sprintf(`<xenc:EncryptedData xmlns:xenc="http://www.w3.org/2001/04/xmlenc#" xmlns:ds="http://www.w3.org/2000/09/xmldsig#" Type="http://www.w3.org/2001/04/xmlenc#Element"><xenc:EncryptionMethod Algorithm="%s"/><ds:KeyInfo>%s</ds:KeyInfo><xenc:CipherData><xenc:CipherValue>%s</xenc:CipherValue></xenc:CipherData></xenc:EncryptedData>`, algorithm, encryptedKeyXML, base64.StdEncoding.EncodeToString(cipherText))
+
+	var data xmlenc.EncryptedData
+	require.NoError(t, xml.Unmarshal([]byte(doc), &data))
+	return &data
+}
+
+func keySize(algorithm string) int {
+	switch algorithm {
+	case xmlenc.AlgorithmAES128CBC, xmlenc.AlgorithmAES128GCM:
+		return 16
+	case xmlenc.AlgorithmAES192CBC, xmlenc.AlgorithmAES192GCM:
+		return 24
+	default:
+		return 32
+	}
+}
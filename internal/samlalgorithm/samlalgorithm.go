@@ -0,0 +1,83 @@
+// Package samlalgorithm converts between the signature and digest algorithms
+// in the API and the XML signature algorithm identifiers they stand for.
+package samlalgorithm
+
+import (
+	"fmt"
+	"slices"
+
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+)
+
+var signatureAlgorithms = map[ssoreadyv1.SAMLSignatureAlgorithm]string{
+	ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA1:     dsig.SignatureAlgorithmRSASHA1,
+	ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256:   dsig.SignatureAlgorithmRSASHA256,
+	ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA384:   dsig.SignatureAlgorithmRSASHA384,
+	ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA512:   dsig.SignatureAlgorithmRSASHA512,
+	ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256: dsig.SignatureAlgorithmECDSASHA256,
+	ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_ECDSA_SHA384: dsig.SignatureAlgorithmECDSASHA384,
+}
+
+var digestAlgorithms = map[ssoreadyv1.SAMLDigestAlgorithm]string{
+	ssoreadyv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA1:   dsig.DigestAlgorithmSHA1,
+	ssoreadyv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256: dsig.DigestAlgorithmSHA256,
+	ssoreadyv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA384: dsig.DigestAlgorithmSHA384,
+	ssoreadyv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA512: dsig.DigestAlgorithmSHA512,
+}
+
+// Parse converts the algorithm allowlists on a SAMLConnection into algorithm
+// identifiers, dropping duplicates. The returned slices are never nil.
+func Parse(samlConn *ssoreadyv1.SAMLConnection) ([]string, []string, error) {
+	signatureURIs := []string{}
+	for _, alg := range samlConn.AllowedSignatureAlgorithms {
+		uri, ok := signatureAlgorithms[alg]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported signature algorithm: %s", alg)
+		}
+		if !slices.Contains(signatureURIs, uri) {
+			signatureURIs = append(signatureURIs, uri)
+		}
+	}
+
+	digestURIs := []string{}
+	for _, alg := range samlConn.AllowedDigestAlgorithms {
+		uri, ok := digestAlgorithms[alg]
+		if !ok {
+			return nil, nil, fmt.Errorf("unsupported digest algorithm: %s", alg)
+		}
+		if !slices.Contains(digestURIs, uri) {
+			digestURIs = append(digestURIs, uri)
+		}
+	}
+
+	return signatureURIs, digestURIs, nil
+}
+
+// FormatSignatureAlgorithms is the inverse of Parse for signature algorithms.
+// Unrecognized identifiers are skipped.
+func FormatSignatureAlgorithms(uris []string) []ssoreadyv1.SAMLSignatureAlgorithm {
+	var algs []ssoreadyv1.SAMLSignatureAlgorithm
+	for _, uri := range uris {
+		for alg, algURI := range signatureAlgorithms {
+			if algURI == uri {
+				algs = append(algs, alg)
+			}
+		}
+	}
+	return algs
+}
+
+// FormatDigestAlgorithms is the inverse of Parse for digest algorithms.
+// Unrecognized identifiers are skipped.
+func FormatDigestAlgorithms(uris []string) []ssoreadyv1.SAMLDigestAlgorithm {
+	var algs []ssoreadyv1.SAMLDigestAlgorithm
+	for _, uri := range uris {
+		for alg, algURI := range digestAlgorithms {
+			if algURI == uri {
+				algs = append(algs, alg)
+			}
+		}
+	}
+	return algs
+}
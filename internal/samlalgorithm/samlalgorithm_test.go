@@ -0,0 +1,51 @@
+package samlalgorithm_test
+
+import (
+	"testing"
+
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/saml/dsig"
+	"github.com/ssoready/ssoready/internal/samlalgorithm"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	signatureAlgorithms, digestAlgorithms, err := samlalgorithm.Parse(&ssoreadyv1.SAMLConnection{
+		AllowedSignatureAlgorithms: []ssoreadyv1.SAMLSignatureAlgorithm{
+			ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256,
+			ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256,
+			ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256,
+		},
+		AllowedDigestAlgorithms: []ssoreadyv1.SAMLDigestAlgorithm{
+			ssoreadyv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256,
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{dsig.SignatureAlgorithmRSASHA256, dsig.SignatureAlgorithmECDSASHA256}, signatureAlgorithms)
+	assert.Equal(t, []string{dsig.DigestAlgorithmSHA256}, digestAlgorithms)
+
+	assert.Equal(t, []ssoreadyv1.SAMLSignatureAlgorithm{
+		ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_RSA_SHA256,
+		ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_ECDSA_SHA256,
+	}, samlalgorithm.FormatSignatureAlgorithms(signatureAlgorithms))
+	assert.Equal(t, []ssoreadyv1.SAMLDigestAlgorithm{
+		ssoreadyv1.SAMLDigestAlgorithm_SAML_DIGEST_ALGORITHM_SHA256,
+	}, samlalgorithm.FormatDigestAlgorithms(digestAlgorithms))
+}
+
+func TestParse_Empty(t *testing.T) {
+	signatureAlgorithms, digestAlgorithms, err := samlalgorithm.Parse(&ssoreadyv1.SAMLConnection{})
+	require.NoError(t, err)
+	assert.NotNil(t, signatureAlgorithms)
+	assert.NotNil(t, digestAlgorithms)
+}
+
+func TestParse_Unsupported(t *testing.T) {
+	_, _, err := samlalgorithm.Parse(&ssoreadyv1.SAMLConnection{
+		AllowedSignatureAlgorithms: []ssoreadyv1.SAMLSignatureAlgorithm{
+			ssoreadyv1.SAMLSignatureAlgorithm_SAML_SIGNATURE_ALGORITHM_UNSPECIFIED,
+		},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,72 @@
+package samlprofile
+
+// Names of the built-in presets.
+const (
+	PresetOkta   = "okta"
+	PresetEntra  = "entra"
+	PresetGoogle = "google"
+	PresetADFS   = "adfs"
+	PresetOID    = "oid"
+)
+
+// Presets are the attribute names IDPs conventionally use for each Profile
+// field, keyed by preset name.
+var Presets = map[string]Mapping{
+	// Okta and Google Workspace let admins choose attribute names freely; these
+	// are the names their setup guides suggest.
+	PresetOkta: {
+		FirstName:   []string{"firstName"},
+		LastName:    []string{"lastName"},
+		DisplayName: []string{"displayName"},
+		Groups:      []string{"groups"},
+		Department:  []string{"department"},
+		JobTitle:    []string{"title"},
+		EmployeeID:  []string{"employeeNumber"},
+		PhoneNumber: []string{"mobilePhone", "primaryPhone"},
+	},
+	PresetGoogle: {
+		FirstName:   []string{"firstName"},
+		LastName:    []string{"lastName"},
+		DisplayName: []string{"displayName"},
+		Groups:      []string{"groups"},
+		Department:  []string{"department"},
+		JobTitle:    []string{"title"},
+		EmployeeID:  []string{"employeeId"},
+		PhoneNumber: []string{"phone"},
+	},
+
+	// Microsoft Entra ID and ADFS use claim type URIs as attribute names.
+	PresetEntra: {
+		FirstName:   []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"},
+		LastName:    []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"},
+		DisplayName: []string{"http://schemas.microsoft.com/identity/claims/displayname"},
+		Groups:      []string{"http://schemas.microsoft.com/ws/2008/06/identity/claims/groups"},
+		Department:  []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/department"},
+		JobTitle:    []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/jobtitle"},
+		EmployeeID:  []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/employeeid"},
+		PhoneNumber: []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/mobilephone"},
+	},
+	PresetADFS: {
+		FirstName:   []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname"},
+		LastName:    []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname"},
+		DisplayName: []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/name", "http://schemas.microsoft.com/ws/2008/06/identity/claims/windowsaccountname"},
+		Groups:      []string{"http://schemas.xmlsoap.org/claims/Group", "http://schemas.microsoft.com/ws/2008/06/identity/claims/role"},
+		Department:  []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/department"},
+		JobTitle:    []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/title"},
+		EmployeeID:  []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/employeeid"},
+		PhoneNumber: []string{"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/mobilephone", "http://schemas.xmlsoap.org/ws/2005/05/identity/claims/otherphone"},
+	},
+
+	// The SAML V2.0 X.500/LDAP Attribute Profile, used by Shibboleth,
+	// PingFederate, and most LDAP-backed IDPs.
+	PresetOID: {
+		FirstName:   []string{"urn:oid:2.5.4.42"},                                       // givenName
+		LastName:    []string{"urn:oid:2.5.4.4"},                                        // sn
+		DisplayName: []string{"urn:oid:2.16.840.1.113730.3.1.241", "urn:oid:2.5.4.3"},   // displayName, cn
+		Groups:      []string{"urn:oid:1.3.6.1.4.1.5923.1.5.1.1"},                       // isMemberOf
+		Department:  []string{"urn:oid:2.5.4.11", "urn:oid:2.16.840.1.113730.3.1.2"},    // ou, departmentNumber
+		JobTitle:    []string{"urn:oid:2.5.4.12"},                                       // title
+		EmployeeID:  []string{"urn:oid:2.16.840.1.113730.3.1.3"},                        // employeeNumber
+		PhoneNumber: []string{"urn:oid:0.9.2342.19200300.100.1.41", "urn:oid:2.5.4.20"}, // mobile, telephoneNumber
+	},
+}
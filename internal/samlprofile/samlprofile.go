@@ -0,0 +1,94 @@
+// Package samlprofile maps the attributes an IDP sends about a user onto a
+// fixed set of profile fields.
+package samlprofile
+
+// Profile is the normalized form of a user's SAML attributes.
+type Profile struct {
+	FirstName   string   `json:"first_name,omitempty"`
+	LastName    string   `json:"last_name,omitempty"`
+	DisplayName string   `json:"display_name,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+	Department  string   `json:"department,omitempty"`
+	JobTitle    string   `json:"job_title,omitempty"`
+	EmployeeID  string   `json:"employee_id,omitempty"`
+	PhoneNumber string   `json:"phone_number,omitempty"`
+}
+
+// Mapping lists, for each Profile field, the names of the attributes to take
+// the field from, in order of preference.
+type Mapping struct {
+	FirstName   []string `json:"first_name,omitempty"`
+	LastName    []string `json:"last_name,omitempty"`
+	DisplayName []string `json:"display_name,omitempty"`
+	Groups      []string `json:"groups,omitempty"`
+	Department  []string `json:"department,omitempty"`
+	JobTitle    []string `json:"job_title,omitempty"`
+	EmployeeID  []string `json:"employee_id,omitempty"`
+	PhoneNumber []string `json:"phone_number,omitempty"`
+}
+
+// Override returns m, with each of its fields replaced by the corresponding
+// field of other wherever that field is non-empty.
+func (m Mapping) Override(other Mapping) Mapping {
+	return Mapping{
+		FirstName:   override(m.FirstName, other.FirstName),
+		LastName:    override(m.LastName, other.LastName),
+		DisplayName: override(m.DisplayName, other.DisplayName),
+		Groups:      override(m.Groups, other.Groups),
+		Department:  override(m.Department, other.Department),
+		JobTitle:    override(m.JobTitle, other.JobTitle),
+		EmployeeID:  override(m.EmployeeID, other.EmployeeID),
+		PhoneNumber: override(m.PhoneNumber, other.PhoneNumber),
+	}
+}
+
+func override(names, other []string) []string {
+	if len(other) > 0 {
+		return other
+	}
+	return names
+}
+
+// Map returns the profile that m describes for attrs.
+//
+// Single-valued fields take the first non-empty value of the first of their
+// attributes that has one. Groups takes every non-empty value of the first of
+// its attributes that has any.
+func Map(m Mapping, attrs map[string][]string) Profile {
+	return Profile{
+		FirstName:   first(m.FirstName, attrs),
+		LastName:    first(m.LastName, attrs),
+		DisplayName: first(m.DisplayName, attrs),
+		Groups:      all(m.Groups, attrs),
+		Department:  first(m.Department, attrs),
+		JobTitle:    first(m.JobTitle, attrs),
+		EmployeeID:  first(m.EmployeeID, attrs),
+		PhoneNumber: first(m.PhoneNumber, attrs),
+	}
+}
+
+func first(names []string, attrs map[string][]string) string {
+	for _, name := range names {
+		for _, v := range attrs[name] {
+			if v != "" {
+				return v
+			}
+		}
+	}
+	return ""
+}
+
+func all(names []string, attrs map[string][]string) []string {
+	for _, name := range names {
+		var values []string
+		for _, v := range attrs[name] {
+			if v != "" {
+				values = append(values, v)
+			}
+		}
+		if len(values) > 0 {
+			return values
+		}
+	}
+	return nil
+}
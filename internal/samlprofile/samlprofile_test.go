@@ -0,0 +1,106 @@
+package samlprofile_test
+
+import (
+	"testing"
+
+	"github.com/ssoready/ssoready/internal/samlprofile"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMap(t *testing.T) {
+	testCases := []struct {
+		name    string
+		mapping samlprofile.Mapping
+		attrs   map[string][]string
+		out     samlprofile.Profile
+	}{
+		{
+			name:    "empty mapping",
+			mapping: samlprofile.Mapping{},
+			attrs:   map[string][]string{"firstName": {"Jane"}},
+			out:     samlprofile.Profile{},
+		},
+		{
+			name:    "okta",
+			mapping: samlprofile.Presets[samlprofile.PresetOkta],
+			attrs: map[string][]string{
+				"firstName":    {"Jane"},
+				"lastName":     {"Doe"},
+				"groups":       {"engineering", "admins"},
+				"primaryPhone": {"+1 555 0100"},
+			},
+			out: samlprofile.Profile{
+				FirstName:   "Jane",
+				LastName:    "Doe",
+				Groups:      []string{"engineering", "admins"},
+				PhoneNumber: "+1 555 0100",
+			},
+		},
+		{
+			name:    "entra",
+			mapping: samlprofile.Presets[samlprofile.PresetEntra],
+			attrs: map[string][]string{
+				"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/givenname":    {"Jane"},
+				"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/surname":      {"Doe"},
+				"http://schemas.microsoft.com/identity/claims/displayname":           {"Jane Doe"},
+				"http://schemas.microsoft.com/ws/2008/06/identity/claims/groups":     {"8d2e7b4c-1a2b-4c3d-9e8f-0a1b2c3d4e5f"},
+				"http://schemas.microsoft.com/identity/claims/objectidentifier":      {"00000000-0000-0000-0000-000000000000"},
+				"http://schemas.xmlsoap.org/ws/2005/05/identity/claims/emailaddress": {"jane.doe@example.com"},
+			},
+			out: samlprofile.Profile{
+				FirstName:   "Jane",
+				LastName:    "Doe",
+				DisplayName: "Jane Doe",
+				Groups:      []string{"8d2e7b4c-1a2b-4c3d-9e8f-0a1b2c3d4e5f"},
+			},
+		},
+		{
+			name:    "oid",
+			mapping: samlprofile.Presets[samlprofile.PresetOID],
+			attrs: map[string][]string{
+				"urn:oid:2.5.4.42": {"Jane"},
+				"urn:oid:2.5.4.4":  {"Doe"},
+				"urn:oid:2.5.4.3":  {"Jane Doe"},
+				"urn:oid:2.5.4.12": {"Engineer"},
+			},
+			out: samlprofile.Profile{
+				FirstName:   "Jane",
+				LastName:    "Doe",
+				DisplayName: "Jane Doe",
+				JobTitle:    "Engineer",
+			},
+		},
+		{
+			name: "fallbacks skip empty values",
+			mapping: samlprofile.Mapping{
+				FirstName: []string{"givenName", "firstName"},
+				Groups:    []string{"memberOf", "groups"},
+			},
+			attrs: map[string][]string{
+				"givenName": {""},
+				"firstName": {"Jane"},
+				"memberOf":  {""},
+				"groups":    {"engineering", "", "admins"},
+			},
+			out: samlprofile.Profile{
+				FirstName: "Jane",
+				Groups:    []string{"engineering", "admins"},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.out, samlprofile.Map(tt.mapping, tt.attrs))
+		})
+	}
+}
+
+func TestMapping_Override(t *testing.T) {
+	mapping := samlprofile.Presets[samlprofile.PresetOkta].Override(samlprofile.Mapping{
+		FirstName: []string{"givenName"},
+	})
+
+	assert.Equal(t, []string{"givenName"}, mapping.FirstName)
+	assert.Equal(t, []string{"lastName"}, mapping.LastName)
+}
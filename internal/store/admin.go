@@ -506,23 +506,47 @@ func (s *Store) AdminCreateSAMLConnection(ctx context.Context, req *ssoreadyv1.A
 		idpCert = blk.Bytes
 	}
 
+	idpBinding, err := parseIDPBinding(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
 	id := uuid.New()
 	entityID := fmt.Sprintf("%s/v1/saml/%s", authURL, idformat.SAMLConnection.Format(id))
 	acsURL := fmt.Sprintf("%s/v1/saml/%s/acs", authURL, idformat.SAMLConnection.Format(id))
 	qSAMLConn, err := q.CreateSAMLConnection(ctx, queries.CreateSAMLConnectionParams{
-		ID:                 id,
-		OrganizationID:     orgID,
-		IsPrimary:          req.SamlConnection.Primary,
-		SpAcsUrl:           acsURL,
-		SpEntityID:         entityID,
-		IdpEntityID:        &req.SamlConnection.IdpEntityId,
-		IdpRedirectUrl:     &req.SamlConnection.IdpRedirectUrl,
-		IdpX509Certificate: idpCert,
+		ID:                         id,
+		OrganizationID:             orgID,
+		IsPrimary:                  req.SamlConnection.Primary,
+		SpAcsUrl:                   acsURL,
+		SpEntityID:                 entityID,
+		IdpEntityID:                &req.SamlConnection.IdpEntityId,
+		IdpRedirectUrl:             &req.SamlConnection.IdpRedirectUrl,
+		IdpX509Certificate:         idpCert,
+		AllowedSignatureAlgorithms: []string{},
+		AllowedDigestAlgorithms:    []string{},
+		IdpBinding:                 idpBinding,
+		EmailAttributeNames:        []string{},
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	qSAMLConn, err = s.rotateSPEncryptionKey(ctx, q, qSAMLConn.ID)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp encryption key: %w", err)
+	}
+
+	qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp signing key: %w", err)
+	}
+
+	qSAMLConn, err = s.detectIDPVendor(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
 	if qSAMLConn.IsPrimary {
 		if err := q.UpdatePrimarySAMLConnection(ctx, queries.UpdatePrimarySAMLConnectionParams{
 			OrganizationID: qSAMLConn.OrganizationID,
@@ -579,17 +603,43 @@ func (s *Store) AdminUpdateSAMLConnection(ctx context.Context, req *ssoreadyv1.A
 		idpCert = blk.Bytes
 	}
 
+	idpBinding, err := parseIDPBinding(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	// algorithm allowlists, request signing, IDP-initiated logins, single logout, IDP metadata refreshes, email
+	// attributes, attribute mappings, AuthnRequest options, clock skew, and artifact resolution are not exposed in the
+	// admin panel, so keep whatever is already configured
 	qSAMLConn, err := q.UpdateSAMLConnection(ctx, queries.UpdateSAMLConnectionParams{
-		ID:                 id,
-		IsPrimary:          req.SamlConnection.Primary,
-		IdpEntityID:        &req.SamlConnection.IdpEntityId,
-		IdpRedirectUrl:     &req.SamlConnection.IdpRedirectUrl,
-		IdpX509Certificate: idpCert,
+		ID:                         id,
+		IsPrimary:                  req.SamlConnection.Primary,
+		IdpEntityID:                &req.SamlConnection.IdpEntityId,
+		IdpRedirectUrl:             &req.SamlConnection.IdpRedirectUrl,
+		IdpX509Certificate:         idpCert,
+		AllowedSignatureAlgorithms: samlConn.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    samlConn.AllowedDigestAlgorithms,
+		SignAuthnRequests:          samlConn.SignAuthnRequests,
+		IdpBinding:                 idpBinding,
+		AllowIdpInitiated:          samlConn.AllowIdpInitiated,
+		IdpSloUrl:                  samlConn.IdpSloUrl,
+		IdpMetadataUrl:             samlConn.IdpMetadataUrl,
+		EmailAttributeNames:        samlConn.EmailAttributeNames,
+		AttributeMappingPreset:     samlConn.AttributeMappingPreset,
+		AttributeMapping:           samlConn.AttributeMapping,
+		AuthnRequestOptions:        samlConn.AuthnRequestOptions,
+		AllowedClockSkewSeconds:    samlConn.AllowedClockSkewSeconds,
+		IdpArtifactResolutionUrl:   samlConn.IdpArtifactResolutionUrl,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("update saml connection: %w", err)
 	}
 
+	qSAMLConn, err = s.detectIDPVendor(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
 	if qSAMLConn.IsPrimary {
 		if err := q.UpdatePrimarySAMLConnection(ctx, queries.UpdatePrimarySAMLConnectionParams{
 			OrganizationID: qSAMLConn.OrganizationID,
@@ -606,6 +656,46 @@ func (s *Store) AdminUpdateSAMLConnection(ctx context.Context, req *ssoreadyv1.A
 	return &ssoreadyv1.AdminUpdateSAMLConnectionResponse{SamlConnection: parseSAMLConnection(qSAMLConn)}, nil
 }
 
+func (s *Store) AdminGetSAMLConnectionSPSigningCertificate(ctx context.Context, req *ssoreadyv1.AdminGetSAMLConnectionSPSigningCertificateRequest) (*ssoreadyv1.AdminGetSAMLConnectionSPSigningCertificateResponse, error) {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	if !authn.FullContextData(ctx).AdminAccessToken.CanManageSAML {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("not authorized to manage saml"))
+	}
+
+	orgID := authn.FullContextData(ctx).AdminAccessToken.OrganizationID
+
+	id, err := idformat.SAMLConnection.Parse(req.SamlConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// authz check; check saml connection has the same org ID as the admin access token
+	samlConn, err := q.GetSAMLConnectionByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("get saml conn by id: %w", err)
+	}
+
+	if samlConn.OrganizationID != orgID {
+		return nil, fmt.Errorf("saml conn organization id != admin access token org id")
+	}
+
+	samlConn, err = s.ensureSPSigningKey(ctx, q, samlConn)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp signing key: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &ssoreadyv1.AdminGetSAMLConnectionSPSigningCertificateResponse{Certificate: spSigningCertificatePEM(samlConn)}, nil
+}
+
 func (s *Store) AdminListSAMLFlows(ctx context.Context, req *ssoreadyv1.AdminListSAMLFlowsRequest) (*ssoreadyv1.AdminListSAMLFlowsResponse, error) {
 	_, q, _, rollback, err := s.tx(ctx)
 	if err != nil {
@@ -14,6 +14,7 @@ import (
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/ssoready/ssoready/internal/authn"
+	"github.com/ssoready/ssoready/internal/samlprofile"
 	"github.com/ssoready/ssoready/internal/store/idformat"
 	"github.com/ssoready/ssoready/internal/store/queries"
 )
@@ -28,7 +29,17 @@ type AuthGetInitDataRequest struct {
 type AuthGetInitDataResponse struct {
 	RequestID      string
 	IDPRedirectURL string
+	IDPBinding     string
 	SPEntityID     string
+
+	// SPSigningPrivateKey and SPSigningCertificate are set only if the SAML
+	// connection signs its AuthnRequests.
+	SPSigningPrivateKey  []byte
+	SPSigningCertificate []byte
+
+	// AuthnRequestOptions are the SAML connection's AuthnRequest options,
+	// overridden by any the SAML flow was started with.
+	AuthnRequestOptions AuthnRequestOptions
 }
 
 type AuthGetInitDataBadStateError struct {
@@ -69,10 +80,71 @@ func (s *Store) AuthGetInitData(ctx context.Context, req *AuthGetInitDataRequest
 		return nil, fmt.Errorf("saml flow id does not match saml connection id")
 	}
 
-	return &AuthGetInitDataResponse{
-		RequestID:      stateData.SAMLFlowID,
-		IDPRedirectURL: *res.IdpRedirectUrl,
-		SPEntityID:     res.SpEntityID,
+	connAuthnRequestOptions, err := unmarshalAuthnRequestOptions(res.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	flowAuthnRequestOptions, err := unmarshalAuthnRequestOptions(qSAMLFlow.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	initData := &AuthGetInitDataResponse{
+		RequestID:           stateData.SAMLFlowID,
+		IDPRedirectURL:      *res.IdpRedirectUrl,
+		IDPBinding:          derefOrEmpty(res.IdpBinding),
+		SPEntityID:          res.SpEntityID,
+		AuthnRequestOptions: connAuthnRequestOptions.Override(flowAuthnRequestOptions),
+	}
+
+	if res.SignAuthnRequests {
+		initData.SPSigningPrivateKey = res.SpSigningPrivateKey
+		initData.SPSigningCertificate = res.SpSigningCertificate
+	}
+
+	return initData, nil
+}
+
+type AuthGetMetadataDataResponse struct {
+	SPEntityID string
+	SPACSURL   string
+	SPSLOURL   string
+
+	// SignAuthnRequests is whether the SAML connection signs its
+	// AuthnRequests with SPSigningCertificate.
+	SignAuthnRequests       bool
+	SPSigningCertificate    []byte
+	SPEncryptionCertificate []byte
+
+	// ArtifactBinding is whether the IDP may send assertions using the
+	// HTTP-Artifact binding.
+	ArtifactBinding bool
+}
+
+func (s *Store) AuthGetMetadataData(ctx context.Context, samlConnectionID string) (*AuthGetMetadataDataResponse, error) {
+	samlConnID, err := idformat.SAMLConnection.Parse(samlConnectionID)
+	if err != nil {
+		return nil, ErrNoSuchSAMLConnection
+	}
+
+	res, err := s.q.AuthGetMetadataData(ctx, samlConnID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoSuchSAMLConnection
+		}
+
+		return nil, fmt.Errorf("get metadata data: %w", err)
+	}
+
+	return &AuthGetMetadataDataResponse{
+		SPEntityID:              res.SpEntityID,
+		SPACSURL:                res.SpAcsUrl,
+		SPSLOURL:                spSLOURL(res.SpAcsUrl),
+		SignAuthnRequests:       res.SignAuthnRequests,
+		SPSigningCertificate:    res.SpSigningCertificate,
+		SPEncryptionCertificate: res.SpEncryptionCertificate,
+		ArtifactBinding:         derefOrEmpty(res.IdpArtifactResolutionUrl) != "",
 	}, nil
 }
 
@@ -129,15 +201,53 @@ type AuthGetValidateDataRequest struct {
 }
 
 type AuthGetValidateDataResponse struct {
-	SPEntityID                  string
-	IDPEntityID                 string
-	IDPX509Certificate          []byte
+	SPEntityID                     string
+	SPACSURL                       string
+	IDPEntityID                    string
+	IDPX509Certificate             []byte
+	AdditionalIDPCertificates      []AuthIDPCertificate
+	AllowedSignatureAlgorithms     []string
+	AllowedDigestAlgorithms        []string
+	SPEncryptionPrivateKey         []byte
+	SPPreviousEncryptionPrivateKey []byte
+	AllowIDPInitiated              bool
+
+	// EmailAttributeNames are the attributes to take the subject's email
+	// address from, in order of preference. If empty, the subject's NameID is
+	// their email address.
+	EmailAttributeNames []string
+
+	// AttributeMapping is how to populate the subject's profile from their
+	// attributes.
+	AttributeMapping samlprofile.Mapping
+
+	// AllowedClockSkew is how far the IDP's clock may be from ours when
+	// checking assertions' validity windows.
+	AllowedClockSkew time.Duration
+
+	// IDPArtifactResolutionURL is where to resolve artifacts sent using the
+	// HTTP-Artifact binding. If empty, the binding is not supported.
+	IDPArtifactResolutionURL string
+
+	// SPSigningPrivateKey and SPSigningCertificate sign ArtifactResolve
+	// messages. They are nil unless the SAML connection signs its requests.
+	SPSigningPrivateKey  []byte
+	SPSigningCertificate []byte
+
 	OrganizationDomains         []string
 	EnvironmentRedirectURL      string
 	EnvironmentOAuthRedirectURI string
 	EnvironmentAdminTestModeURL string
 }
 
+// AuthIDPCertificate is an additional certificate the IDP may sign with. If
+// non-zero, NotBefore and NotAfter restrict when it is trusted.
+type AuthIDPCertificate struct {
+	X509Certificate []byte
+	NotBefore       time.Time
+	NotAfter        time.Time
+}
+
 var ErrNoSuchSAMLConnection = errors.New("no such saml connection")
 
 func (s *Store) AuthGetValidateData(ctx context.Context, req *AuthGetValidateDataRequest) (*AuthGetValidateDataResponse, error) {
@@ -166,6 +276,25 @@ func (s *Store) AuthGetValidateData(ctx context.Context, req *AuthGetValidateDat
 		return nil, fmt.Errorf("get domains: %w", err)
 	}
 
+	qIDPCerts, err := q.ListSAMLConnectionIDPCertificates(ctx, samlConnID)
+	if err != nil {
+		return nil, fmt.Errorf("list idp certificates: %w", err)
+	}
+
+	var idpCerts []AuthIDPCertificate
+	for _, qIDPCert := range qIDPCerts {
+		idpCerts = append(idpCerts, AuthIDPCertificate{
+			X509Certificate: qIDPCert.X509Certificate,
+			NotBefore:       derefOrEmpty(qIDPCert.NotBefore),
+			NotAfter:        derefOrEmpty(qIDPCert.NotAfter),
+		})
+	}
+
+	attributeMapping, err := effectiveAttributeMapping(res.AttributeMappingPreset, res.AttributeMapping)
+	if err != nil {
+		return nil, err
+	}
+
 	if res.IdpEntityID == nil || res.IdpX509Certificate == nil {
 		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("saml connection is not fully configured, see: https://ssoready.com/docs/ssoready-concepts/saml-connections#not-yet-configured"))
 	}
@@ -177,15 +306,33 @@ func (s *Store) AuthGetValidateData(ctx context.Context, req *AuthGetValidateDat
 		adminTestModeURL = s.defaultAdminTestModeURL
 	}
 
-	return &AuthGetValidateDataResponse{
-		SPEntityID:                  res.SpEntityID,
-		IDPEntityID:                 *res.IdpEntityID,
-		IDPX509Certificate:          res.IdpX509Certificate,
-		OrganizationDomains:         domains,
-		EnvironmentRedirectURL:      *res.RedirectUrl,
-		EnvironmentOAuthRedirectURI: derefOrEmpty(res.OauthRedirectUri),
-		EnvironmentAdminTestModeURL: adminTestModeURL,
-	}, nil
+	validateData := &AuthGetValidateDataResponse{
+		SPEntityID:                     res.SpEntityID,
+		SPACSURL:                       res.SpAcsUrl,
+		IDPEntityID:                    *res.IdpEntityID,
+		IDPX509Certificate:             res.IdpX509Certificate,
+		AdditionalIDPCertificates:      idpCerts,
+		AllowedSignatureAlgorithms:     res.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:        res.AllowedDigestAlgorithms,
+		SPEncryptionPrivateKey:         res.SpEncryptionPrivateKey,
+		SPPreviousEncryptionPrivateKey: res.SpPreviousEncryptionPrivateKey,
+		AllowIDPInitiated:              res.AllowIdpInitiated,
+		EmailAttributeNames:            res.EmailAttributeNames,
+		AttributeMapping:               attributeMapping,
+		AllowedClockSkew:               time.Duration(res.AllowedClockSkewSeconds) * time.Second,
+		IDPArtifactResolutionURL:       derefOrEmpty(res.IdpArtifactResolutionUrl),
+		OrganizationDomains:            domains,
+		EnvironmentRedirectURL:         *res.RedirectUrl,
+		EnvironmentOAuthRedirectURI:    derefOrEmpty(res.OauthRedirectUri),
+		EnvironmentAdminTestModeURL:    adminTestModeURL,
+	}
+
+	if res.SignAuthnRequests {
+		validateData.SPSigningPrivateKey = res.SpSigningPrivateKey
+		validateData.SPSigningCertificate = res.SpSigningCertificate
+	}
+
+	return validateData, nil
 }
 
 var InvalidSAMLRequestID = errors.New("invalid saml request id")
@@ -213,8 +360,16 @@ type AuthUpsertSAMLLoginEventRequest struct {
 	SAMLAssertionID                      *string
 	SAMLFlowID                           string
 	Email                                string
-	SubjectIDPAttributes                 map[string]string
+	SubjectID                            string
+	SubjectIDFormat                      string
+	SubjectIDPAttributes                 map[string][]string
+	SubjectProfile                       samlprofile.Profile
+	SubjectSessionIndex                  string
+	SubjectAuthnInstant                  time.Time
+	SubjectSessionNotOnOrAfter           time.Time
+	SubjectAuthnContextClassRef          string
 	SAMLAssertion                        string
+	IDPInitiated                         bool
 	ErrorSAMLConnectionNotConfigured     bool
 	ErrorUnsignedAssertion               bool
 	ErrorBadIssuer                       *string
@@ -224,6 +379,21 @@ type AuthUpsertSAMLLoginEventRequest struct {
 	ErrorBadCertificate                  *x509.Certificate
 	ErrorBadSubjectID                    *string
 	ErrorEmailOutsideOrganizationDomains *string
+	ErrorIDPInitiatedNotAllowed          bool
+	ErrorExpiredAssertion                bool
+	ErrorExpiredIDPInitiatedAssertion    bool
+	ErrorExpiredSubjectConfirmation      bool
+	ErrorBadDestination                  *string
+	ErrorBadRecipient                    *string
+	ErrorBadSubjectConfirmationMethod    *string
+	ErrorIDPStatusCode                   *string
+	ErrorIDPSubStatusCode                *string
+	ErrorIDPStatusMessage                *string
+	ErrorArtifactResolution              *string
+	ResponseSigned                       bool
+	AssertionSigned                      bool
+	IDPCertificate                       *x509.Certificate
+	IDPVendor                            string
 }
 
 type AuthUpsertSAMLLoginEventResponse struct {
@@ -276,7 +446,7 @@ func (s *Store) AuthUpsertReceiveAssertionData(ctx context.Context, req *AuthUps
 	}
 
 	var assertionOk bool
-	if !req.ErrorSAMLConnectionNotConfigured && !req.ErrorUnsignedAssertion && req.ErrorBadIssuer == nil && req.ErrorBadAudience == nil && req.ErrorBadSignatureAlgorithm == nil && req.ErrorBadDigestAlgorithm == nil && req.ErrorBadCertificate == nil && req.ErrorBadSubjectID == nil && req.ErrorEmailOutsideOrganizationDomains == nil {
+	if !req.ErrorSAMLConnectionNotConfigured && !req.ErrorUnsignedAssertion && req.ErrorBadIssuer == nil && req.ErrorBadAudience == nil && req.ErrorBadSignatureAlgorithm == nil && req.ErrorBadDigestAlgorithm == nil && req.ErrorBadCertificate == nil && req.ErrorBadSubjectID == nil && req.ErrorEmailOutsideOrganizationDomains == nil && !req.ErrorIDPInitiatedNotAllowed && !req.ErrorExpiredAssertion && !req.ErrorExpiredIDPInitiatedAssertion && !req.ErrorExpiredSubjectConfirmation && req.ErrorBadDestination == nil && req.ErrorBadRecipient == nil && req.ErrorBadSubjectConfirmationMethod == nil && req.ErrorIDPStatusCode == nil && req.ErrorArtifactResolution == nil {
 		assertionOk = true
 	}
 
@@ -300,6 +470,11 @@ func (s *Store) AuthUpsertReceiveAssertionData(ctx context.Context, req *AuthUps
 		badX509Certificate = req.ErrorBadCertificate.Raw
 	}
 
+	var idpX509Certificate []byte
+	if req.IDPCertificate != nil {
+		idpX509Certificate = req.IDPCertificate.Raw
+	}
+
 	qSAMLFlow, err := q.UpsertSAMLFlowReceiveAssertion(ctx, queries.UpsertSAMLFlowReceiveAssertionParams{
 		ID:                                   samlFlowID,
 		AssertionID:                          req.SAMLAssertionID,
@@ -321,6 +496,22 @@ func (s *Store) AuthUpsertReceiveAssertionData(ctx context.Context, req *AuthUps
 		ErrorBadSubjectID:                    req.ErrorBadSubjectID,
 		ErrorEmailOutsideOrganizationDomains: req.ErrorEmailOutsideOrganizationDomains,
 		Status:                               status,
+		ResponseSigned:                       req.ResponseSigned,
+		AssertionSigned:                      req.AssertionSigned,
+		IdpX509Certificate:                   idpX509Certificate,
+		IsIdpInitiated:                       req.IDPInitiated,
+		ErrorIdpInitiatedNotAllowed:          req.ErrorIDPInitiatedNotAllowed,
+		ErrorExpiredAssertion:                req.ErrorExpiredAssertion,
+		ErrorExpiredSubjectConfirmation:      req.ErrorExpiredSubjectConfirmation,
+		ErrorBadDestination:                  req.ErrorBadDestination,
+		ErrorBadRecipient:                    req.ErrorBadRecipient,
+		ErrorBadSubjectConfirmationMethod:    req.ErrorBadSubjectConfirmationMethod,
+		ErrorIdpStatusCode:                   req.ErrorIDPStatusCode,
+		ErrorIdpSubStatusCode:                req.ErrorIDPSubStatusCode,
+		ErrorIdpStatusMessage:                req.ErrorIDPStatusMessage,
+		ErrorArtifactResolution:              req.ErrorArtifactResolution,
+		IdpVendor:                            nilIfZero(req.IDPVendor),
+		ErrorExpiredIdpInitiatedAssertion:    req.ErrorExpiredIDPInitiatedAssertion,
 	})
 	if err != nil {
 		var pgxErr *pgconn.PgError
@@ -342,10 +533,22 @@ func (s *Store) AuthUpsertReceiveAssertionData(ctx context.Context, req *AuthUps
 		return nil, err
 	}
 
+	profile, err := json.Marshal(req.SubjectProfile)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, err := q.UpdateSAMLFlowSubjectData(ctx, queries.UpdateSAMLFlowSubjectDataParams{
-		ID:                   samlFlowID,
-		Email:                &req.Email,
-		SubjectIdpAttributes: attrs,
+		ID:                          samlFlowID,
+		Email:                       &req.Email,
+		SubjectIdpAttributes:        attrs,
+		SubjectSessionIndex:         &req.SubjectSessionIndex,
+		SubjectID:                   &req.SubjectID,
+		SubjectIDFormat:             &req.SubjectIDFormat,
+		SubjectProfile:              profile,
+		SubjectAuthnInstant:         nilIfZero(req.SubjectAuthnInstant),
+		SubjectSessionNotOnOrAfter:  nilIfZero(req.SubjectSessionNotOnOrAfter),
+		SubjectAuthnContextClassRef: nilIfZero(req.SubjectAuthnContextClassRef),
 	}); err != nil {
 		return nil, err
 	}
@@ -368,6 +571,41 @@ func (s *Store) AuthUpsertReceiveAssertionData(ctx context.Context, req *AuthUps
 	}, nil
 }
 
+type AuthRecordUnverifiedIDPStatusRequest struct {
+	SAMLConnectionID string
+	SAMLFlowID       string
+	StatusCode       string
+	SubStatusCode    string
+	StatusMessage    string
+}
+
+// AuthRecordUnverifiedIDPStatus records an IDP status from a response that
+// could not be verified against a SAML flow, purely for debugging. It does not
+// change the flow's status, as the response may have been forged.
+func (s *Store) AuthRecordUnverifiedIDPStatus(ctx context.Context, req *AuthRecordUnverifiedIDPStatusRequest) error {
+	samlConnID, err := idformat.SAMLConnection.Parse(req.SAMLConnectionID)
+	if err != nil {
+		return err
+	}
+
+	samlFlowID, err := idformat.SAMLFlow.Parse(req.SAMLFlowID)
+	if err != nil {
+		return InvalidSAMLRequestID
+	}
+
+	if err := s.q.UpdateSAMLFlowUnverifiedIDPStatus(ctx, queries.UpdateSAMLFlowUnverifiedIDPStatusParams{
+		UnverifiedIdpStatusCode:    &req.StatusCode,
+		UnverifiedIdpSubStatusCode: nilIfZero(req.SubStatusCode),
+		UnverifiedIdpStatusMessage: nilIfZero(req.StatusMessage),
+		ID:                         samlFlowID,
+		SamlConnectionID:           samlConnID,
+	}); err != nil {
+		return fmt.Errorf("update saml flow unverified idp status: %w", err)
+	}
+
+	return nil
+}
+
 type AuthGetOAuthAuthorizeDataRequest struct {
 	OrganizationID         string
 	OrganizationExternalID string
@@ -376,8 +614,17 @@ type AuthGetOAuthAuthorizeDataRequest struct {
 
 type AuthGetOAuthAuthorizeDataResponse struct {
 	IDPRedirectURL   string
+	IDPBinding       string
 	SPEntityID       string
 	SAMLConnectionID string
+
+	// SPSigningPrivateKey and SPSigningCertificate are set only if the SAML
+	// connection signs its AuthnRequests.
+	SPSigningPrivateKey  []byte
+	SPSigningCertificate []byte
+
+	// AuthnRequestOptions are the SAML connection's AuthnRequest options.
+	AuthnRequestOptions AuthnRequestOptions
 }
 
 func (s *Store) AuthGetOAuthAuthorizeData(ctx context.Context, req *AuthGetOAuthAuthorizeDataRequest) (*AuthGetOAuthAuthorizeDataResponse, error) {
@@ -457,11 +704,25 @@ func (s *Store) AuthGetOAuthAuthorizeData(ctx context.Context, req *AuthGetOAuth
 		}, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("saml connection is not fully configured, see: https://ssoready.com/docs/ssoready-concepts/saml-flows#saml-connection-not-fully-configured"))
 	}
 
-	return &AuthGetOAuthAuthorizeDataResponse{
-		IDPRedirectURL:   *samlConn.IdpRedirectUrl,
-		SPEntityID:       samlConn.SpEntityID,
-		SAMLConnectionID: idformat.SAMLConnection.Format(samlConn.ID),
-	}, nil
+	authnRequestOptions, err := unmarshalAuthnRequestOptions(samlConn.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	authorizeData := &AuthGetOAuthAuthorizeDataResponse{
+		IDPRedirectURL:      *samlConn.IdpRedirectUrl,
+		IDPBinding:          derefOrEmpty(samlConn.IdpBinding),
+		SPEntityID:          samlConn.SpEntityID,
+		SAMLConnectionID:    idformat.SAMLConnection.Format(samlConn.ID),
+		AuthnRequestOptions: authnRequestOptions,
+	}
+
+	if samlConn.SignAuthnRequests {
+		authorizeData.SPSigningPrivateKey = samlConn.SpSigningPrivateKey
+		authorizeData.SPSigningCertificate = samlConn.SpSigningCertificate
+	}
+
+	return authorizeData, nil
 }
 
 type AuthUpsertOAuthAuthorizeDataRequest struct {
@@ -511,3 +772,244 @@ func (s *Store) AuthUpsertOAuthAuthorizeData(ctx context.Context, req *AuthUpser
 
 	return nil
 }
+
+type AuthGetLogoutDataResponse struct {
+	SPEntityID                 string
+	IDPEntityID                string
+	IDPX509Certificate         []byte
+	AdditionalIDPCertificates  []AuthIDPCertificate
+	AllowedSignatureAlgorithms []string
+	AllowedDigestAlgorithms    []string
+
+	// IDPSLOURL is where logout messages are sent to the IDP. It is empty if
+	// the IDP does not support single logout.
+	IDPSLOURL string
+
+	// SPSigningPrivateKey and SPSigningCertificate sign the logout messages
+	// sent to the IDP, if set.
+	SPSigningPrivateKey  []byte
+	SPSigningCertificate []byte
+}
+
+func (s *Store) AuthGetLogoutData(ctx context.Context, samlConnectionID string) (*AuthGetLogoutDataResponse, error) {
+	samlConnID, err := idformat.SAMLConnection.Parse(samlConnectionID)
+	if err != nil {
+		return nil, ErrNoSuchSAMLConnection
+	}
+
+	_, q, _, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	qSAMLConn, err := q.GetSAMLConnectionByID(ctx, samlConnID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoSuchSAMLConnection
+		}
+
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	if qSAMLConn.IdpEntityID == nil || qSAMLConn.IdpX509Certificate == nil {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("saml connection is not fully configured, see: https://ssoready.com/docs/ssoready-concepts/saml-connections#not-yet-configured"))
+	}
+
+	qIDPCerts, err := q.ListSAMLConnectionIDPCertificates(ctx, samlConnID)
+	if err != nil {
+		return nil, fmt.Errorf("list idp certificates: %w", err)
+	}
+
+	var idpCerts []AuthIDPCertificate
+	for _, qIDPCert := range qIDPCerts {
+		idpCerts = append(idpCerts, AuthIDPCertificate{
+			X509Certificate: qIDPCert.X509Certificate,
+			NotBefore:       derefOrEmpty(qIDPCert.NotBefore),
+			NotAfter:        derefOrEmpty(qIDPCert.NotAfter),
+		})
+	}
+
+	return &AuthGetLogoutDataResponse{
+		SPEntityID:                 qSAMLConn.SpEntityID,
+		IDPEntityID:                *qSAMLConn.IdpEntityID,
+		IDPX509Certificate:         qSAMLConn.IdpX509Certificate,
+		AdditionalIDPCertificates:  idpCerts,
+		AllowedSignatureAlgorithms: qSAMLConn.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    qSAMLConn.AllowedDigestAlgorithms,
+		IDPSLOURL:                  derefOrEmpty(qSAMLConn.IdpSloUrl),
+		SPSigningPrivateKey:        qSAMLConn.SpSigningPrivateKey,
+		SPSigningCertificate:       qSAMLConn.SpSigningCertificate,
+	}, nil
+}
+
+var ErrNoSuchSAMLLogout = errors.New("no such saml logout, or saml logout already used")
+
+type AuthCreateIDPInitiatedLogoutRequest struct {
+	SAMLConnectionID string
+	NameID           string
+	SessionIndexes   []string
+}
+
+// AuthCreateIDPInitiatedLogout records a logout the IDP sent, along with the
+// SAML flows whose sessions it ends. It returns the new SAML logout's ID.
+func (s *Store) AuthCreateIDPInitiatedLogout(ctx context.Context, req *AuthCreateIDPInitiatedLogoutRequest) (string, error) {
+	samlConnID, err := idformat.SAMLConnection.Parse(req.SAMLConnectionID)
+	if err != nil {
+		return "", ErrNoSuchSAMLConnection
+	}
+
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer rollback()
+
+	samlFlowIDs, err := q.AuthListSAMLLogoutFlowIDs(ctx, queries.AuthListSAMLLogoutFlowIDsParams{
+		SamlConnectionID: samlConnID,
+		SubjectID:        req.NameID,
+		SessionIndexes:   req.SessionIndexes,
+	})
+	if err != nil {
+		return "", fmt.Errorf("list saml logout flow ids: %w", err)
+	}
+
+	now := time.Now()
+	success := true
+	qSAMLLogout, err := q.CreateSAMLLogout(ctx, queries.CreateSAMLLogoutParams{
+		ID:               uuid.New(),
+		SamlConnectionID: samlConnID,
+		IsIdpInitiated:   true,
+		SubjectID:        req.NameID,
+		SessionIndexes:   req.SessionIndexes,
+		SamlFlowIds:      samlFlowIDs,
+		CreateTime:       now,
+		CompleteTime:     &now,
+		Success:          &success,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create saml logout: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return "", err
+	}
+
+	return idformat.SAMLLogout.Format(qSAMLLogout.ID), nil
+}
+
+type AuthInitiateLogoutResponse struct {
+	NameID       string
+	NameIDFormat string
+	SessionIndex string
+}
+
+// AuthInitiateLogout marks an SP-initiated SAML logout as sent to the IDP. A
+// SAML logout can only be initiated once.
+func (s *Store) AuthInitiateLogout(ctx context.Context, samlConnectionID, samlLogoutID string) (*AuthInitiateLogoutResponse, error) {
+	samlConnID, err := idformat.SAMLConnection.Parse(samlConnectionID)
+	if err != nil {
+		return nil, ErrNoSuchSAMLConnection
+	}
+
+	id, err := idformat.SAMLLogout.Parse(samlLogoutID)
+	if err != nil {
+		return nil, ErrNoSuchSAMLLogout
+	}
+
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	if _, err := q.AuthGetSAMLLogout(ctx, queries.AuthGetSAMLLogoutParams{
+		SamlConnectionID: samlConnID,
+		ID:               id,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoSuchSAMLLogout
+		}
+
+		return nil, fmt.Errorf("get saml logout: %w", err)
+	}
+
+	now := time.Now()
+	qSAMLLogout, err := q.AuthUpdateSAMLLogoutInitiate(ctx, queries.AuthUpdateSAMLLogoutInitiateParams{
+		InitiateTime: &now,
+		ID:           id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNoSuchSAMLLogout
+		}
+
+		return nil, fmt.Errorf("update saml logout: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, err
+	}
+
+	var sessionIndex string
+	if len(qSAMLLogout.SessionIndexes) > 0 {
+		sessionIndex = qSAMLLogout.SessionIndexes[0]
+	}
+
+	return &AuthInitiateLogoutResponse{
+		NameID:       qSAMLLogout.SubjectID,
+		NameIDFormat: derefOrEmpty(qSAMLLogout.SubjectIDFormat),
+		SessionIndex: sessionIndex,
+	}, nil
+}
+
+// AuthCompleteLogout records the IDP's response to an SP-initiated SAML logout.
+// It returns the URL to send the user back to.
+func (s *Store) AuthCompleteLogout(ctx context.Context, samlConnectionID, samlLogoutID string, success bool) (string, error) {
+	samlConnID, err := idformat.SAMLConnection.Parse(samlConnectionID)
+	if err != nil {
+		return "", ErrNoSuchSAMLConnection
+	}
+
+	id, err := idformat.SAMLLogout.Parse(samlLogoutID)
+	if err != nil {
+		return "", ErrNoSuchSAMLLogout
+	}
+
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer rollback()
+
+	if _, err := q.AuthGetSAMLLogout(ctx, queries.AuthGetSAMLLogoutParams{
+		SamlConnectionID: samlConnID,
+		ID:               id,
+	}); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrNoSuchSAMLLogout
+		}
+
+		return "", fmt.Errorf("get saml logout: %w", err)
+	}
+
+	now := time.Now()
+	qSAMLLogout, err := q.AuthUpdateSAMLLogoutComplete(ctx, queries.AuthUpdateSAMLLogoutCompleteParams{
+		CompleteTime: &now,
+		Success:      &success,
+		ID:           id,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", ErrNoSuchSAMLLogout
+		}
+
+		return "", fmt.Errorf("update saml logout: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return "", err
+	}
+
+	return derefOrEmpty(qSAMLLogout.ReturnUrl), nil
+}
@@ -0,0 +1,118 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+)
+
+// AuthnRequestOptions are the options a SAML login's AuthnRequest is sent
+// with.
+type AuthnRequestOptions struct {
+	ForceAuthn                      bool     `json:"force_authn,omitempty"`
+	IsPassive                       bool     `json:"is_passive,omitempty"`
+	NameIDPolicyFormat              string   `json:"name_id_policy_format,omitempty"`
+	RequestedAuthnContextClassRefs  []string `json:"requested_authn_context_class_refs,omitempty"`
+	RequestedAuthnContextComparison string   `json:"requested_authn_context_comparison,omitempty"`
+}
+
+// Override returns o with other applied to it. ForceAuthn and IsPassive are
+// set if either of o or other sets them; other fields are replaced wherever
+// other sets them.
+func (o AuthnRequestOptions) Override(other AuthnRequestOptions) AuthnRequestOptions {
+	res := AuthnRequestOptions{
+		ForceAuthn:                      o.ForceAuthn || other.ForceAuthn,
+		IsPassive:                       o.IsPassive || other.IsPassive,
+		NameIDPolicyFormat:              o.NameIDPolicyFormat,
+		RequestedAuthnContextClassRefs:  o.RequestedAuthnContextClassRefs,
+		RequestedAuthnContextComparison: o.RequestedAuthnContextComparison,
+	}
+
+	if other.NameIDPolicyFormat != "" {
+		res.NameIDPolicyFormat = other.NameIDPolicyFormat
+	}
+	if len(other.RequestedAuthnContextClassRefs) > 0 {
+		res.RequestedAuthnContextClassRefs = other.RequestedAuthnContextClassRefs
+	}
+	if other.RequestedAuthnContextComparison != "" {
+		res.RequestedAuthnContextComparison = other.RequestedAuthnContextComparison
+	}
+
+	return res
+}
+
+var authnContextComparisons = map[string]struct{}{
+	"exact":   {},
+	"minimum": {},
+	"maximum": {},
+	"better":  {},
+}
+
+// parseAuthnRequestOptions converts AuthnRequest options from the API into the
+// value stored in the database. Unset options are stored as NULL.
+func parseAuthnRequestOptions(opts *ssoreadyv1.SAMLAuthnRequestOptions) ([]byte, error) {
+	if opts == nil {
+		return nil, nil
+	}
+
+	for _, classRef := range opts.RequestedAuthnContextClassRefs {
+		if strings.TrimSpace(classRef) == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requested_authn_context_class_refs must not contain empty values"))
+		}
+	}
+
+	if opts.RequestedAuthnContextComparison != "" {
+		if _, ok := authnContextComparisons[opts.RequestedAuthnContextComparison]; !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("requested_authn_context_comparison must be one of exact, minimum, maximum, or better"))
+		}
+	}
+
+	options := AuthnRequestOptions{
+		ForceAuthn:                      opts.ForceAuthn,
+		IsPassive:                       opts.IsPassive,
+		NameIDPolicyFormat:              strings.TrimSpace(opts.NameIdPolicyFormat),
+		RequestedAuthnContextClassRefs:  opts.RequestedAuthnContextClassRefs,
+		RequestedAuthnContextComparison: opts.RequestedAuthnContextComparison,
+	}
+
+	data, err := json.Marshal(options)
+	if err != nil {
+		panic(err)
+	}
+
+	if string(data) == "{}" {
+		return nil, nil
+	}
+	return data, nil
+}
+
+// samlAuthnRequestOptions is the inverse of parseAuthnRequestOptions.
+func samlAuthnRequestOptions(data []byte) *ssoreadyv1.SAMLAuthnRequestOptions {
+	options, err := unmarshalAuthnRequestOptions(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return &ssoreadyv1.SAMLAuthnRequestOptions{
+		ForceAuthn:                      options.ForceAuthn,
+		IsPassive:                       options.IsPassive,
+		NameIdPolicyFormat:              options.NameIDPolicyFormat,
+		RequestedAuthnContextClassRefs:  options.RequestedAuthnContextClassRefs,
+		RequestedAuthnContextComparison: options.RequestedAuthnContextComparison,
+	}
+}
+
+func unmarshalAuthnRequestOptions(data []byte) (AuthnRequestOptions, error) {
+	var options AuthnRequestOptions
+	if len(data) == 0 {
+		return options, nil
+	}
+
+	if err := json.Unmarshal(data, &options); err != nil {
+		return AuthnRequestOptions{}, fmt.Errorf("unmarshal authn request options: %w", err)
+	}
+	return options, nil
+}
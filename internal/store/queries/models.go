@@ -236,14 +236,59 @@ type OrganizationDomain struct {
 }
 
 type SamlConnection struct {
-	ID                 uuid.UUID
-	OrganizationID     uuid.UUID
-	IdpRedirectUrl     *string
-	IdpX509Certificate []byte
-	IdpEntityID        *string
-	SpEntityID         string
-	IsPrimary          bool
-	SpAcsUrl           string
+	ID                             uuid.UUID
+	OrganizationID                 uuid.UUID
+	IdpRedirectUrl                 *string
+	IdpX509Certificate             []byte
+	IdpEntityID                    *string
+	SpEntityID                     string
+	IsPrimary                      bool
+	SpAcsUrl                       string
+	AllowedSignatureAlgorithms     []string
+	AllowedDigestAlgorithms        []string
+	SpEncryptionPrivateKey         []byte
+	SpEncryptionCertificate        []byte
+	SpPreviousEncryptionPrivateKey []byte
+	SpSigningPrivateKey            []byte
+	SpSigningCertificate           []byte
+	SignAuthnRequests              bool
+	IdpBinding                     *string
+	AllowIdpInitiated              bool
+	IdpSloUrl                      *string
+	IdpMetadataUrl                 *string
+	IdpMetadataRefreshTime         *time.Time
+	IdpMetadataRefreshError        *string
+	EmailAttributeNames            []string
+	AttributeMappingPreset         *string
+	AttributeMapping               []byte
+	AuthnRequestOptions            []byte
+	AllowedClockSkewSeconds        int32
+	IdpArtifactResolutionUrl       *string
+	IdpVendor                      *string
+}
+
+type SamlConnectionIdpCertificate struct {
+	ID               uuid.UUID
+	SamlConnectionID uuid.UUID
+	X509Certificate  []byte
+	NotBefore        *time.Time
+	NotAfter         *time.Time
+	CreateTime       time.Time
+	FromIdpMetadata  bool
+}
+
+type SamlConnectionIdpMetadataUpdate struct {
+	ID                         uuid.UUID
+	SamlConnectionID           uuid.UUID
+	CreateTime                 time.Time
+	PreviousIdpEntityID        *string
+	IdpEntityID                *string
+	PreviousIdpRedirectUrl     *string
+	IdpRedirectUrl             *string
+	PreviousIdpX509Certificate []byte
+	IdpX509Certificate         []byte
+	Pending                    bool
+	AcceptTime                 *time.Time
 }
 
 type SamlFlow struct {
@@ -280,6 +325,48 @@ type SamlFlow struct {
 	ErrorEnvironmentOauthRedirectUriNotConfigured bool
 	AssertionID                                   *string
 	TestModeIdp                                   *string
+	ResponseSigned                                bool
+	AssertionSigned                               bool
+	IdpX509Certificate                            []byte
+	IsIdpInitiated                                bool
+	ErrorIdpInitiatedNotAllowed                   bool
+	SubjectSessionIndex                           *string
+	SubjectID                                     *string
+	SubjectIDFormat                               *string
+	SubjectProfile                                []byte
+	AuthnRequestOptions                           []byte
+	ErrorExpiredAssertion                         bool
+	ErrorExpiredSubjectConfirmation               bool
+	ErrorBadDestination                           *string
+	ErrorBadRecipient                             *string
+	ErrorBadSubjectConfirmationMethod             *string
+	ErrorIdpStatusCode                            *string
+	ErrorIdpSubStatusCode                         *string
+	ErrorIdpStatusMessage                         *string
+	SubjectAuthnInstant                           *time.Time
+	SubjectSessionNotOnOrAfter                    *time.Time
+	SubjectAuthnContextClassRef                   *string
+	ErrorArtifactResolution                       *string
+	IdpVendor                                     *string
+	UnverifiedIdpStatusCode                       *string
+	UnverifiedIdpSubStatusCode                    *string
+	UnverifiedIdpStatusMessage                    *string
+	ErrorExpiredIdpInitiatedAssertion             bool
+}
+
+type SamlLogout struct {
+	ID               uuid.UUID
+	SamlConnectionID uuid.UUID
+	IsIdpInitiated   bool
+	SubjectID        string
+	SessionIndexes   []string
+	SamlFlowIds      []uuid.UUID
+	ReturnUrl        *string
+	CreateTime       time.Time
+	InitiateTime     *time.Time
+	CompleteTime     *time.Time
+	Success          *bool
+	SubjectIDFormat  *string
 }
 
 type SamlOauthClient struct {
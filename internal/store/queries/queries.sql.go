@@ -12,6 +12,38 @@ import (
 	"github.com/google/uuid"
 )
 
+const acceptSAMLConnectionIDPMetadataUpdate = `-- name: AcceptSAMLConnectionIDPMetadataUpdate :one
+update saml_connection_idp_metadata_updates
+set pending     = false,
+    accept_time = $1
+where id = $2
+returning id, saml_connection_id, create_time, previous_idp_entity_id, idp_entity_id, previous_idp_redirect_url, idp_redirect_url, previous_idp_x509_certificate, idp_x509_certificate, pending, accept_time
+`
+
+type AcceptSAMLConnectionIDPMetadataUpdateParams struct {
+	AcceptTime *time.Time
+	ID         uuid.UUID
+}
+
+func (q *Queries) AcceptSAMLConnectionIDPMetadataUpdate(ctx context.Context, arg AcceptSAMLConnectionIDPMetadataUpdateParams) (SamlConnectionIdpMetadataUpdate, error) {
+	row := q.db.QueryRow(ctx, acceptSAMLConnectionIDPMetadataUpdate, arg.AcceptTime, arg.ID)
+	var i SamlConnectionIdpMetadataUpdate
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.CreateTime,
+		&i.PreviousIdpEntityID,
+		&i.IdpEntityID,
+		&i.PreviousIdpRedirectUrl,
+		&i.IdpRedirectUrl,
+		&i.PreviousIdpX509Certificate,
+		&i.IdpX509Certificate,
+		&i.Pending,
+		&i.AcceptTime,
+	)
+	return i, err
+}
+
 const adminConvertAdminAccessTokenToSession = `-- name: AdminConvertAdminAccessTokenToSession :one
 update admin_access_tokens
 set one_time_token_sha256 = null,
@@ -92,7 +124,7 @@ func (q *Queries) AdminGetAdminAccessTokenByOneTimeToken(ctx context.Context, on
 }
 
 const adminGetSAMLConnection = `-- name: AdminGetSAMLConnection :one
-select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url
+select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
 from saml_connections
 where organization_id = $1
   and id = $2
@@ -115,6 +147,27 @@ func (q *Queries) AdminGetSAMLConnection(ctx context.Context, arg AdminGetSAMLCo
 		&i.SpEntityID,
 		&i.IsPrimary,
 		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
 	)
 	return i, err
 }
@@ -288,7 +341,7 @@ func (q *Queries) AppListSCIMRequests(ctx context.Context, arg AppListSCIMReques
 }
 
 const authCheckAssertionAlreadyProcessed = `-- name: AuthCheckAssertionAlreadyProcessed :one
-select exists(select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+select exists(select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
               from saml_flows
               where id = $1
                 and access_code_sha256 is not null)
@@ -470,20 +523,73 @@ func (q *Queries) AuthDeleteSCIMUserGroupMembership(ctx context.Context, arg Aut
 }
 
 const authGetInitData = `-- name: AuthGetInitData :one
-select idp_redirect_url, sp_entity_id
+select idp_redirect_url,
+       sp_entity_id,
+       sign_authn_requests,
+       sp_signing_private_key,
+       sp_signing_certificate,
+       idp_binding,
+       authn_request_options
 from saml_connections
 where saml_connections.id = $1
 `
 
 type AuthGetInitDataRow struct {
-	IdpRedirectUrl *string
-	SpEntityID     string
+	IdpRedirectUrl       *string
+	SpEntityID           string
+	SignAuthnRequests    bool
+	SpSigningPrivateKey  []byte
+	SpSigningCertificate []byte
+	IdpBinding           *string
+	AuthnRequestOptions  []byte
 }
 
 func (q *Queries) AuthGetInitData(ctx context.Context, id uuid.UUID) (AuthGetInitDataRow, error) {
 	row := q.db.QueryRow(ctx, authGetInitData, id)
 	var i AuthGetInitDataRow
-	err := row.Scan(&i.IdpRedirectUrl, &i.SpEntityID)
+	err := row.Scan(
+		&i.IdpRedirectUrl,
+		&i.SpEntityID,
+		&i.SignAuthnRequests,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.IdpBinding,
+		&i.AuthnRequestOptions,
+	)
+	return i, err
+}
+
+const authGetMetadataData = `-- name: AuthGetMetadataData :one
+select sp_entity_id,
+       sp_acs_url,
+       sign_authn_requests,
+       sp_signing_certificate,
+       sp_encryption_certificate,
+       idp_artifact_resolution_url
+from saml_connections
+where saml_connections.id = $1
+`
+
+type AuthGetMetadataDataRow struct {
+	SpEntityID               string
+	SpAcsUrl                 string
+	SignAuthnRequests        bool
+	SpSigningCertificate     []byte
+	SpEncryptionCertificate  []byte
+	IdpArtifactResolutionUrl *string
+}
+
+func (q *Queries) AuthGetMetadataData(ctx context.Context, id uuid.UUID) (AuthGetMetadataDataRow, error) {
+	row := q.db.QueryRow(ctx, authGetMetadataData, id)
+	var i AuthGetMetadataDataRow
+	err := row.Scan(
+		&i.SpEntityID,
+		&i.SpAcsUrl,
+		&i.SignAuthnRequests,
+		&i.SpSigningCertificate,
+		&i.SpEncryptionCertificate,
+		&i.IdpArtifactResolutionUrl,
+	)
 	return i, err
 }
 
@@ -518,7 +624,7 @@ func (q *Queries) AuthGetSAMLConnectionDomains(ctx context.Context, id uuid.UUID
 }
 
 const authGetSAMLFlow = `-- name: AuthGetSAMLFlow :one
-select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 from saml_flows
 where id = $1
 `
@@ -560,6 +666,65 @@ func (q *Queries) AuthGetSAMLFlow(ctx context.Context, id uuid.UUID) (SamlFlow,
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
+	)
+	return i, err
+}
+
+const authGetSAMLLogout = `-- name: AuthGetSAMLLogout :one
+select id, saml_connection_id, is_idp_initiated, subject_id, session_indexes, saml_flow_ids, return_url, create_time, initiate_time, complete_time, success, subject_id_format
+from saml_logouts
+where saml_connection_id = $1
+  and id = $2
+`
+
+type AuthGetSAMLLogoutParams struct {
+	SamlConnectionID uuid.UUID
+	ID               uuid.UUID
+}
+
+func (q *Queries) AuthGetSAMLLogout(ctx context.Context, arg AuthGetSAMLLogoutParams) (SamlLogout, error) {
+	row := q.db.QueryRow(ctx, authGetSAMLLogout, arg.SamlConnectionID, arg.ID)
+	var i SamlLogout
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.IsIdpInitiated,
+		&i.SubjectID,
+		&i.SessionIndexes,
+		&i.SamlFlowIds,
+		&i.ReturnUrl,
+		&i.CreateTime,
+		&i.InitiateTime,
+		&i.CompleteTime,
+		&i.Success,
+		&i.SubjectIDFormat,
 	)
 	return i, err
 }
@@ -804,6 +969,20 @@ const authGetValidateData = `-- name: AuthGetValidateData :one
 select saml_connections.sp_entity_id,
        saml_connections.idp_entity_id,
        saml_connections.idp_x509_certificate,
+       saml_connections.allowed_signature_algorithms,
+       saml_connections.allowed_digest_algorithms,
+       saml_connections.sp_encryption_private_key,
+       saml_connections.sp_previous_encryption_private_key,
+       saml_connections.allow_idp_initiated,
+       saml_connections.email_attribute_names,
+       saml_connections.attribute_mapping_preset,
+       saml_connections.attribute_mapping,
+       saml_connections.sp_acs_url,
+       saml_connections.allowed_clock_skew_seconds,
+       saml_connections.idp_artifact_resolution_url,
+       saml_connections.sign_authn_requests,
+       saml_connections.sp_signing_private_key,
+       saml_connections.sp_signing_certificate,
        environments.redirect_url,
        environments.oauth_redirect_uri,
        environments.admin_url
@@ -815,12 +994,26 @@ where saml_connections.id = $1
 `
 
 type AuthGetValidateDataRow struct {
-	SpEntityID         string
-	IdpEntityID        *string
-	IdpX509Certificate []byte
-	RedirectUrl        *string
-	OauthRedirectUri   *string
-	AdminUrl           *string
+	SpEntityID                     string
+	IdpEntityID                    *string
+	IdpX509Certificate             []byte
+	AllowedSignatureAlgorithms     []string
+	AllowedDigestAlgorithms        []string
+	SpEncryptionPrivateKey         []byte
+	SpPreviousEncryptionPrivateKey []byte
+	AllowIdpInitiated              bool
+	EmailAttributeNames            []string
+	AttributeMappingPreset         *string
+	AttributeMapping               []byte
+	SpAcsUrl                       string
+	AllowedClockSkewSeconds        int32
+	IdpArtifactResolutionUrl       *string
+	SignAuthnRequests              bool
+	SpSigningPrivateKey            []byte
+	SpSigningCertificate           []byte
+	RedirectUrl                    *string
+	OauthRedirectUri               *string
+	AdminUrl                       *string
 }
 
 func (q *Queries) AuthGetValidateData(ctx context.Context, id uuid.UUID) (AuthGetValidateDataRow, error) {
@@ -830,6 +1023,20 @@ func (q *Queries) AuthGetValidateData(ctx context.Context, id uuid.UUID) (AuthGe
 		&i.SpEntityID,
 		&i.IdpEntityID,
 		&i.IdpX509Certificate,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.AllowIdpInitiated,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.SpAcsUrl,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.SignAuthnRequests,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
 		&i.RedirectUrl,
 		&i.OauthRedirectUri,
 		&i.AdminUrl,
@@ -837,6 +1044,42 @@ func (q *Queries) AuthGetValidateData(ctx context.Context, id uuid.UUID) (AuthGe
 	return i, err
 }
 
+const authListSAMLLogoutFlowIDs = `-- name: AuthListSAMLLogoutFlowIDs :many
+select id
+from saml_flows
+where saml_connection_id = $1
+  and coalesce(subject_id, email) = $2::varchar
+  and status = 'succeeded'
+  and (cardinality($3::varchar[]) = 0 or
+       subject_session_index = any ($3::varchar[]))
+`
+
+type AuthListSAMLLogoutFlowIDsParams struct {
+	SamlConnectionID uuid.UUID
+	SubjectID        string
+	SessionIndexes   []string
+}
+
+func (q *Queries) AuthListSAMLLogoutFlowIDs(ctx context.Context, arg AuthListSAMLLogoutFlowIDsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, authListSAMLLogoutFlowIDs, arg.SamlConnectionID, arg.SubjectID, arg.SessionIndexes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const authListSCIMGroups = `-- name: AuthListSCIMGroups :many
 select id, scim_directory_id, display_name, deleted, attributes
 from scim_groups
@@ -1007,6 +1250,74 @@ func (q *Queries) AuthMarkSCIMUserDeleted(ctx context.Context, id uuid.UUID) (Sc
 	return i, err
 }
 
+const authUpdateSAMLLogoutComplete = `-- name: AuthUpdateSAMLLogoutComplete :one
+update saml_logouts
+set complete_time = $1,
+    success       = $2
+where id = $3
+  and complete_time is null
+returning id, saml_connection_id, is_idp_initiated, subject_id, session_indexes, saml_flow_ids, return_url, create_time, initiate_time, complete_time, success, subject_id_format
+`
+
+type AuthUpdateSAMLLogoutCompleteParams struct {
+	CompleteTime *time.Time
+	Success      *bool
+	ID           uuid.UUID
+}
+
+func (q *Queries) AuthUpdateSAMLLogoutComplete(ctx context.Context, arg AuthUpdateSAMLLogoutCompleteParams) (SamlLogout, error) {
+	row := q.db.QueryRow(ctx, authUpdateSAMLLogoutComplete, arg.CompleteTime, arg.Success, arg.ID)
+	var i SamlLogout
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.IsIdpInitiated,
+		&i.SubjectID,
+		&i.SessionIndexes,
+		&i.SamlFlowIds,
+		&i.ReturnUrl,
+		&i.CreateTime,
+		&i.InitiateTime,
+		&i.CompleteTime,
+		&i.Success,
+		&i.SubjectIDFormat,
+	)
+	return i, err
+}
+
+const authUpdateSAMLLogoutInitiate = `-- name: AuthUpdateSAMLLogoutInitiate :one
+update saml_logouts
+set initiate_time = $1
+where id = $2
+  and initiate_time is null
+returning id, saml_connection_id, is_idp_initiated, subject_id, session_indexes, saml_flow_ids, return_url, create_time, initiate_time, complete_time, success, subject_id_format
+`
+
+type AuthUpdateSAMLLogoutInitiateParams struct {
+	InitiateTime *time.Time
+	ID           uuid.UUID
+}
+
+func (q *Queries) AuthUpdateSAMLLogoutInitiate(ctx context.Context, arg AuthUpdateSAMLLogoutInitiateParams) (SamlLogout, error) {
+	row := q.db.QueryRow(ctx, authUpdateSAMLLogoutInitiate, arg.InitiateTime, arg.ID)
+	var i SamlLogout
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.IsIdpInitiated,
+		&i.SubjectID,
+		&i.SessionIndexes,
+		&i.SamlFlowIds,
+		&i.ReturnUrl,
+		&i.CreateTime,
+		&i.InitiateTime,
+		&i.CompleteTime,
+		&i.Success,
+		&i.SubjectIDFormat,
+	)
+	return i, err
+}
+
 const authUpdateSCIMGroup = `-- name: AuthUpdateSCIMGroup :one
 update scim_groups
 set display_name = $1,
@@ -1497,20 +1808,40 @@ const createSAMLConnection = `-- name: CreateSAMLConnection :one
 insert into saml_connections (id, organization_id, sp_entity_id, sp_acs_url,
                               idp_entity_id, idp_redirect_url,
                               idp_x509_certificate,
-                              is_primary)
-values ($1, $2, $3, $4, $5, $6, $7, $8)
-returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url
+                              is_primary, allowed_signature_algorithms,
+                              allowed_digest_algorithms, sign_authn_requests,
+                              idp_binding, allow_idp_initiated, idp_slo_url,
+                              idp_metadata_url, email_attribute_names,
+                              attribute_mapping_preset, attribute_mapping,
+                              authn_request_options, allowed_clock_skew_seconds,
+                              idp_artifact_resolution_url)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+        $18, $19, $20, $21)
+returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
 `
 
 type CreateSAMLConnectionParams struct {
-	ID                 uuid.UUID
-	OrganizationID     uuid.UUID
-	SpEntityID         string
-	SpAcsUrl           string
-	IdpEntityID        *string
-	IdpRedirectUrl     *string
-	IdpX509Certificate []byte
-	IsPrimary          bool
+	ID                         uuid.UUID
+	OrganizationID             uuid.UUID
+	SpEntityID                 string
+	SpAcsUrl                   string
+	IdpEntityID                *string
+	IdpRedirectUrl             *string
+	IdpX509Certificate         []byte
+	IsPrimary                  bool
+	AllowedSignatureAlgorithms []string
+	AllowedDigestAlgorithms    []string
+	SignAuthnRequests          bool
+	IdpBinding                 *string
+	AllowIdpInitiated          bool
+	IdpSloUrl                  *string
+	IdpMetadataUrl             *string
+	EmailAttributeNames        []string
+	AttributeMappingPreset     *string
+	AttributeMapping           []byte
+	AuthnRequestOptions        []byte
+	AllowedClockSkewSeconds    int32
+	IdpArtifactResolutionUrl   *string
 }
 
 func (q *Queries) CreateSAMLConnection(ctx context.Context, arg CreateSAMLConnectionParams) (SamlConnection, error) {
@@ -1523,6 +1854,19 @@ func (q *Queries) CreateSAMLConnection(ctx context.Context, arg CreateSAMLConnec
 		arg.IdpRedirectUrl,
 		arg.IdpX509Certificate,
 		arg.IsPrimary,
+		arg.AllowedSignatureAlgorithms,
+		arg.AllowedDigestAlgorithms,
+		arg.SignAuthnRequests,
+		arg.IdpBinding,
+		arg.AllowIdpInitiated,
+		arg.IdpSloUrl,
+		arg.IdpMetadataUrl,
+		arg.EmailAttributeNames,
+		arg.AttributeMappingPreset,
+		arg.AttributeMapping,
+		arg.AuthnRequestOptions,
+		arg.AllowedClockSkewSeconds,
+		arg.IdpArtifactResolutionUrl,
 	)
 	var i SamlConnection
 	err := row.Scan(
@@ -1534,6 +1878,125 @@ func (q *Queries) CreateSAMLConnection(ctx context.Context, arg CreateSAMLConnec
 		&i.SpEntityID,
 		&i.IsPrimary,
 		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const createSAMLConnectionIDPCertificate = `-- name: CreateSAMLConnectionIDPCertificate :one
+insert into saml_connection_idp_certificates (id, saml_connection_id,
+                                              x509_certificate, not_before,
+                                              not_after, create_time,
+                                              from_idp_metadata)
+values ($1, $2, $3, $4, $5, $6, $7)
+returning id, saml_connection_id, x509_certificate, not_before, not_after, create_time, from_idp_metadata
+`
+
+type CreateSAMLConnectionIDPCertificateParams struct {
+	ID               uuid.UUID
+	SamlConnectionID uuid.UUID
+	X509Certificate  []byte
+	NotBefore        *time.Time
+	NotAfter         *time.Time
+	CreateTime       time.Time
+	FromIdpMetadata  bool
+}
+
+func (q *Queries) CreateSAMLConnectionIDPCertificate(ctx context.Context, arg CreateSAMLConnectionIDPCertificateParams) (SamlConnectionIdpCertificate, error) {
+	row := q.db.QueryRow(ctx, createSAMLConnectionIDPCertificate,
+		arg.ID,
+		arg.SamlConnectionID,
+		arg.X509Certificate,
+		arg.NotBefore,
+		arg.NotAfter,
+		arg.CreateTime,
+		arg.FromIdpMetadata,
+	)
+	var i SamlConnectionIdpCertificate
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.X509Certificate,
+		&i.NotBefore,
+		&i.NotAfter,
+		&i.CreateTime,
+		&i.FromIdpMetadata,
+	)
+	return i, err
+}
+
+const createSAMLConnectionIDPMetadataUpdate = `-- name: CreateSAMLConnectionIDPMetadataUpdate :one
+insert into saml_connection_idp_metadata_updates (id, saml_connection_id,
+                                                  create_time,
+                                                  previous_idp_entity_id,
+                                                  idp_entity_id,
+                                                  previous_idp_redirect_url,
+                                                  idp_redirect_url,
+                                                  previous_idp_x509_certificate,
+                                                  idp_x509_certificate, pending)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+returning id, saml_connection_id, create_time, previous_idp_entity_id, idp_entity_id, previous_idp_redirect_url, idp_redirect_url, previous_idp_x509_certificate, idp_x509_certificate, pending, accept_time
+`
+
+type CreateSAMLConnectionIDPMetadataUpdateParams struct {
+	ID                         uuid.UUID
+	SamlConnectionID           uuid.UUID
+	CreateTime                 time.Time
+	PreviousIdpEntityID        *string
+	IdpEntityID                *string
+	PreviousIdpRedirectUrl     *string
+	IdpRedirectUrl             *string
+	PreviousIdpX509Certificate []byte
+	IdpX509Certificate         []byte
+	Pending                    bool
+}
+
+func (q *Queries) CreateSAMLConnectionIDPMetadataUpdate(ctx context.Context, arg CreateSAMLConnectionIDPMetadataUpdateParams) (SamlConnectionIdpMetadataUpdate, error) {
+	row := q.db.QueryRow(ctx, createSAMLConnectionIDPMetadataUpdate,
+		arg.ID,
+		arg.SamlConnectionID,
+		arg.CreateTime,
+		arg.PreviousIdpEntityID,
+		arg.IdpEntityID,
+		arg.PreviousIdpRedirectUrl,
+		arg.IdpRedirectUrl,
+		arg.PreviousIdpX509Certificate,
+		arg.IdpX509Certificate,
+		arg.Pending,
+	)
+	var i SamlConnectionIdpMetadataUpdate
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.CreateTime,
+		&i.PreviousIdpEntityID,
+		&i.IdpEntityID,
+		&i.PreviousIdpRedirectUrl,
+		&i.IdpRedirectUrl,
+		&i.PreviousIdpX509Certificate,
+		&i.IdpX509Certificate,
+		&i.Pending,
+		&i.AcceptTime,
 	)
 	return i, err
 }
@@ -1544,9 +2007,10 @@ insert into saml_flows (id, saml_connection_id, expire_time, state, create_time,
                         auth_redirect_url, get_redirect_time, status,
                         test_mode_idp,
                         error_saml_connection_not_configured,
-                        error_environment_oauth_redirect_uri_not_configured)
-values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
-returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+                        error_environment_oauth_redirect_uri_not_configured,
+                        authn_request_options)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 `
 
 type CreateSAMLFlowGetRedirectParams struct {
@@ -1562,6 +2026,7 @@ type CreateSAMLFlowGetRedirectParams struct {
 	TestModeIdp                                   *string
 	ErrorSamlConnectionNotConfigured              bool
 	ErrorEnvironmentOauthRedirectUriNotConfigured bool
+	AuthnRequestOptions                           []byte
 }
 
 func (q *Queries) CreateSAMLFlowGetRedirect(ctx context.Context, arg CreateSAMLFlowGetRedirectParams) (SamlFlow, error) {
@@ -1578,6 +2043,7 @@ func (q *Queries) CreateSAMLFlowGetRedirect(ctx context.Context, arg CreateSAMLF
 		arg.TestModeIdp,
 		arg.ErrorSamlConnectionNotConfigured,
 		arg.ErrorEnvironmentOauthRedirectUriNotConfigured,
+		arg.AuthnRequestOptions,
 	)
 	var i SamlFlow
 	err := row.Scan(
@@ -1614,6 +2080,88 @@ func (q *Queries) CreateSAMLFlowGetRedirect(ctx context.Context, arg CreateSAMLF
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
+	)
+	return i, err
+}
+
+const createSAMLLogout = `-- name: CreateSAMLLogout :one
+insert into saml_logouts (id, saml_connection_id, is_idp_initiated, subject_id,
+                          session_indexes, saml_flow_ids, return_url,
+                          create_time, complete_time, success,
+                          subject_id_format)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+returning id, saml_connection_id, is_idp_initiated, subject_id, session_indexes, saml_flow_ids, return_url, create_time, initiate_time, complete_time, success, subject_id_format
+`
+
+type CreateSAMLLogoutParams struct {
+	ID               uuid.UUID
+	SamlConnectionID uuid.UUID
+	IsIdpInitiated   bool
+	SubjectID        string
+	SessionIndexes   []string
+	SamlFlowIds      []uuid.UUID
+	ReturnUrl        *string
+	CreateTime       time.Time
+	CompleteTime     *time.Time
+	Success          *bool
+	SubjectIDFormat  *string
+}
+
+func (q *Queries) CreateSAMLLogout(ctx context.Context, arg CreateSAMLLogoutParams) (SamlLogout, error) {
+	row := q.db.QueryRow(ctx, createSAMLLogout,
+		arg.ID,
+		arg.SamlConnectionID,
+		arg.IsIdpInitiated,
+		arg.SubjectID,
+		arg.SessionIndexes,
+		arg.SamlFlowIds,
+		arg.ReturnUrl,
+		arg.CreateTime,
+		arg.CompleteTime,
+		arg.Success,
+		arg.SubjectIDFormat,
+	)
+	var i SamlLogout
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.IsIdpInitiated,
+		&i.SubjectID,
+		&i.SessionIndexes,
+		&i.SamlFlowIds,
+		&i.ReturnUrl,
+		&i.CreateTime,
+		&i.InitiateTime,
+		&i.CompleteTime,
+		&i.Success,
+		&i.SubjectIDFormat,
 	)
 	return i, err
 }
@@ -1735,6 +2283,45 @@ func (q *Queries) DeleteSAMLConnection(ctx context.Context, id uuid.UUID) (int64
 	return result.RowsAffected(), nil
 }
 
+const deleteSAMLConnectionIDPCertificate = `-- name: DeleteSAMLConnectionIDPCertificate :exec
+delete
+from saml_connection_idp_certificates
+where id = $1
+`
+
+func (q *Queries) DeleteSAMLConnectionIDPCertificate(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSAMLConnectionIDPCertificate, id)
+	return err
+}
+
+const deleteSAMLConnectionIDPCertificatesBySAMLConnectionID = `-- name: DeleteSAMLConnectionIDPCertificatesBySAMLConnectionID :execrows
+delete
+from saml_connection_idp_certificates
+where saml_connection_id = $1
+`
+
+func (q *Queries) DeleteSAMLConnectionIDPCertificatesBySAMLConnectionID(ctx context.Context, samlConnectionID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSAMLConnectionIDPCertificatesBySAMLConnectionID, samlConnectionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteSAMLConnectionIDPMetadataUpdatesBySAMLConnectionID = `-- name: DeleteSAMLConnectionIDPMetadataUpdatesBySAMLConnectionID :execrows
+delete
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+`
+
+func (q *Queries) DeleteSAMLConnectionIDPMetadataUpdatesBySAMLConnectionID(ctx context.Context, samlConnectionID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSAMLConnectionIDPMetadataUpdatesBySAMLConnectionID, samlConnectionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteSAMLFlowsBySAMLConnectionID = `-- name: DeleteSAMLFlowsBySAMLConnectionID :execrows
 delete
 from saml_flows
@@ -1749,6 +2336,20 @@ func (q *Queries) DeleteSAMLFlowsBySAMLConnectionID(ctx context.Context, samlCon
 	return result.RowsAffected(), nil
 }
 
+const deleteSAMLLogoutsBySAMLConnectionID = `-- name: DeleteSAMLLogoutsBySAMLConnectionID :execrows
+delete
+from saml_logouts
+where saml_connection_id = $1
+`
+
+func (q *Queries) DeleteSAMLLogoutsBySAMLConnectionID(ctx context.Context, samlConnectionID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteSAMLLogoutsBySAMLConnectionID, samlConnectionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
 const deleteSAMLOAuthClient = `-- name: DeleteSAMLOAuthClient :exec
 delete
 from saml_oauth_clients
@@ -2110,6 +2711,33 @@ func (q *Queries) GetEnvironmentByID(ctx context.Context, id uuid.UUID) (Environ
 	return i, err
 }
 
+const getLatestSAMLConnectionIDPMetadataUpdate = `-- name: GetLatestSAMLConnectionIDPMetadataUpdate :one
+select id, saml_connection_id, create_time, previous_idp_entity_id, idp_entity_id, previous_idp_redirect_url, idp_redirect_url, previous_idp_x509_certificate, idp_x509_certificate, pending, accept_time
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+order by (create_time, id) desc
+limit 1
+`
+
+func (q *Queries) GetLatestSAMLConnectionIDPMetadataUpdate(ctx context.Context, samlConnectionID uuid.UUID) (SamlConnectionIdpMetadataUpdate, error) {
+	row := q.db.QueryRow(ctx, getLatestSAMLConnectionIDPMetadataUpdate, samlConnectionID)
+	var i SamlConnectionIdpMetadataUpdate
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.CreateTime,
+		&i.PreviousIdpEntityID,
+		&i.IdpEntityID,
+		&i.PreviousIdpRedirectUrl,
+		&i.IdpRedirectUrl,
+		&i.PreviousIdpX509Certificate,
+		&i.IdpX509Certificate,
+		&i.Pending,
+		&i.AcceptTime,
+	)
+	return i, err
+}
+
 const getOnboardingState = `-- name: GetOnboardingState :one
 select app_organization_id, dummyidp_app_id, onboarding_environment_id, onboarding_organization_id, onboarding_saml_connection_id
 from onboarding_states
@@ -2263,7 +2891,14 @@ func (q *Queries) GetPrimarySCIMDirectoryIDByOrganizationID(ctx context.Context,
 const getSAMLAccessCodeData = `-- name: GetSAMLAccessCodeData :one
 select saml_flows.id             as saml_flow_id,
        saml_flows.email,
+       saml_flows.subject_id,
+       saml_flows.subject_id_format,
        saml_flows.subject_idp_attributes,
+       saml_flows.subject_profile,
+       saml_flows.subject_session_index,
+       saml_flows.subject_authn_instant,
+       saml_flows.subject_session_not_on_or_after,
+       saml_flows.subject_authn_context_class_ref,
        saml_flows.state,
        organizations.id          as organization_id,
        organizations.external_id as organization_external_id,
@@ -2286,13 +2921,20 @@ type GetSAMLAccessCodeDataParams struct {
 }
 
 type GetSAMLAccessCodeDataRow struct {
-	SamlFlowID             uuid.UUID
-	Email                  *string
-	SubjectIdpAttributes   []byte
-	State                  string
-	OrganizationID         uuid.UUID
-	OrganizationExternalID *string
-	EnvironmentID          uuid.UUID
+	SamlFlowID                  uuid.UUID
+	Email                       *string
+	SubjectID                   *string
+	SubjectIDFormat             *string
+	SubjectIdpAttributes        []byte
+	SubjectProfile              []byte
+	SubjectSessionIndex         *string
+	SubjectAuthnInstant         *time.Time
+	SubjectSessionNotOnOrAfter  *time.Time
+	SubjectAuthnContextClassRef *string
+	State                       string
+	OrganizationID              uuid.UUID
+	OrganizationExternalID      *string
+	EnvironmentID               uuid.UUID
 }
 
 func (q *Queries) GetSAMLAccessCodeData(ctx context.Context, arg GetSAMLAccessCodeDataParams) (GetSAMLAccessCodeDataRow, error) {
@@ -2301,7 +2943,14 @@ func (q *Queries) GetSAMLAccessCodeData(ctx context.Context, arg GetSAMLAccessCo
 	err := row.Scan(
 		&i.SamlFlowID,
 		&i.Email,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
 		&i.SubjectIdpAttributes,
+		&i.SubjectProfile,
+		&i.SubjectSessionIndex,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
 		&i.State,
 		&i.OrganizationID,
 		&i.OrganizationExternalID,
@@ -2311,7 +2960,7 @@ func (q *Queries) GetSAMLAccessCodeData(ctx context.Context, arg GetSAMLAccessCo
 }
 
 const getSAMLConnection = `-- name: GetSAMLConnection :one
-select saml_connections.id, saml_connections.organization_id, saml_connections.idp_redirect_url, saml_connections.idp_x509_certificate, saml_connections.idp_entity_id, saml_connections.sp_entity_id, saml_connections.is_primary, saml_connections.sp_acs_url
+select saml_connections.id, saml_connections.organization_id, saml_connections.idp_redirect_url, saml_connections.idp_x509_certificate, saml_connections.idp_entity_id, saml_connections.sp_entity_id, saml_connections.is_primary, saml_connections.sp_acs_url, saml_connections.allowed_signature_algorithms, saml_connections.allowed_digest_algorithms, saml_connections.sp_encryption_private_key, saml_connections.sp_encryption_certificate, saml_connections.sp_previous_encryption_private_key, saml_connections.sp_signing_private_key, saml_connections.sp_signing_certificate, saml_connections.sign_authn_requests, saml_connections.idp_binding, saml_connections.allow_idp_initiated, saml_connections.idp_slo_url, saml_connections.idp_metadata_url, saml_connections.idp_metadata_refresh_time, saml_connections.idp_metadata_refresh_error, saml_connections.email_attribute_names, saml_connections.attribute_mapping_preset, saml_connections.attribute_mapping, saml_connections.authn_request_options, saml_connections.allowed_clock_skew_seconds, saml_connections.idp_artifact_resolution_url, saml_connections.idp_vendor
 from saml_connections
          join organizations
               on saml_connections.organization_id = organizations.id
@@ -2337,12 +2986,33 @@ func (q *Queries) GetSAMLConnection(ctx context.Context, arg GetSAMLConnectionPa
 		&i.SpEntityID,
 		&i.IsPrimary,
 		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
 	)
 	return i, err
 }
 
 const getSAMLConnectionByID = `-- name: GetSAMLConnectionByID :one
-select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url
+select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
 from saml_connections
 where id = $1
 `
@@ -2359,29 +3029,162 @@ func (q *Queries) GetSAMLConnectionByID(ctx context.Context, id uuid.UUID) (Saml
 		&i.SpEntityID,
 		&i.IsPrimary,
 		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
 	)
 	return i, err
 }
 
-const getSAMLFlow = `-- name: GetSAMLFlow :one
-select saml_flows.id, saml_flows.saml_connection_id, saml_flows.access_code, saml_flows.state, saml_flows.create_time, saml_flows.expire_time, saml_flows.email, saml_flows.subject_idp_attributes, saml_flows.update_time, saml_flows.auth_redirect_url, saml_flows.get_redirect_time, saml_flows.initiate_request, saml_flows.initiate_time, saml_flows.assertion, saml_flows.app_redirect_url, saml_flows.receive_assertion_time, saml_flows.redeem_time, saml_flows.redeem_response, saml_flows.error_bad_issuer, saml_flows.error_bad_audience, saml_flows.error_bad_subject_id, saml_flows.error_email_outside_organization_domains, saml_flows.status, saml_flows.error_unsigned_assertion, saml_flows.access_code_sha256, saml_flows.is_oauth, saml_flows.error_bad_signature_algorithm, saml_flows.error_bad_digest_algorithm, saml_flows.error_bad_x509_certificate, saml_flows.error_saml_connection_not_configured, saml_flows.error_environment_oauth_redirect_uri_not_configured, saml_flows.assertion_id, saml_flows.test_mode_idp
-from saml_flows
-         join saml_connections
-              on saml_flows.saml_connection_id = saml_connections.id
-         join organizations
-              on saml_connections.organization_id = organizations.id
-         join environments on organizations.environment_id = environments.id
-where environments.app_organization_id = $1
-  and saml_flows.id = $2
+const getSAMLConnectionForIDPMetadataRefresh = `-- name: GetSAMLConnectionForIDPMetadataRefresh :one
+select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
+from saml_connections
+where id = $1
+    for update
 `
 
-type GetSAMLFlowParams struct {
-	AppOrganizationID uuid.UUID
-	ID                uuid.UUID
-}
-
-func (q *Queries) GetSAMLFlow(ctx context.Context, arg GetSAMLFlowParams) (SamlFlow, error) {
-	row := q.db.QueryRow(ctx, getSAMLFlow, arg.AppOrganizationID, arg.ID)
+func (q *Queries) GetSAMLConnectionForIDPMetadataRefresh(ctx context.Context, id uuid.UUID) (SamlConnection, error) {
+	row := q.db.QueryRow(ctx, getSAMLConnectionForIDPMetadataRefresh, id)
+	var i SamlConnection
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.IdpRedirectUrl,
+		&i.IdpX509Certificate,
+		&i.IdpEntityID,
+		&i.SpEntityID,
+		&i.IsPrimary,
+		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const getSAMLConnectionIDPCertificate = `-- name: GetSAMLConnectionIDPCertificate :one
+select saml_connection_idp_certificates.id, saml_connection_idp_certificates.saml_connection_id, saml_connection_idp_certificates.x509_certificate, saml_connection_idp_certificates.not_before, saml_connection_idp_certificates.not_after, saml_connection_idp_certificates.create_time, saml_connection_idp_certificates.from_idp_metadata
+from saml_connection_idp_certificates
+         join saml_connections
+              on saml_connection_idp_certificates.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+         join environments on organizations.environment_id = environments.id
+where environments.app_organization_id = $1
+  and saml_connection_idp_certificates.id = $2
+`
+
+type GetSAMLConnectionIDPCertificateParams struct {
+	AppOrganizationID uuid.UUID
+	ID                uuid.UUID
+}
+
+func (q *Queries) GetSAMLConnectionIDPCertificate(ctx context.Context, arg GetSAMLConnectionIDPCertificateParams) (SamlConnectionIdpCertificate, error) {
+	row := q.db.QueryRow(ctx, getSAMLConnectionIDPCertificate, arg.AppOrganizationID, arg.ID)
+	var i SamlConnectionIdpCertificate
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.X509Certificate,
+		&i.NotBefore,
+		&i.NotAfter,
+		&i.CreateTime,
+		&i.FromIdpMetadata,
+	)
+	return i, err
+}
+
+const getSAMLConnectionIDPMetadataUpdate = `-- name: GetSAMLConnectionIDPMetadataUpdate :one
+select saml_connection_idp_metadata_updates.id, saml_connection_idp_metadata_updates.saml_connection_id, saml_connection_idp_metadata_updates.create_time, saml_connection_idp_metadata_updates.previous_idp_entity_id, saml_connection_idp_metadata_updates.idp_entity_id, saml_connection_idp_metadata_updates.previous_idp_redirect_url, saml_connection_idp_metadata_updates.idp_redirect_url, saml_connection_idp_metadata_updates.previous_idp_x509_certificate, saml_connection_idp_metadata_updates.idp_x509_certificate, saml_connection_idp_metadata_updates.pending, saml_connection_idp_metadata_updates.accept_time
+from saml_connection_idp_metadata_updates
+         join saml_connections
+              on saml_connection_idp_metadata_updates.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+         join environments on organizations.environment_id = environments.id
+where environments.app_organization_id = $1
+  and saml_connection_idp_metadata_updates.id = $2
+`
+
+type GetSAMLConnectionIDPMetadataUpdateParams struct {
+	AppOrganizationID uuid.UUID
+	ID                uuid.UUID
+}
+
+func (q *Queries) GetSAMLConnectionIDPMetadataUpdate(ctx context.Context, arg GetSAMLConnectionIDPMetadataUpdateParams) (SamlConnectionIdpMetadataUpdate, error) {
+	row := q.db.QueryRow(ctx, getSAMLConnectionIDPMetadataUpdate, arg.AppOrganizationID, arg.ID)
+	var i SamlConnectionIdpMetadataUpdate
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.CreateTime,
+		&i.PreviousIdpEntityID,
+		&i.IdpEntityID,
+		&i.PreviousIdpRedirectUrl,
+		&i.IdpRedirectUrl,
+		&i.PreviousIdpX509Certificate,
+		&i.IdpX509Certificate,
+		&i.Pending,
+		&i.AcceptTime,
+	)
+	return i, err
+}
+
+const getSAMLFlow = `-- name: GetSAMLFlow :one
+select saml_flows.id, saml_flows.saml_connection_id, saml_flows.access_code, saml_flows.state, saml_flows.create_time, saml_flows.expire_time, saml_flows.email, saml_flows.subject_idp_attributes, saml_flows.update_time, saml_flows.auth_redirect_url, saml_flows.get_redirect_time, saml_flows.initiate_request, saml_flows.initiate_time, saml_flows.assertion, saml_flows.app_redirect_url, saml_flows.receive_assertion_time, saml_flows.redeem_time, saml_flows.redeem_response, saml_flows.error_bad_issuer, saml_flows.error_bad_audience, saml_flows.error_bad_subject_id, saml_flows.error_email_outside_organization_domains, saml_flows.status, saml_flows.error_unsigned_assertion, saml_flows.access_code_sha256, saml_flows.is_oauth, saml_flows.error_bad_signature_algorithm, saml_flows.error_bad_digest_algorithm, saml_flows.error_bad_x509_certificate, saml_flows.error_saml_connection_not_configured, saml_flows.error_environment_oauth_redirect_uri_not_configured, saml_flows.assertion_id, saml_flows.test_mode_idp, saml_flows.response_signed, saml_flows.assertion_signed, saml_flows.idp_x509_certificate, saml_flows.is_idp_initiated, saml_flows.error_idp_initiated_not_allowed, saml_flows.subject_session_index, saml_flows.subject_id, saml_flows.subject_id_format, saml_flows.subject_profile, saml_flows.authn_request_options, saml_flows.error_expired_assertion, saml_flows.error_expired_subject_confirmation, saml_flows.error_bad_destination, saml_flows.error_bad_recipient, saml_flows.error_bad_subject_confirmation_method, saml_flows.error_idp_status_code, saml_flows.error_idp_sub_status_code, saml_flows.error_idp_status_message, saml_flows.subject_authn_instant, saml_flows.subject_session_not_on_or_after, saml_flows.subject_authn_context_class_ref, saml_flows.error_artifact_resolution, saml_flows.idp_vendor, saml_flows.unverified_idp_status_code, saml_flows.unverified_idp_sub_status_code, saml_flows.unverified_idp_status_message, saml_flows.error_expired_idp_initiated_assertion
+from saml_flows
+         join saml_connections
+              on saml_flows.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+         join environments on organizations.environment_id = environments.id
+where environments.app_organization_id = $1
+  and saml_flows.id = $2
+`
+
+type GetSAMLFlowParams struct {
+	AppOrganizationID uuid.UUID
+	ID                uuid.UUID
+}
+
+func (q *Queries) GetSAMLFlow(ctx context.Context, arg GetSAMLFlowParams) (SamlFlow, error) {
+	row := q.db.QueryRow(ctx, getSAMLFlow, arg.AppOrganizationID, arg.ID)
 	var i SamlFlow
 	err := row.Scan(
 		&i.ID,
@@ -2417,12 +3220,39 @@ func (q *Queries) GetSAMLFlow(ctx context.Context, arg GetSAMLFlowParams) (SamlF
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
 
 const getSAMLFlowByID = `-- name: GetSAMLFlowByID :one
-select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 from saml_flows
 where id = $1
 `
@@ -2464,6 +3294,33 @@ func (q *Queries) GetSAMLFlowByID(ctx context.Context, id uuid.UUID) (SamlFlow,
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
@@ -2893,8 +3750,179 @@ func (q *Queries) ListOrganizations(ctx context.Context, arg ListOrganizationsPa
 	return items, nil
 }
 
+const listSAMLConnectionIDPCertificates = `-- name: ListSAMLConnectionIDPCertificates :many
+select id, saml_connection_id, x509_certificate, not_before, not_after, create_time, from_idp_metadata
+from saml_connection_idp_certificates
+where saml_connection_id = $1
+order by create_time
+`
+
+func (q *Queries) ListSAMLConnectionIDPCertificates(ctx context.Context, samlConnectionID uuid.UUID) ([]SamlConnectionIdpCertificate, error) {
+	rows, err := q.db.Query(ctx, listSAMLConnectionIDPCertificates, samlConnectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlConnectionIdpCertificate
+	for rows.Next() {
+		var i SamlConnectionIdpCertificate
+		if err := rows.Scan(
+			&i.ID,
+			&i.SamlConnectionID,
+			&i.X509Certificate,
+			&i.NotBefore,
+			&i.NotAfter,
+			&i.CreateTime,
+			&i.FromIdpMetadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLConnectionIDPCertificatesByEnvironmentID = `-- name: ListSAMLConnectionIDPCertificatesByEnvironmentID :many
+select saml_connection_idp_certificates.id, saml_connection_idp_certificates.saml_connection_id, saml_connection_idp_certificates.x509_certificate, saml_connection_idp_certificates.not_before, saml_connection_idp_certificates.not_after, saml_connection_idp_certificates.create_time, saml_connection_idp_certificates.from_idp_metadata
+from saml_connection_idp_certificates
+         join saml_connections
+              on saml_connection_idp_certificates.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+where organizations.environment_id = $1
+`
+
+func (q *Queries) ListSAMLConnectionIDPCertificatesByEnvironmentID(ctx context.Context, environmentID uuid.UUID) ([]SamlConnectionIdpCertificate, error) {
+	rows, err := q.db.Query(ctx, listSAMLConnectionIDPCertificatesByEnvironmentID, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlConnectionIdpCertificate
+	for rows.Next() {
+		var i SamlConnectionIdpCertificate
+		if err := rows.Scan(
+			&i.ID,
+			&i.SamlConnectionID,
+			&i.X509Certificate,
+			&i.NotBefore,
+			&i.NotAfter,
+			&i.CreateTime,
+			&i.FromIdpMetadata,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLConnectionIDPMetadataUpdatesFirstPage = `-- name: ListSAMLConnectionIDPMetadataUpdatesFirstPage :many
+select id, saml_connection_id, create_time, previous_idp_entity_id, idp_entity_id, previous_idp_redirect_url, idp_redirect_url, previous_idp_x509_certificate, idp_x509_certificate, pending, accept_time
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+order by (create_time, id) desc
+limit $2
+`
+
+type ListSAMLConnectionIDPMetadataUpdatesFirstPageParams struct {
+	SamlConnectionID uuid.UUID
+	Limit            int32
+}
+
+func (q *Queries) ListSAMLConnectionIDPMetadataUpdatesFirstPage(ctx context.Context, arg ListSAMLConnectionIDPMetadataUpdatesFirstPageParams) ([]SamlConnectionIdpMetadataUpdate, error) {
+	rows, err := q.db.Query(ctx, listSAMLConnectionIDPMetadataUpdatesFirstPage, arg.SamlConnectionID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlConnectionIdpMetadataUpdate
+	for rows.Next() {
+		var i SamlConnectionIdpMetadataUpdate
+		if err := rows.Scan(
+			&i.ID,
+			&i.SamlConnectionID,
+			&i.CreateTime,
+			&i.PreviousIdpEntityID,
+			&i.IdpEntityID,
+			&i.PreviousIdpRedirectUrl,
+			&i.IdpRedirectUrl,
+			&i.PreviousIdpX509Certificate,
+			&i.IdpX509Certificate,
+			&i.Pending,
+			&i.AcceptTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLConnectionIDPMetadataUpdatesNextPage = `-- name: ListSAMLConnectionIDPMetadataUpdatesNextPage :many
+select id, saml_connection_id, create_time, previous_idp_entity_id, idp_entity_id, previous_idp_redirect_url, idp_redirect_url, previous_idp_x509_certificate, idp_x509_certificate, pending, accept_time
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+  and (create_time, id) <= ($3, $4::uuid)
+order by (create_time, id) desc
+limit $2
+`
+
+type ListSAMLConnectionIDPMetadataUpdatesNextPageParams struct {
+	SamlConnectionID uuid.UUID
+	Limit            int32
+	CreateTime       time.Time
+	ID               uuid.UUID
+}
+
+func (q *Queries) ListSAMLConnectionIDPMetadataUpdatesNextPage(ctx context.Context, arg ListSAMLConnectionIDPMetadataUpdatesNextPageParams) ([]SamlConnectionIdpMetadataUpdate, error) {
+	rows, err := q.db.Query(ctx, listSAMLConnectionIDPMetadataUpdatesNextPage,
+		arg.SamlConnectionID,
+		arg.Limit,
+		arg.CreateTime,
+		arg.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlConnectionIdpMetadataUpdate
+	for rows.Next() {
+		var i SamlConnectionIdpMetadataUpdate
+		if err := rows.Scan(
+			&i.ID,
+			&i.SamlConnectionID,
+			&i.CreateTime,
+			&i.PreviousIdpEntityID,
+			&i.IdpEntityID,
+			&i.PreviousIdpRedirectUrl,
+			&i.IdpRedirectUrl,
+			&i.PreviousIdpX509Certificate,
+			&i.IdpX509Certificate,
+			&i.Pending,
+			&i.AcceptTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listSAMLConnections = `-- name: ListSAMLConnections :many
-select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url
+select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
 from saml_connections
 where organization_id = $1
   and id >= $2
@@ -2926,6 +3954,151 @@ func (q *Queries) ListSAMLConnections(ctx context.Context, arg ListSAMLConnectio
 			&i.SpEntityID,
 			&i.IsPrimary,
 			&i.SpAcsUrl,
+			&i.AllowedSignatureAlgorithms,
+			&i.AllowedDigestAlgorithms,
+			&i.SpEncryptionPrivateKey,
+			&i.SpEncryptionCertificate,
+			&i.SpPreviousEncryptionPrivateKey,
+			&i.SpSigningPrivateKey,
+			&i.SpSigningCertificate,
+			&i.SignAuthnRequests,
+			&i.IdpBinding,
+			&i.AllowIdpInitiated,
+			&i.IdpSloUrl,
+			&i.IdpMetadataUrl,
+			&i.IdpMetadataRefreshTime,
+			&i.IdpMetadataRefreshError,
+			&i.EmailAttributeNames,
+			&i.AttributeMappingPreset,
+			&i.AttributeMapping,
+			&i.AuthnRequestOptions,
+			&i.AllowedClockSkewSeconds,
+			&i.IdpArtifactResolutionUrl,
+			&i.IdpVendor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLConnectionsDueForIDPMetadataRefresh = `-- name: ListSAMLConnectionsDueForIDPMetadataRefresh :many
+select id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
+from saml_connections
+where idp_metadata_url is not null
+  and idp_metadata_url != ''
+  and (idp_metadata_refresh_time is null or idp_metadata_refresh_time < $1)
+order by idp_metadata_refresh_time nulls first
+limit $2
+`
+
+type ListSAMLConnectionsDueForIDPMetadataRefreshParams struct {
+	IdpMetadataRefreshTime *time.Time
+	Limit                  int32
+}
+
+func (q *Queries) ListSAMLConnectionsDueForIDPMetadataRefresh(ctx context.Context, arg ListSAMLConnectionsDueForIDPMetadataRefreshParams) ([]SamlConnection, error) {
+	rows, err := q.db.Query(ctx, listSAMLConnectionsDueForIDPMetadataRefresh, arg.IdpMetadataRefreshTime, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlConnection
+	for rows.Next() {
+		var i SamlConnection
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.IdpRedirectUrl,
+			&i.IdpX509Certificate,
+			&i.IdpEntityID,
+			&i.SpEntityID,
+			&i.IsPrimary,
+			&i.SpAcsUrl,
+			&i.AllowedSignatureAlgorithms,
+			&i.AllowedDigestAlgorithms,
+			&i.SpEncryptionPrivateKey,
+			&i.SpEncryptionCertificate,
+			&i.SpPreviousEncryptionPrivateKey,
+			&i.SpSigningPrivateKey,
+			&i.SpSigningCertificate,
+			&i.SignAuthnRequests,
+			&i.IdpBinding,
+			&i.AllowIdpInitiated,
+			&i.IdpSloUrl,
+			&i.IdpMetadataUrl,
+			&i.IdpMetadataRefreshTime,
+			&i.IdpMetadataRefreshError,
+			&i.EmailAttributeNames,
+			&i.AttributeMappingPreset,
+			&i.AttributeMapping,
+			&i.AuthnRequestOptions,
+			&i.AllowedClockSkewSeconds,
+			&i.IdpArtifactResolutionUrl,
+			&i.IdpVendor,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLConnectionsWithIDPCertificateByEnvironmentID = `-- name: ListSAMLConnectionsWithIDPCertificateByEnvironmentID :many
+select saml_connections.id, saml_connections.organization_id, saml_connections.idp_redirect_url, saml_connections.idp_x509_certificate, saml_connections.idp_entity_id, saml_connections.sp_entity_id, saml_connections.is_primary, saml_connections.sp_acs_url, saml_connections.allowed_signature_algorithms, saml_connections.allowed_digest_algorithms, saml_connections.sp_encryption_private_key, saml_connections.sp_encryption_certificate, saml_connections.sp_previous_encryption_private_key, saml_connections.sp_signing_private_key, saml_connections.sp_signing_certificate, saml_connections.sign_authn_requests, saml_connections.idp_binding, saml_connections.allow_idp_initiated, saml_connections.idp_slo_url, saml_connections.idp_metadata_url, saml_connections.idp_metadata_refresh_time, saml_connections.idp_metadata_refresh_error, saml_connections.email_attribute_names, saml_connections.attribute_mapping_preset, saml_connections.attribute_mapping, saml_connections.authn_request_options, saml_connections.allowed_clock_skew_seconds, saml_connections.idp_artifact_resolution_url, saml_connections.idp_vendor
+from saml_connections
+         join organizations
+              on saml_connections.organization_id = organizations.id
+where organizations.environment_id = $1
+  and saml_connections.idp_x509_certificate is not null
+`
+
+func (q *Queries) ListSAMLConnectionsWithIDPCertificateByEnvironmentID(ctx context.Context, environmentID uuid.UUID) ([]SamlConnection, error) {
+	rows, err := q.db.Query(ctx, listSAMLConnectionsWithIDPCertificateByEnvironmentID, environmentID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlConnection
+	for rows.Next() {
+		var i SamlConnection
+		if err := rows.Scan(
+			&i.ID,
+			&i.OrganizationID,
+			&i.IdpRedirectUrl,
+			&i.IdpX509Certificate,
+			&i.IdpEntityID,
+			&i.SpEntityID,
+			&i.IsPrimary,
+			&i.SpAcsUrl,
+			&i.AllowedSignatureAlgorithms,
+			&i.AllowedDigestAlgorithms,
+			&i.SpEncryptionPrivateKey,
+			&i.SpEncryptionCertificate,
+			&i.SpPreviousEncryptionPrivateKey,
+			&i.SpSigningPrivateKey,
+			&i.SpSigningCertificate,
+			&i.SignAuthnRequests,
+			&i.IdpBinding,
+			&i.AllowIdpInitiated,
+			&i.IdpSloUrl,
+			&i.IdpMetadataUrl,
+			&i.IdpMetadataRefreshTime,
+			&i.IdpMetadataRefreshError,
+			&i.EmailAttributeNames,
+			&i.AttributeMappingPreset,
+			&i.AttributeMapping,
+			&i.AuthnRequestOptions,
+			&i.AllowedClockSkewSeconds,
+			&i.IdpArtifactResolutionUrl,
+			&i.IdpVendor,
 		); err != nil {
 			return nil, err
 		}
@@ -2938,7 +4111,7 @@ func (q *Queries) ListSAMLConnections(ctx context.Context, arg ListSAMLConnectio
 }
 
 const listSAMLFlowsFirstPage = `-- name: ListSAMLFlowsFirstPage :many
-select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 from saml_flows
 where saml_connection_id = $1
 order by (create_time, id) desc
@@ -2993,6 +4166,33 @@ func (q *Queries) ListSAMLFlowsFirstPage(ctx context.Context, arg ListSAMLFlowsF
 			&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 			&i.AssertionID,
 			&i.TestModeIdp,
+			&i.ResponseSigned,
+			&i.AssertionSigned,
+			&i.IdpX509Certificate,
+			&i.IsIdpInitiated,
+			&i.ErrorIdpInitiatedNotAllowed,
+			&i.SubjectSessionIndex,
+			&i.SubjectID,
+			&i.SubjectIDFormat,
+			&i.SubjectProfile,
+			&i.AuthnRequestOptions,
+			&i.ErrorExpiredAssertion,
+			&i.ErrorExpiredSubjectConfirmation,
+			&i.ErrorBadDestination,
+			&i.ErrorBadRecipient,
+			&i.ErrorBadSubjectConfirmationMethod,
+			&i.ErrorIdpStatusCode,
+			&i.ErrorIdpSubStatusCode,
+			&i.ErrorIdpStatusMessage,
+			&i.SubjectAuthnInstant,
+			&i.SubjectSessionNotOnOrAfter,
+			&i.SubjectAuthnContextClassRef,
+			&i.ErrorArtifactResolution,
+			&i.IdpVendor,
+			&i.UnverifiedIdpStatusCode,
+			&i.UnverifiedIdpSubStatusCode,
+			&i.UnverifiedIdpStatusMessage,
+			&i.ErrorExpiredIdpInitiatedAssertion,
 		); err != nil {
 			return nil, err
 		}
@@ -3005,7 +4205,7 @@ func (q *Queries) ListSAMLFlowsFirstPage(ctx context.Context, arg ListSAMLFlowsF
 }
 
 const listSAMLFlowsNextPage = `-- name: ListSAMLFlowsNextPage :many
-select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+select id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 from saml_flows
 where saml_connection_id = $1
   and (create_time, id) <= ($3, $4::uuid)
@@ -3068,6 +4268,133 @@ func (q *Queries) ListSAMLFlowsNextPage(ctx context.Context, arg ListSAMLFlowsNe
 			&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 			&i.AssertionID,
 			&i.TestModeIdp,
+			&i.ResponseSigned,
+			&i.AssertionSigned,
+			&i.IdpX509Certificate,
+			&i.IsIdpInitiated,
+			&i.ErrorIdpInitiatedNotAllowed,
+			&i.SubjectSessionIndex,
+			&i.SubjectID,
+			&i.SubjectIDFormat,
+			&i.SubjectProfile,
+			&i.AuthnRequestOptions,
+			&i.ErrorExpiredAssertion,
+			&i.ErrorExpiredSubjectConfirmation,
+			&i.ErrorBadDestination,
+			&i.ErrorBadRecipient,
+			&i.ErrorBadSubjectConfirmationMethod,
+			&i.ErrorIdpStatusCode,
+			&i.ErrorIdpSubStatusCode,
+			&i.ErrorIdpStatusMessage,
+			&i.SubjectAuthnInstant,
+			&i.SubjectSessionNotOnOrAfter,
+			&i.SubjectAuthnContextClassRef,
+			&i.ErrorArtifactResolution,
+			&i.IdpVendor,
+			&i.UnverifiedIdpStatusCode,
+			&i.UnverifiedIdpSubStatusCode,
+			&i.UnverifiedIdpStatusMessage,
+			&i.ErrorExpiredIdpInitiatedAssertion,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLLogoutsFirstPage = `-- name: ListSAMLLogoutsFirstPage :many
+select id, saml_connection_id, is_idp_initiated, subject_id, session_indexes, saml_flow_ids, return_url, create_time, initiate_time, complete_time, success, subject_id_format
+from saml_logouts
+where saml_connection_id = $1
+order by (create_time, id) desc
+limit $2
+`
+
+type ListSAMLLogoutsFirstPageParams struct {
+	SamlConnectionID uuid.UUID
+	Limit            int32
+}
+
+func (q *Queries) ListSAMLLogoutsFirstPage(ctx context.Context, arg ListSAMLLogoutsFirstPageParams) ([]SamlLogout, error) {
+	rows, err := q.db.Query(ctx, listSAMLLogoutsFirstPage, arg.SamlConnectionID, arg.Limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlLogout
+	for rows.Next() {
+		var i SamlLogout
+		if err := rows.Scan(
+			&i.ID,
+			&i.SamlConnectionID,
+			&i.IsIdpInitiated,
+			&i.SubjectID,
+			&i.SessionIndexes,
+			&i.SamlFlowIds,
+			&i.ReturnUrl,
+			&i.CreateTime,
+			&i.InitiateTime,
+			&i.CompleteTime,
+			&i.Success,
+			&i.SubjectIDFormat,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listSAMLLogoutsNextPage = `-- name: ListSAMLLogoutsNextPage :many
+select id, saml_connection_id, is_idp_initiated, subject_id, session_indexes, saml_flow_ids, return_url, create_time, initiate_time, complete_time, success, subject_id_format
+from saml_logouts
+where saml_connection_id = $1
+  and (create_time, id) <= ($3, $4::uuid)
+order by (create_time, id) desc
+limit $2
+`
+
+type ListSAMLLogoutsNextPageParams struct {
+	SamlConnectionID uuid.UUID
+	Limit            int32
+	CreateTime       time.Time
+	ID               uuid.UUID
+}
+
+func (q *Queries) ListSAMLLogoutsNextPage(ctx context.Context, arg ListSAMLLogoutsNextPageParams) ([]SamlLogout, error) {
+	rows, err := q.db.Query(ctx, listSAMLLogoutsNextPage,
+		arg.SamlConnectionID,
+		arg.Limit,
+		arg.CreateTime,
+		arg.ID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SamlLogout
+	for rows.Next() {
+		var i SamlLogout
+		if err := rows.Scan(
+			&i.ID,
+			&i.SamlConnectionID,
+			&i.IsIdpInitiated,
+			&i.SubjectID,
+			&i.SessionIndexes,
+			&i.SamlFlowIds,
+			&i.ReturnUrl,
+			&i.CreateTime,
+			&i.InitiateTime,
+			&i.CompleteTime,
+			&i.Success,
+			&i.SubjectIDFormat,
 		); err != nil {
 			return nil, err
 		}
@@ -3364,7 +4691,7 @@ func (q *Queries) ManagementGetOrganization(ctx context.Context, arg ManagementG
 }
 
 const managementGetSAMLConnection = `-- name: ManagementGetSAMLConnection :one
-select saml_connections.id, saml_connections.organization_id, saml_connections.idp_redirect_url, saml_connections.idp_x509_certificate, saml_connections.idp_entity_id, saml_connections.sp_entity_id, saml_connections.is_primary, saml_connections.sp_acs_url
+select saml_connections.id, saml_connections.organization_id, saml_connections.idp_redirect_url, saml_connections.idp_x509_certificate, saml_connections.idp_entity_id, saml_connections.sp_entity_id, saml_connections.is_primary, saml_connections.sp_acs_url, saml_connections.allowed_signature_algorithms, saml_connections.allowed_digest_algorithms, saml_connections.sp_encryption_private_key, saml_connections.sp_encryption_certificate, saml_connections.sp_previous_encryption_private_key, saml_connections.sp_signing_private_key, saml_connections.sp_signing_certificate, saml_connections.sign_authn_requests, saml_connections.idp_binding, saml_connections.allow_idp_initiated, saml_connections.idp_slo_url, saml_connections.idp_metadata_url, saml_connections.idp_metadata_refresh_time, saml_connections.idp_metadata_refresh_error, saml_connections.email_attribute_names, saml_connections.attribute_mapping_preset, saml_connections.attribute_mapping, saml_connections.authn_request_options, saml_connections.allowed_clock_skew_seconds, saml_connections.idp_artifact_resolution_url, saml_connections.idp_vendor
 from saml_connections
          join organizations
               on saml_connections.organization_id = organizations.id
@@ -3389,6 +4716,111 @@ func (q *Queries) ManagementGetSAMLConnection(ctx context.Context, arg Managemen
 		&i.SpEntityID,
 		&i.IsPrimary,
 		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const managementGetSAMLFlow = `-- name: ManagementGetSAMLFlow :one
+select saml_flows.id, saml_flows.saml_connection_id, saml_flows.access_code, saml_flows.state, saml_flows.create_time, saml_flows.expire_time, saml_flows.email, saml_flows.subject_idp_attributes, saml_flows.update_time, saml_flows.auth_redirect_url, saml_flows.get_redirect_time, saml_flows.initiate_request, saml_flows.initiate_time, saml_flows.assertion, saml_flows.app_redirect_url, saml_flows.receive_assertion_time, saml_flows.redeem_time, saml_flows.redeem_response, saml_flows.error_bad_issuer, saml_flows.error_bad_audience, saml_flows.error_bad_subject_id, saml_flows.error_email_outside_organization_domains, saml_flows.status, saml_flows.error_unsigned_assertion, saml_flows.access_code_sha256, saml_flows.is_oauth, saml_flows.error_bad_signature_algorithm, saml_flows.error_bad_digest_algorithm, saml_flows.error_bad_x509_certificate, saml_flows.error_saml_connection_not_configured, saml_flows.error_environment_oauth_redirect_uri_not_configured, saml_flows.assertion_id, saml_flows.test_mode_idp, saml_flows.response_signed, saml_flows.assertion_signed, saml_flows.idp_x509_certificate, saml_flows.is_idp_initiated, saml_flows.error_idp_initiated_not_allowed, saml_flows.subject_session_index, saml_flows.subject_id, saml_flows.subject_id_format, saml_flows.subject_profile, saml_flows.authn_request_options, saml_flows.error_expired_assertion, saml_flows.error_expired_subject_confirmation, saml_flows.error_bad_destination, saml_flows.error_bad_recipient, saml_flows.error_bad_subject_confirmation_method, saml_flows.error_idp_status_code, saml_flows.error_idp_sub_status_code, saml_flows.error_idp_status_message, saml_flows.subject_authn_instant, saml_flows.subject_session_not_on_or_after, saml_flows.subject_authn_context_class_ref, saml_flows.error_artifact_resolution, saml_flows.idp_vendor, saml_flows.unverified_idp_status_code, saml_flows.unverified_idp_sub_status_code, saml_flows.unverified_idp_status_message, saml_flows.error_expired_idp_initiated_assertion
+from saml_flows
+         join saml_connections
+              on saml_flows.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+where organizations.environment_id = $1
+  and saml_flows.id = $2
+`
+
+type ManagementGetSAMLFlowParams struct {
+	EnvironmentID uuid.UUID
+	ID            uuid.UUID
+}
+
+func (q *Queries) ManagementGetSAMLFlow(ctx context.Context, arg ManagementGetSAMLFlowParams) (SamlFlow, error) {
+	row := q.db.QueryRow(ctx, managementGetSAMLFlow, arg.EnvironmentID, arg.ID)
+	var i SamlFlow
+	err := row.Scan(
+		&i.ID,
+		&i.SamlConnectionID,
+		&i.AccessCode,
+		&i.State,
+		&i.CreateTime,
+		&i.ExpireTime,
+		&i.Email,
+		&i.SubjectIdpAttributes,
+		&i.UpdateTime,
+		&i.AuthRedirectUrl,
+		&i.GetRedirectTime,
+		&i.InitiateRequest,
+		&i.InitiateTime,
+		&i.Assertion,
+		&i.AppRedirectUrl,
+		&i.ReceiveAssertionTime,
+		&i.RedeemTime,
+		&i.RedeemResponse,
+		&i.ErrorBadIssuer,
+		&i.ErrorBadAudience,
+		&i.ErrorBadSubjectID,
+		&i.ErrorEmailOutsideOrganizationDomains,
+		&i.Status,
+		&i.ErrorUnsignedAssertion,
+		&i.AccessCodeSha256,
+		&i.IsOauth,
+		&i.ErrorBadSignatureAlgorithm,
+		&i.ErrorBadDigestAlgorithm,
+		&i.ErrorBadX509Certificate,
+		&i.ErrorSamlConnectionNotConfigured,
+		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
+		&i.AssertionID,
+		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
@@ -3805,20 +5237,46 @@ func (q *Queries) UpdatePrimarySCIMDirectory(ctx context.Context, arg UpdatePrim
 
 const updateSAMLConnection = `-- name: UpdateSAMLConnection :one
 update saml_connections
-set idp_entity_id        = $1,
-    idp_redirect_url     = $2,
-    idp_x509_certificate = $3,
-    is_primary           = $4
-where id = $5
-returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url
+set idp_entity_id                = $1,
+    idp_redirect_url             = $2,
+    idp_x509_certificate         = $3,
+    is_primary                   = $4,
+    allowed_signature_algorithms = $5,
+    allowed_digest_algorithms    = $6,
+    sign_authn_requests          = $7,
+    idp_binding                  = $8,
+    allow_idp_initiated          = $9,
+    idp_slo_url                  = $10,
+    idp_metadata_url             = $11,
+    email_attribute_names        = $12,
+    attribute_mapping_preset     = $13,
+    attribute_mapping            = $14,
+    authn_request_options        = $15,
+    allowed_clock_skew_seconds   = $16,
+    idp_artifact_resolution_url  = $17
+where id = $18
+returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
 `
 
 type UpdateSAMLConnectionParams struct {
-	IdpEntityID        *string
-	IdpRedirectUrl     *string
-	IdpX509Certificate []byte
-	IsPrimary          bool
-	ID                 uuid.UUID
+	IdpEntityID                *string
+	IdpRedirectUrl             *string
+	IdpX509Certificate         []byte
+	IsPrimary                  bool
+	AllowedSignatureAlgorithms []string
+	AllowedDigestAlgorithms    []string
+	SignAuthnRequests          bool
+	IdpBinding                 *string
+	AllowIdpInitiated          bool
+	IdpSloUrl                  *string
+	IdpMetadataUrl             *string
+	EmailAttributeNames        []string
+	AttributeMappingPreset     *string
+	AttributeMapping           []byte
+	AuthnRequestOptions        []byte
+	AllowedClockSkewSeconds    int32
+	IdpArtifactResolutionUrl   *string
+	ID                         uuid.UUID
 }
 
 func (q *Queries) UpdateSAMLConnection(ctx context.Context, arg UpdateSAMLConnectionParams) (SamlConnection, error) {
@@ -3827,6 +5285,19 @@ func (q *Queries) UpdateSAMLConnection(ctx context.Context, arg UpdateSAMLConnec
 		arg.IdpRedirectUrl,
 		arg.IdpX509Certificate,
 		arg.IsPrimary,
+		arg.AllowedSignatureAlgorithms,
+		arg.AllowedDigestAlgorithms,
+		arg.SignAuthnRequests,
+		arg.IdpBinding,
+		arg.AllowIdpInitiated,
+		arg.IdpSloUrl,
+		arg.IdpMetadataUrl,
+		arg.EmailAttributeNames,
+		arg.AttributeMappingPreset,
+		arg.AttributeMapping,
+		arg.AuthnRequestOptions,
+		arg.AllowedClockSkewSeconds,
+		arg.IdpArtifactResolutionUrl,
 		arg.ID,
 	)
 	var i SamlConnection
@@ -3839,6 +5310,258 @@ func (q *Queries) UpdateSAMLConnection(ctx context.Context, arg UpdateSAMLConnec
 		&i.SpEntityID,
 		&i.IsPrimary,
 		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const updateSAMLConnectionIDPMetadata = `-- name: UpdateSAMLConnectionIDPMetadata :one
+update saml_connections
+set idp_entity_id              = $1,
+    idp_redirect_url           = $2,
+    idp_x509_certificate       = $3,
+    idp_metadata_refresh_time  = $4,
+    idp_metadata_refresh_error = $5
+where id = $6
+returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
+`
+
+type UpdateSAMLConnectionIDPMetadataParams struct {
+	IdpEntityID             *string
+	IdpRedirectUrl          *string
+	IdpX509Certificate      []byte
+	IdpMetadataRefreshTime  *time.Time
+	IdpMetadataRefreshError *string
+	ID                      uuid.UUID
+}
+
+func (q *Queries) UpdateSAMLConnectionIDPMetadata(ctx context.Context, arg UpdateSAMLConnectionIDPMetadataParams) (SamlConnection, error) {
+	row := q.db.QueryRow(ctx, updateSAMLConnectionIDPMetadata,
+		arg.IdpEntityID,
+		arg.IdpRedirectUrl,
+		arg.IdpX509Certificate,
+		arg.IdpMetadataRefreshTime,
+		arg.IdpMetadataRefreshError,
+		arg.ID,
+	)
+	var i SamlConnection
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.IdpRedirectUrl,
+		&i.IdpX509Certificate,
+		&i.IdpEntityID,
+		&i.SpEntityID,
+		&i.IsPrimary,
+		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const updateSAMLConnectionIDPVendor = `-- name: UpdateSAMLConnectionIDPVendor :one
+update saml_connections
+set idp_vendor                 = $1,
+    attribute_mapping_preset   = $2,
+    authn_request_options      = $3,
+    sign_authn_requests        = $4,
+    allowed_clock_skew_seconds = $5
+where id = $6
+returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
+`
+
+type UpdateSAMLConnectionIDPVendorParams struct {
+	IdpVendor               *string
+	AttributeMappingPreset  *string
+	AuthnRequestOptions     []byte
+	SignAuthnRequests       bool
+	AllowedClockSkewSeconds int32
+	ID                      uuid.UUID
+}
+
+func (q *Queries) UpdateSAMLConnectionIDPVendor(ctx context.Context, arg UpdateSAMLConnectionIDPVendorParams) (SamlConnection, error) {
+	row := q.db.QueryRow(ctx, updateSAMLConnectionIDPVendor,
+		arg.IdpVendor,
+		arg.AttributeMappingPreset,
+		arg.AuthnRequestOptions,
+		arg.SignAuthnRequests,
+		arg.AllowedClockSkewSeconds,
+		arg.ID,
+	)
+	var i SamlConnection
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.IdpRedirectUrl,
+		&i.IdpX509Certificate,
+		&i.IdpEntityID,
+		&i.SpEntityID,
+		&i.IsPrimary,
+		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const updateSAMLConnectionSPEncryptionKey = `-- name: UpdateSAMLConnectionSPEncryptionKey :one
+update saml_connections
+set sp_previous_encryption_private_key = sp_encryption_private_key,
+    sp_encryption_private_key          = $1,
+    sp_encryption_certificate          = $2
+where id = $3
+returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
+`
+
+type UpdateSAMLConnectionSPEncryptionKeyParams struct {
+	SpEncryptionPrivateKey  []byte
+	SpEncryptionCertificate []byte
+	ID                      uuid.UUID
+}
+
+func (q *Queries) UpdateSAMLConnectionSPEncryptionKey(ctx context.Context, arg UpdateSAMLConnectionSPEncryptionKeyParams) (SamlConnection, error) {
+	row := q.db.QueryRow(ctx, updateSAMLConnectionSPEncryptionKey, arg.SpEncryptionPrivateKey, arg.SpEncryptionCertificate, arg.ID)
+	var i SamlConnection
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.IdpRedirectUrl,
+		&i.IdpX509Certificate,
+		&i.IdpEntityID,
+		&i.SpEntityID,
+		&i.IsPrimary,
+		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
+	)
+	return i, err
+}
+
+const updateSAMLConnectionSPSigningKey = `-- name: UpdateSAMLConnectionSPSigningKey :one
+update saml_connections
+set sp_signing_private_key = $1,
+    sp_signing_certificate = $2
+where id = $3
+returning id, organization_id, idp_redirect_url, idp_x509_certificate, idp_entity_id, sp_entity_id, is_primary, sp_acs_url, allowed_signature_algorithms, allowed_digest_algorithms, sp_encryption_private_key, sp_encryption_certificate, sp_previous_encryption_private_key, sp_signing_private_key, sp_signing_certificate, sign_authn_requests, idp_binding, allow_idp_initiated, idp_slo_url, idp_metadata_url, idp_metadata_refresh_time, idp_metadata_refresh_error, email_attribute_names, attribute_mapping_preset, attribute_mapping, authn_request_options, allowed_clock_skew_seconds, idp_artifact_resolution_url, idp_vendor
+`
+
+type UpdateSAMLConnectionSPSigningKeyParams struct {
+	SpSigningPrivateKey  []byte
+	SpSigningCertificate []byte
+	ID                   uuid.UUID
+}
+
+func (q *Queries) UpdateSAMLConnectionSPSigningKey(ctx context.Context, arg UpdateSAMLConnectionSPSigningKeyParams) (SamlConnection, error) {
+	row := q.db.QueryRow(ctx, updateSAMLConnectionSPSigningKey, arg.SpSigningPrivateKey, arg.SpSigningCertificate, arg.ID)
+	var i SamlConnection
+	err := row.Scan(
+		&i.ID,
+		&i.OrganizationID,
+		&i.IdpRedirectUrl,
+		&i.IdpX509Certificate,
+		&i.IdpEntityID,
+		&i.SpEntityID,
+		&i.IsPrimary,
+		&i.SpAcsUrl,
+		&i.AllowedSignatureAlgorithms,
+		&i.AllowedDigestAlgorithms,
+		&i.SpEncryptionPrivateKey,
+		&i.SpEncryptionCertificate,
+		&i.SpPreviousEncryptionPrivateKey,
+		&i.SpSigningPrivateKey,
+		&i.SpSigningCertificate,
+		&i.SignAuthnRequests,
+		&i.IdpBinding,
+		&i.AllowIdpInitiated,
+		&i.IdpSloUrl,
+		&i.IdpMetadataUrl,
+		&i.IdpMetadataRefreshTime,
+		&i.IdpMetadataRefreshError,
+		&i.EmailAttributeNames,
+		&i.AttributeMappingPreset,
+		&i.AttributeMapping,
+		&i.AuthnRequestOptions,
+		&i.AllowedClockSkewSeconds,
+		&i.IdpArtifactResolutionUrl,
+		&i.IdpVendor,
 	)
 	return i, err
 }
@@ -3851,7 +5574,7 @@ set update_time        = $1,
     status             = $4,
     access_code_sha256 = null
 where id = $5
-returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 `
 
 type UpdateSAMLFlowRedeemParams struct {
@@ -3905,26 +5628,78 @@ func (q *Queries) UpdateSAMLFlowRedeem(ctx context.Context, arg UpdateSAMLFlowRe
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
 
 const updateSAMLFlowSubjectData = `-- name: UpdateSAMLFlowSubjectData :one
 update saml_flows
-set email                  = $1,
-    subject_idp_attributes = $2
-where id = $3
-returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+set email                           = $1,
+    subject_idp_attributes          = $2,
+    subject_session_index           = $3,
+    subject_id                      = $4,
+    subject_id_format               = $5,
+    subject_profile                 = $6,
+    subject_authn_instant           = $7,
+    subject_session_not_on_or_after = $8,
+    subject_authn_context_class_ref = $9
+where id = $10
+returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 `
 
 type UpdateSAMLFlowSubjectDataParams struct {
-	Email                *string
-	SubjectIdpAttributes []byte
-	ID                   uuid.UUID
+	Email                       *string
+	SubjectIdpAttributes        []byte
+	SubjectSessionIndex         *string
+	SubjectID                   *string
+	SubjectIDFormat             *string
+	SubjectProfile              []byte
+	SubjectAuthnInstant         *time.Time
+	SubjectSessionNotOnOrAfter  *time.Time
+	SubjectAuthnContextClassRef *string
+	ID                          uuid.UUID
 }
 
 func (q *Queries) UpdateSAMLFlowSubjectData(ctx context.Context, arg UpdateSAMLFlowSubjectDataParams) (SamlFlow, error) {
-	row := q.db.QueryRow(ctx, updateSAMLFlowSubjectData, arg.Email, arg.SubjectIdpAttributes, arg.ID)
+	row := q.db.QueryRow(ctx, updateSAMLFlowSubjectData,
+		arg.Email,
+		arg.SubjectIdpAttributes,
+		arg.SubjectSessionIndex,
+		arg.SubjectID,
+		arg.SubjectIDFormat,
+		arg.SubjectProfile,
+		arg.SubjectAuthnInstant,
+		arg.SubjectSessionNotOnOrAfter,
+		arg.SubjectAuthnContextClassRef,
+		arg.ID,
+	)
 	var i SamlFlow
 	err := row.Scan(
 		&i.ID,
@@ -3960,10 +5735,65 @@ func (q *Queries) UpdateSAMLFlowSubjectData(ctx context.Context, arg UpdateSAMLF
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
 
+const updateSAMLFlowUnverifiedIDPStatus = `-- name: UpdateSAMLFlowUnverifiedIDPStatus :exec
+update saml_flows
+set unverified_idp_status_code     = $1,
+    unverified_idp_sub_status_code = $2,
+    unverified_idp_status_message  = $3
+where id = $4
+  and saml_connection_id = $5
+`
+
+type UpdateSAMLFlowUnverifiedIDPStatusParams struct {
+	UnverifiedIdpStatusCode    *string
+	UnverifiedIdpSubStatusCode *string
+	UnverifiedIdpStatusMessage *string
+	ID                         uuid.UUID
+	SamlConnectionID           uuid.UUID
+}
+
+func (q *Queries) UpdateSAMLFlowUnverifiedIDPStatus(ctx context.Context, arg UpdateSAMLFlowUnverifiedIDPStatusParams) error {
+	_, err := q.db.Exec(ctx, updateSAMLFlowUnverifiedIDPStatus,
+		arg.UnverifiedIdpStatusCode,
+		arg.UnverifiedIdpSubStatusCode,
+		arg.UnverifiedIdpStatusMessage,
+		arg.ID,
+		arg.SamlConnectionID,
+	)
+	return err
+}
+
 const updateSCIMDirectory = `-- name: UpdateSCIMDirectory :one
 update scim_directories
 set is_primary = $1
@@ -4023,7 +5853,7 @@ on conflict (id) do update set update_time      = excluded.update_time,
                                initiate_request = excluded.initiate_request,
                                initiate_time    = excluded.initiate_time,
                                status           = excluded.status
-returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 `
 
 type UpsertSAMLFlowInitiateParams struct {
@@ -4087,6 +5917,33 @@ func (q *Queries) UpsertSAMLFlowInitiate(ctx context.Context, arg UpsertSAMLFlow
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
@@ -4100,9 +5957,20 @@ insert into saml_flows (id, saml_connection_id, assertion_id,
                         error_bad_audience, error_bad_signature_algorithm,
                         error_bad_digest_algorithm, error_bad_x509_certificate,
                         error_bad_subject_id,
-                        error_email_outside_organization_domains, status)
+                        error_email_outside_organization_domains, status,
+                        response_signed, assertion_signed,
+                        idp_x509_certificate, is_idp_initiated,
+                        error_idp_initiated_not_allowed,
+                        error_expired_assertion,
+                        error_expired_subject_confirmation,
+                        error_bad_destination, error_bad_recipient,
+                        error_bad_subject_confirmation_method,
+                        error_idp_status_code, error_idp_sub_status_code,
+                        error_idp_status_message, error_artifact_resolution,
+                        idp_vendor, error_expired_idp_initiated_assertion)
 values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
-        $17, $18, $19, $20)
+        $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30,
+        $31, $32, $33, $34, $35, $36)
 on conflict (id) do update set assertion_id                             = excluded.assertion_id,
                                access_code_sha256                       = excluded.access_code_sha256,
                                update_time                              = excluded.update_time,
@@ -4117,8 +5985,23 @@ on conflict (id) do update set assertion_id                             = exclud
                                error_bad_x509_certificate               = excluded.error_bad_x509_certificate,
                                error_bad_subject_id                     = excluded.error_bad_subject_id,
                                error_email_outside_organization_domains = excluded.error_email_outside_organization_domains,
-                               status                                   = excluded.status
-returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp
+                               status                                   = excluded.status,
+                               response_signed                          = excluded.response_signed,
+                               assertion_signed                         = excluded.assertion_signed,
+                               idp_x509_certificate                     = excluded.idp_x509_certificate,
+                               error_idp_initiated_not_allowed          = excluded.error_idp_initiated_not_allowed,
+                               error_expired_assertion                  = excluded.error_expired_assertion,
+                               error_expired_subject_confirmation       = excluded.error_expired_subject_confirmation,
+                               error_bad_destination                    = excluded.error_bad_destination,
+                               error_bad_recipient                      = excluded.error_bad_recipient,
+                               error_bad_subject_confirmation_method    = excluded.error_bad_subject_confirmation_method,
+                               error_idp_status_code                    = excluded.error_idp_status_code,
+                               error_idp_sub_status_code                = excluded.error_idp_sub_status_code,
+                               error_idp_status_message                 = excluded.error_idp_status_message,
+                               error_artifact_resolution                = excluded.error_artifact_resolution,
+                               idp_vendor                               = excluded.idp_vendor,
+                               error_expired_idp_initiated_assertion    = excluded.error_expired_idp_initiated_assertion
+returning id, saml_connection_id, access_code, state, create_time, expire_time, email, subject_idp_attributes, update_time, auth_redirect_url, get_redirect_time, initiate_request, initiate_time, assertion, app_redirect_url, receive_assertion_time, redeem_time, redeem_response, error_bad_issuer, error_bad_audience, error_bad_subject_id, error_email_outside_organization_domains, status, error_unsigned_assertion, access_code_sha256, is_oauth, error_bad_signature_algorithm, error_bad_digest_algorithm, error_bad_x509_certificate, error_saml_connection_not_configured, error_environment_oauth_redirect_uri_not_configured, assertion_id, test_mode_idp, response_signed, assertion_signed, idp_x509_certificate, is_idp_initiated, error_idp_initiated_not_allowed, subject_session_index, subject_id, subject_id_format, subject_profile, authn_request_options, error_expired_assertion, error_expired_subject_confirmation, error_bad_destination, error_bad_recipient, error_bad_subject_confirmation_method, error_idp_status_code, error_idp_sub_status_code, error_idp_status_message, subject_authn_instant, subject_session_not_on_or_after, subject_authn_context_class_ref, error_artifact_resolution, idp_vendor, unverified_idp_status_code, unverified_idp_sub_status_code, unverified_idp_status_message, error_expired_idp_initiated_assertion
 `
 
 type UpsertSAMLFlowReceiveAssertionParams struct {
@@ -4142,6 +6025,22 @@ type UpsertSAMLFlowReceiveAssertionParams struct {
 	ErrorBadSubjectID                    *string
 	ErrorEmailOutsideOrganizationDomains *string
 	Status                               SamlFlowStatus
+	ResponseSigned                       bool
+	AssertionSigned                      bool
+	IdpX509Certificate                   []byte
+	IsIdpInitiated                       bool
+	ErrorIdpInitiatedNotAllowed          bool
+	ErrorExpiredAssertion                bool
+	ErrorExpiredSubjectConfirmation      bool
+	ErrorBadDestination                  *string
+	ErrorBadRecipient                    *string
+	ErrorBadSubjectConfirmationMethod    *string
+	ErrorIdpStatusCode                   *string
+	ErrorIdpSubStatusCode                *string
+	ErrorIdpStatusMessage                *string
+	ErrorArtifactResolution              *string
+	IdpVendor                            *string
+	ErrorExpiredIdpInitiatedAssertion    bool
 }
 
 func (q *Queries) UpsertSAMLFlowReceiveAssertion(ctx context.Context, arg UpsertSAMLFlowReceiveAssertionParams) (SamlFlow, error) {
@@ -4166,6 +6065,22 @@ func (q *Queries) UpsertSAMLFlowReceiveAssertion(ctx context.Context, arg Upsert
 		arg.ErrorBadSubjectID,
 		arg.ErrorEmailOutsideOrganizationDomains,
 		arg.Status,
+		arg.ResponseSigned,
+		arg.AssertionSigned,
+		arg.IdpX509Certificate,
+		arg.IsIdpInitiated,
+		arg.ErrorIdpInitiatedNotAllowed,
+		arg.ErrorExpiredAssertion,
+		arg.ErrorExpiredSubjectConfirmation,
+		arg.ErrorBadDestination,
+		arg.ErrorBadRecipient,
+		arg.ErrorBadSubjectConfirmationMethod,
+		arg.ErrorIdpStatusCode,
+		arg.ErrorIdpSubStatusCode,
+		arg.ErrorIdpStatusMessage,
+		arg.ErrorArtifactResolution,
+		arg.IdpVendor,
+		arg.ErrorExpiredIdpInitiatedAssertion,
 	)
 	var i SamlFlow
 	err := row.Scan(
@@ -4202,6 +6117,33 @@ func (q *Queries) UpsertSAMLFlowReceiveAssertion(ctx context.Context, arg Upsert
 		&i.ErrorEnvironmentOauthRedirectUriNotConfigured,
 		&i.AssertionID,
 		&i.TestModeIdp,
+		&i.ResponseSigned,
+		&i.AssertionSigned,
+		&i.IdpX509Certificate,
+		&i.IsIdpInitiated,
+		&i.ErrorIdpInitiatedNotAllowed,
+		&i.SubjectSessionIndex,
+		&i.SubjectID,
+		&i.SubjectIDFormat,
+		&i.SubjectProfile,
+		&i.AuthnRequestOptions,
+		&i.ErrorExpiredAssertion,
+		&i.ErrorExpiredSubjectConfirmation,
+		&i.ErrorBadDestination,
+		&i.ErrorBadRecipient,
+		&i.ErrorBadSubjectConfirmationMethod,
+		&i.ErrorIdpStatusCode,
+		&i.ErrorIdpSubStatusCode,
+		&i.ErrorIdpStatusMessage,
+		&i.SubjectAuthnInstant,
+		&i.SubjectSessionNotOnOrAfter,
+		&i.SubjectAuthnContextClassRef,
+		&i.ErrorArtifactResolution,
+		&i.IdpVendor,
+		&i.UnverifiedIdpStatusCode,
+		&i.UnverifiedIdpSubStatusCode,
+		&i.UnverifiedIdpStatusMessage,
+		&i.ErrorExpiredIdpInitiatedAssertion,
 	)
 	return i, err
 }
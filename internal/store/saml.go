@@ -75,6 +75,11 @@ func (s *Store) GetSAMLRedirectURL(ctx context.Context, req *ssoreadyv1.GetSAMLR
 		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("one of saml_connection_id, organization_id, or organization_external_id must be provided"))
 	}
 
+	authnRequestOptions, err := parseAuthnRequestOptions(req.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
 	samlRedirectData, err := q.GetSAMLRedirectURLData(ctx, queries.GetSAMLRedirectURLDataParams{
 		AppOrganizationID: authn.AppOrgID(ctx),
 		EnvironmentID:     envID,
@@ -122,15 +127,16 @@ func (s *Store) GetSAMLRedirectURL(ctx context.Context, req *ssoreadyv1.GetSAMLR
 
 	now := time.Now()
 	if _, err := q.CreateSAMLFlowGetRedirect(ctx, queries.CreateSAMLFlowGetRedirectParams{
-		ID:               samlFlowID,
-		SamlConnectionID: samlConnID,
-		ExpireTime:       time.Now().Add(time.Hour),
-		State:            req.State,
-		CreateTime:       time.Now(),
-		UpdateTime:       time.Now(),
-		AuthRedirectUrl:  &redirect,
-		GetRedirectTime:  &now,
-		Status:           queries.SamlFlowStatusInProgress,
+		ID:                  samlFlowID,
+		SamlConnectionID:    samlConnID,
+		ExpireTime:          time.Now().Add(time.Hour),
+		State:               req.State,
+		CreateTime:          time.Now(),
+		UpdateTime:          time.Now(),
+		AuthRedirectUrl:     &redirect,
+		GetRedirectTime:     &now,
+		Status:              queries.SamlFlowStatusInProgress,
+		AuthnRequestOptions: authnRequestOptions,
 	}); err != nil {
 		return nil, err
 	}
@@ -191,18 +197,31 @@ func (s *Store) RedeemSAMLAccessCode(ctx context.Context, req *ssoreadyv1.Redeem
 		return nil, fmt.Errorf("get saml access code data: %w", err)
 	}
 
-	var attrs map[string]string
-	if err := json.Unmarshal(samlAccessTokenData.SubjectIdpAttributes, &attrs); err != nil {
+	attrs, err := parseSubjectIDPAttributes(samlAccessTokenData.SubjectIdpAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := parseSubjectProfile(samlAccessTokenData.SubjectProfile)
+	if err != nil {
 		return nil, err
 	}
 
 	res := &ssoreadyv1.RedeemSAMLAccessCodeResponse{
 		Email:                  *samlAccessTokenData.Email,
-		Attributes:             attrs,
+		SubjectId:              derefOrEmpty(samlAccessTokenData.SubjectID),
+		SubjectIdFormat:        derefOrEmpty(samlAccessTokenData.SubjectIDFormat),
+		Attributes:             firstAttributeValues(attrs),
+		AttributeValues:        samlAttributeValues(attrs),
+		Profile:                profile,
 		State:                  samlAccessTokenData.State,
 		OrganizationId:         idformat.Organization.Format(samlAccessTokenData.OrganizationID),
 		OrganizationExternalId: derefOrEmpty(samlAccessTokenData.OrganizationExternalID),
 		SamlFlowId:             idformat.SAMLFlow.Format(samlAccessTokenData.SamlFlowID),
+		AuthnInstant:           ptrTimeToTimestamp(samlAccessTokenData.SubjectAuthnInstant),
+		SessionIndex:           derefOrEmpty(samlAccessTokenData.SubjectSessionIndex),
+		SessionNotOnOrAfter:    ptrTimeToTimestamp(samlAccessTokenData.SubjectSessionNotOnOrAfter),
+		AuthnContextClassRef:   derefOrEmpty(samlAccessTokenData.SubjectAuthnContextClassRef),
 	}
 
 	resJSON, err := json.Marshal(res)
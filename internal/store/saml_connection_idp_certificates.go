@@ -0,0 +1,160 @@
+package store
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/ssoready/ssoready/internal/authn"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/store/idformat"
+	"github.com/ssoready/ssoready/internal/store/queries"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Store) AppListSAMLConnectionIDPCertificates(ctx context.Context, req *ssoreadyv1.AppListSAMLConnectionIDPCertificatesRequest) (*ssoreadyv1.AppListSAMLConnectionIDPCertificatesResponse, error) {
+	_, q, _, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	samlConnID, err := idformat.SAMLConnection.Parse(req.SamlConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	if _, err = q.GetSAMLConnection(ctx, queries.GetSAMLConnectionParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                samlConnID,
+	}); err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	qIDPCerts, err := q.ListSAMLConnectionIDPCertificates(ctx, samlConnID)
+	if err != nil {
+		return nil, fmt.Errorf("list saml connection idp certificates: %w", err)
+	}
+
+	var idpCerts []*ssoreadyv1.SAMLConnectionIDPCertificate
+	for _, qIDPCert := range qIDPCerts {
+		idpCerts = append(idpCerts, parseSAMLConnectionIDPCertificate(qIDPCert))
+	}
+
+	return &ssoreadyv1.AppListSAMLConnectionIDPCertificatesResponse{
+		SamlConnectionIdpCertificates: idpCerts,
+	}, nil
+}
+
+func (s *Store) AppCreateSAMLConnectionIDPCertificate(ctx context.Context, req *ssoreadyv1.AppCreateSAMLConnectionIDPCertificateRequest) (*ssoreadyv1.SAMLConnectionIDPCertificate, error) {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	samlConnID, err := idformat.SAMLConnection.Parse(req.SamlConnectionIdpCertificate.SamlConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	if _, err = q.GetSAMLConnection(ctx, queries.GetSAMLConnectionParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                samlConnID,
+	}); err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	blk, _ := pem.Decode([]byte(req.SamlConnectionIdpCertificate.Certificate))
+	if blk == nil || blk.Type != "CERTIFICATE" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("idp certificate must be a PEM-encoded CERTIFICATE block"))
+	}
+	if _, err := x509.ParseCertificate(blk.Bytes); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse idp certificate: %w", err))
+	}
+
+	var notBefore, notAfter *time.Time
+	if req.SamlConnectionIdpCertificate.NotBefore != nil {
+		t := req.SamlConnectionIdpCertificate.NotBefore.AsTime()
+		notBefore = &t
+	}
+	if req.SamlConnectionIdpCertificate.NotAfter != nil {
+		t := req.SamlConnectionIdpCertificate.NotAfter.AsTime()
+		notAfter = &t
+	}
+
+	if notBefore != nil && notAfter != nil && !notBefore.Before(*notAfter) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("not_before must be before not_after"))
+	}
+
+	qIDPCert, err := q.CreateSAMLConnectionIDPCertificate(ctx, queries.CreateSAMLConnectionIDPCertificateParams{
+		ID:               uuid.New(),
+		SamlConnectionID: samlConnID,
+		X509Certificate:  blk.Bytes,
+		NotBefore:        notBefore,
+		NotAfter:         notAfter,
+		CreateTime:       time.Now(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create saml connection idp certificate: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return parseSAMLConnectionIDPCertificate(qIDPCert), nil
+}
+
+func (s *Store) AppDeleteSAMLConnectionIDPCertificate(ctx context.Context, req *ssoreadyv1.AppDeleteSAMLConnectionIDPCertificateRequest) (*emptypb.Empty, error) {
+	id, err := idformat.SAMLConnectionIDPCertificate.Parse(req.SamlConnectionIdpCertificateId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection idp certificate id: %w", err)
+	}
+
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	// idor check
+	if _, err := q.GetSAMLConnectionIDPCertificate(ctx, queries.GetSAMLConnectionIDPCertificateParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                id,
+	}); err != nil {
+		return nil, fmt.Errorf("get saml connection idp certificate: %w", err)
+	}
+
+	if err := q.DeleteSAMLConnectionIDPCertificate(ctx, id); err != nil {
+		return nil, fmt.Errorf("delete saml connection idp certificate: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func parseSAMLConnectionIDPCertificate(qIDPCert queries.SamlConnectionIdpCertificate) *ssoreadyv1.SAMLConnectionIDPCertificate {
+	return &ssoreadyv1.SAMLConnectionIDPCertificate{
+		Id:               idformat.SAMLConnectionIDPCertificate.Format(qIDPCert.ID),
+		SamlConnectionId: idformat.SAMLConnection.Format(qIDPCert.SamlConnectionID),
+		Certificate: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: qIDPCert.X509Certificate,
+		})),
+		NotBefore:       ptrTimeToTimestamp(qIDPCert.NotBefore),
+		NotAfter:        ptrTimeToTimestamp(qIDPCert.NotAfter),
+		CreateTime:      timestamppb.New(qIDPCert.CreateTime),
+		FromIdpMetadata: qIDPCert.FromIdpMetadata,
+	}
+}
@@ -0,0 +1,499 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/ssoready/ssoready/internal/authn"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/store/idformat"
+	"github.com/ssoready/ssoready/internal/store/queries"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// IDPMetadata is the part of a SAML connection's configuration that is kept in
+// sync with its IDP metadata URL.
+type IDPMetadata struct {
+	EntityID        string
+	RedirectURL     string
+	X509Certificate []byte
+
+	// AdditionalX509Certificates are the other signing certificates the IDP
+	// publishes, typically while it rotates certificates. They are kept in
+	// sync with the SAML connection's IDP certificates that are marked
+	// from_idp_metadata. They are not compared by equal, nor recorded in IDP
+	// metadata updates.
+	AdditionalX509Certificates [][]byte
+}
+
+// IDPMetadataRefresh is a SAML connection whose IDP metadata URL is due to be
+// fetched.
+type IDPMetadataRefresh struct {
+	SAMLConnectionID string
+	IDPMetadataURL   string
+
+	// IDPBinding is the binding the SAML connection sends AuthnRequests with.
+	IDPBinding string
+
+	// IDPMetadata is the SAML connection's configuration as of when it was
+	// listed.
+	IDPMetadata IDPMetadata
+}
+
+// ListIDPMetadataRefreshes returns up to limit SAML connections whose IDP
+// metadata URL has not been fetched since dueBefore.
+func (s *Store) ListIDPMetadataRefreshes(ctx context.Context, dueBefore time.Time, limit int) ([]IDPMetadataRefresh, error) {
+	_, q, _, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	qSAMLConns, err := q.ListSAMLConnectionsDueForIDPMetadataRefresh(ctx, queries.ListSAMLConnectionsDueForIDPMetadataRefreshParams{
+		IdpMetadataRefreshTime: &dueBefore,
+		Limit:                  int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var refreshes []IDPMetadataRefresh
+	for _, qSAMLConn := range qSAMLConns {
+		idpBinding := saml.BindingHTTPPost
+		if qSAMLConn.IdpBinding != nil {
+			idpBinding = *qSAMLConn.IdpBinding
+		}
+
+		refreshes = append(refreshes, IDPMetadataRefresh{
+			SAMLConnectionID: idformat.SAMLConnection.Format(qSAMLConn.ID),
+			IDPMetadataURL:   derefOrEmpty(qSAMLConn.IdpMetadataUrl),
+			IDPBinding:       idpBinding,
+			IDPMetadata:      idpMetadata(qSAMLConn),
+		})
+	}
+
+	return refreshes, nil
+}
+
+type CompleteIDPMetadataRefreshRequest struct {
+	Refresh     IDPMetadataRefresh
+	RefreshTime time.Time
+
+	// IDPMetadata is the configuration to apply to the SAML connection. It is
+	// nil if the metadata could not be used, in which case RefreshError
+	// explains why.
+	IDPMetadata  *IDPMetadata
+	RefreshError string
+}
+
+// CompleteIDPMetadataRefresh records that a SAML connection's IDP metadata URL
+// was fetched, and applies any changes to the SAML connection's configuration.
+//
+// Changes that include a new entity ID are not applied. They are recorded as a
+// pending update, which AppAcceptSAMLConnectionIDPMetadataUpdate applies.
+//
+// If the SAML connection was modified since req.Refresh was listed, nothing is
+// recorded, and the SAML connection will be refreshed again later.
+func (s *Store) CompleteIDPMetadataRefresh(ctx context.Context, req *CompleteIDPMetadataRefreshRequest) error {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return err
+	}
+	defer rollback()
+
+	samlConnID, err := idformat.SAMLConnection.Parse(req.Refresh.SAMLConnectionID)
+	if err != nil {
+		return err
+	}
+
+	qSAMLConn, err := q.GetSAMLConnectionForIDPMetadataRefresh(ctx, samlConnID)
+	if err != nil {
+		return fmt.Errorf("get saml connection: %w", err)
+	}
+
+	current := idpMetadata(qSAMLConn)
+	if derefOrEmpty(qSAMLConn.IdpMetadataUrl) != req.Refresh.IDPMetadataURL || !current.equal(req.Refresh.IDPMetadata) {
+		slog.InfoContext(ctx, "complete_idp_metadata_refresh_conflict", "saml_connection_id", req.Refresh.SAMLConnectionID)
+		return nil
+	}
+
+	next := current
+	if req.IDPMetadata != nil {
+		next = *req.IDPMetadata
+	}
+
+	// a new entity ID may mean the metadata URL now describes a different IDP,
+	// so it waits for someone to accept it rather than being applied
+	pending := current.EntityID != "" && next.EntityID != current.EntityID
+
+	var refreshError *string
+	if req.RefreshError != "" {
+		refreshError = &req.RefreshError
+	}
+	if pending {
+		msg := fmt.Sprintf("idp metadata has a new entity id %q; accept the idp metadata update to apply it", next.EntityID)
+		refreshError = &msg
+	}
+
+	applied := next
+	if pending {
+		applied = current
+	}
+
+	qSAMLConn, err = q.UpdateSAMLConnectionIDPMetadata(ctx, queries.UpdateSAMLConnectionIDPMetadataParams{
+		ID:                      samlConnID,
+		IdpEntityID:             &applied.EntityID,
+		IdpRedirectUrl:          &applied.RedirectURL,
+		IdpX509Certificate:      applied.X509Certificate,
+		IdpMetadataRefreshTime:  &req.RefreshTime,
+		IdpMetadataRefreshError: refreshError,
+	})
+	if err != nil {
+		return fmt.Errorf("update saml connection: %w", err)
+	}
+
+	if _, err := s.detectIDPVendor(ctx, q, qSAMLConn); err != nil {
+		return fmt.Errorf("detect idp vendor: %w", err)
+	}
+
+	if req.IDPMetadata != nil && !pending {
+		if err := syncIDPMetadataCertificates(ctx, q, samlConnID, next, req.RefreshTime); err != nil {
+			return fmt.Errorf("sync idp metadata certificates: %w", err)
+		}
+	}
+
+	if !next.equal(current) {
+		update := idpMetadataUpdate(current, next)
+		update.ID = uuid.New()
+		update.SamlConnectionID = samlConnID
+		update.CreateTime = req.RefreshTime
+		update.Pending = pending
+
+		// don't record the same pending update every time the metadata URL is
+		// fetched
+		if pending {
+			qLatestUpdate, err := q.GetLatestSAMLConnectionIDPMetadataUpdate(ctx, samlConnID)
+			if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+				return fmt.Errorf("get latest saml connection idp metadata update: %w", err)
+			}
+
+			if err == nil && qLatestUpdate.Pending && sameIDPMetadataUpdate(qLatestUpdate, update) {
+				if err := commit(); err != nil {
+					return fmt.Errorf("commit: %w", err)
+				}
+				return nil
+			}
+		}
+
+		qUpdate, err := q.CreateSAMLConnectionIDPMetadataUpdate(ctx, update)
+		if err != nil {
+			return fmt.Errorf("create saml connection idp metadata update: %w", err)
+		}
+
+		slog.InfoContext(ctx, "complete_idp_metadata_refresh_update", "saml_connection_id", req.Refresh.SAMLConnectionID, "saml_connection_idp_metadata_update_id", idformat.SAMLConnectionIDPMetadataUpdate.Format(qUpdate.ID), "pending", pending)
+	}
+
+	if err := commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+// syncIDPMetadataCertificates makes a SAML connection's from_idp_metadata IDP
+// certificates match next.AdditionalX509Certificates, so that SSOReady trusts
+// every certificate the IDP publishes. IDP certificates that were added by
+// hand are left alone.
+func syncIDPMetadataCertificates(ctx context.Context, q *queries.Queries, samlConnID uuid.UUID, next IDPMetadata, now time.Time) error {
+	qIDPCerts, err := q.ListSAMLConnectionIDPCertificates(ctx, samlConnID)
+	if err != nil {
+		return fmt.Errorf("list saml connection idp certificates: %w", err)
+	}
+
+	published := func(cert []byte) bool {
+		if bytes.Equal(cert, next.X509Certificate) {
+			return false // already trusted as the primary certificate
+		}
+		return slices.ContainsFunc(next.AdditionalX509Certificates, func(c []byte) bool {
+			return bytes.Equal(c, cert)
+		})
+	}
+
+	var trusted [][]byte
+	for _, qIDPCert := range qIDPCerts {
+		if qIDPCert.FromIdpMetadata && !published(qIDPCert.X509Certificate) {
+			if err := q.DeleteSAMLConnectionIDPCertificate(ctx, qIDPCert.ID); err != nil {
+				return fmt.Errorf("delete saml connection idp certificate: %w", err)
+			}
+			continue
+		}
+
+		trusted = append(trusted, qIDPCert.X509Certificate)
+	}
+
+	for _, cert := range next.AdditionalX509Certificates {
+		if !published(cert) || slices.ContainsFunc(trusted, func(c []byte) bool { return bytes.Equal(c, cert) }) {
+			continue
+		}
+
+		if _, err := q.CreateSAMLConnectionIDPCertificate(ctx, queries.CreateSAMLConnectionIDPCertificateParams{
+			ID:               uuid.New(),
+			SamlConnectionID: samlConnID,
+			X509Certificate:  cert,
+			CreateTime:       now,
+			FromIdpMetadata:  true,
+		}); err != nil {
+			return fmt.Errorf("create saml connection idp certificate: %w", err)
+		}
+
+		trusted = append(trusted, cert)
+	}
+
+	return nil
+}
+
+// idpMetadataUpdate returns the fields of an IDP metadata update that records
+// the changes from current to next.
+func idpMetadataUpdate(current, next IDPMetadata) queries.CreateSAMLConnectionIDPMetadataUpdateParams {
+	var update queries.CreateSAMLConnectionIDPMetadataUpdateParams
+	if next.EntityID != current.EntityID {
+		update.PreviousIdpEntityID = &current.EntityID
+		update.IdpEntityID = &next.EntityID
+	}
+	if next.RedirectURL != current.RedirectURL {
+		update.PreviousIdpRedirectUrl = &current.RedirectURL
+		update.IdpRedirectUrl = &next.RedirectURL
+	}
+	if !bytes.Equal(next.X509Certificate, current.X509Certificate) {
+		update.PreviousIdpX509Certificate = current.X509Certificate
+		update.IdpX509Certificate = next.X509Certificate
+	}
+	return update
+}
+
+func sameIDPMetadataUpdate(qUpdate queries.SamlConnectionIdpMetadataUpdate, update queries.CreateSAMLConnectionIDPMetadataUpdateParams) bool {
+	return derefOrEmpty(qUpdate.PreviousIdpEntityID) == derefOrEmpty(update.PreviousIdpEntityID) &&
+		derefOrEmpty(qUpdate.IdpEntityID) == derefOrEmpty(update.IdpEntityID) &&
+		derefOrEmpty(qUpdate.PreviousIdpRedirectUrl) == derefOrEmpty(update.PreviousIdpRedirectUrl) &&
+		derefOrEmpty(qUpdate.IdpRedirectUrl) == derefOrEmpty(update.IdpRedirectUrl) &&
+		bytes.Equal(qUpdate.PreviousIdpX509Certificate, update.PreviousIdpX509Certificate) &&
+		bytes.Equal(qUpdate.IdpX509Certificate, update.IdpX509Certificate)
+}
+
+func idpMetadata(qSAMLConn queries.SamlConnection) IDPMetadata {
+	return IDPMetadata{
+		EntityID:        derefOrEmpty(qSAMLConn.IdpEntityID),
+		RedirectURL:     derefOrEmpty(qSAMLConn.IdpRedirectUrl),
+		X509Certificate: qSAMLConn.IdpX509Certificate,
+	}
+}
+
+func (m IDPMetadata) equal(other IDPMetadata) bool {
+	return m.EntityID == other.EntityID && m.RedirectURL == other.RedirectURL && bytes.Equal(m.X509Certificate, other.X509Certificate)
+}
+
+func (s *Store) AppListSAMLConnectionIDPMetadataUpdates(ctx context.Context, req *ssoreadyv1.AppListSAMLConnectionIDPMetadataUpdatesRequest) (*ssoreadyv1.AppListSAMLConnectionIDPMetadataUpdatesResponse, error) {
+	_, q, _, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	samlConnID, err := idformat.SAMLConnection.Parse(req.SamlConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	if _, err = q.GetSAMLConnection(ctx, queries.GetSAMLConnectionParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                samlConnID,
+	}); err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	type pageData struct {
+		CreateTime time.Time
+		ID         uuid.UUID
+	}
+
+	var startPageData pageData
+	if err := s.pageEncoder.Unmarshal(req.PageToken, &startPageData); err != nil {
+		return nil, err
+	}
+
+	limit := 10
+	var qUpdates []queries.SamlConnectionIdpMetadataUpdate
+	if req.PageToken == "" {
+		qUpdates, err = q.ListSAMLConnectionIDPMetadataUpdatesFirstPage(ctx, queries.ListSAMLConnectionIDPMetadataUpdatesFirstPageParams{
+			SamlConnectionID: samlConnID,
+			Limit:            int32(limit + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		qUpdates, err = q.ListSAMLConnectionIDPMetadataUpdatesNextPage(ctx, queries.ListSAMLConnectionIDPMetadataUpdatesNextPageParams{
+			SamlConnectionID: samlConnID,
+			Limit:            int32(limit + 1),
+			CreateTime:       startPageData.CreateTime,
+			ID:               startPageData.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var updates []*ssoreadyv1.SAMLConnectionIDPMetadataUpdate
+	for _, qUpdate := range qUpdates {
+		updates = append(updates, parseSAMLConnectionIDPMetadataUpdate(qUpdate))
+	}
+
+	var nextPageToken string
+	if len(updates) == limit+1 {
+		nextPageToken = s.pageEncoder.Marshal(pageData{
+			CreateTime: qUpdates[limit].CreateTime,
+			ID:         qUpdates[limit].ID,
+		})
+		updates = updates[:limit]
+	}
+
+	return &ssoreadyv1.AppListSAMLConnectionIDPMetadataUpdatesResponse{
+		SamlConnectionIdpMetadataUpdates: updates,
+		NextPageToken:                    nextPageToken,
+	}, nil
+}
+
+// AppAcceptSAMLConnectionIDPMetadataUpdate applies a pending IDP metadata
+// update to its SAML connection.
+func (s *Store) AppAcceptSAMLConnectionIDPMetadataUpdate(ctx context.Context, req *ssoreadyv1.AppAcceptSAMLConnectionIDPMetadataUpdateRequest) (*ssoreadyv1.SAMLConnectionIDPMetadataUpdate, error) {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	updateID, err := idformat.SAMLConnectionIDPMetadataUpdate.Parse(req.SamlConnectionIdpMetadataUpdateId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection idp metadata update id: %w", err)
+	}
+
+	// idor check
+	qUpdate, err := q.GetSAMLConnectionIDPMetadataUpdate(ctx, queries.GetSAMLConnectionIDPMetadataUpdateParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                updateID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get saml connection idp metadata update: %w", err)
+	}
+
+	if !qUpdate.Pending {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("idp metadata update is not pending"))
+	}
+
+	qLatestUpdate, err := q.GetLatestSAMLConnectionIDPMetadataUpdate(ctx, qUpdate.SamlConnectionID)
+	if err != nil {
+		return nil, fmt.Errorf("get latest saml connection idp metadata update: %w", err)
+	}
+
+	if qLatestUpdate.ID != qUpdate.ID {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("idp metadata update has been superseded by a newer one"))
+	}
+
+	qSAMLConn, err := q.GetSAMLConnectionForIDPMetadataRefresh(ctx, qUpdate.SamlConnectionID)
+	if err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	// the update is relative to the SAML connection's configuration when it was
+	// recorded; don't apply it over changes made since
+	current := idpMetadata(qSAMLConn)
+	next := applyIDPMetadataUpdate(current, qUpdate)
+	if !sameIDPMetadataUpdate(qUpdate, idpMetadataUpdate(current, next)) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("saml connection has changed since the idp metadata update was recorded"))
+	}
+
+	qSAMLConn, err = q.UpdateSAMLConnectionIDPMetadata(ctx, queries.UpdateSAMLConnectionIDPMetadataParams{
+		ID:                     qSAMLConn.ID,
+		IdpEntityID:            &next.EntityID,
+		IdpRedirectUrl:         &next.RedirectURL,
+		IdpX509Certificate:     next.X509Certificate,
+		IdpMetadataRefreshTime: qSAMLConn.IdpMetadataRefreshTime,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("update saml connection: %w", err)
+	}
+
+	if _, err := s.detectIDPVendor(ctx, q, qSAMLConn); err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
+	now := time.Now()
+	qUpdate, err = q.AcceptSAMLConnectionIDPMetadataUpdate(ctx, queries.AcceptSAMLConnectionIDPMetadataUpdateParams{
+		ID:         qUpdate.ID,
+		AcceptTime: &now,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("accept saml connection idp metadata update: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return parseSAMLConnectionIDPMetadataUpdate(qUpdate), nil
+}
+
+// applyIDPMetadataUpdate returns current with the changes qUpdate records.
+func applyIDPMetadataUpdate(current IDPMetadata, qUpdate queries.SamlConnectionIdpMetadataUpdate) IDPMetadata {
+	next := current
+	if qUpdate.IdpEntityID != nil {
+		next.EntityID = *qUpdate.IdpEntityID
+	}
+	if qUpdate.IdpRedirectUrl != nil {
+		next.RedirectURL = *qUpdate.IdpRedirectUrl
+	}
+	if qUpdate.IdpX509Certificate != nil {
+		next.X509Certificate = qUpdate.IdpX509Certificate
+	}
+	return next
+}
+
+func parseSAMLConnectionIDPMetadataUpdate(qUpdate queries.SamlConnectionIdpMetadataUpdate) *ssoreadyv1.SAMLConnectionIDPMetadataUpdate {
+	return &ssoreadyv1.SAMLConnectionIDPMetadataUpdate{
+		Id:                     idformat.SAMLConnectionIDPMetadataUpdate.Format(qUpdate.ID),
+		SamlConnectionId:       idformat.SAMLConnection.Format(qUpdate.SamlConnectionID),
+		CreateTime:             timestamppb.New(qUpdate.CreateTime),
+		PreviousIdpEntityId:    derefOrEmpty(qUpdate.PreviousIdpEntityID),
+		IdpEntityId:            derefOrEmpty(qUpdate.IdpEntityID),
+		PreviousIdpRedirectUrl: derefOrEmpty(qUpdate.PreviousIdpRedirectUrl),
+		IdpRedirectUrl:         derefOrEmpty(qUpdate.IdpRedirectUrl),
+		PreviousIdpCertificate: certificatePEM(qUpdate.PreviousIdpX509Certificate),
+		IdpCertificate:         certificatePEM(qUpdate.IdpX509Certificate),
+		Pending:                qUpdate.Pending,
+		AcceptTime:             ptrTimeToTimestamp(qUpdate.AcceptTime),
+	}
+}
+
+// certificatePEM returns the PEM encoding of a DER-encoded certificate, or an
+// empty string if there is no certificate.
+func certificatePEM(der []byte) string {
+	if len(der) == 0 {
+		return ""
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: der,
+	}))
+}
@@ -2,17 +2,29 @@ package store
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/pem"
 	"fmt"
 	"log/slog"
+	"math/big"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/ssoready/ssoready/internal/authn"
 	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/samlalgorithm"
 	"github.com/ssoready/ssoready/internal/store/idformat"
 	"github.com/ssoready/ssoready/internal/store/queries"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Store) AppListSAMLConnections(ctx context.Context, req *ssoreadyv1.AppListSAMLConnectionsRequest) (*ssoreadyv1.AppListSAMLConnectionsResponse, error) {
@@ -136,23 +148,86 @@ func (s *Store) AppCreateSAMLConnection(ctx context.Context, req *ssoreadyv1.App
 		idpCert = blk.Bytes
 	}
 
+	signatureAlgorithms, digestAlgorithms, err := parseAllowedAlgorithms(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	idpBinding, err := parseIDPBinding(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	idpMetadataURL, err := parseIDPMetadataURL(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAttributeNames, err := parseEmailAttributeNames(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	attributeMappingPreset, attributeMapping, err := parseAttributeMapping(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	authnRequestOptions, err := parseAuthnRequestOptions(req.SamlConnection.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedClockSkewSeconds, err := parseAllowedClockSkewSeconds(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
 	id := uuid.New()
 	entityID := fmt.Sprintf("%s/v1/saml/%s", authURL, idformat.SAMLConnection.Format(id))
 	acsURL := fmt.Sprintf("%s/v1/saml/%s/acs", authURL, idformat.SAMLConnection.Format(id))
 	qSAMLConn, err := q.CreateSAMLConnection(ctx, queries.CreateSAMLConnectionParams{
-		ID:                 id,
-		OrganizationID:     orgID,
-		IsPrimary:          req.SamlConnection.Primary,
-		SpAcsUrl:           acsURL,
-		SpEntityID:         entityID,
-		IdpEntityID:        &req.SamlConnection.IdpEntityId,
-		IdpRedirectUrl:     &req.SamlConnection.IdpRedirectUrl,
-		IdpX509Certificate: idpCert,
+		ID:                         id,
+		OrganizationID:             orgID,
+		IsPrimary:                  req.SamlConnection.Primary,
+		SpAcsUrl:                   acsURL,
+		SpEntityID:                 entityID,
+		IdpEntityID:                &req.SamlConnection.IdpEntityId,
+		IdpRedirectUrl:             &req.SamlConnection.IdpRedirectUrl,
+		IdpX509Certificate:         idpCert,
+		AllowedSignatureAlgorithms: signatureAlgorithms,
+		AllowedDigestAlgorithms:    digestAlgorithms,
+		SignAuthnRequests:          req.SamlConnection.SignAuthnRequests,
+		IdpBinding:                 idpBinding,
+		AllowIdpInitiated:          req.SamlConnection.AllowIdpInitiated,
+		IdpSloUrl:                  &req.SamlConnection.IdpSloUrl,
+		IdpMetadataUrl:             idpMetadataURL,
+		EmailAttributeNames:        emailAttributeNames,
+		AttributeMappingPreset:     attributeMappingPreset,
+		AttributeMapping:           attributeMapping,
+		AuthnRequestOptions:        authnRequestOptions,
+		AllowedClockSkewSeconds:    allowedClockSkewSeconds,
+		IdpArtifactResolutionUrl:   &req.SamlConnection.IdpArtifactResolutionUrl,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	qSAMLConn, err = s.rotateSPEncryptionKey(ctx, q, qSAMLConn.ID)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp encryption key: %w", err)
+	}
+
+	qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp signing key: %w", err)
+	}
+
+	qSAMLConn, err = s.detectIDPVendor(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
 	if qSAMLConn.IsPrimary {
 		if err := q.UpdatePrimarySAMLConnection(ctx, queries.UpdatePrimarySAMLConnectionParams{
 			OrganizationID: qSAMLConn.OrganizationID,
@@ -201,17 +276,77 @@ func (s *Store) AppUpdateSAMLConnection(ctx context.Context, req *ssoreadyv1.App
 		idpCert = blk.Bytes
 	}
 
+	signatureAlgorithms, digestAlgorithms, err := parseAllowedAlgorithms(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	idpBinding, err := parseIDPBinding(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	idpMetadataURL, err := parseIDPMetadataURL(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAttributeNames, err := parseEmailAttributeNames(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	attributeMappingPreset, attributeMapping, err := parseAttributeMapping(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	authnRequestOptions, err := parseAuthnRequestOptions(req.SamlConnection.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedClockSkewSeconds, err := parseAllowedClockSkewSeconds(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
 	qSAMLConn, err := q.UpdateSAMLConnection(ctx, queries.UpdateSAMLConnectionParams{
-		ID:                 id,
-		IsPrimary:          req.SamlConnection.Primary,
-		IdpEntityID:        &req.SamlConnection.IdpEntityId,
-		IdpRedirectUrl:     &req.SamlConnection.IdpRedirectUrl,
-		IdpX509Certificate: idpCert,
+		ID:                         id,
+		IsPrimary:                  req.SamlConnection.Primary,
+		IdpEntityID:                &req.SamlConnection.IdpEntityId,
+		IdpRedirectUrl:             &req.SamlConnection.IdpRedirectUrl,
+		IdpX509Certificate:         idpCert,
+		AllowedSignatureAlgorithms: signatureAlgorithms,
+		AllowedDigestAlgorithms:    digestAlgorithms,
+		SignAuthnRequests:          req.SamlConnection.SignAuthnRequests,
+		IdpBinding:                 idpBinding,
+		AllowIdpInitiated:          req.SamlConnection.AllowIdpInitiated,
+		IdpSloUrl:                  &req.SamlConnection.IdpSloUrl,
+		IdpMetadataUrl:             idpMetadataURL,
+		EmailAttributeNames:        emailAttributeNames,
+		AttributeMappingPreset:     attributeMappingPreset,
+		AttributeMapping:           attributeMapping,
+		AuthnRequestOptions:        authnRequestOptions,
+		AllowedClockSkewSeconds:    allowedClockSkewSeconds,
+		IdpArtifactResolutionUrl:   &req.SamlConnection.IdpArtifactResolutionUrl,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("update saml connection: %w", err)
 	}
 
+	qSAMLConn, err = s.detectIDPVendor(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
+	if qSAMLConn.SignAuthnRequests {
+		qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+		if err != nil {
+			return nil, fmt.Errorf("generate sp signing key: %w", err)
+		}
+	}
+
 	if qSAMLConn.IsPrimary {
 		if err := q.UpdatePrimarySAMLConnection(ctx, queries.UpdatePrimarySAMLConnectionParams{
 			OrganizationID: qSAMLConn.OrganizationID,
@@ -228,6 +363,73 @@ func (s *Store) AppUpdateSAMLConnection(ctx context.Context, req *ssoreadyv1.App
 	return parseSAMLConnection(qSAMLConn), nil
 }
 
+func (s *Store) AppRotateSAMLConnectionSPCertificate(ctx context.Context, req *ssoreadyv1.AppRotateSAMLConnectionSPCertificateRequest) (*ssoreadyv1.SAMLConnection, error) {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	id, err := idformat.SAMLConnection.Parse(req.SamlConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	if _, err = q.GetSAMLConnection(ctx, queries.GetSAMLConnectionParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                id,
+	}); err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	qSAMLConn, err := s.rotateSPEncryptionKey(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("rotate sp encryption key: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return parseSAMLConnection(qSAMLConn), nil
+}
+
+func (s *Store) AppGetSAMLConnectionSPSigningCertificate(ctx context.Context, req *ssoreadyv1.AppGetSAMLConnectionSPSigningCertificateRequest) (*ssoreadyv1.AppGetSAMLConnectionSPSigningCertificateResponse, error) {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	id, err := idformat.SAMLConnection.Parse(req.SamlConnectionId)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	qSAMLConn, err := q.GetSAMLConnection(ctx, queries.GetSAMLConnectionParams{
+		AppOrganizationID: authn.AppOrgID(ctx),
+		ID:                id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp signing key: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &ssoreadyv1.AppGetSAMLConnectionSPSigningCertificateResponse{
+		Certificate: spSigningCertificatePEM(qSAMLConn),
+	}, nil
+}
+
 func (s *Store) AppDeleteSAMLConnection(ctx context.Context, req *ssoreadyv1.AppDeleteSAMLConnectionRequest) (*emptypb.Empty, error) {
 	_, q, commit, rollback, err := s.tx(ctx)
 	if err != nil {
@@ -269,6 +471,27 @@ func (s *Store) deleteSAMLConnection(ctx context.Context, q *queries.Queries, id
 
 	slog.InfoContext(ctx, "delete_saml_connection", "flows_count", flowsCount)
 
+	idpCertsCount, err := q.DeleteSAMLConnectionIDPCertificatesBySAMLConnectionID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete saml connection idp certificates: %w", err)
+	}
+
+	slog.InfoContext(ctx, "delete_saml_connection", "idp_certs_count", idpCertsCount)
+
+	logoutsCount, err := q.DeleteSAMLLogoutsBySAMLConnectionID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete saml logouts: %w", err)
+	}
+
+	slog.InfoContext(ctx, "delete_saml_connection", "logouts_count", logoutsCount)
+
+	idpMetadataUpdatesCount, err := q.DeleteSAMLConnectionIDPMetadataUpdatesBySAMLConnectionID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("delete saml connection idp metadata updates: %w", err)
+	}
+
+	slog.InfoContext(ctx, "delete_saml_connection", "idp_metadata_updates_count", idpMetadataUpdatesCount)
+
 	samlConnsCount, err := q.DeleteSAMLConnection(ctx, id)
 	if err != nil {
 		return fmt.Errorf("delete saml connection: %w", err)
@@ -278,8 +501,91 @@ func (s *Store) deleteSAMLConnection(ctx context.Context, q *queries.Queries, id
 	return nil
 }
 
+// rotateSPEncryptionKey generates a new keypair IDPs can use to encrypt
+// assertions to a SAML connection. The current private key, if any, is kept as
+// the previous key, so that assertions from IDPs that have not yet picked up
+// the new certificate can still be decrypted.
+func (s *Store) rotateSPEncryptionKey(ctx context.Context, q *queries.Queries, id uuid.UUID) (queries.SamlConnection, error) {
+	keyDER, certDER, err := generateSPKeyPair(id, x509.KeyUsageKeyEncipherment)
+	if err != nil {
+		return queries.SamlConnection{}, err
+	}
+
+	qSAMLConn, err := q.UpdateSAMLConnectionSPEncryptionKey(ctx, queries.UpdateSAMLConnectionSPEncryptionKeyParams{
+		SpEncryptionPrivateKey:  keyDER,
+		SpEncryptionCertificate: certDER,
+		ID:                      id,
+	})
+	if err != nil {
+		return queries.SamlConnection{}, fmt.Errorf("update sp encryption key: %w", err)
+	}
+
+	return qSAMLConn, nil
+}
+
+// ensureSPSigningKey generates the keypair a SAML connection signs its
+// AuthnRequests with, unless it already has one. SAML connections created
+// before SP signing keys were introduced get one the first time it's needed.
+func (s *Store) ensureSPSigningKey(ctx context.Context, q *queries.Queries, qSAMLConn queries.SamlConnection) (queries.SamlConnection, error) {
+	if len(qSAMLConn.SpSigningCertificate) != 0 {
+		return qSAMLConn, nil
+	}
+
+	keyDER, certDER, err := generateSPKeyPair(qSAMLConn.ID, x509.KeyUsageDigitalSignature)
+	if err != nil {
+		return queries.SamlConnection{}, err
+	}
+
+	qSAMLConn, err = q.UpdateSAMLConnectionSPSigningKey(ctx, queries.UpdateSAMLConnectionSPSigningKeyParams{
+		SpSigningPrivateKey:  keyDER,
+		SpSigningCertificate: certDER,
+		ID:                   qSAMLConn.ID,
+	})
+	if err != nil {
+		return queries.SamlConnection{}, fmt.Errorf("update sp signing key: %w", err)
+	}
+
+	return qSAMLConn, nil
+}
+
+// generateSPKeyPair returns a new PKCS#8 RSA private key and a self-signed
+// certificate for it, both DER-encoded.
+func generateSPKeyPair(id uuid.UUID, keyUsage x509.KeyUsage) ([]byte, []byte, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate key: %w", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal key: %w", err)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("generate serial number: %w", err)
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject:      pkix.Name{CommonName: idformat.SAMLConnection.Format(id)},
+		NotBefore:    now,
+		NotAfter:     now.AddDate(10, 0, 0),
+		KeyUsage:     keyUsage,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("create certificate: %w", err)
+	}
+
+	return keyDER, certDER, nil
+}
+
 func parseSAMLConnection(qSAMLConn queries.SamlConnection) *ssoreadyv1.SAMLConnection {
-	var certPEM string
+	var certPEM, certSubject, certIssuer string
+	var certNotBefore, certNotAfter *timestamppb.Timestamp
 	if len(qSAMLConn.IdpX509Certificate) != 0 {
 		cert, err := x509.ParseCertificate(qSAMLConn.IdpX509Certificate)
 		if err != nil {
@@ -290,16 +596,161 @@ func parseSAMLConnection(qSAMLConn queries.SamlConnection) *ssoreadyv1.SAMLConne
 			Type:  "CERTIFICATE",
 			Bytes: cert.Raw,
 		}))
+		certSubject = cert.Subject.String()
+		certIssuer = cert.Issuer.String()
+		certNotBefore = timestamppb.New(cert.NotBefore)
+		certNotAfter = timestamppb.New(cert.NotAfter)
+	}
+
+	var spCertPEM string
+	if len(qSAMLConn.SpEncryptionCertificate) != 0 {
+		spCertPEM = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: qSAMLConn.SpEncryptionCertificate,
+		}))
 	}
 
+	signatureAlgorithms := samlalgorithm.FormatSignatureAlgorithms(qSAMLConn.AllowedSignatureAlgorithms)
+	digestAlgorithms := samlalgorithm.FormatDigestAlgorithms(qSAMLConn.AllowedDigestAlgorithms)
+
+	var idpBinding ssoreadyv1.SAMLBinding
+	for binding, bindingURI := range samlBindings {
+		if qSAMLConn.IdpBinding != nil && *qSAMLConn.IdpBinding == bindingURI {
+			idpBinding = binding
+		}
+	}
+
+	attributeMappingPreset, attributeMapping := samlConnectionAttributeMapping(qSAMLConn)
+
 	return &ssoreadyv1.SAMLConnection{
-		Id:             idformat.SAMLConnection.Format(qSAMLConn.ID),
-		OrganizationId: idformat.Organization.Format(qSAMLConn.OrganizationID),
-		Primary:        qSAMLConn.IsPrimary,
-		IdpRedirectUrl: derefOrEmpty(qSAMLConn.IdpRedirectUrl),
-		IdpCertificate: certPEM,
-		IdpEntityId:    derefOrEmpty(qSAMLConn.IdpEntityID),
-		SpEntityId:     qSAMLConn.SpEntityID,
-		SpAcsUrl:       qSAMLConn.SpAcsUrl,
+		Id:                         idformat.SAMLConnection.Format(qSAMLConn.ID),
+		OrganizationId:             idformat.Organization.Format(qSAMLConn.OrganizationID),
+		Primary:                    qSAMLConn.IsPrimary,
+		IdpRedirectUrl:             derefOrEmpty(qSAMLConn.IdpRedirectUrl),
+		IdpCertificate:             certPEM,
+		IdpEntityId:                derefOrEmpty(qSAMLConn.IdpEntityID),
+		SpEntityId:                 qSAMLConn.SpEntityID,
+		SpAcsUrl:                   qSAMLConn.SpAcsUrl,
+		SpMetadataUrl:              spMetadataURL(qSAMLConn.SpAcsUrl),
+		AllowedSignatureAlgorithms: signatureAlgorithms,
+		AllowedDigestAlgorithms:    digestAlgorithms,
+		SpCertificate:              spCertPEM,
+		SignAuthnRequests:          qSAMLConn.SignAuthnRequests,
+		IdpBinding:                 idpBinding,
+		AllowIdpInitiated:          qSAMLConn.AllowIdpInitiated,
+		IdpSloUrl:                  derefOrEmpty(qSAMLConn.IdpSloUrl),
+		SpSloUrl:                   spSLOURL(qSAMLConn.SpAcsUrl),
+		IdpMetadataUrl:             derefOrEmpty(qSAMLConn.IdpMetadataUrl),
+		IdpMetadataRefreshTime:     ptrTimeToTimestamp(qSAMLConn.IdpMetadataRefreshTime),
+		IdpMetadataRefreshError:    derefOrEmpty(qSAMLConn.IdpMetadataRefreshError),
+		IdpCertificateSubject:      certSubject,
+		IdpCertificateIssuer:       certIssuer,
+		IdpCertificateNotBefore:    certNotBefore,
+		IdpCertificateNotAfter:     certNotAfter,
+		EmailAttributeNames:        qSAMLConn.EmailAttributeNames,
+		AttributeMappingPreset:     attributeMappingPreset,
+		AttributeMapping:           attributeMapping,
+		AuthnRequestOptions:        samlAuthnRequestOptions(qSAMLConn.AuthnRequestOptions),
+		AllowedClockSkewSeconds:    qSAMLConn.AllowedClockSkewSeconds,
+		IdpArtifactResolutionUrl:   derefOrEmpty(qSAMLConn.IdpArtifactResolutionUrl),
+		IdpVendor:                  samlIDPVendor(qSAMLConn.IdpVendor),
+	}
+}
+
+// spMetadataURL returns the URL the auth service serves a SAML connection's
+// metadata from, alongside its ACS URL.
+func spMetadataURL(acsURL string) string {
+	return strings.TrimSuffix(acsURL, "/acs") + "/metadata"
+}
+
+// spSLOURL returns the URL the auth service receives a SAML connection's
+// logout messages at, alongside its ACS URL.
+func spSLOURL(acsURL string) string {
+	return strings.TrimSuffix(acsURL, "/acs") + "/slo"
+}
+
+func spSigningCertificatePEM(qSAMLConn queries.SamlConnection) string {
+	return string(pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: qSAMLConn.SpSigningCertificate,
+	}))
+}
+
+// parseAllowedAlgorithms converts the algorithm allowlists on a SAMLConnection
+// into the algorithm identifiers stored in the database. The returned slices
+// are never nil, because the underlying columns are not nullable.
+func parseAllowedAlgorithms(samlConn *ssoreadyv1.SAMLConnection) ([]string, []string, error) {
+	signatureAlgorithms, digestAlgorithms, err := samlalgorithm.Parse(samlConn)
+	if err != nil {
+		return nil, nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return signatureAlgorithms, digestAlgorithms, nil
+}
+
+var samlBindings = map[ssoreadyv1.SAMLBinding]string{
+	ssoreadyv1.SAMLBinding_SAML_BINDING_HTTP_POST:     saml.BindingHTTPPost,
+	ssoreadyv1.SAMLBinding_SAML_BINDING_HTTP_REDIRECT: saml.BindingHTTPRedirect,
+}
+
+// parseIDPBinding converts the binding on a SAMLConnection into the binding
+// identifier stored in the database. An unspecified binding is stored as NULL,
+// which is treated as HTTP-POST.
+func parseIDPBinding(samlConn *ssoreadyv1.SAMLConnection) (*string, error) {
+	if samlConn.IdpBinding == ssoreadyv1.SAMLBinding_SAML_BINDING_UNSPECIFIED {
+		return nil, nil
+	}
+
+	uri, ok := samlBindings[samlConn.IdpBinding]
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported idp binding: %s", samlConn.IdpBinding))
+	}
+
+	return &uri, nil
+}
+
+// parseIDPMetadataURL validates the IDP metadata URL on a SAMLConnection. An
+// empty URL is stored as NULL, which turns off IDP metadata refreshes.
+//
+// The URL must be https, because IDP metadata refreshes trust whatever
+// certificates it serves.
+func parseIDPMetadataURL(samlConn *ssoreadyv1.SAMLConnection) (*string, error) {
+	if samlConn.IdpMetadataUrl == "" {
+		return nil, nil
+	}
+
+	u, err := url.Parse(samlConn.IdpMetadataUrl)
+	if err != nil || u.Scheme != "https" || u.Host == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("idp metadata url must be an absolute https url"))
+	}
+
+	return &samlConn.IdpMetadataUrl, nil
+}
+
+// parseEmailAttributeNames validates the email attribute names on a
+// SAMLConnection. The returned slice is never nil, because the underlying
+// column is not nullable.
+func parseEmailAttributeNames(samlConn *ssoreadyv1.SAMLConnection) ([]string, error) {
+	emailAttributeNames := []string{}
+	for _, name := range samlConn.EmailAttributeNames {
+		if strings.TrimSpace(name) == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("email attribute names must not be empty"))
+		}
+		if !slices.Contains(emailAttributeNames, name) {
+			emailAttributeNames = append(emailAttributeNames, name)
+		}
+	}
+
+	return emailAttributeNames, nil
+}
+
+// maxAllowedClockSkewSeconds is the most clock skew a SAML connection may
+// tolerate. Much more than this would let assertions be replayed long after
+// the IDP meant them to expire.
+const maxAllowedClockSkewSeconds = 600
+
+func parseAllowedClockSkewSeconds(samlConn *ssoreadyv1.SAMLConnection) (int32, error) {
+	if samlConn.AllowedClockSkewSeconds < 0 || samlConn.AllowedClockSkewSeconds > maxAllowedClockSkewSeconds {
+		return 0, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("allowed_clock_skew_seconds must be between 0 and %d", maxAllowedClockSkewSeconds))
 	}
+	return samlConn.AllowedClockSkewSeconds, nil
 }
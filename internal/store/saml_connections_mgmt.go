@@ -5,12 +5,17 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"slices"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/google/uuid"
 	"github.com/ssoready/ssoready/internal/authn"
 	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
 	"github.com/ssoready/ssoready/internal/store/idformat"
 	"github.com/ssoready/ssoready/internal/store/queries"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *Store) ListSAMLConnections(ctx context.Context, req *ssoreadyv1.ListSAMLConnectionsRequest) (*ssoreadyv1.ListSAMLConnectionsResponse, error) {
@@ -70,6 +75,142 @@ func (s *Store) ListSAMLConnections(ctx context.Context, req *ssoreadyv1.ListSAM
 	}, nil
 }
 
+func (s *Store) ListExpiringSAMLConnections(ctx context.Context, req *ssoreadyv1.ListExpiringSAMLConnectionsRequest) (*ssoreadyv1.ListExpiringSAMLConnectionsResponse, error) {
+	_, q, _, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	envID, err := idformat.Environment.Parse(authn.FullContextData(ctx).APIKey.EnvID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExpiresWithinDays < 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("expires_within_days must not be negative"))
+	}
+
+	expiresWithinDays := req.ExpiresWithinDays
+	if expiresWithinDays == 0 {
+		expiresWithinDays = 30
+	}
+
+	// certificates are stored DER-encoded, so their expiration can only be
+	// checked once they are parsed
+	qSAMLConns, err := q.ListSAMLConnectionsWithIDPCertificateByEnvironmentID(ctx, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	qIDPCerts, err := q.ListSAMLConnectionIDPCertificatesByEnvironmentID(ctx, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	idpCerts := map[uuid.UUID][]queries.SamlConnectionIdpCertificate{}
+	for _, qIDPCert := range qIDPCerts {
+		idpCerts[qIDPCert.SamlConnectionID] = append(idpCerts[qIDPCert.SamlConnectionID], qIDPCert)
+	}
+
+	cutoff := time.Now().AddDate(0, 0, int(expiresWithinDays))
+
+	var samlConns []*ssoreadyv1.SAMLConnection
+	var expiringCerts []*ssoreadyv1.ExpiringIDPCertificate
+	for _, qSAMLConn := range qSAMLConns {
+		samlConn := parseSAMLConnection(qSAMLConn)
+
+		var samlConnExpiringCerts []*ssoreadyv1.ExpiringIDPCertificate
+		expireTime := samlConn.IdpCertificateNotAfter.AsTime()
+		if expireTime.Before(cutoff) && !hasIDPCertificateReplacement(idpCerts[qSAMLConn.ID], expireTime, cutoff) {
+			samlConnExpiringCerts = append(samlConnExpiringCerts, &ssoreadyv1.ExpiringIDPCertificate{
+				SamlConnectionId: samlConn.Id,
+				Certificate:      samlConn.IdpCertificate,
+				ExpireTime:       samlConn.IdpCertificateNotAfter,
+			})
+		}
+
+		for _, qIDPCert := range idpCerts[qSAMLConn.ID] {
+			_, expireTime, err := idpCertificateTrustWindow(qIDPCert)
+			if err != nil {
+				return nil, err
+			}
+
+			if expireTime.Before(cutoff) {
+				idpCert := parseSAMLConnectionIDPCertificate(qIDPCert)
+				samlConnExpiringCerts = append(samlConnExpiringCerts, &ssoreadyv1.ExpiringIDPCertificate{
+					SamlConnectionId:               samlConn.Id,
+					SamlConnectionIdpCertificateId: idpCert.Id,
+					Certificate:                    idpCert.Certificate,
+					ExpireTime:                     timestamppb.New(expireTime),
+				})
+			}
+		}
+
+		if len(samlConnExpiringCerts) > 0 {
+			samlConns = append(samlConns, samlConn)
+			expiringCerts = append(expiringCerts, samlConnExpiringCerts...)
+		}
+	}
+
+	slices.SortStableFunc(expiringCerts, func(a, b *ssoreadyv1.ExpiringIDPCertificate) int {
+		return a.ExpireTime.AsTime().Compare(b.ExpireTime.AsTime())
+	})
+
+	// order connections by their soonest-expiring certificate
+	samlConnOrder := map[string]int{}
+	for i, expiringCert := range expiringCerts {
+		if _, ok := samlConnOrder[expiringCert.SamlConnectionId]; !ok {
+			samlConnOrder[expiringCert.SamlConnectionId] = i
+		}
+	}
+
+	slices.SortFunc(samlConns, func(a, b *ssoreadyv1.SAMLConnection) int {
+		return samlConnOrder[a.Id] - samlConnOrder[b.Id]
+	})
+
+	return &ssoreadyv1.ListExpiringSAMLConnectionsResponse{
+		SamlConnections:         samlConns,
+		ExpiringIdpCertificates: expiringCerts,
+	}, nil
+}
+
+// hasIDPCertificateReplacement reports whether any of a SAML connection's
+// additional IDP certificates is trusted by the time its primary IDP
+// certificate expires, and stays trusted past cutoff.
+func hasIDPCertificateReplacement(qIDPCerts []queries.SamlConnectionIdpCertificate, expireTime, cutoff time.Time) bool {
+	for _, qIDPCert := range qIDPCerts {
+		startTime, endTime, err := idpCertificateTrustWindow(qIDPCert)
+		if err != nil {
+			continue
+		}
+
+		if !startTime.After(expireTime) && !endTime.Before(cutoff) {
+			return true
+		}
+	}
+	return false
+}
+
+// idpCertificateTrustWindow returns when an additional IDP certificate starts
+// and stops being trusted: its validity period, narrowed by its not_before and
+// not_after if they are set.
+func idpCertificateTrustWindow(qIDPCert queries.SamlConnectionIdpCertificate) (time.Time, time.Time, error) {
+	cert, err := x509.ParseCertificate(qIDPCert.X509Certificate)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("parse idp certificate: %w", err)
+	}
+
+	startTime, endTime := cert.NotBefore, cert.NotAfter
+	if qIDPCert.NotBefore != nil && qIDPCert.NotBefore.After(startTime) {
+		startTime = *qIDPCert.NotBefore
+	}
+	if qIDPCert.NotAfter != nil && qIDPCert.NotAfter.Before(endTime) {
+		endTime = *qIDPCert.NotAfter
+	}
+	return startTime, endTime, nil
+}
+
 func (s *Store) GetSAMLConnection(ctx context.Context, req *ssoreadyv1.GetSAMLConnectionRequest) (*ssoreadyv1.GetSAMLConnectionResponse, error) {
 	envID, err := idformat.Environment.Parse(authn.FullContextData(ctx).APIKey.EnvID)
 	if err != nil {
@@ -145,23 +286,86 @@ func (s *Store) CreateSAMLConnection(ctx context.Context, req *ssoreadyv1.Create
 		idpCert = blk.Bytes
 	}
 
+	signatureAlgorithms, digestAlgorithms, err := parseAllowedAlgorithms(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	idpBinding, err := parseIDPBinding(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	idpMetadataURL, err := parseIDPMetadataURL(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	emailAttributeNames, err := parseEmailAttributeNames(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	attributeMappingPreset, attributeMapping, err := parseAttributeMapping(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
+	authnRequestOptions, err := parseAuthnRequestOptions(req.SamlConnection.AuthnRequestOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedClockSkewSeconds, err := parseAllowedClockSkewSeconds(req.SamlConnection)
+	if err != nil {
+		return nil, err
+	}
+
 	id := uuid.New()
 	entityID := fmt.Sprintf("%s/v1/saml/%s", authURL, idformat.SAMLConnection.Format(id))
 	acsURL := fmt.Sprintf("%s/v1/saml/%s/acs", authURL, idformat.SAMLConnection.Format(id))
 	qSAMLConn, err := q.CreateSAMLConnection(ctx, queries.CreateSAMLConnectionParams{
-		ID:                 id,
-		OrganizationID:     orgID,
-		IsPrimary:          req.SamlConnection.Primary,
-		SpAcsUrl:           acsURL,
-		SpEntityID:         entityID,
-		IdpEntityID:        &req.SamlConnection.IdpEntityId,
-		IdpRedirectUrl:     &req.SamlConnection.IdpRedirectUrl,
-		IdpX509Certificate: idpCert,
+		ID:                         id,
+		OrganizationID:             orgID,
+		IsPrimary:                  req.SamlConnection.Primary,
+		SpAcsUrl:                   acsURL,
+		SpEntityID:                 entityID,
+		IdpEntityID:                &req.SamlConnection.IdpEntityId,
+		IdpRedirectUrl:             &req.SamlConnection.IdpRedirectUrl,
+		IdpX509Certificate:         idpCert,
+		AllowedSignatureAlgorithms: signatureAlgorithms,
+		AllowedDigestAlgorithms:    digestAlgorithms,
+		SignAuthnRequests:          req.SamlConnection.SignAuthnRequests,
+		IdpBinding:                 idpBinding,
+		AllowIdpInitiated:          req.SamlConnection.AllowIdpInitiated,
+		IdpSloUrl:                  &req.SamlConnection.IdpSloUrl,
+		IdpMetadataUrl:             idpMetadataURL,
+		EmailAttributeNames:        emailAttributeNames,
+		AttributeMappingPreset:     attributeMappingPreset,
+		AttributeMapping:           attributeMapping,
+		AuthnRequestOptions:        authnRequestOptions,
+		AllowedClockSkewSeconds:    allowedClockSkewSeconds,
+		IdpArtifactResolutionUrl:   &req.SamlConnection.IdpArtifactResolutionUrl,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	qSAMLConn, err = s.rotateSPEncryptionKey(ctx, q, qSAMLConn.ID)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp encryption key: %w", err)
+	}
+
+	qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp signing key: %w", err)
+	}
+
+	qSAMLConn, err = s.detectIDPVendor(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
 	if qSAMLConn.IsPrimary {
 		if err := q.UpdatePrimarySAMLConnection(ctx, queries.UpdatePrimarySAMLConnectionParams{
 			OrganizationID: qSAMLConn.OrganizationID,
@@ -196,36 +400,166 @@ func (s *Store) UpdateSAMLConnection(ctx context.Context, req *ssoreadyv1.Update
 	}
 
 	// idor check
-	if _, err = q.ManagementGetSAMLConnection(ctx, queries.ManagementGetSAMLConnectionParams{
+	qSAMLConn, err := q.ManagementGetSAMLConnection(ctx, queries.ManagementGetSAMLConnectionParams{
 		EnvironmentID: envID,
 		ID:            id,
-	}); err != nil {
+	})
+	if err != nil {
 		return nil, fmt.Errorf("get saml connection: %w", err)
 	}
 
-	var idpCert []byte
-	if req.SamlConnection.IdpCertificate != "" {
-		blk, _ := pem.Decode([]byte(req.SamlConnection.IdpCertificate))
-		if blk == nil || blk.Type != "CERTIFICATE" {
-			return nil, fmt.Errorf("idp certificate must be a PEM-encoded CERTIFICATE block")
+	update, err := samlConnectionUpdateFields(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := queries.UpdateSAMLConnectionParams{
+		ID:                         id,
+		IsPrimary:                  qSAMLConn.IsPrimary,
+		IdpEntityID:                qSAMLConn.IdpEntityID,
+		IdpRedirectUrl:             qSAMLConn.IdpRedirectUrl,
+		IdpX509Certificate:         qSAMLConn.IdpX509Certificate,
+		AllowedSignatureAlgorithms: qSAMLConn.AllowedSignatureAlgorithms,
+		AllowedDigestAlgorithms:    qSAMLConn.AllowedDigestAlgorithms,
+		SignAuthnRequests:          qSAMLConn.SignAuthnRequests,
+		IdpBinding:                 qSAMLConn.IdpBinding,
+		AllowIdpInitiated:          qSAMLConn.AllowIdpInitiated,
+		IdpSloUrl:                  qSAMLConn.IdpSloUrl,
+		IdpMetadataUrl:             qSAMLConn.IdpMetadataUrl,
+		EmailAttributeNames:        qSAMLConn.EmailAttributeNames,
+		AttributeMappingPreset:     qSAMLConn.AttributeMappingPreset,
+		AttributeMapping:           qSAMLConn.AttributeMapping,
+		AuthnRequestOptions:        qSAMLConn.AuthnRequestOptions,
+		AllowedClockSkewSeconds:    qSAMLConn.AllowedClockSkewSeconds,
+		IdpArtifactResolutionUrl:   qSAMLConn.IdpArtifactResolutionUrl,
+	}
+
+	if update("primary") {
+		params.IsPrimary = req.SamlConnection.Primary
+	}
+
+	if update("idp_entity_id") {
+		params.IdpEntityID = &req.SamlConnection.IdpEntityId
+	}
+
+	if update("idp_redirect_url") {
+		params.IdpRedirectUrl = &req.SamlConnection.IdpRedirectUrl
+	}
+
+	if update("idp_certificate") {
+		var idpCert []byte
+		if req.SamlConnection.IdpCertificate != "" {
+			blk, _ := pem.Decode([]byte(req.SamlConnection.IdpCertificate))
+			if blk == nil || blk.Type != "CERTIFICATE" {
+				return nil, fmt.Errorf("idp certificate must be a PEM-encoded CERTIFICATE block")
+			}
+			if _, err := x509.ParseCertificate(blk.Bytes); err != nil {
+				return nil, fmt.Errorf("parse idp certificate: %w", err)
+			}
+			idpCert = blk.Bytes
 		}
-		if _, err := x509.ParseCertificate(blk.Bytes); err != nil {
-			return nil, fmt.Errorf("parse idp certificate: %w", err)
+		params.IdpX509Certificate = idpCert
+	}
+
+	if update("allowed_signature_algorithms") || update("allowed_digest_algorithms") {
+		signatureAlgorithms, digestAlgorithms, err := parseAllowedAlgorithms(req.SamlConnection)
+		if err != nil {
+			return nil, err
+		}
+		if update("allowed_signature_algorithms") {
+			params.AllowedSignatureAlgorithms = signatureAlgorithms
+		}
+		if update("allowed_digest_algorithms") {
+			params.AllowedDigestAlgorithms = digestAlgorithms
 		}
-		idpCert = blk.Bytes
 	}
 
-	qSAMLConn, err := q.UpdateSAMLConnection(ctx, queries.UpdateSAMLConnectionParams{
-		ID:                 id,
-		IsPrimary:          req.SamlConnection.Primary,
-		IdpEntityID:        &req.SamlConnection.IdpEntityId,
-		IdpRedirectUrl:     &req.SamlConnection.IdpRedirectUrl,
-		IdpX509Certificate: idpCert,
-	})
+	if update("sign_authn_requests") {
+		params.SignAuthnRequests = req.SamlConnection.SignAuthnRequests
+	}
+
+	if update("idp_binding") {
+		idpBinding, err := parseIDPBinding(req.SamlConnection)
+		if err != nil {
+			return nil, err
+		}
+		params.IdpBinding = idpBinding
+	}
+
+	if update("allow_idp_initiated") {
+		params.AllowIdpInitiated = req.SamlConnection.AllowIdpInitiated
+	}
+
+	if update("idp_slo_url") {
+		params.IdpSloUrl = &req.SamlConnection.IdpSloUrl
+	}
+
+	if update("idp_metadata_url") {
+		idpMetadataURL, err := parseIDPMetadataURL(req.SamlConnection)
+		if err != nil {
+			return nil, err
+		}
+		params.IdpMetadataUrl = idpMetadataURL
+	}
+
+	if update("email_attribute_names") {
+		emailAttributeNames, err := parseEmailAttributeNames(req.SamlConnection)
+		if err != nil {
+			return nil, err
+		}
+		params.EmailAttributeNames = emailAttributeNames
+	}
+
+	if update("attribute_mapping_preset") || update("attribute_mapping") {
+		attributeMappingPreset, attributeMapping, err := parseAttributeMapping(req.SamlConnection)
+		if err != nil {
+			return nil, err
+		}
+		if update("attribute_mapping_preset") {
+			params.AttributeMappingPreset = attributeMappingPreset
+		}
+		if update("attribute_mapping") {
+			params.AttributeMapping = attributeMapping
+		}
+	}
+
+	if update("authn_request_options") {
+		authnRequestOptions, err := parseAuthnRequestOptions(req.SamlConnection.AuthnRequestOptions)
+		if err != nil {
+			return nil, err
+		}
+		params.AuthnRequestOptions = authnRequestOptions
+	}
+
+	if update("allowed_clock_skew_seconds") {
+		allowedClockSkewSeconds, err := parseAllowedClockSkewSeconds(req.SamlConnection)
+		if err != nil {
+			return nil, err
+		}
+		params.AllowedClockSkewSeconds = allowedClockSkewSeconds
+	}
+
+	if update("idp_artifact_resolution_url") {
+		params.IdpArtifactResolutionUrl = &req.SamlConnection.IdpArtifactResolutionUrl
+	}
+
+	qSAMLConn, err = q.UpdateSAMLConnection(ctx, params)
 	if err != nil {
 		return nil, fmt.Errorf("update saml connection: %w", err)
 	}
 
+	qSAMLConn, err = s.detectIDPVendor(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("detect idp vendor: %w", err)
+	}
+
+	if qSAMLConn.SignAuthnRequests {
+		qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+		if err != nil {
+			return nil, fmt.Errorf("generate sp signing key: %w", err)
+		}
+	}
+
 	if qSAMLConn.IsPrimary {
 		if err := q.UpdatePrimarySAMLConnection(ctx, queries.UpdatePrimarySAMLConnectionParams{
 			OrganizationID: qSAMLConn.OrganizationID,
@@ -241,3 +575,107 @@ func (s *Store) UpdateSAMLConnection(ctx context.Context, req *ssoreadyv1.Update
 
 	return &ssoreadyv1.UpdateSAMLConnectionResponse{SamlConnection: parseSAMLConnection(qSAMLConn)}, nil
 }
+
+// alwaysUpdatedSAMLConnectionFields are the SAMLConnection fields that
+// UpdateSAMLConnection overwrites when the request has no update mask, even if
+// the request leaves them empty. These are the fields UpdateSAMLConnection has
+// always overwritten.
+var alwaysUpdatedSAMLConnectionFields = []string{"primary", "idp_redirect_url", "idp_certificate", "idp_entity_id"}
+
+// samlConnectionUpdateFields returns whether an UpdateSAMLConnection request
+// updates a SAMLConnection field, identified by its proto name. See
+// UpdateSAMLConnectionRequest.update_mask.
+func samlConnectionUpdateFields(req *ssoreadyv1.UpdateSAMLConnectionRequest) (func(string) bool, error) {
+	if req.UpdateMask != nil {
+		if !req.UpdateMask.IsValid(&ssoreadyv1.SAMLConnection{}) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("update_mask must only contain saml connection fields"))
+		}
+
+		return func(name string) bool {
+			return slices.Contains(req.UpdateMask.Paths, name)
+		}, nil
+	}
+
+	samlConn := req.SamlConnection.ProtoReflect()
+	return func(name string) bool {
+		if slices.Contains(alwaysUpdatedSAMLConnectionFields, name) {
+			return true
+		}
+		return samlConn.Has(samlConn.Descriptor().Fields().ByName(protoreflect.Name(name)))
+	}, nil
+}
+
+func (s *Store) RotateSAMLConnectionSPCertificate(ctx context.Context, req *ssoreadyv1.RotateSAMLConnectionSPCertificateRequest) (*ssoreadyv1.RotateSAMLConnectionSPCertificateResponse, error) {
+	envID, err := idformat.Environment.Parse(authn.FullContextData(ctx).APIKey.EnvID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	id, err := idformat.SAMLConnection.Parse(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	if _, err = q.ManagementGetSAMLConnection(ctx, queries.ManagementGetSAMLConnectionParams{
+		EnvironmentID: envID,
+		ID:            id,
+	}); err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	qSAMLConn, err := s.rotateSPEncryptionKey(ctx, q, id)
+	if err != nil {
+		return nil, fmt.Errorf("rotate sp encryption key: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &ssoreadyv1.RotateSAMLConnectionSPCertificateResponse{SamlConnection: parseSAMLConnection(qSAMLConn)}, nil
+}
+
+func (s *Store) GetSAMLConnectionSPSigningCertificate(ctx context.Context, req *ssoreadyv1.GetSAMLConnectionSPSigningCertificateRequest) (*ssoreadyv1.GetSAMLConnectionSPSigningCertificateResponse, error) {
+	envID, err := idformat.Environment.Parse(authn.FullContextData(ctx).APIKey.EnvID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	id, err := idformat.SAMLConnection.Parse(req.Id)
+	if err != nil {
+		return nil, fmt.Errorf("parse saml connection id: %w", err)
+	}
+
+	// idor check
+	qSAMLConn, err := q.ManagementGetSAMLConnection(ctx, queries.ManagementGetSAMLConnectionParams{
+		EnvironmentID: envID,
+		ID:            id,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get saml connection: %w", err)
+	}
+
+	qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+	if err != nil {
+		return nil, fmt.Errorf("generate sp signing key: %w", err)
+	}
+
+	if err := commit(); err != nil {
+		return nil, fmt.Errorf("commit: %w", err)
+	}
+
+	return &ssoreadyv1.GetSAMLConnectionSPSigningCertificateResponse{Certificate: spSigningCertificatePEM(qSAMLConn)}, nil
+}
@@ -112,11 +112,14 @@ func (s *Store) AppGetSAMLFlow(ctx context.Context, req *ssoreadyv1.AppGetSAMLFl
 }
 
 func parseSAMLFlow(qSAMLFlow queries.SamlFlow) *ssoreadyv1.SAMLFlow {
-	var attrs map[string]string
-	if len(qSAMLFlow.SubjectIdpAttributes) != 0 {
-		if err := json.Unmarshal(qSAMLFlow.SubjectIdpAttributes, &attrs); err != nil {
-			panic(err)
-		}
+	attrs, err := parseSubjectIDPAttributes(qSAMLFlow.SubjectIdpAttributes)
+	if err != nil {
+		panic(err)
+	}
+
+	profile, err := parseSubjectProfile(qSAMLFlow.SubjectProfile)
+	if err != nil {
+		panic(err)
 	}
 
 	var status ssoreadyv1.SAMLFlowStatus
@@ -135,7 +138,11 @@ func parseSAMLFlow(qSAMLFlow queries.SamlFlow) *ssoreadyv1.SAMLFlow {
 		Status:               status,
 		State:                qSAMLFlow.State,
 		Email:                derefOrEmpty(qSAMLFlow.Email),
-		Attributes:           attrs,
+		SubjectId:            derefOrEmpty(qSAMLFlow.SubjectID),
+		SubjectIdFormat:      derefOrEmpty(qSAMLFlow.SubjectIDFormat),
+		Attributes:           firstAttributeValues(attrs),
+		AttributeValues:      samlAttributeValues(attrs),
+		Profile:              profile,
 		CreateTime:           timestamppb.New(qSAMLFlow.CreateTime),
 		UpdateTime:           timestamppb.New(qSAMLFlow.UpdateTime),
 		AuthRedirectUrl:      derefOrEmpty(qSAMLFlow.AuthRedirectUrl),
@@ -145,8 +152,23 @@ func parseSAMLFlow(qSAMLFlow queries.SamlFlow) *ssoreadyv1.SAMLFlow {
 		Assertion:            derefOrEmpty(qSAMLFlow.Assertion),
 		AppRedirectUrl:       derefOrEmpty(qSAMLFlow.AppRedirectUrl),
 		ReceiveAssertionTime: ptrTimeToTimestamp(qSAMLFlow.ReceiveAssertionTime),
+		IdpInitiated:         qSAMLFlow.IsIdpInitiated,
+		SessionIndex:         derefOrEmpty(qSAMLFlow.SubjectSessionIndex),
+		AuthnInstant:         ptrTimeToTimestamp(qSAMLFlow.SubjectAuthnInstant),
+		SessionNotOnOrAfter:  ptrTimeToTimestamp(qSAMLFlow.SubjectSessionNotOnOrAfter),
+		AuthnContextClassRef: derefOrEmpty(qSAMLFlow.SubjectAuthnContextClassRef),
 		RedeemTime:           ptrTimeToTimestamp(qSAMLFlow.RedeemTime),
 		RedeemResponse:       string(qSAMLFlow.RedeemResponse),
+		ResponseSigned:       qSAMLFlow.ResponseSigned,
+		AssertionSigned:      qSAMLFlow.AssertionSigned,
+		IdpVendor:            samlIDPVendor(qSAMLFlow.IdpVendor),
+	}
+
+	if qSAMLFlow.IdpX509Certificate != nil {
+		res.IdpCertificate = string(pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: qSAMLFlow.IdpX509Certificate,
+		}))
 	}
 
 	if qSAMLFlow.ErrorSamlConnectionNotConfigured {
@@ -189,6 +211,104 @@ func parseSAMLFlow(qSAMLFlow queries.SamlFlow) *ssoreadyv1.SAMLFlow {
 	if qSAMLFlow.ErrorEmailOutsideOrganizationDomains != nil {
 		res.Error = &ssoreadyv1.SAMLFlow_EmailOutsideOrganizationDomains{EmailOutsideOrganizationDomains: *qSAMLFlow.ErrorEmailOutsideOrganizationDomains}
 	}
+	if qSAMLFlow.ErrorIdpInitiatedNotAllowed {
+		res.Error = &ssoreadyv1.SAMLFlow_IdpInitiatedNotAllowed{IdpInitiatedNotAllowed: &emptypb.Empty{}}
+	}
+	if qSAMLFlow.ErrorExpiredAssertion {
+		res.Error = &ssoreadyv1.SAMLFlow_ExpiredAssertion{ExpiredAssertion: &emptypb.Empty{}}
+	}
+	if qSAMLFlow.ErrorExpiredIdpInitiatedAssertion {
+		res.Error = &ssoreadyv1.SAMLFlow_ExpiredIdpInitiatedAssertion{ExpiredIdpInitiatedAssertion: &emptypb.Empty{}}
+	}
+	if qSAMLFlow.ErrorExpiredSubjectConfirmation {
+		res.Error = &ssoreadyv1.SAMLFlow_ExpiredSubjectConfirmation{ExpiredSubjectConfirmation: &emptypb.Empty{}}
+	}
+	if qSAMLFlow.ErrorBadDestination != nil {
+		res.Error = &ssoreadyv1.SAMLFlow_BadDestination{BadDestination: *qSAMLFlow.ErrorBadDestination}
+	}
+	if qSAMLFlow.ErrorBadRecipient != nil {
+		res.Error = &ssoreadyv1.SAMLFlow_BadRecipient{BadRecipient: *qSAMLFlow.ErrorBadRecipient}
+	}
+	if qSAMLFlow.ErrorBadSubjectConfirmationMethod != nil {
+		res.Error = &ssoreadyv1.SAMLFlow_BadSubjectConfirmationMethod{BadSubjectConfirmationMethod: *qSAMLFlow.ErrorBadSubjectConfirmationMethod}
+	}
+	if qSAMLFlow.ErrorIdpStatusCode != nil {
+		res.Error = &ssoreadyv1.SAMLFlow_IdpStatus{
+			IdpStatus: &ssoreadyv1.SAMLFlowIDPStatus{
+				StatusCode:    *qSAMLFlow.ErrorIdpStatusCode,
+				SubStatusCode: derefOrEmpty(qSAMLFlow.ErrorIdpSubStatusCode),
+				StatusMessage: derefOrEmpty(qSAMLFlow.ErrorIdpStatusMessage),
+			},
+		}
+	}
+	if qSAMLFlow.ErrorArtifactResolution != nil {
+		res.Error = &ssoreadyv1.SAMLFlow_ArtifactResolutionFailed{ArtifactResolutionFailed: *qSAMLFlow.ErrorArtifactResolution}
+	}
+	if qSAMLFlow.UnverifiedIdpStatusCode != nil {
+		res.UnverifiedIdpStatus = &ssoreadyv1.SAMLFlowIDPStatus{
+			StatusCode:    *qSAMLFlow.UnverifiedIdpStatusCode,
+			SubStatusCode: derefOrEmpty(qSAMLFlow.UnverifiedIdpSubStatusCode),
+			StatusMessage: derefOrEmpty(qSAMLFlow.UnverifiedIdpStatusMessage),
+		}
+	}
 
 	return &res
 }
+
+// parseSubjectIDPAttributes parses saml_flows.subject_idp_attributes, which
+// maps each attribute to a list of values. SAML flows from before multi-valued
+// attributes were supported map each attribute to a single string instead.
+func parseSubjectIDPAttributes(data []byte) (map[string][]string, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var rawAttrs map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawAttrs); err != nil {
+		return nil, err
+	}
+
+	attrs := map[string][]string{}
+	for name, rawValues := range rawAttrs {
+		var values []string
+		if err := json.Unmarshal(rawValues, &values); err != nil {
+			var value string
+			if err := json.Unmarshal(rawValues, &value); err != nil {
+				return nil, err
+			}
+			values = []string{value}
+		}
+		attrs[name] = values
+	}
+	return attrs, nil
+}
+
+// firstAttributeValues returns the first value of each attribute, for the
+// single-valued attributes fields in the API.
+func firstAttributeValues(attrs map[string][]string) map[string]string {
+	if attrs == nil {
+		return nil
+	}
+
+	out := map[string]string{}
+	for name, values := range attrs {
+		var value string
+		if len(values) > 0 {
+			value = values[0]
+		}
+		out[name] = value
+	}
+	return out
+}
+
+func samlAttributeValues(attrs map[string][]string) map[string]*ssoreadyv1.SAMLAttributeValues {
+	if attrs == nil {
+		return nil
+	}
+
+	out := map[string]*ssoreadyv1.SAMLAttributeValues{}
+	for name, values := range attrs {
+		out[name] = &ssoreadyv1.SAMLAttributeValues{Values: values}
+	}
+	return out
+}
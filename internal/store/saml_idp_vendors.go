@@ -0,0 +1,152 @@
+package store
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/saml"
+	"github.com/ssoready/ssoready/internal/samlprofile"
+	"github.com/ssoready/ssoready/internal/store/queries"
+)
+
+var samlIDPVendors = map[ssoreadyv1.SAMLIDPVendor]saml.Vendor{
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_OKTA:      saml.VendorOkta,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_ENTRA:     saml.VendorEntra,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_GOOGLE:    saml.VendorGoogle,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_ADFS:      saml.VendorADFS,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_JUMPCLOUD: saml.VendorJumpCloud,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_PING:      saml.VendorPing,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_KEYCLOAK:  saml.VendorKeycloak,
+	ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_ONELOGIN:  saml.VendorOneLogin,
+}
+
+// samlIDPVendorAttributeMappingPresets are the attribute mapping presets that
+// match each vendor's default attribute names. Vendors without an entry have
+// no conventional attribute names.
+var samlIDPVendorAttributeMappingPresets = map[saml.Vendor]string{
+	saml.VendorOkta:     samlprofile.PresetOkta,
+	saml.VendorEntra:    samlprofile.PresetEntra,
+	saml.VendorGoogle:   samlprofile.PresetGoogle,
+	saml.VendorADFS:     samlprofile.PresetADFS,
+	saml.VendorPing:     samlprofile.PresetOID,
+	saml.VendorKeycloak: samlprofile.PresetOID,
+}
+
+// samlIDPVendorNameIDFormats are the NameID formats to request from vendors
+// that honor the format requested in an AuthnRequest's NameIDPolicy, and whose
+// default NameID is often not an email address: Entra's is the user principal
+// name, and Keycloak's is the username.
+var samlIDPVendorNameIDFormats = map[saml.Vendor]string{
+	saml.VendorEntra:    "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+	saml.VendorKeycloak: "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+}
+
+// samlIDPVendorsRequiringSignedAuthnRequests are the vendors that, by default,
+// reject AuthnRequests that aren't signed. Keycloak clients require a signature
+// unless "Client signature required" is turned off.
+var samlIDPVendorsRequiringSignedAuthnRequests = map[saml.Vendor]struct{}{
+	saml.VendorKeycloak: {},
+}
+
+// samlIDPVendorAllowedClockSkewSeconds are the clock skews to allow for vendors
+// that don't allow for any themselves. ADFS makes assertions valid from the
+// instant they are issued, unless its NotBeforeSkew is configured, so any
+// drift between its clock and ours rejects them.
+var samlIDPVendorAllowedClockSkewSeconds = map[saml.Vendor]int32{
+	saml.VendorADFS: 60,
+}
+
+// samlIDPVendor is the inverse of the vendor stored in the database.
+func samlIDPVendor(vendor *string) ssoreadyv1.SAMLIDPVendor {
+	for v, name := range samlIDPVendors {
+		if vendor != nil && *vendor == string(name) {
+			return v
+		}
+	}
+	return ssoreadyv1.SAMLIDPVendor_SAML_IDP_VENDOR_UNSPECIFIED
+}
+
+// detectIDPVendor updates the IDP vendor of a SAML connection from its IDP
+// settings. When the vendor changes, the connection's settings default to the
+// vendor's, wherever they aren't already set: the attribute mapping preset, the
+// NameID format requested in AuthnRequests, whether AuthnRequests are signed,
+// and the allowed clock skew.
+func (s *Store) detectIDPVendor(ctx context.Context, q *queries.Queries, qSAMLConn queries.SamlConnection) (queries.SamlConnection, error) {
+	var cert *x509.Certificate
+	if len(qSAMLConn.IdpX509Certificate) != 0 {
+		var err error
+		cert, err = x509.ParseCertificate(qSAMLConn.IdpX509Certificate)
+		if err != nil {
+			return queries.SamlConnection{}, fmt.Errorf("parse idp certificate: %w", err)
+		}
+	}
+
+	vendor := saml.DetectVendor(&saml.DetectVendorRequest{
+		EntityID: derefOrEmpty(qSAMLConn.IdpEntityID),
+		URLs: []string{
+			derefOrEmpty(qSAMLConn.IdpRedirectUrl),
+			derefOrEmpty(qSAMLConn.IdpSloUrl),
+			derefOrEmpty(qSAMLConn.IdpArtifactResolutionUrl),
+		},
+		Certificate: cert,
+	})
+
+	if string(vendor) == derefOrEmpty(qSAMLConn.IdpVendor) {
+		return qSAMLConn, nil
+	}
+
+	attributeMappingPreset := qSAMLConn.AttributeMappingPreset
+	if preset, ok := samlIDPVendorAttributeMappingPresets[vendor]; ok && attributeMappingPreset == nil {
+		attributeMappingPreset = &preset
+	}
+
+	authnRequestOptions := qSAMLConn.AuthnRequestOptions
+	if format, ok := samlIDPVendorNameIDFormats[vendor]; ok {
+		options, err := unmarshalAuthnRequestOptions(authnRequestOptions)
+		if err != nil {
+			return queries.SamlConnection{}, err
+		}
+
+		if options.NameIDPolicyFormat == "" {
+			options.NameIDPolicyFormat = format
+			authnRequestOptions, err = json.Marshal(options)
+			if err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	signAuthnRequests := qSAMLConn.SignAuthnRequests
+	if _, ok := samlIDPVendorsRequiringSignedAuthnRequests[vendor]; ok {
+		signAuthnRequests = true
+	}
+
+	allowedClockSkewSeconds := qSAMLConn.AllowedClockSkewSeconds
+	if skew, ok := samlIDPVendorAllowedClockSkewSeconds[vendor]; ok && allowedClockSkewSeconds == 0 {
+		allowedClockSkewSeconds = skew
+	}
+
+	qSAMLConn, err := q.UpdateSAMLConnectionIDPVendor(ctx, queries.UpdateSAMLConnectionIDPVendorParams{
+		IdpVendor:               nilIfZero(string(vendor)),
+		AttributeMappingPreset:  attributeMappingPreset,
+		AuthnRequestOptions:     authnRequestOptions,
+		SignAuthnRequests:       signAuthnRequests,
+		AllowedClockSkewSeconds: allowedClockSkewSeconds,
+		ID:                      qSAMLConn.ID,
+	})
+	if err != nil {
+		return queries.SamlConnection{}, fmt.Errorf("update saml connection idp vendor: %w", err)
+	}
+
+	if qSAMLConn.SignAuthnRequests {
+		qSAMLConn, err = s.ensureSPSigningKey(ctx, q, qSAMLConn)
+		if err != nil {
+			return queries.SamlConnection{}, fmt.Errorf("generate sp signing key: %w", err)
+		}
+	}
+
+	return qSAMLConn, nil
+}
@@ -0,0 +1,242 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/ssoready/ssoready/internal/authn"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/store/idformat"
+	"github.com/ssoready/ssoready/internal/store/queries"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Store) GetSAMLLogoutRedirectURL(ctx context.Context, req *ssoreadyv1.GetSAMLLogoutRedirectURLRequest) (*ssoreadyv1.GetSAMLLogoutRedirectURLResponse, error) {
+	_, q, commit, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	authnData := authn.FullContextData(ctx)
+	if authnData.APIKey == nil {
+		return nil, connect.NewError(connect.CodeUnauthenticated, fmt.Errorf("api key authentication is required"))
+	}
+
+	envID, err := idformat.Environment.Parse(authnData.APIKey.EnvID)
+	if err != nil {
+		return nil, err
+	}
+
+	samlFlowID, err := idformat.SAMLFlow.Parse(req.SamlFlowId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bad saml_flow_id: %w", err))
+	}
+
+	qSAMLFlow, err := q.ManagementGetSAMLFlow(ctx, queries.ManagementGetSAMLFlowParams{
+		EnvironmentID: envID,
+		ID:            samlFlowID,
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("saml flow not found"))
+		}
+		return nil, err
+	}
+
+	if qSAMLFlow.Status != queries.SamlFlowStatusSucceeded || qSAMLFlow.Email == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bad saml_flow_id: saml flow did not log a user in"))
+	}
+
+	// saml flows from before subject ids were recorded separately always had
+	// the subject's email as their subject id
+	subjectID := *qSAMLFlow.Email
+	if qSAMLFlow.SubjectID != nil {
+		subjectID = *qSAMLFlow.SubjectID
+	}
+
+	qSAMLConn, err := q.GetSAMLConnectionByID(ctx, qSAMLFlow.SamlConnectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if derefOrEmpty(qSAMLConn.IdpSloUrl) == "" {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("saml connection does not have an idp single logout url"))
+	}
+
+	qEnv, err := q.GetEnvironmentByID(ctx, envID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkSameOrigin(derefOrEmpty(qEnv.RedirectUrl), req.ReturnUrl); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bad return_url: %w", err))
+	}
+
+	var sessionIndexes []string
+	if qSAMLFlow.SubjectSessionIndex != nil && *qSAMLFlow.SubjectSessionIndex != "" {
+		sessionIndexes = []string{*qSAMLFlow.SubjectSessionIndex}
+	}
+
+	qSAMLLogout, err := q.CreateSAMLLogout(ctx, queries.CreateSAMLLogoutParams{
+		ID:               uuid.New(),
+		SamlConnectionID: qSAMLConn.ID,
+		IsIdpInitiated:   false,
+		SubjectID:        subjectID,
+		SubjectIDFormat:  qSAMLFlow.SubjectIDFormat,
+		SessionIndexes:   sessionIndexes,
+		SamlFlowIds:      []uuid.UUID{qSAMLFlow.ID},
+		ReturnUrl:        &req.ReturnUrl,
+		CreateTime:       time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authURL := s.defaultAuthURL
+	if qEnv.AuthUrl != nil {
+		authURL = *qEnv.AuthUrl
+	}
+
+	samlLogoutID := idformat.SAMLLogout.Format(qSAMLLogout.ID)
+
+	redirectURLQuery := url.Values{}
+	redirectURLQuery.Set("saml_logout_id", samlLogoutID)
+
+	redirectURL, err := url.Parse(authURL)
+	if err != nil {
+		return nil, err
+	}
+	redirectURL = redirectURL.JoinPath(fmt.Sprintf("/v1/saml/%s/slo/init", idformat.SAMLConnection.Format(qSAMLConn.ID)))
+	redirectURL.RawQuery = redirectURLQuery.Encode()
+
+	if err := commit(); err != nil {
+		return nil, err
+	}
+
+	return &ssoreadyv1.GetSAMLLogoutRedirectURLResponse{
+		RedirectUrl:  redirectURL.String(),
+		SamlLogoutId: samlLogoutID,
+	}, nil
+}
+
+// checkSameOrigin returns an error unless returnURL has the same scheme and
+// host as redirectURL.
+func checkSameOrigin(redirectURL, returnURL string) error {
+	base, err := url.Parse(redirectURL)
+	if err != nil || base.Host == "" {
+		return fmt.Errorf("environment does not have a redirect url")
+	}
+
+	u, err := url.Parse(returnURL)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != base.Scheme || u.Host != base.Host {
+		return fmt.Errorf("must have the same origin as the environment redirect url")
+	}
+	return nil
+}
+
+func (s *Store) ListSAMLLogouts(ctx context.Context, req *ssoreadyv1.ListSAMLLogoutsRequest) (*ssoreadyv1.ListSAMLLogoutsResponse, error) {
+	envID, err := idformat.Environment.Parse(authn.FullContextData(ctx).APIKey.EnvID)
+	if err != nil {
+		return nil, err
+	}
+
+	samlConnectionID, err := idformat.SAMLConnection.Parse(req.SamlConnectionId)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("bad saml_connection_id: %w", err))
+	}
+
+	_, q, _, rollback, err := s.tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rollback()
+
+	// idor check
+	if _, err = q.ManagementGetSAMLConnection(ctx, queries.ManagementGetSAMLConnectionParams{
+		EnvironmentID: envID,
+		ID:            samlConnectionID,
+	}); err != nil {
+		return nil, err
+	}
+
+	type pageData struct {
+		CreateTime time.Time
+		ID         uuid.UUID
+	}
+
+	var startPageData pageData
+	if err := s.pageEncoder.Unmarshal(req.PageToken, &startPageData); err != nil {
+		return nil, err
+	}
+
+	limit := 10
+	var qSAMLLogouts []queries.SamlLogout
+	if req.PageToken == "" {
+		qSAMLLogouts, err = q.ListSAMLLogoutsFirstPage(ctx, queries.ListSAMLLogoutsFirstPageParams{
+			SamlConnectionID: samlConnectionID,
+			Limit:            int32(limit + 1),
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		qSAMLLogouts, err = q.ListSAMLLogoutsNextPage(ctx, queries.ListSAMLLogoutsNextPageParams{
+			SamlConnectionID: samlConnectionID,
+			Limit:            int32(limit + 1),
+			CreateTime:       startPageData.CreateTime,
+			ID:               startPageData.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var logouts []*ssoreadyv1.SAMLLogout
+	for _, qSAMLLogout := range qSAMLLogouts {
+		logouts = append(logouts, parseSAMLLogout(qSAMLLogout))
+	}
+
+	var nextPageToken string
+	if len(logouts) == limit+1 {
+		nextPageToken = s.pageEncoder.Marshal(pageData{
+			CreateTime: qSAMLLogouts[limit].CreateTime,
+			ID:         qSAMLLogouts[limit].ID,
+		})
+		logouts = logouts[:limit]
+	}
+
+	return &ssoreadyv1.ListSAMLLogoutsResponse{
+		SamlLogouts:   logouts,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func parseSAMLLogout(qSAMLLogout queries.SamlLogout) *ssoreadyv1.SAMLLogout {
+	var samlFlowIDs []string
+	for _, id := range qSAMLLogout.SamlFlowIds {
+		samlFlowIDs = append(samlFlowIDs, idformat.SAMLFlow.Format(id))
+	}
+
+	return &ssoreadyv1.SAMLLogout{
+		Id:               idformat.SAMLLogout.Format(qSAMLLogout.ID),
+		SamlConnectionId: idformat.SAMLConnection.Format(qSAMLLogout.SamlConnectionID),
+		IdpInitiated:     qSAMLLogout.IsIdpInitiated,
+		Email:            qSAMLLogout.SubjectID,
+		SessionIndexes:   qSAMLLogout.SessionIndexes,
+		SamlFlowIds:      samlFlowIDs,
+		CreateTime:       timestamppb.New(qSAMLLogout.CreateTime),
+		CompleteTime:     ptrTimeToTimestamp(qSAMLLogout.CompleteTime),
+		Success:          derefOrEmpty(qSAMLLogout.Success),
+	}
+}
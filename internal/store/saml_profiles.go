@@ -0,0 +1,146 @@
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	ssoreadyv1 "github.com/ssoready/ssoready/internal/gen/ssoready/v1"
+	"github.com/ssoready/ssoready/internal/samlprofile"
+	"github.com/ssoready/ssoready/internal/store/queries"
+)
+
+var samlAttributeMappingPresets = map[ssoreadyv1.SAMLAttributeMappingPreset]string{
+	ssoreadyv1.SAMLAttributeMappingPreset_SAML_ATTRIBUTE_MAPPING_PRESET_OKTA:   samlprofile.PresetOkta,
+	ssoreadyv1.SAMLAttributeMappingPreset_SAML_ATTRIBUTE_MAPPING_PRESET_ENTRA:  samlprofile.PresetEntra,
+	ssoreadyv1.SAMLAttributeMappingPreset_SAML_ATTRIBUTE_MAPPING_PRESET_GOOGLE: samlprofile.PresetGoogle,
+	ssoreadyv1.SAMLAttributeMappingPreset_SAML_ATTRIBUTE_MAPPING_PRESET_ADFS:   samlprofile.PresetADFS,
+	ssoreadyv1.SAMLAttributeMappingPreset_SAML_ATTRIBUTE_MAPPING_PRESET_OID:    samlprofile.PresetOID,
+}
+
+// parseAttributeMapping converts the attribute mapping preset and attribute
+// mapping on a SAMLConnection into the values stored in the database. An
+// unspecified preset and an empty mapping are stored as NULL.
+func parseAttributeMapping(samlConn *ssoreadyv1.SAMLConnection) (*string, []byte, error) {
+	var preset *string
+	if samlConn.AttributeMappingPreset != ssoreadyv1.SAMLAttributeMappingPreset_SAML_ATTRIBUTE_MAPPING_PRESET_UNSPECIFIED {
+		name, ok := samlAttributeMappingPresets[samlConn.AttributeMappingPreset]
+		if !ok {
+			return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported attribute mapping preset: %s", samlConn.AttributeMappingPreset))
+		}
+		preset = &name
+	}
+
+	m := samlConn.AttributeMapping
+	if m == nil {
+		return preset, nil, nil
+	}
+
+	mapping := samlprofile.Mapping{
+		FirstName:   m.FirstName,
+		LastName:    m.LastName,
+		DisplayName: m.DisplayName,
+		Groups:      m.Groups,
+		Department:  m.Department,
+		JobTitle:    m.JobTitle,
+		EmployeeID:  m.EmployeeId,
+		PhoneNumber: m.PhoneNumber,
+	}
+
+	empty := true
+	for _, names := range [][]string{mapping.FirstName, mapping.LastName, mapping.DisplayName, mapping.Groups, mapping.Department, mapping.JobTitle, mapping.EmployeeID, mapping.PhoneNumber} {
+		for _, name := range names {
+			if strings.TrimSpace(name) == "" {
+				return nil, nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("attribute mapping names must not be empty"))
+			}
+			empty = false
+		}
+	}
+
+	if empty {
+		return preset, nil, nil
+	}
+
+	data, err := json.Marshal(mapping)
+	if err != nil {
+		panic(err)
+	}
+
+	return preset, data, nil
+}
+
+// samlConnectionAttributeMapping is the inverse of parseAttributeMapping.
+func samlConnectionAttributeMapping(qSAMLConn queries.SamlConnection) (ssoreadyv1.SAMLAttributeMappingPreset, *ssoreadyv1.SAMLAttributeMapping) {
+	var preset ssoreadyv1.SAMLAttributeMappingPreset
+	for p, name := range samlAttributeMappingPresets {
+		if qSAMLConn.AttributeMappingPreset != nil && *qSAMLConn.AttributeMappingPreset == name {
+			preset = p
+		}
+	}
+
+	mapping, err := unmarshalAttributeMapping(qSAMLConn.AttributeMapping)
+	if err != nil {
+		panic(err)
+	}
+
+	return preset, &ssoreadyv1.SAMLAttributeMapping{
+		FirstName:   mapping.FirstName,
+		LastName:    mapping.LastName,
+		DisplayName: mapping.DisplayName,
+		Groups:      mapping.Groups,
+		Department:  mapping.Department,
+		JobTitle:    mapping.JobTitle,
+		EmployeeId:  mapping.EmployeeID,
+		PhoneNumber: mapping.PhoneNumber,
+	}
+}
+
+// effectiveAttributeMapping returns the mapping a SAML connection applies: its
+// preset, overridden by any fields of its own mapping.
+func effectiveAttributeMapping(preset *string, data []byte) (samlprofile.Mapping, error) {
+	mapping, err := unmarshalAttributeMapping(data)
+	if err != nil {
+		return samlprofile.Mapping{}, err
+	}
+
+	if preset == nil {
+		return mapping, nil
+	}
+
+	return samlprofile.Presets[*preset].Override(mapping), nil
+}
+
+func unmarshalAttributeMapping(data []byte) (samlprofile.Mapping, error) {
+	var mapping samlprofile.Mapping
+	if len(data) == 0 {
+		return mapping, nil
+	}
+
+	if err := json.Unmarshal(data, &mapping); err != nil {
+		return samlprofile.Mapping{}, fmt.Errorf("unmarshal attribute mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// parseSubjectProfile parses saml_flows.subject_profile. SAML flows from
+// before attribute mappings have an empty profile.
+func parseSubjectProfile(data []byte) (*ssoreadyv1.SAMLProfile, error) {
+	var profile samlprofile.Profile
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &profile); err != nil {
+			return nil, fmt.Errorf("unmarshal subject profile: %w", err)
+		}
+	}
+
+	return &ssoreadyv1.SAMLProfile{
+		FirstName:   profile.FirstName,
+		LastName:    profile.LastName,
+		DisplayName: profile.DisplayName,
+		Groups:      profile.Groups,
+		Department:  profile.Department,
+		JobTitle:    profile.JobTitle,
+		EmployeeId:  profile.EmployeeID,
+		PhoneNumber: profile.PhoneNumber,
+	}, nil
+}
@@ -63,6 +63,15 @@ func derefOrEmpty[T any](t *T) T {
 	return *t
 }
 
+// nilIfZero returns nil if t is the zero value of its type, and &t otherwise.
+func nilIfZero[T comparable](t T) *T {
+	var z T
+	if t == z {
+		return nil
+	}
+	return &t
+}
+
 func ptrTimeToTimestamp(t *time.Time) *timestamppb.Timestamp {
 	if t == nil {
 		return nil
@@ -40,7 +40,23 @@ on conflict (app_organization_id) do update set dummyidp_app_id               =
 returning *;
 
 -- name: AuthGetInitData :one
-select idp_redirect_url, sp_entity_id
+select idp_redirect_url,
+       sp_entity_id,
+       sign_authn_requests,
+       sp_signing_private_key,
+       sp_signing_certificate,
+       idp_binding,
+       authn_request_options
+from saml_connections
+where saml_connections.id = $1;
+
+-- name: AuthGetMetadataData :one
+select sp_entity_id,
+       sp_acs_url,
+       sign_authn_requests,
+       sp_signing_certificate,
+       sp_encryption_certificate,
+       idp_artifact_resolution_url
 from saml_connections
 where saml_connections.id = $1;
 
@@ -48,6 +64,20 @@ where saml_connections.id = $1;
 select saml_connections.sp_entity_id,
        saml_connections.idp_entity_id,
        saml_connections.idp_x509_certificate,
+       saml_connections.allowed_signature_algorithms,
+       saml_connections.allowed_digest_algorithms,
+       saml_connections.sp_encryption_private_key,
+       saml_connections.sp_previous_encryption_private_key,
+       saml_connections.allow_idp_initiated,
+       saml_connections.email_attribute_names,
+       saml_connections.attribute_mapping_preset,
+       saml_connections.attribute_mapping,
+       saml_connections.sp_acs_url,
+       saml_connections.allowed_clock_skew_seconds,
+       saml_connections.idp_artifact_resolution_url,
+       saml_connections.sign_authn_requests,
+       saml_connections.sp_signing_private_key,
+       saml_connections.sp_signing_certificate,
        environments.redirect_url,
        environments.oauth_redirect_uri,
        environments.admin_url
@@ -78,8 +108,9 @@ insert into saml_flows (id, saml_connection_id, expire_time, state, create_time,
                         auth_redirect_url, get_redirect_time, status,
                         test_mode_idp,
                         error_saml_connection_not_configured,
-                        error_environment_oauth_redirect_uri_not_configured)
-values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+                        error_environment_oauth_redirect_uri_not_configured,
+                        authn_request_options)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 returning *;
 
 -- name: UpsertSAMLFlowInitiate :one
@@ -102,9 +133,20 @@ insert into saml_flows (id, saml_connection_id, assertion_id,
                         error_bad_audience, error_bad_signature_algorithm,
                         error_bad_digest_algorithm, error_bad_x509_certificate,
                         error_bad_subject_id,
-                        error_email_outside_organization_domains, status)
+                        error_email_outside_organization_domains, status,
+                        response_signed, assertion_signed,
+                        idp_x509_certificate, is_idp_initiated,
+                        error_idp_initiated_not_allowed,
+                        error_expired_assertion,
+                        error_expired_subject_confirmation,
+                        error_bad_destination, error_bad_recipient,
+                        error_bad_subject_confirmation_method,
+                        error_idp_status_code, error_idp_sub_status_code,
+                        error_idp_status_message, error_artifact_resolution,
+                        idp_vendor, error_expired_idp_initiated_assertion)
 values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16,
-        $17, $18, $19, $20)
+        $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30,
+        $31, $32, $33, $34, $35, $36)
 on conflict (id) do update set assertion_id                             = excluded.assertion_id,
                                access_code_sha256                       = excluded.access_code_sha256,
                                update_time                              = excluded.update_time,
@@ -119,7 +161,22 @@ on conflict (id) do update set assertion_id                             = exclud
                                error_bad_x509_certificate               = excluded.error_bad_x509_certificate,
                                error_bad_subject_id                     = excluded.error_bad_subject_id,
                                error_email_outside_organization_domains = excluded.error_email_outside_organization_domains,
-                               status                                   = excluded.status
+                               status                                   = excluded.status,
+                               response_signed                          = excluded.response_signed,
+                               assertion_signed                         = excluded.assertion_signed,
+                               idp_x509_certificate                     = excluded.idp_x509_certificate,
+                               error_idp_initiated_not_allowed          = excluded.error_idp_initiated_not_allowed,
+                               error_expired_assertion                  = excluded.error_expired_assertion,
+                               error_expired_subject_confirmation       = excluded.error_expired_subject_confirmation,
+                               error_bad_destination                    = excluded.error_bad_destination,
+                               error_bad_recipient                      = excluded.error_bad_recipient,
+                               error_bad_subject_confirmation_method    = excluded.error_bad_subject_confirmation_method,
+                               error_idp_status_code                    = excluded.error_idp_status_code,
+                               error_idp_sub_status_code                = excluded.error_idp_sub_status_code,
+                               error_idp_status_message                 = excluded.error_idp_status_message,
+                               error_artifact_resolution                = excluded.error_artifact_resolution,
+                               idp_vendor                               = excluded.idp_vendor,
+                               error_expired_idp_initiated_assertion    = excluded.error_expired_idp_initiated_assertion
 returning *;
 
 -- name: UpdateSAMLFlowRedeem :one
@@ -139,11 +196,26 @@ where id = $1;
 
 -- name: UpdateSAMLFlowSubjectData :one
 update saml_flows
-set email                  = $1,
-    subject_idp_attributes = $2
-where id = $3
+set email                           = $1,
+    subject_idp_attributes          = $2,
+    subject_session_index           = $3,
+    subject_id                      = $4,
+    subject_id_format               = $5,
+    subject_profile                 = $6,
+    subject_authn_instant           = $7,
+    subject_session_not_on_or_after = $8,
+    subject_authn_context_class_ref = $9
+where id = $10
 returning *;
 
+-- name: UpdateSAMLFlowUnverifiedIDPStatus :exec
+update saml_flows
+set unverified_idp_status_code     = $1,
+    unverified_idp_sub_status_code = $2,
+    unverified_idp_status_message  = $3
+where id = $4
+  and saml_connection_id = $5;
+
 -- name: GetPrimarySAMLConnectionIDByOrganizationID :one
 select saml_connections.id
 from saml_connections
@@ -199,7 +271,14 @@ where secret_value_sha256 = $1;
 -- name: GetSAMLAccessCodeData :one
 select saml_flows.id             as saml_flow_id,
        saml_flows.email,
+       saml_flows.subject_id,
+       saml_flows.subject_id_format,
        saml_flows.subject_idp_attributes,
+       saml_flows.subject_profile,
+       saml_flows.subject_session_index,
+       saml_flows.subject_authn_instant,
+       saml_flows.subject_session_not_on_or_after,
+       saml_flows.subject_authn_context_class_ref,
        saml_flows.state,
        organizations.id          as organization_id,
        organizations.external_id as organization_external_id,
@@ -434,6 +513,23 @@ where organization_id = $1
 order by id
 limit $3;
 
+-- name: ListSAMLConnectionsWithIDPCertificateByEnvironmentID :many
+select saml_connections.*
+from saml_connections
+         join organizations
+              on saml_connections.organization_id = organizations.id
+where organizations.environment_id = $1
+  and saml_connections.idp_x509_certificate is not null;
+
+-- name: ListSAMLConnectionIDPCertificatesByEnvironmentID :many
+select saml_connection_idp_certificates.*
+from saml_connection_idp_certificates
+         join saml_connections
+              on saml_connection_idp_certificates.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+where organizations.environment_id = $1;
+
 -- name: GetSAMLConnection :one
 select saml_connections.*
 from saml_connections
@@ -455,17 +551,62 @@ where organizations.environment_id = $1
 insert into saml_connections (id, organization_id, sp_entity_id, sp_acs_url,
                               idp_entity_id, idp_redirect_url,
                               idp_x509_certificate,
-                              is_primary)
-values ($1, $2, $3, $4, $5, $6, $7, $8)
+                              is_primary, allowed_signature_algorithms,
+                              allowed_digest_algorithms, sign_authn_requests,
+                              idp_binding, allow_idp_initiated, idp_slo_url,
+                              idp_metadata_url, email_attribute_names,
+                              attribute_mapping_preset, attribute_mapping,
+                              authn_request_options, allowed_clock_skew_seconds,
+                              idp_artifact_resolution_url)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17,
+        $18, $19, $20, $21)
 returning *;
 
 -- name: UpdateSAMLConnection :one
 update saml_connections
-set idp_entity_id        = $1,
-    idp_redirect_url     = $2,
-    idp_x509_certificate = $3,
-    is_primary           = $4
-where id = $5
+set idp_entity_id                = $1,
+    idp_redirect_url             = $2,
+    idp_x509_certificate         = $3,
+    is_primary                   = $4,
+    allowed_signature_algorithms = $5,
+    allowed_digest_algorithms    = $6,
+    sign_authn_requests          = $7,
+    idp_binding                  = $8,
+    allow_idp_initiated          = $9,
+    idp_slo_url                  = $10,
+    idp_metadata_url             = $11,
+    email_attribute_names        = $12,
+    attribute_mapping_preset     = $13,
+    attribute_mapping            = $14,
+    authn_request_options        = $15,
+    allowed_clock_skew_seconds   = $16,
+    idp_artifact_resolution_url  = $17
+where id = $18
+returning *;
+
+-- name: UpdateSAMLConnectionIDPVendor :one
+update saml_connections
+set idp_vendor                 = $1,
+    attribute_mapping_preset   = $2,
+    authn_request_options      = $3,
+    sign_authn_requests        = $4,
+    allowed_clock_skew_seconds = $5
+where id = $6
+returning *;
+
+-- name: UpdateSAMLConnectionSPEncryptionKey :one
+update saml_connections
+set sp_previous_encryption_private_key = sp_encryption_private_key,
+    sp_encryption_private_key          = $1,
+    sp_encryption_certificate          = $2
+where id = $3
+returning *;
+
+-- name: UpdateSAMLConnectionSPSigningKey :one
+update saml_connections
+set sp_signing_private_key = $1,
+    sp_signing_certificate = $2
+where id = $3
 returning *;
 
 -- name: UpdatePrimarySAMLConnection :exec
@@ -483,6 +624,123 @@ delete
 from saml_flows
 where saml_connection_id = $1;
 
+-- name: ListSAMLConnectionIDPCertificates :many
+select *
+from saml_connection_idp_certificates
+where saml_connection_id = $1
+order by create_time;
+
+-- name: GetSAMLConnectionIDPCertificate :one
+select saml_connection_idp_certificates.*
+from saml_connection_idp_certificates
+         join saml_connections
+              on saml_connection_idp_certificates.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+         join environments on organizations.environment_id = environments.id
+where environments.app_organization_id = $1
+  and saml_connection_idp_certificates.id = $2;
+
+-- name: CreateSAMLConnectionIDPCertificate :one
+insert into saml_connection_idp_certificates (id, saml_connection_id,
+                                              x509_certificate, not_before,
+                                              not_after, create_time,
+                                              from_idp_metadata)
+values ($1, $2, $3, $4, $5, $6, $7)
+returning *;
+
+-- name: DeleteSAMLConnectionIDPCertificate :exec
+delete
+from saml_connection_idp_certificates
+where id = $1;
+
+-- name: DeleteSAMLConnectionIDPCertificatesBySAMLConnectionID :execrows
+delete
+from saml_connection_idp_certificates
+where saml_connection_id = $1;
+
+-- name: ListSAMLConnectionsDueForIDPMetadataRefresh :many
+select *
+from saml_connections
+where idp_metadata_url is not null
+  and idp_metadata_url != ''
+  and (idp_metadata_refresh_time is null or idp_metadata_refresh_time < $1)
+order by idp_metadata_refresh_time nulls first
+limit $2;
+
+-- name: GetSAMLConnectionForIDPMetadataRefresh :one
+select *
+from saml_connections
+where id = $1
+    for update;
+
+-- name: UpdateSAMLConnectionIDPMetadata :one
+update saml_connections
+set idp_entity_id              = $1,
+    idp_redirect_url           = $2,
+    idp_x509_certificate       = $3,
+    idp_metadata_refresh_time  = $4,
+    idp_metadata_refresh_error = $5
+where id = $6
+returning *;
+
+-- name: CreateSAMLConnectionIDPMetadataUpdate :one
+insert into saml_connection_idp_metadata_updates (id, saml_connection_id,
+                                                  create_time,
+                                                  previous_idp_entity_id,
+                                                  idp_entity_id,
+                                                  previous_idp_redirect_url,
+                                                  idp_redirect_url,
+                                                  previous_idp_x509_certificate,
+                                                  idp_x509_certificate, pending)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+returning *;
+
+-- name: GetSAMLConnectionIDPMetadataUpdate :one
+select saml_connection_idp_metadata_updates.*
+from saml_connection_idp_metadata_updates
+         join saml_connections
+              on saml_connection_idp_metadata_updates.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+         join environments on organizations.environment_id = environments.id
+where environments.app_organization_id = $1
+  and saml_connection_idp_metadata_updates.id = $2;
+
+-- name: GetLatestSAMLConnectionIDPMetadataUpdate :one
+select *
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+order by (create_time, id) desc
+limit 1;
+
+-- name: AcceptSAMLConnectionIDPMetadataUpdate :one
+update saml_connection_idp_metadata_updates
+set pending     = false,
+    accept_time = $1
+where id = $2
+returning *;
+
+-- name: ListSAMLConnectionIDPMetadataUpdatesFirstPage :many
+select *
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+order by (create_time, id) desc
+limit $2;
+
+-- name: ListSAMLConnectionIDPMetadataUpdatesNextPage :many
+select *
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1
+  and (create_time, id) <= (@create_time, @id::uuid)
+order by (create_time, id) desc
+limit $2;
+
+-- name: DeleteSAMLConnectionIDPMetadataUpdatesBySAMLConnectionID :execrows
+delete
+from saml_connection_idp_metadata_updates
+where saml_connection_id = $1;
+
 -- name: ListSAMLFlowsFirstPage :many
 select *
 from saml_flows
@@ -981,3 +1239,71 @@ where scim_directory_id = $1;
 delete
 from scim_requests
 where scim_directory_id = $1;
+
+-- name: CreateSAMLLogout :one
+insert into saml_logouts (id, saml_connection_id, is_idp_initiated, subject_id,
+                          session_indexes, saml_flow_ids, return_url,
+                          create_time, complete_time, success,
+                          subject_id_format)
+values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+returning *;
+
+-- name: AuthListSAMLLogoutFlowIDs :many
+select id
+from saml_flows
+where saml_connection_id = @saml_connection_id
+  and coalesce(subject_id, email) = @subject_id::varchar
+  and status = 'succeeded'
+  and (cardinality(@session_indexes::varchar[]) = 0 or
+       subject_session_index = any (@session_indexes::varchar[]));
+
+-- name: AuthGetSAMLLogout :one
+select *
+from saml_logouts
+where saml_connection_id = $1
+  and id = $2;
+
+-- name: AuthUpdateSAMLLogoutInitiate :one
+update saml_logouts
+set initiate_time = $1
+where id = $2
+  and initiate_time is null
+returning *;
+
+-- name: AuthUpdateSAMLLogoutComplete :one
+update saml_logouts
+set complete_time = $1,
+    success       = $2
+where id = $3
+  and complete_time is null
+returning *;
+
+-- name: ManagementGetSAMLFlow :one
+select saml_flows.*
+from saml_flows
+         join saml_connections
+              on saml_flows.saml_connection_id = saml_connections.id
+         join organizations
+              on saml_connections.organization_id = organizations.id
+where organizations.environment_id = $1
+  and saml_flows.id = $2;
+
+-- name: ListSAMLLogoutsFirstPage :many
+select *
+from saml_logouts
+where saml_connection_id = $1
+order by (create_time, id) desc
+limit $2;
+
+-- name: ListSAMLLogoutsNextPage :many
+select *
+from saml_logouts
+where saml_connection_id = $1
+  and (create_time, id) <= (@create_time, @id::uuid)
+order by (create_time, id) desc
+limit $2;
+
+-- name: DeleteSAMLLogoutsBySAMLConnectionID :execrows
+delete
+from saml_logouts
+where saml_connection_id = $1;
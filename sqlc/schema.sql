@@ -224,6 +224,44 @@ CREATE TABLE public.organizations (
 
 ALTER TABLE public.organizations OWNER TO postgres;
 
+--
+-- Name: saml_connection_idp_certificates; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.saml_connection_idp_certificates (
+    id uuid NOT NULL,
+    saml_connection_id uuid NOT NULL,
+    x509_certificate bytea NOT NULL,
+    not_before timestamp with time zone,
+    not_after timestamp with time zone,
+    create_time timestamp with time zone NOT NULL,
+    from_idp_metadata boolean DEFAULT false NOT NULL
+);
+
+
+ALTER TABLE public.saml_connection_idp_certificates OWNER TO postgres;
+
+--
+-- Name: saml_connection_idp_metadata_updates; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.saml_connection_idp_metadata_updates (
+    id uuid NOT NULL,
+    saml_connection_id uuid NOT NULL,
+    create_time timestamp with time zone NOT NULL,
+    previous_idp_entity_id character varying,
+    idp_entity_id character varying,
+    previous_idp_redirect_url character varying,
+    idp_redirect_url character varying,
+    previous_idp_x509_certificate bytea,
+    idp_x509_certificate bytea,
+    pending boolean DEFAULT false NOT NULL,
+    accept_time timestamp with time zone
+);
+
+
+ALTER TABLE public.saml_connection_idp_metadata_updates OWNER TO postgres;
+
 --
 -- Name: saml_connections; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -236,7 +274,28 @@ CREATE TABLE public.saml_connections (
     idp_entity_id character varying,
     sp_entity_id character varying NOT NULL,
     is_primary boolean DEFAULT false NOT NULL,
-    sp_acs_url character varying NOT NULL
+    sp_acs_url character varying NOT NULL,
+    allowed_signature_algorithms character varying[] DEFAULT '{}'::character varying[] NOT NULL,
+    allowed_digest_algorithms character varying[] DEFAULT '{}'::character varying[] NOT NULL,
+    sp_encryption_private_key bytea,
+    sp_encryption_certificate bytea,
+    sp_previous_encryption_private_key bytea,
+    sp_signing_private_key bytea,
+    sp_signing_certificate bytea,
+    sign_authn_requests boolean DEFAULT false NOT NULL,
+    idp_binding character varying,
+    allow_idp_initiated boolean DEFAULT false NOT NULL,
+    idp_slo_url character varying,
+    idp_metadata_url character varying,
+    idp_metadata_refresh_time timestamp with time zone,
+    idp_metadata_refresh_error character varying,
+    email_attribute_names character varying[] DEFAULT '{}'::character varying[] NOT NULL,
+    attribute_mapping_preset character varying,
+    attribute_mapping jsonb,
+    authn_request_options jsonb,
+    allowed_clock_skew_seconds integer DEFAULT 0 NOT NULL,
+    idp_artifact_resolution_url character varying,
+    idp_vendor character varying
 );
 
 
@@ -279,12 +338,61 @@ CREATE TABLE public.saml_flows (
     error_saml_connection_not_configured boolean DEFAULT false NOT NULL,
     error_environment_oauth_redirect_uri_not_configured boolean DEFAULT false NOT NULL,
     assertion_id character varying,
-    test_mode_idp character varying
+    test_mode_idp character varying,
+    response_signed boolean DEFAULT false NOT NULL,
+    assertion_signed boolean DEFAULT false NOT NULL,
+    idp_x509_certificate bytea,
+    is_idp_initiated boolean DEFAULT false NOT NULL,
+    error_idp_initiated_not_allowed boolean DEFAULT false NOT NULL,
+    subject_session_index character varying,
+    subject_id character varying,
+    subject_id_format character varying,
+    subject_profile jsonb,
+    authn_request_options jsonb,
+    error_expired_assertion boolean DEFAULT false NOT NULL,
+    error_expired_subject_confirmation boolean DEFAULT false NOT NULL,
+    error_bad_destination character varying,
+    error_bad_recipient character varying,
+    error_bad_subject_confirmation_method character varying,
+    error_idp_status_code character varying,
+    error_idp_sub_status_code character varying,
+    error_idp_status_message character varying,
+    subject_authn_instant timestamp with time zone,
+    subject_session_not_on_or_after timestamp with time zone,
+    subject_authn_context_class_ref character varying,
+    error_artifact_resolution character varying,
+    idp_vendor character varying,
+    unverified_idp_status_code character varying,
+    unverified_idp_sub_status_code character varying,
+    unverified_idp_status_message character varying,
+    error_expired_idp_initiated_assertion boolean DEFAULT false NOT NULL
 );
 
 
 ALTER TABLE public.saml_flows OWNER TO postgres;
 
+--
+-- Name: saml_logouts; Type: TABLE; Schema: public; Owner: postgres
+--
+
+CREATE TABLE public.saml_logouts (
+    id uuid NOT NULL,
+    saml_connection_id uuid NOT NULL,
+    is_idp_initiated boolean NOT NULL,
+    subject_id character varying NOT NULL,
+    session_indexes character varying[] DEFAULT '{}'::character varying[] NOT NULL,
+    saml_flow_ids uuid[] DEFAULT '{}'::uuid[] NOT NULL,
+    return_url character varying,
+    create_time timestamp with time zone NOT NULL,
+    initiate_time timestamp with time zone,
+    complete_time timestamp with time zone,
+    success boolean,
+    subject_id_format character varying
+);
+
+
+ALTER TABLE public.saml_logouts OWNER TO postgres;
+
 --
 -- Name: saml_oauth_clients; Type: TABLE; Schema: public; Owner: postgres
 --
@@ -542,6 +650,22 @@ ALTER TABLE ONLY public.organizations
     ADD CONSTRAINT organizations_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: saml_connection_idp_certificates saml_connection_idp_certificates_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saml_connection_idp_certificates
+    ADD CONSTRAINT saml_connection_idp_certificates_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: saml_connection_idp_metadata_updates saml_connection_idp_metadata_updates_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saml_connection_idp_metadata_updates
+    ADD CONSTRAINT saml_connection_idp_metadata_updates_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: saml_connections saml_connections_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -590,6 +714,14 @@ ALTER TABLE ONLY public.saml_oauth_clients
     ADD CONSTRAINT saml_oauth_clients_client_secret_sha256_key UNIQUE (client_secret_sha256);
 
 
+--
+-- Name: saml_logouts saml_logouts_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saml_logouts
+    ADD CONSTRAINT saml_logouts_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: saml_oauth_clients saml_oauth_clients_pkey; Type: CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -726,6 +858,22 @@ ALTER TABLE ONLY public.organizations
     ADD CONSTRAINT organizations_environment_id_fkey FOREIGN KEY (environment_id) REFERENCES public.environments(id);
 
 
+--
+-- Name: saml_connection_idp_certificates saml_connection_idp_certificates_saml_connection_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saml_connection_idp_certificates
+    ADD CONSTRAINT saml_connection_idp_certificates_saml_connection_id_fkey FOREIGN KEY (saml_connection_id) REFERENCES public.saml_connections(id);
+
+
+--
+-- Name: saml_connection_idp_metadata_updates saml_connection_idp_metadata_updates_saml_connection_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saml_connection_idp_metadata_updates
+    ADD CONSTRAINT saml_connection_idp_metadata_updates_saml_connection_id_fkey FOREIGN KEY (saml_connection_id) REFERENCES public.saml_connections(id);
+
+
 --
 -- Name: saml_connections saml_connections_organization_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --
@@ -742,6 +890,14 @@ ALTER TABLE ONLY public.saml_flows
     ADD CONSTRAINT saml_flows_saml_connection_id_fkey FOREIGN KEY (saml_connection_id) REFERENCES public.saml_connections(id);
 
 
+--
+-- Name: saml_logouts saml_logouts_saml_connection_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
+--
+
+ALTER TABLE ONLY public.saml_logouts
+    ADD CONSTRAINT saml_logouts_saml_connection_id_fkey FOREIGN KEY (saml_connection_id) REFERENCES public.saml_connections(id);
+
+
 --
 -- Name: saml_oauth_clients saml_oauth_clients_environment_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: postgres
 --